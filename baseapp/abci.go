@@ -1,17 +1,20 @@
 package baseapp
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"sort"
 	"strings"
 	"syscall"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // InitChain implements the ABCI interface. It runs the initialization logic
@@ -34,8 +37,12 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 		return
 	}
 
-	// add block gas meter for any genesis transactions (allow infinite gas)
-	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	// add block and tx gas meters for any genesis state and transactions (allow
+	// infinite gas) so that genesis is processed independently of the consensus
+	// max gas
+	app.deliverState.ctx = app.deliverState.ctx.
+		WithBlockGasMeter(sdk.NewInfiniteGasMeter()).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
 
 	res = app.initChainer(app.deliverState.ctx, req)
 
@@ -102,6 +109,16 @@ func (app *BaseApp) FilterPeerByID(info string) abci.ResponseQuery {
 
 // BeginBlock implements the ABCI application interface.
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
+	if err := app.checkHalt(req.Header.Height, req.Header.Time); err != nil {
+		// Halt the binary before anything of the block runs, and block until the
+		// node is shut down, so that the block is neither executed nor committed.
+		// As ABCI BeginBlock cannot return errors, this is the only way to refuse
+		// a block without committing a state Tendermint would not agree on.
+		app.logger.Error("refusing to execute block per halt configuration", "height", req.Header.Height, "err", err)
+		app.halt()
+		select {}
+	}
+
 	if app.cms.TracingEnabled() {
 		app.cms.SetTracingContext(sdk.TraceContext(
 			map[string]interface{}{"blockHeight": req.Header.Height},
@@ -125,6 +142,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithBlockHeight(req.Header.Height)
 	}
 
+	// run the pre-blocker before the block gas meter is set, as it may change
+	// the maximum block gas
+	preRes := app.preBlock(req)
+
 	// add block gas meter
 	var gasMeter sdk.GasMeter
 	if maxGas := app.getMaximumBlockGas(app.deliverState.ctx); maxGas > 0 {
@@ -138,8 +159,32 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
 	}
+	res.Events = append(preRes.Events, res.Events...)
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	app.resultLeaves = nil
+	app.addResultLeaf(0, nil, res.Events)
+	app.blockSummary = blockSummary{}
+
+	app.listenBeginBlock(app.deliverState.ctx, req, res)
+	return res
+}
+
+// preBlock runs the pre-blocker, if any, and panics if it fails so that the
+// block is not processed.
+func (app *BaseApp) preBlock(req abci.RequestBeginBlock) sdk.ResponsePreBlock {
+	app.consensusParamsChanged = false
+	if app.preBlocker == nil {
+		return sdk.ResponsePreBlock{}
+	}
+
+	res, err := app.preBlocker(app.deliverState.ctx, req)
+	if err != nil {
+		panic(fmt.Errorf("failed to run pre-blocker at height %d: %w", req.Header.Height, err))
+	}
+
+	app.consensusParamsChanged = res.ConsensusParamsChanged
 	return res
 }
 
@@ -153,6 +198,18 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	// report the consensus params changed by the pre-blocker to Tendermint,
+	// unless the end blocker updated them already
+	if app.consensusParamsChanged && res.ConsensusParamUpdates == nil {
+		res.ConsensusParamUpdates = app.GetConsensusParams(app.deliverState.ctx)
+	}
+
+	res.Events = append(res.Events, app.blockSummaryEvent(app.deliverState.ctx))
+
+	app.addResultLeaf(0, nil, res.Events)
+	app.commitResults()
+
+	app.listenEndBlock(app.deliverState.ctx, req, res)
 	return
 }
 
@@ -162,6 +219,10 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 // internal CheckTx state if the AnteHandler passes. Otherwise, the ResponseCheckTx
 // will contain releveant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
+//
+// CheckTx may be called concurrently: the txs are decoded in parallel, while
+// each one is checked against the check state, from its AnteHandler to its
+// writes, under the lock guarding it, as if the txs were checked sequentially.
 func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
@@ -181,8 +242,17 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
+	// New txs are inserted in the app mempool before being run, so that the
+	// mempool observes the check state the tx is executed against.
+	if app.mempool != nil && mode == runTxModeCheck {
+		if err := app.mempool.Insert(app.getCheckState().ctx, tx, req.Tx); err != nil {
+			return sdkerrors.ResponseCheckTx(err, 0, 0)
+		}
+	}
+
 	gInfo, result, err := app.runTx(mode, req.Tx, tx)
 	if err != nil {
+		app.removeFromMempool(req.Tx)
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed)
 	}
 
@@ -200,7 +270,19 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
+	defer func() {
+		app.addResultLeaf(res.Code, res.Data, res.Events)
+		app.blockSummary.addTx(res)
+
+		if len(app.abciListeners) > 0 {
+			app.listenDeliverTx(app.deliverState.ctx, req, res)
+		}
+	}()
+
+	// the tx is included in a block, so it is no longer pending in the mempool
+	app.removeFromMempool(req.Tx)
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0)
@@ -223,12 +305,17 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
-// latest header and reset the deliver state. Also, if a non-zero halt height is
-// defined in config, Commit will execute a deferred function call to check
-// against that height and gracefully halt if it matches the latest committed
-// height.
+// latest header and reset the deliver state.
+//
+// Also, if a halt height is defined in config, Commit gracefully halts the node
+// once it committed the block at the halt height. The blocks above the halt
+// height, or at or after the halt time, are refused by BeginBlock, which halts
+// the node before executing them, so that all the nodes sharing the halt
+// configuration stop at the same state, including after a restart with the
+// same configuration.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
-	header := app.deliverState.ctx.BlockHeader()
+	ctx := app.deliverState.ctx
+	header := ctx.BlockHeader()
 
 	// Write the DeliverTx state which is cache-wrapped and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
@@ -240,23 +327,20 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
-	// Commit. Use the header from this latest block.
+	// Commit, and setCheckState holds the lock guarding the check state against
+	// concurrent CheckTx calls and simulations. Use the header from this latest
+	// block.
 	app.setCheckState(header)
 
 	// empty/reset the deliver state
 	app.deliverState = nil
 
-	var halt bool
-
-	switch {
-	case app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight:
-		halt = true
-
-	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
-		halt = true
+	res = abci.ResponseCommit{
+		Data: commitID.Hash,
 	}
+	app.listenCommit(ctx, res)
 
-	if halt {
+	if app.haltHeight > 0 && uint64(header.Height) == app.haltHeight {
 		// Halt the binary and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
 		// restart and process blocks assuming the halt configuration has been
@@ -264,9 +348,22 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
-	return abci.ResponseCommit{
-		Data: commitID.Hash,
+	return res
+}
+
+// checkHalt returns an error if the block of the given height and time must not
+// be executed per the halt configuration, i.e. if its height is above the halt
+// height or if its time is at or after the halt time.
+func (app *BaseApp) checkHalt(height int64, blockTime time.Time) error {
+	switch {
+	case app.haltHeight > 0 && uint64(height) > app.haltHeight:
+		return fmt.Errorf("halt per configuration height %d", app.haltHeight)
+
+	case app.haltTime > 0 && blockTime.Unix() >= int64(app.haltTime):
+		return fmt.Errorf("halt per configuration time %d", app.haltTime)
 	}
+
+	return nil
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -296,7 +393,7 @@ func (app *BaseApp) halt() {
 func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
 	path := splitPath(req.Path)
 	if len(path) == 0 {
-		sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"))
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"))
 	}
 
 	switch path[0] {
@@ -321,14 +418,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	if len(path) >= 2 {
 		switch path[1] {
 		case "simulate":
-			txBytes := req.Data
-
-			tx, err := app.txDecoder(txBytes)
-			if err != nil {
-				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to decode tx"))
-			}
-
-			gInfo, res, err := app.Simulate(txBytes, tx)
+			gInfo, res, err := app.SimulateTxBytes(req.Data)
 			if err != nil {
 				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to simulate tx"))
 			}
@@ -356,6 +446,18 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.appVersion),
 			}
 
+		case "info":
+			bz, err := json.Marshal(version.NewInfo())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode version info"))
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -364,7 +466,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be one of 'simulate', 'version' or 'info', none was present",
 		),
 	)
 }
@@ -439,6 +541,8 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
+	querier = app.wrapQuerier(path[1], querier)
+
 	// when a client did not provide a query height, manually inject the latest
 	if req.Height == 0 {
 		req.Height = app.LastBlockHeight()
@@ -465,14 +569,26 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+		cacheMS, app.getCheckState().ctx.BlockHeader(), true, app.logger,
+	).WithMinGasPrices(app.minGasPrices).WithKVGasConfig(app.kvGasConfig)
+
+	// bound the store reads of the querier when a query gas limit is set
+	if app.queryGasLimit > 0 {
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(app.queryGasLimit))
+	}
 
 	// Passes the rest of the path as an argument to the querier.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
 	// []string{"proposal", "test"} as the path.
-	resBytes, err := querier(ctx, path[2:], req)
+	resBytes, err := runQuerier(ctx, querier, path[2:], req)
+	if err == nil && app.queryMaxResultBytes > 0 && uint64(len(resBytes)) > app.queryMaxResultBytes {
+		err = sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"query result size %d exceeds the maximum of %d bytes", len(resBytes), app.queryMaxResultBytes,
+		)
+	}
+
 	if err != nil {
 		space, code, log := sdkerrors.ABCIInfo(err, false)
 		return abci.ResponseQuery{
@@ -489,6 +605,27 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 	}
 }
 
+// runQuerier executes the querier, converting an out of gas panic raised when
+// the query gas limit is reached into an ErrOutOfGas error. Any other panic is
+// propagated.
+func runQuerier(ctx sdk.Context, querier sdk.Querier, path []string, req abci.RequestQuery) (res []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(
+				sdkerrors.ErrOutOfGas, "query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+				oog.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+			)
+		}
+	}()
+
+	return querier(ctx, path, req)
+}
+
 // splitPath splits a string path using the delimiter '/'.
 //
 // e.g. "this/is/funny" becomes []string{"this", "is", "funny"}
@@ -1,10 +1,11 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
-	"runtime/debug"
 	"strings"
+	"sync"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -12,8 +13,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 const (
@@ -25,6 +28,10 @@ const (
 
 var (
 	_ abci.Application = (*BaseApp)(nil)
+
+	// errUndeclaredStoreAccess is returned by runMsgsParallel when a message
+	// accesses a store key it does not declare.
+	errUndeclaredStoreAccess = errors.New("undeclared store access")
 )
 
 type (
@@ -41,23 +48,28 @@ type (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
-	logger      log.Logger
-	name        string               // application name from abci.Info
-	db          dbm.DB               // common DB backend
-	cms         sdk.CommitMultiStore // Main (uncached) state
-	storeLoader StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
-	router      sdk.Router           // handle any kind of message
-	queryRouter sdk.QueryRouter      // router for redirecting query calls
-	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
+	logger           log.Logger
+	name             string               // application name from abci.Info
+	db               dbm.DB               // common DB backend
+	cms              sdk.CommitMultiStore // Main (uncached) state
+	storeLoader      StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
+	router           sdk.Router           // handle any kind of message
+	msgServiceRouter *MsgServiceRouter    // router for redirecting service msgs
+	queryRouter      sdk.QueryRouter      // router for redirecting query calls
+	txDecoder        sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
+	preBlocker     sdk.PreBlocker   // logic to run before the begin blocker
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
 	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// proposalFilter rejects the txs which may not be included in the blocks
+	proposalFilter sdk.ProposalFilter
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -65,12 +77,24 @@ type BaseApp struct { // nolint: maligned
 	checkState   *state // for CheckTx
 	deliverState *state // for DeliverTx
 
+	// checkStateMtx guards the reset of checkState, and is held by each
+	// CheckTx call while its tx is checked against it, so that the concurrent
+	// calls, and the simulations branching it, observe each other's writes
+	checkStateMtx sync.RWMutex
+
+	// mempool is the optional application side mempool, tracking the txs
+	// which passed CheckTx until they are included in a block
+	mempool mempool.Mempool
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
+	// set if the pre-blocker changed the consensus params of the current block
+	consensusParamsChanged bool
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -79,6 +103,23 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// gas costs charged for operations on the KVStores and transient stores
+	// accessed while processing blocks and transactions
+	kvGasConfig        sdk.GasConfig
+	transientGasConfig sdk.GasConfig
+
+	// if true, the messages of a tx declaring disjoint store accesses are
+	// executed in parallel
+	parallelMsgExecution bool
+
+	// maximum gas consumed by the store reads of a custom query, and maximum
+	// size of its result in bytes; zero means unlimited
+	queryGasLimit       uint64
+	queryMaxResultBytes uint64
+
+	// middlewares wrapping the querier of every custom query route
+	querierMiddlewares []sdk.QuerierMiddleware
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -90,6 +131,21 @@ type BaseApp struct { // nolint: maligned
 
 	// application's version string
 	appVersion string
+
+	// recovery handler for app.runTx method
+	runTxRecoveryMiddleware recoveryMiddleware
+
+	// listeners streaming the ABCI requests and responses to external services
+	abciListeners []ABCIListener
+
+	// store in which the results hash of each block is committed, if any, and
+	// leaves of the results of the current block
+	resultsStoreKey sdk.StoreKey
+	resultLeaves    [][]byte
+
+	// totals of the txs delivered in the current block, emitted as a block
+	// summary event in EndBlock
+	blockSummary blockSummary
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -101,15 +157,19 @@ func NewBaseApp(
 	name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecoder, options ...func(*BaseApp),
 ) *BaseApp {
 	app := &BaseApp{
-		logger:         logger,
-		name:           name,
-		db:             db,
-		cms:            store.NewCommitMultiStore(db),
-		storeLoader:    DefaultStoreLoader,
-		router:         NewRouter(),
-		queryRouter:    NewQueryRouter(),
-		txDecoder:      txDecoder,
-		fauxMerkleMode: false,
+		logger:                  logger,
+		name:                    name,
+		db:                      db,
+		cms:                     store.NewCommitMultiStore(db),
+		storeLoader:             DefaultStoreLoader,
+		router:                  NewRouter(),
+		queryRouter:             NewQueryRouter(),
+		msgServiceRouter:        NewMsgServiceRouter(),
+		txDecoder:               txDecoder,
+		fauxMerkleMode:          false,
+		kvGasConfig:             storetypes.KVGasConfig(),
+		transientGasConfig:      storetypes.TransientGasConfig(),
+		runTxRecoveryMiddleware: newDefaultRecoveryMiddleware(),
 	}
 
 	for _, option := range options {
@@ -155,6 +215,9 @@ func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
 		case *sdk.TransientStoreKey:
 			app.MountStore(key, sdk.StoreTypeTransient)
 
+		case *sdk.MemoryStoreKey:
+			app.MountStore(key, sdk.StoreTypeMemory)
+
 		default:
 			panic("Unrecognized store key type " + reflect.TypeOf(key).Name())
 		}
@@ -230,6 +293,23 @@ func (app *BaseApp) LoadVersion(version int64) error {
 	return app.init()
 }
 
+// RollbackToVersion rolls the application state back to the given committed
+// version, deleting all the versions above it, and reloads the multistore at
+// that version. It is meant to recover from a non-deterministic commit along
+// with a rollback of the Tendermint state.
+func (app *BaseApp) RollbackToVersion(version int64) error {
+	if err := app.cms.RollbackToVersion(version); err != nil {
+		return fmt.Errorf("failed to roll back to version %d: %w", version, err)
+	}
+
+	if err := app.cms.LoadVersion(version); err != nil {
+		return fmt.Errorf("failed to load version %d: %w", version, err)
+	}
+
+	app.setCheckState(abci.Header{})
+	return nil
+}
+
 // LastCommitID returns the last CommitID of the multistore.
 func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
@@ -256,6 +336,11 @@ func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setGasConfig(kvGasConfig, transientGasConfig sdk.GasConfig) {
+	app.kvGasConfig = kvGasConfig
+	app.transientGasConfig = transientGasConfig
+}
+
 func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
@@ -268,6 +353,38 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setQueryGasLimit(gasLimit uint64) {
+	app.queryGasLimit = gasLimit
+}
+
+func (app *BaseApp) setParallelMsgExecution(enabled bool) {
+	app.parallelMsgExecution = enabled
+}
+
+func (app *BaseApp) setMempool(mp mempool.Mempool) {
+	app.mempool = mp
+}
+
+// Mempool returns the application side mempool of the BaseApp, which may be
+// used to select the txs of the blocks proposed by the app. It is nil when no
+// mempool is set.
+func (app *BaseApp) Mempool() mempool.Mempool { return app.mempool }
+
+// removeFromMempool removes a tx from the application side mempool, if any.
+func (app *BaseApp) removeFromMempool(txBytes []byte) {
+	if app.mempool == nil {
+		return
+	}
+
+	if err := app.mempool.Remove(txBytes); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		app.logger.Error("failed to remove tx from mempool", "err", err)
+	}
+}
+
+func (app *BaseApp) setQueryMaxResultBytes(maxBytes uint64) {
+	app.queryMaxResultBytes = maxBytes
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -279,6 +396,15 @@ func (app *BaseApp) Router() sdk.Router {
 	return app.router
 }
 
+// MsgServiceRouter returns the MsgServiceRouter of the BaseApp.
+func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter {
+	if app.sealed {
+		panic("MsgServiceRouter() on sealed BaseApp")
+	}
+
+	return app.msgServiceRouter
+}
+
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
@@ -293,10 +419,16 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // provided header, and minimum gas prices set. It is set on InitChain and reset
 // on Commit.
 func (app *BaseApp) setCheckState(header abci.Header) {
+	app.checkStateMtx.Lock()
+	defer app.checkStateMtx.Unlock()
+
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, true, app.logger).
+			WithMinGasPrices(app.minGasPrices).
+			WithKVGasConfig(app.kvGasConfig).
+			WithTransientKVGasConfig(app.transientGasConfig),
 	}
 }
 
@@ -307,8 +439,10 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.deliverState = &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, false, app.logger).
+			WithKVGasConfig(app.kvGasConfig).
+			WithTransientKVGasConfig(app.transientGasConfig),
 	}
 }
 
@@ -413,7 +547,8 @@ func validateBasicTxMsgs(msgs []sdk.Msg) error {
 }
 
 // Returns the applications's deliverState if app is in runTxModeDeliver,
-// otherwise it returns the application's checkstate.
+// otherwise it returns the application's checkstate, in which case the caller
+// must hold checkStateMtx.
 func (app *BaseApp) getState(mode runTxMode) *state {
 	if mode == runTxModeDeliver {
 		return app.deliverState
@@ -422,25 +557,61 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 	return app.checkState
 }
 
+// getCheckState returns the application's checkState, which may be reset by a
+// concurrent Commit.
+func (app *BaseApp) getCheckState() *state {
+	app.checkStateMtx.RLock()
+	defer app.checkStateMtx.RUnlock()
+
+	return app.checkState
+}
+
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context {
-	ctx := app.getState(mode).ctx.
+	var ctx sdk.Context
+	if mode == runTxModeSimulate {
+		ctx = app.branchCheckState()
+	} else {
+		ctx = app.getState(mode).ctx
+	}
+
+	ctx = ctx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
-	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
-
-	if mode == runTxModeReCheck {
-		ctx = ctx.WithIsReCheckTx(true)
-	}
+	return ctx.
+		WithConsensusParams(app.GetConsensusParams(ctx)).
+		WithExecMode(mode.execMode())
+}
 
-	if mode == runTxModeSimulate {
-		ctx, _ = ctx.CacheContext()
-	}
+// branchCheckState returns a context on a cache branch of the check state.
+// Simulations run on such a branch, and as they may run concurrently with
+// CheckTx and Commit, e.g. when served by the gRPC server, the branch is
+// created while holding the lock guarding the check state, so that it does not
+// observe the partial writes of a CheckTx call.
+func (app *BaseApp) branchCheckState() sdk.Context {
+	app.checkStateMtx.RLock()
+	defer app.checkStateMtx.RUnlock()
 
+	ctx, _ := app.checkState.ctx.CacheContext()
 	return ctx
 }
 
+// execMode returns the context execution mode matching the mode a transaction
+// is run in.
+func (mode runTxMode) execMode() sdk.ExecMode {
+	switch mode {
+	case runTxModeCheck:
+		return sdk.ExecModeCheck
+	case runTxModeReCheck:
+		return sdk.ExecModeReCheck
+	case runTxModeSimulate:
+		return sdk.ExecModeSimulate
+	default:
+		return sdk.ExecModeDeliver
+	}
+}
+
 // cacheTxContext returns a new context based off of the provided context with
 // a cache wrapped multi-store.
 func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context, sdk.CacheMultiStore) {
@@ -473,6 +644,15 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
+	if mode == runTxModeCheck || mode == runTxModeReCheck {
+		// The check state is shared by the concurrent CheckTx calls: a tx is
+		// checked under the lock guarding it, from the reads of its AnteHandler
+		// to its writes, so that e.g. two txs of the same signer and sequence
+		// cannot both pass, and no write overwrites the one of another tx.
+		app.checkStateMtx.Lock()
+		defer app.checkStateMtx.Unlock()
+	}
+
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
@@ -489,26 +669,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			// TODO: Use ErrOutOfGas instead of ErrorOutOfGas which would allow us
-			// to keep the stracktrace.
-			case sdk.ErrorOutOfGas:
-				err = sdkerrors.Wrap(
-					sdkerrors.ErrOutOfGas, fmt.Sprintf(
-						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-						rType.Descriptor, gasWanted, ctx.GasMeter().GasConsumed(),
-					),
-				)
-
-			default:
-				err = sdkerrors.Wrap(
-					sdkerrors.ErrPanic, fmt.Sprintf(
-						"recovered: %v\nstack:\n%v", r, string(debug.Stack()),
-					),
-				)
-			}
-
-			result = nil
+			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx,
+				newGasOverflowRecoveryMiddleware(app.runTxRecoveryMiddleware),
+			)
+			err, result = processRecovery(r, recoveryMW), nil
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
@@ -536,6 +700,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		return sdk.GasInfo{}, nil, err
 	}
 
+	if app.proposalFilter != nil {
+		filterCtx, _ := ctx.CacheContext()
+		if err := app.proposalFilter(filterCtx, tx); err != nil {
+			return sdk.GasInfo{}, nil, err
+		}
+	}
+
 	var events sdk.Events
 	if app.anteHandler != nil {
 		var (
@@ -607,6 +778,17 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 	data := make([]byte, 0, len(msgs))
 	events := sdk.EmptyEvents()
 
+	// messages declaring disjoint store accesses may be executed in parallel
+	var parallelResults []*sdk.Result
+	if app.parallelMsgExecution && mode != runTxModeCheck && mode != runTxModeReCheck &&
+		len(msgs) > 1 && sdk.StoreAccessDisjoint(msgs) {
+		var err error
+		parallelResults, err = app.runMsgsParallel(ctx, msgs)
+		if err != nil && !errors.Is(err, errUndeclaredStoreAccess) {
+			return nil, err
+		}
+	}
+
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
 		// skip actual execution for (Re)CheckTx mode
@@ -614,16 +796,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
-		msgRoute := msg.Route()
-		handler := app.router.Route(ctx, msgRoute)
-
-		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
-		}
+		var (
+			msgResult *sdk.Result
+			err       error
+		)
 
-		msgResult, err := handler(ctx, msg)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		if parallelResults != nil {
+			msgResult = parallelResults[i]
+		} else if msgResult, err = app.runMsg(ctx, msg, i); err != nil {
+			return nil, err
 		}
 
 		msgEvents := sdk.Events{
@@ -647,3 +828,132 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		Events: events.ToABCIEvents(),
 	}, nil
 }
+
+// runMsg routes a message to its handler and executes it. The index of the
+// message in its transaction is used for error reporting.
+func (app *BaseApp) runMsg(ctx sdk.Context, msg sdk.Msg, i int) (*sdk.Result, error) {
+	var (
+		msgResult *sdk.Result
+		err       error
+	)
+
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		handler := app.msgServiceRouter.Handler(svcMsg.MethodName)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message service method: %s; message index: %d", svcMsg.MethodName, i)
+		}
+
+		msgResult, err = handler(ctx, svcMsg.Request)
+	} else {
+		msgRoute := msg.Route()
+		handler := app.router.Route(ctx, msgRoute)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+		}
+
+		msgResult, err = handler(ctx, msg)
+	}
+
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+	}
+
+	return msgResult, nil
+}
+
+// parallelMsgRun holds the state and outcome of a message executed by
+// runMsgsParallel.
+type parallelMsgRun struct {
+	ms           sdk.CacheMultiStore
+	gasMeter     *gasRecorder
+	eventManager *sdk.EventManager
+
+	result   *sdk.Result
+	err      error
+	panicked interface{}
+}
+
+// runMsgsParallel executes each message concurrently against its own cache of
+// the context multi-store, with its own gas meter and event manager, the stores
+// only allowing the access of the keys declared by the message. Once all the
+// messages are executed, their outcomes are merged in the order of the
+// messages: the gas consumptions are replayed on the context gas meter, the
+// events are emitted and the caches are written, so that the gas, events and
+// state are the same as when the messages are executed sequentially. The first
+// failing message, in order, fails the whole execution; a message panic is
+// re-raised.
+//
+// If a message accesses an undeclared key, nothing is merged and
+// errUndeclaredStoreAccess is returned, for the messages to be executed
+// sequentially instead.
+func (app *BaseApp) runMsgsParallel(ctx sdk.Context, msgs []sdk.Msg) ([]*sdk.Result, error) {
+	runs := make([]parallelMsgRun, len(msgs))
+
+	var wg sync.WaitGroup
+	for i, msg := range msgs {
+		run := &runs[i]
+		run.ms = newAccessCheckMultiStore(
+			ctx.MultiStore().CacheMultiStore(), msg.(sdk.StoreAccessMsg).GetStoreAccess(),
+		)
+		run.eventManager = sdk.NewEventManager()
+
+		// each message may consume up to the gas remaining in the tx
+		if limit := ctx.GasMeter().Limit(); limit > 0 {
+			run.gasMeter = &gasRecorder{GasMeter: sdk.NewGasMeter(limit - ctx.GasMeter().GasConsumedToLimit())}
+		} else {
+			run.gasMeter = &gasRecorder{GasMeter: sdk.NewInfiniteGasMeter()}
+		}
+
+		msgCtx := ctx.
+			WithMultiStore(run.ms).
+			WithGasMeter(run.gasMeter).
+			WithEventManager(run.eventManager)
+
+		wg.Add(1)
+		go func(i int, msg sdk.Msg) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					run.panicked = r
+				}
+			}()
+
+			run.result, run.err = app.runMsg(msgCtx, msg, i)
+		}(i, msg)
+	}
+
+	wg.Wait()
+
+	for i := range runs {
+		if violation, ok := runs[i].panicked.(storeAccessViolation); ok {
+			app.logger.Error(
+				"message accessed an undeclared store key, executing the messages sequentially",
+				"index", i, "violation", violation.String(),
+			)
+
+			return nil, errUndeclaredStoreAccess
+		}
+	}
+
+	results := make([]*sdk.Result, len(msgs))
+	for i := range runs {
+		runs[i].gasMeter.replay(ctx.GasMeter())
+
+		if runs[i].panicked != nil {
+			panic(runs[i].panicked)
+		}
+
+		if runs[i].err != nil {
+			return nil, runs[i].err
+		}
+
+		results[i] = runs[i].result
+	}
+
+	for i := range runs {
+		runs[i].ms.Write()
+		ctx.EventManager().EmitEvents(runs[i].eventManager.Events())
+	}
+
+	return results, nil
+}
@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +27,8 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 var (
@@ -271,6 +278,20 @@ func TestAppVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestQueryAppInfo(t *testing.T) {
+	app := setupBaseApp(t)
+
+	res := app.Query(abci.RequestQuery{Path: "/app/info"})
+	require.True(t, res.IsOK())
+
+	var info version.Info
+	require.NoError(t, json.Unmarshal(res.Value, &info))
+	require.Equal(t, version.NewInfo(), info)
+
+	res = app.Query(abci.RequestQuery{Path: ""})
+	require.False(t, res.IsOK())
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := SetPruning(store.PruneNothing)
@@ -469,6 +490,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetProposalFilter(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -810,6 +834,127 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestCheckTxMempool(t *testing.T) {
+	counterKey := []byte("counter-key")
+	mp := mempool.NewFIFOMempool(0)
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	require.Equal(t, mp, app.Mempool())
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txsBytes := make([][]byte, 3)
+	for i := range txsBytes {
+		tx := newTxCounter(int64(i), 0)
+		tx.setFailOnAnte(i == 2)
+
+		bz, err := codec.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		txsBytes[i] = bz
+	}
+
+	// txs passing CheckTx are inserted in the mempool
+	for _, bz := range txsBytes[:2] {
+		r := app.CheckTx(abci.RequestCheckTx{Tx: bz})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	}
+	require.Equal(t, 2, mp.CountTx())
+
+	// txs failing CheckTx are not kept in the mempool
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txsBytes[2]})
+	require.False(t, r.IsOK())
+	require.Equal(t, 2, mp.CountTx())
+
+	// txs already in the mempool are rejected
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txsBytes[0]})
+	require.Equal(t, sdkerrors.ErrTxInMempoolCache.ABCICode(), r.Code)
+	require.Equal(t, txsBytes[:2], mp.Select(0))
+
+	// txs included in a block are removed from the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txsBytes[0]})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, txsBytes[1:2], mp.Select(0))
+
+	// txs are kept in the mempool as long as they pass a recheck
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txsBytes[1], Type: abci.CheckTxType_Recheck})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 1, mp.CountTx())
+
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txsBytes[1], Type: abci.CheckTxType_Recheck})
+	require.False(t, r.IsOK())
+	require.Zero(t, mp.CountTx())
+}
+
+func TestProposalFilter(t *testing.T) {
+	counterKey := []byte("counter-key")
+	deliverKey := []byte("deliver-key")
+	mp := mempool.NewFIFOMempool(0)
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+	// reject the txs with an odd counter, discarding the writes of the filter
+	filterOpt := func(bapp *BaseApp) {
+		bapp.SetProposalFilter(func(ctx sdk.Context, tx sdk.Tx) error {
+			ctx.KVStore(capKey1).Set([]byte("filter-key"), []byte("filtered"))
+			if tx.(txTest).Counter%2 == 1 {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "odd counter")
+			}
+			return nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, filterOpt, SetMempool(mp))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txsBytes := make([][]byte, 2)
+	for i := range txsBytes {
+		bz, err := codec.MarshalBinaryBare(newTxCounter(int64(i), int64(i)))
+		require.NoError(t, err)
+		txsBytes[i] = bz
+	}
+
+	// the rejected txs are neither checked nor kept in the mempool
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txsBytes[0]})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txsBytes[1]})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), r.Code)
+	require.Equal(t, txsBytes[:1], mp.Select(0))
+
+	checkStore := app.checkState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(checkStore, counterKey))
+	require.Nil(t, checkStore.Get([]byte("filter-key")))
+
+	// the rejected txs proposed by other validators fail
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txsBytes[0]})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txsBytes[1]})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+
+	deliverStore := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(deliverStore, counterKey))
+	require.Equal(t, int64(1), getIntFromStore(deliverStore, deliverKey))
+	require.Nil(t, deliverStore.Get([]byte("filter-key")))
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1006,6 +1151,164 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// Simulations, e.g. served by the gRPC server, run concurrently with CheckTx
+// and Commit, which write and reset the check state the simulations branch.
+func TestConcurrentSimulate(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, counterKey, getIntFromStore(store, counterKey)+1)
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				_, _, err := app.SimulateTxBytes(txBytes)
+				if !assert.NoError(t, err) {
+					return
+				}
+			}
+		}()
+	}
+
+	for height := int64(1); height <= 5; height++ {
+		for i := 0; i < 10; i++ {
+			res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+			require.True(t, res.IsOK(), res.Log)
+		}
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	close(done)
+	wg.Wait()
+}
+
+func TestConcurrentCheckTx(t *testing.T) {
+	const numTxs = 4
+
+	// the ante handler writes a key per tx
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			ctx.KVStore(capKey1).Set([]byte{byte(tx.(txTest).Counter)}, []byte{0x01})
+			return ctx, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	var wg sync.WaitGroup
+	for i := 0; i < numTxs; i++ {
+		txBytes, err := cdc.MarshalBinaryBare(newTxCounter(int64(i), 0))
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+			assert.True(t, res.IsOK(), res.Log)
+		}()
+	}
+
+	wg.Wait()
+
+	// the writes of every call are applied to the check state
+	store := app.getCheckState().ctx.KVStore(capKey1)
+	for i := 0; i < numTxs; i++ {
+		require.True(t, store.Has([]byte{byte(i)}))
+	}
+}
+
+func TestConcurrentCheckTxSameSequence(t *testing.T) {
+	const numTxs = 8
+	sequenceKey := []byte("sequence")
+
+	// the ante handler checks the counter of the tx against a sequence, which
+	// it increments, after yielding so that the calls interleave if not
+	// serialized
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			store := ctx.KVStore(capKey1)
+			sequence := getIntFromStore(store, sequenceKey)
+			time.Sleep(10 * time.Millisecond)
+
+			if tx.(txTest).Counter != sequence {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected sequence %d", sequence)
+			}
+
+			setIntOnStore(store, sequenceKey, sequence+1)
+			return ctx, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	// txs of the same signer and sequence are checked at once
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	var (
+		wg     sync.WaitGroup
+		passed int32
+	)
+	for i := 0; i < numTxs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK() {
+				atomic.AddInt32(&passed, 1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// only one of them passes
+	require.Equal(t, int32(1), passed)
+	require.Equal(t, int64(1), getIntFromStore(app.getCheckState().ctx.KVStore(capKey1), sequenceKey))
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1494,6 +1797,50 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestCustomQueryLimits(t *testing.T) {
+	key := []byte("key")
+	querier := func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		store := ctx.KVStore(capKey1)
+		for i := 0; i < 10; i++ {
+			store.Get(key)
+		}
+
+		return make([]byte, 100), nil
+	}
+	queryRouterOpt := func(bapp *BaseApp) { bapp.QueryRouter().AddRoute("limits", querier) }
+	query := abci.RequestQuery{Path: "/custom/limits"}
+
+	testCases := []struct {
+		name      string
+		options   []func(*BaseApp)
+		expectErr *sdkerrors.Error
+	}{
+		{"no limits", nil, nil},
+		{"within limits", []func(*BaseApp){SetQueryGasLimit(100000), SetQueryMaxResultBytes(100)}, nil},
+		{"out of gas", []func(*BaseApp){SetQueryGasLimit(5000)}, sdkerrors.ErrOutOfGas},
+		{"result too large", []func(*BaseApp){SetQueryMaxResultBytes(99)}, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := setupBaseApp(t, append(tc.options, queryRouterOpt)...)
+			app.InitChain(abci.RequestInitChain{})
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+			app.Commit()
+
+			res := app.Query(query)
+			if tc.expectErr == nil {
+				require.True(t, res.IsOK(), res.Log)
+				require.Len(t, res.Value, 100)
+			} else {
+				require.Equal(t, tc.expectErr.ABCICode(), res.Code, res.Log)
+				require.Empty(t, res.Value)
+			}
+		})
+	}
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -1525,6 +1872,22 @@ func TestP2PQuery(t *testing.T) {
 	require.Equal(t, uint32(4), res.Code)
 }
 
+func TestPeerAllowList(t *testing.T) {
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.SetAddrPeerFilter(NewPeerAllowList(nil))
+		bapp.SetIDPeerFilter(NewPeerAllowList([]string{"allowedid"}))
+	})
+
+	res := app.Query(abci.RequestQuery{Path: "/p2p/filter/addr/1.1.1.1:8000"})
+	require.True(t, res.IsOK())
+
+	res = app.Query(abci.RequestQuery{Path: "/p2p/filter/id/allowedid"})
+	require.True(t, res.IsOK())
+
+	res = app.Query(abci.RequestQuery{Path: "/p2p/filter/id/otherid"})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+}
+
 func TestGetMaximumBlockGas(t *testing.T) {
 	app := setupBaseApp(t)
 	app.InitChain(abci.RequestInitChain{})
@@ -1543,6 +1906,59 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	require.Panics(t, func() { app.getMaximumBlockGas(ctx) })
 }
 
+func TestPreBlocker(t *testing.T) {
+	var (
+		changeParams bool
+		preBlockErr  error
+	)
+
+	preBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetPreBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) (sdk.ResponsePreBlock, error) {
+			if preBlockErr != nil {
+				return sdk.ResponsePreBlock{}, preBlockErr
+			}
+
+			if changeParams {
+				bapp.StoreConsensusParams(ctx, &abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 50}})
+			}
+
+			return sdk.ResponsePreBlock{
+				ConsensusParamsChanged: changeParams,
+				Events:                 []abci.Event{{Type: "pre_block"}},
+			}, nil
+		})
+	}
+
+	app := setupBaseApp(t, preBlockerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 100}},
+	})
+
+	// the params changed by the pre-blocker apply to the block and are
+	// reported to Tendermint
+	changeParams = true
+	res := app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.Equal(t, "pre_block", res.Events[0].Type)
+	require.Equal(t, uint64(50), app.deliverState.ctx.BlockGasMeter().Limit())
+
+	endRes := app.EndBlock(abci.RequestEndBlock{Height: 1})
+	require.NotNil(t, endRes.ConsensusParamUpdates)
+	require.Equal(t, int64(50), endRes.ConsensusParamUpdates.Block.MaxGas)
+	app.Commit()
+
+	changeParams = false
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	endRes = app.EndBlock(abci.RequestEndBlock{Height: 2})
+	require.Nil(t, endRes.ConsensusParamUpdates)
+	app.Commit()
+
+	// the block is not processed if the pre-blocker fails
+	preBlockErr = errors.New("upgrade needed")
+	require.Panics(t, func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 3}})
+	})
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
@@ -1603,3 +2019,124 @@ func TestWithRouter(t *testing.T) {
 		app.Commit()
 	}
 }
+
+func TestCheckHalt(t *testing.T) {
+	haltTime := time.Unix(1600000000, 0)
+
+	app := newBaseApp(t.Name(), SetHaltHeight(10), SetHaltTime(uint64(haltTime.Unix())))
+	require.NoError(t, app.checkHalt(9, haltTime.Add(-time.Second)))
+	require.NoError(t, app.checkHalt(10, haltTime.Add(-time.Second)))
+	require.Error(t, app.checkHalt(11, haltTime.Add(-time.Second)))
+	require.Error(t, app.checkHalt(9, haltTime))
+
+	app = newBaseApp(t.Name())
+	require.NoError(t, app.checkHalt(11, haltTime))
+}
+
+// notifyHalt catches the signals sent by BaseApp.halt, which would otherwise
+// stop the test, and returns the channel receiving them.
+func notifyHalt(t *testing.T) chan os.Signal {
+	sigs := make(chan os.Signal, 2)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	t.Cleanup(func() { signal.Stop(sigs) })
+
+	return sigs
+}
+
+// requireHalted requires the SIGINT and SIGTERM signals of BaseApp.halt.
+func requireHalted(t *testing.T, sigs chan os.Signal) {
+	for i := 0; i < 2; i++ {
+		select {
+		case <-sigs:
+		case <-time.After(5 * time.Second):
+			t.Fatal("node not halted")
+		}
+	}
+}
+
+// loadHaltApp loads a BaseApp from the given database, as a node (re)started
+// with the given halt options.
+func loadHaltApp(t *testing.T, db dbm.DB, options ...func(*BaseApp)) *BaseApp {
+	app := NewBaseApp(t.Name(), defaultLogger(), db, nil, options...)
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+
+	return app
+}
+
+func commitBlock(app *BaseApp, header abci.Header) {
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+}
+
+// requireBlockRefused requires BeginBlock to halt the node without returning,
+// so that the block is never executed nor committed.
+func requireBlockRefused(t *testing.T, app *BaseApp, sigs chan os.Signal, header abci.Header) {
+	lastCommitID := app.LastCommitID()
+
+	returned := make(chan struct{})
+	go func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		close(returned)
+	}()
+
+	requireHalted(t, sigs)
+	select {
+	case <-returned:
+		t.Fatal("BeginBlock returned after halting")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.Equal(t, lastCommitID, app.LastCommitID())
+}
+
+func TestHaltHeight(t *testing.T) {
+	sigs := notifyHalt(t)
+	db := dbm.NewMemDB()
+	app := loadHaltApp(t, db, SetHaltHeight(2))
+
+	commitBlock(app, abci.Header{Height: 1})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+	require.Empty(t, sigs)
+
+	// the block at the halt height is committed before halting
+	commitBlock(app, abci.Header{Height: 2})
+	require.Equal(t, int64(2), app.LastBlockHeight())
+	requireHalted(t, sigs)
+
+	// a node restarted with the same configuration refuses the next block
+	app = loadHaltApp(t, db, SetHaltHeight(2))
+	require.Equal(t, int64(2), app.LastBlockHeight())
+	requireBlockRefused(t, app, sigs, abci.Header{Height: 3})
+
+	// and processes it once the halt height is moved
+	app = loadHaltApp(t, db, SetHaltHeight(10))
+	commitBlock(app, abci.Header{Height: 3})
+	require.Equal(t, int64(3), app.LastBlockHeight())
+	require.Empty(t, sigs)
+}
+
+func TestHaltTime(t *testing.T) {
+	sigs := notifyHalt(t)
+	haltTime := time.Unix(1600000000, 0)
+	db := dbm.NewMemDB()
+	app := loadHaltApp(t, db, SetHaltTime(uint64(haltTime.Unix())))
+
+	commitBlock(app, abci.Header{Height: 1, Time: haltTime.Add(-time.Second)})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+	require.Empty(t, sigs)
+
+	// the first block at or after the halt time is not executed
+	requireBlockRefused(t, app, sigs, abci.Header{Height: 2, Time: haltTime})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	// nor by a node restarted with the same configuration
+	app = loadHaltApp(t, db, SetHaltTime(uint64(haltTime.Unix())))
+	require.Equal(t, int64(1), app.LastBlockHeight())
+	requireBlockRefused(t, app, sigs, abci.Header{Height: 2, Time: haltTime.Add(time.Second)})
+
+	app = loadHaltApp(t, db)
+	commitBlock(app, abci.Header{Height: 2, Time: haltTime})
+	require.Equal(t, int64(2), app.LastBlockHeight())
+}
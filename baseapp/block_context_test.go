@@ -0,0 +1,79 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockData holds the block data read from a context.
+type blockData struct {
+	ChainID  string
+	Height   int64
+	Time     time.Time
+	Proposer sdk.ConsAddress
+}
+
+func readBlockData(ctx sdk.Context) blockData {
+	return blockData{ctx.ChainID(), ctx.BlockHeight(), ctx.BlockTime(), ctx.ProposerAddress()}
+}
+
+func TestBlockContextAccessors(t *testing.T) {
+	var beginBlock, deliverTx, endBlock blockData
+
+	opts := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			beginBlock = readBlockData(ctx)
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			endBlock = readBlockData(ctx)
+			return abci.ResponseEndBlock{}
+		})
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+			deliverTx = readBlockData(ctx)
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, opts)
+	app.InitChain(abci.RequestInitChain{ChainId: "test-chain"})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	// the first block is processed on the InitChain context, the next ones on
+	// a context created from their header
+	for height := int64(1); height <= 2; height++ {
+		header := abci.Header{
+			ChainID:         "test-chain",
+			Height:          height,
+			Time:            time.Unix(1600000000+height, 0),
+			ProposerAddress: []byte("proposer____________"),
+		}
+		expected := blockData{
+			ChainID:  header.ChainID,
+			Height:   header.Height,
+			Time:     header.Time.UTC(),
+			Proposer: sdk.ConsAddress(header.ProposerAddress),
+		}
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		require.Equal(t, expected, beginBlock)
+		require.Equal(t, expected, deliverTx)
+		require.Equal(t, expected, endBlock)
+	}
+}
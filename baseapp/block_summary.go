@@ -0,0 +1,39 @@
+package baseapp
+
+import (
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockSummary accumulates the gas totals of the txs delivered in a block.
+type blockSummary struct {
+	numTxs    uint64
+	gasWanted uint64
+	gasUsed   uint64
+}
+
+// addTx adds the gas wanted and used by a delivered tx, successful or not, to
+// the block totals.
+func (bs *blockSummary) addTx(res abci.ResponseDeliverTx) {
+	bs.numTxs++
+	bs.gasWanted += uint64(res.GasWanted)
+	bs.gasUsed += uint64(res.GasUsed)
+}
+
+// blockSummaryEvent returns the event summarizing the txs delivered in the
+// current block and its proposer, so that clients do not need to sum the
+// results of every tx themselves.
+func (app *BaseApp) blockSummaryEvent(ctx sdk.Context) abci.Event {
+	event := sdk.NewEvent(
+		sdk.EventTypeBlockSummary,
+		sdk.NewAttribute(sdk.AttributeKeyNumTxs, strconv.FormatUint(app.blockSummary.numTxs, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyGasWanted, strconv.FormatUint(app.blockSummary.gasWanted, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyGasUsed, strconv.FormatUint(app.blockSummary.gasUsed, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyProposer, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress).String()),
+	)
+
+	return abci.Event(event)
+}
@@ -0,0 +1,77 @@
+package baseapp
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestBlockSummaryEvent(t *testing.T) {
+	opts := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			ctx.GasMeter().ConsumeGas(uint64(m.Counter+1)*10, "counter")
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, opts)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	proposer := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	header := abci.Header{Height: 1, ProposerAddress: proposer}
+
+	for block := 0; block < 2; block++ {
+		header.Height = int64(block + 1)
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		var gasWanted, gasUsed int64
+		for i := int64(0); i < 3; i++ {
+			tx := newTxCounter(i, i)
+			tx.setFailOnHandler(i == 1)
+
+			txBytes, err := cdc.MarshalBinaryBare(tx)
+			require.NoError(t, err)
+
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			gasWanted += res.GasWanted
+			gasUsed += res.GasUsed
+		}
+
+		res := app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+
+		// the totals are reset at every block
+		var summary abci.Event
+		for _, event := range res.Events {
+			if event.Type == sdk.EventTypeBlockSummary {
+				summary = event
+			}
+		}
+
+		attrs := make(map[string]string)
+		for _, attr := range summary.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+
+		require.Equal(t, "3", attrs[sdk.AttributeKeyNumTxs])
+		require.Equal(t, strconv.FormatInt(gasWanted, 10), attrs[sdk.AttributeKeyGasWanted])
+		require.Equal(t, strconv.FormatInt(gasUsed, 10), attrs[sdk.AttributeKeyGasUsed])
+		require.NotEqual(t, "0", attrs[sdk.AttributeKeyGasUsed])
+		require.Equal(t, proposer.String(), attrs[sdk.AttributeKeyProposer])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (app *BaseApp) Check(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
@@ -14,6 +15,17 @@ func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Resul
 	return app.runTx(runTxModeSimulate, txBytes, tx)
 }
 
+// SimulateTxBytes decodes the transaction bytes with the application's
+// TxDecoder and simulates its execution against the latest committed state.
+func (app *BaseApp) SimulateTxBytes(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return sdk.GasInfo{}, nil, sdkerrors.Wrap(err, "failed to decode tx")
+	}
+
+	return app.Simulate(txBytes, tx)
+}
+
 func (app *BaseApp) Deliver(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	return app.runTx(runTxModeDeliver, nil, tx)
 }
@@ -21,7 +33,7 @@ func (app *BaseApp) Deliver(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 // Context with current {check, deliver}State of the app used by tests.
 func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	if isCheckTx {
-		return sdk.NewContext(app.checkState.ms, header, true, app.logger).
+		return sdk.NewContext(app.getCheckState().ms, header, true, app.logger).
 			WithMinGasPrices(app.minGasPrices)
 	}
 
@@ -0,0 +1,53 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.MsgRouterService = MsgRouterService{}
+
+// MsgRouterService implements sdk.MsgRouterService with the handlers of a
+// Router. It only dispatches the messages signed by the account they are
+// dispatched for, e.g. the module account of a keeper, a group policy account
+// or a contract.
+type MsgRouterService struct {
+	router sdk.Router
+}
+
+// NewMsgRouterService creates a new MsgRouterService dispatching messages with
+// the given router, typically the one of the application.
+func NewMsgRouterService(router sdk.Router) MsgRouterService {
+	return MsgRouterService{router: router}
+}
+
+// DispatchMsg implements sdk.MsgRouterService. The message is validated and
+// handled in the given context, so that its gas is consumed from the current
+// gas meter, and its events are emitted on the current event manager. The
+// state written by a failed message is not reverted, which is left to the
+// caller, e.g. by dispatching it in a cache context.
+func (s MsgRouterService) DispatchMsg(ctx sdk.Context, signer sdk.AccAddress, msg sdk.Msg) (*sdk.Result, error) {
+	for _, msgSigner := range msg.GetSigners() {
+		if !msgSigner.Equals(signer) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message signer %s is not %s", msgSigner, signer)
+		}
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	handler := s.router.Route(ctx, msg.Route())
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+	}
+
+	// handlers return the events of their context event manager
+	res, err := handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(res.GetEvents())
+	return res, nil
+}
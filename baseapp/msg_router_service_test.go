@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgRouterService(t *testing.T) {
+	signer := sdk.AccAddress([]byte("module_account______"))
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger()).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	rtr := NewRouter()
+	rtr.AddRoute("TestMsg", func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(10, "test")
+		ctx.EventManager().EmitEvent(sdk.NewEvent("dispatched"))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	})
+	svc := NewMsgRouterService(rtr)
+
+	// the gas and events of the message are accounted in the current context
+	res, err := svc.DispatchMsg(ctx, signer, sdk.NewTestMsg(signer))
+	require.NoError(t, err)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, sdk.Events{sdk.NewEvent("dispatched")}, ctx.EventManager().Events())
+	require.Equal(t, sdk.Gas(10), ctx.GasMeter().GasConsumed())
+
+	// messages of other signers are rejected
+	other := sdk.AccAddress([]byte("other_______________"))
+	_, err = svc.DispatchMsg(ctx, signer, sdk.NewTestMsg(signer, other))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// and messages without route
+	_, err = NewMsgRouterService(NewRouter()).DispatchMsg(ctx, signer, sdk.NewTestMsg(signer))
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+}
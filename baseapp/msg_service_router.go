@@ -0,0 +1,76 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	gogogrpc "google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgServiceHandler defines a function type which handles the request of a
+// proto service method.
+type MsgServiceHandler = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error)
+
+// MsgServiceRouter routes fully-qualified proto service method names to their
+// handlers.
+type MsgServiceRouter struct {
+	routes map[string]MsgServiceHandler
+}
+
+// NewMsgServiceRouter creates a new MsgServiceRouter.
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// Handler returns the MsgServiceHandler for a given fully-qualified method
+// name, or nil if none is registered.
+func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
+	return msr.routes[methodName]
+}
+
+// RegisterService implements the gRPC Server.RegisterService method. sd is a
+// gRPC service description, handler is an object which implements that gRPC
+// service. Each method of the service is registered under its fully-qualified
+// name, i.e. `/<ServiceName>/<MethodName>`.
+//
+// This function PANICs if a method is already registered.
+func (msr *MsgServiceRouter) RegisterService(sd *gogogrpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		if _, found := msr.routes[fqMethod]; found {
+			panic(fmt.Sprintf("msg service %s has already been registered", fqMethod))
+		}
+
+		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// the request has already been decoded along with the transaction,
+			// so it is passed directly to the method handler by the interceptor
+			interceptor := func(goCtx context.Context, _ interface{}, _ *gogogrpc.UnaryServerInfo, handler gogogrpc.UnaryHandler) (interface{}, error) {
+				return handler(goCtx, req)
+			}
+
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), noopDecoder, interceptor)
+			if err != nil {
+				return nil, err
+			}
+
+			resMsg, ok := res.(proto.Message)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting proto.Message, got %T", res)
+			}
+
+			return sdk.WrapServiceResult(ctx, resMsg, err)
+		}
+	}
+}
+
+func noopDecoder(_ interface{}) error { return nil }
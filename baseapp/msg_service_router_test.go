@@ -0,0 +1,113 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	gogogrpc "google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const testMsgServiceName = "cosmos_sdk.test.v1.Msg"
+
+// msgCoinRequest is a service msg request reusing the Coin proto message.
+type msgCoinRequest struct {
+	sdk.Coin
+}
+
+var _ sdk.MsgRequest = &msgCoinRequest{}
+
+func (msg *msgCoinRequest) ValidateBasic() error {
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins
+	}
+	return nil
+}
+
+func (msg *msgCoinRequest) GetSigners() []sdk.AccAddress { return nil }
+
+type coinMsgServer interface {
+	Double(context.Context, *msgCoinRequest) (*sdk.Coin, error)
+}
+
+type coinMsgServerImpl struct{}
+
+func (coinMsgServerImpl) Double(goCtx context.Context, req *msgCoinRequest) (*sdk.Coin, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("double", sdk.NewAttribute("denom", req.Denom)))
+
+	res := sdk.NewCoin(req.Denom, req.Amount.MulRaw(2))
+	return &res, nil
+}
+
+func doubleHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor gogogrpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(msgCoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(coinMsgServer).Double(ctx, in)
+	}
+
+	info := &gogogrpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + testMsgServiceName + "/Double",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(coinMsgServer).Double(ctx, req.(*msgCoinRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+var testMsgServiceDesc = gogogrpc.ServiceDesc{
+	ServiceName: testMsgServiceName,
+	HandlerType: (*coinMsgServer)(nil),
+	Methods: []gogogrpc.MethodDesc{
+		{MethodName: "Double", Handler: doubleHandler},
+	},
+	Streams: []gogogrpc.StreamDesc{},
+}
+
+func TestMsgServiceRouter(t *testing.T) {
+	msr := NewMsgServiceRouter()
+	msr.RegisterService(&testMsgServiceDesc, coinMsgServerImpl{})
+
+	require.Nil(t, msr.Handler("/"+testMsgServiceName+"/Triple"))
+	require.NotNil(t, msr.Handler("/"+testMsgServiceName+"/Double"))
+
+	require.Panics(t, func() {
+		msr.RegisterService(&testMsgServiceDesc, coinMsgServerImpl{})
+	})
+}
+
+func TestRunServiceMsgs(t *testing.T) {
+	app := setupBaseApp(t)
+	app.MsgServiceRouter().RegisterService(&testMsgServiceDesc, coinMsgServerImpl{})
+	app.InitChain(abci.RequestInitChain{})
+
+	ctx := app.deliverState.ctx
+	msg := sdk.ServiceMsg{
+		MethodName: "/" + testMsgServiceName + "/Double",
+		Request:    &msgCoinRequest{Coin: sdk.NewInt64Coin("stake", 10)},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := app.runMsgs(ctx, []sdk.Msg{msg}, runTxModeDeliver)
+	require.NoError(t, err)
+
+	var coin sdk.Coin
+	require.NoError(t, proto.Unmarshal(res.Data, &coin))
+	require.Equal(t, sdk.NewInt64Coin("stake", 20), coin)
+	require.Equal(t, "double", res.Events[len(res.Events)-1].Type)
+
+	msg.MethodName = "/" + testMsgServiceName + "/Triple"
+	_, err = app.runMsgs(ctx, []sdk.Msg{msg}, runTxModeDeliver)
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+}
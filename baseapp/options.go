@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -28,6 +29,12 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetGasConfig returns a BaseApp option function that sets the gas costs
+// charged for operations on the KVStores and transient stores.
+func SetGasConfig(kvGasConfig, transientGasConfig sdk.GasConfig) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setGasConfig(kvGasConfig, transientGasConfig) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
@@ -44,6 +51,62 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetIAVLFastIndex provides a BaseApp option function that sets whether the
+// IAVL stores are loaded with a flat index of their latest version.
+func SetIAVLFastIndex(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.cms.SetIAVLFastIndex(enabled) }
+}
+
+// SetQueryGasLimit returns a BaseApp option function that sets the maximum gas
+// a custom query may consume reading from the stores. A zero limit means
+// queries are not gas limited.
+func SetQueryGasLimit(gasLimit uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setQueryGasLimit(gasLimit) }
+}
+
+// SetQueryMaxResultBytes returns a BaseApp option function that sets the
+// maximum size in bytes of a custom query result. A zero size means the result
+// size is not limited.
+func SetQueryMaxResultBytes(maxBytes uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setQueryMaxResultBytes(maxBytes) }
+}
+
+// SetQuerierMiddlewares returns a BaseApp option function that sets the
+// middlewares wrapping the querier of every custom query route. The first
+// middleware is the outermost one, i.e. the first to handle a query.
+func SetQuerierMiddlewares(middlewares ...sdk.QuerierMiddleware) func(*BaseApp) {
+	return func(app *BaseApp) { app.setQuerierMiddlewares(middlewares) }
+}
+
+// SetParallelMsgExecution returns a BaseApp option function that enables or
+// disables the parallel execution of the messages of a transaction which all
+// declare disjoint store accesses, see sdk.StoreAccessMsg.
+func SetParallelMsgExecution(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setParallelMsgExecution(enabled) }
+}
+
+// SetMempool returns a BaseApp option function that sets the application side
+// mempool, in which the txs passing CheckTx are inserted.
+func SetMempool(mp mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setMempool(mp) }
+}
+
+// SetABCIListeners returns a BaseApp option function that sets the listeners
+// which are notified of the ABCI requests and responses processed by the app.
+func SetABCIListeners(listeners ...ABCIListener) func(*BaseApp) {
+	return func(app *BaseApp) { app.setABCIListeners(listeners) }
+}
+
+// SetResultsCommitment returns a BaseApp option function that commits the
+// results of each block in the app hash: the merkle root of the leaves of the
+// results of a block, see ResultLeaves, is stored under ResultsHashKey in the
+// given store, which the app must mount. The events and data of the block at
+// height H may then be proven against the app hash of the block at height
+// H+1, by querying the results hash with a proof at height H.
+func SetResultsCommitment(key sdk.StoreKey) func(*BaseApp) {
+	return func(app *BaseApp) { app.setResultsStoreKey(key) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -61,6 +124,19 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// AddRunTxRecoveryHandler adds custom app.runTx method panic handlers. The
+// handlers are processed before the default panic handler, which converts any
+// unhandled panic into an ErrPanic error, in the reverse order of registration.
+func (app *BaseApp) AddRunTxRecoveryHandler(handlers ...RecoveryHandler) {
+	if app.sealed {
+		panic("AddRunTxRecoveryHandler() on sealed BaseApp")
+	}
+
+	for _, h := range handlers {
+		app.runTxRecoveryMiddleware = newRecoveryMiddleware(h, app.runTxRecoveryMiddleware)
+	}
+}
+
 // SetAppVersion sets the application's version string.
 func (app *BaseApp) SetAppVersion(v string) {
 	if app.sealed {
@@ -94,6 +170,14 @@ func (app *BaseApp) SetInitChainer(initChainer sdk.InitChainer) {
 	app.initChainer = initChainer
 }
 
+func (app *BaseApp) SetPreBlocker(preBlocker sdk.PreBlocker) {
+	if app.sealed {
+		panic("SetPreBlocker() on sealed BaseApp")
+	}
+
+	app.preBlocker = preBlocker
+}
+
 func (app *BaseApp) SetBeginBlocker(beginBlocker sdk.BeginBlocker) {
 	if app.sealed {
 		panic("SetBeginBlocker() on sealed BaseApp")
@@ -118,6 +202,19 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetProposalFilter sets the filter of the txs which may be included in the
+// blocks. Tendermint builds the block proposals from its own mempool, which
+// only holds the txs passing CheckTx, so the filter can keep txs out of the
+// proposals and reject the txs of the proposals in DeliverTx, but it cannot
+// reorder or inject txs.
+func (app *BaseApp) SetProposalFilter(filter sdk.ProposalFilter) {
+	if app.sealed {
+		panic("SetProposalFilter() on sealed BaseApp")
+	}
+
+	app.proposalFilter = filter
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -0,0 +1,169 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const routeMsgKVSet = "kvset"
+
+// msgKVSet sets a key of the capKey1 store, declaring it as its only access.
+// It also sets its Undeclared key, if any, without declaring it.
+type msgKVSet struct {
+	Key, Value string
+	Undeclared string
+	Fail       bool
+}
+
+var _ sdk.StoreAccessMsg = msgKVSet{}
+
+func (msg msgKVSet) Route() string                { return routeMsgKVSet }
+func (msg msgKVSet) Type() string                 { return "kvset" }
+func (msg msgKVSet) GetSignBytes() []byte         { return nil }
+func (msg msgKVSet) GetSigners() []sdk.AccAddress { return nil }
+func (msg msgKVSet) ValidateBasic() error         { return nil }
+
+func (msg msgKVSet) GetStoreAccess() []sdk.StoreAccess {
+	return []sdk.StoreAccess{sdk.NewStoreAccess(capKey1.Name(), []byte(msg.Key))}
+}
+
+func handlerMsgKVSet(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	m := msg.(msgKVSet)
+	if m.Fail {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "kvset failure")
+	}
+
+	store := ctx.KVStore(capKey1)
+	store.Set([]byte(m.Key), []byte(m.Value))
+	if m.Undeclared != "" {
+		store.Set([]byte(m.Undeclared), []byte(m.Value))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent("kvset", sdk.NewAttribute("key", m.Key)))
+
+	return &sdk.Result{
+		Data:   []byte(m.Value),
+		Events: sdk.Events{sdk.NewEvent("kvset_result", sdk.NewAttribute("key", m.Key))}.ToABCIEvents(),
+	}, nil
+}
+
+func TestParallelMsgExecution(t *testing.T) {
+	var msgs []sdk.Msg
+	for i := 0; i < 50; i++ {
+		msgs = append(msgs, msgKVSet{Key: fmt.Sprintf("key%03d", i), Value: fmt.Sprintf("value%d", i)})
+	}
+
+	runMsgs := func(parallel bool, msgs []sdk.Msg, gasLimit uint64) (sdk.Context, *sdk.Result, error) {
+		routerOpt := func(bapp *BaseApp) { bapp.Router().AddRoute(routeMsgKVSet, handlerMsgKVSet) }
+		app := setupBaseApp(t, routerOpt, SetParallelMsgExecution(parallel))
+		app.InitChain(abci.RequestInitChain{})
+
+		ctx := app.deliverState.ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+		res, err := app.runMsgs(ctx, msgs, runTxModeDeliver)
+		return ctx, res, err
+	}
+
+	seqCtx, seqRes, err := runMsgs(false, msgs, 10000000)
+	require.NoError(t, err)
+
+	parCtx, parRes, err := runMsgs(true, msgs, 10000000)
+	require.NoError(t, err)
+
+	// parallel execution yields the same result, state, events and gas
+	require.Equal(t, seqRes, parRes)
+	require.Equal(t, seqCtx.GasMeter().GasConsumed(), parCtx.GasMeter().GasConsumed())
+	require.Equal(t, seqCtx.EventManager().ABCIEvents(), parCtx.EventManager().ABCIEvents())
+
+	store := parCtx.KVStore(capKey1)
+	for _, msg := range msgs {
+		m := msg.(msgKVSet)
+		require.Equal(t, []byte(m.Value), store.Get([]byte(m.Key)))
+	}
+
+	// the first failing message fails the execution and no state is written
+	failing := append([]sdk.Msg{}, msgs...)
+	failing[10] = msgKVSet{Key: "key010", Fail: true}
+	failing[20] = msgKVSet{Key: "key020", Fail: true}
+
+	ctx, _, err := runMsgs(true, failing, 10000000)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+	require.Contains(t, err.Error(), "message index: 10")
+	require.Nil(t, ctx.KVStore(capKey1).Get([]byte("key000")))
+
+	// the gas of the messages is charged on the tx gas meter as when they are
+	// executed sequentially, running out of gas at the same consumption
+	outOfGas := func(parallel bool, gasLimit uint64) (r interface{}) {
+		defer func() { r = recover() }()
+		_, _, _ = runMsgs(parallel, msgs, gasLimit)
+		return nil
+	}
+
+	for _, gasLimit := range []uint64{seqCtx.GasMeter().GasConsumed() - 1, seqCtx.GasMeter().GasConsumed() / 2, 1} {
+		seqPanic := outOfGas(false, gasLimit)
+		require.IsType(t, sdk.ErrorOutOfGas{}, seqPanic)
+		require.Equal(t, seqPanic, outOfGas(true, gasLimit))
+	}
+}
+
+func TestParallelMsgExecutionUndeclaredAccess(t *testing.T) {
+	runMsgs := func(parallel bool, msgs []sdk.Msg) (sdk.Context, *sdk.Result) {
+		routerOpt := func(bapp *BaseApp) { bapp.Router().AddRoute(routeMsgKVSet, handlerMsgKVSet) }
+		app := setupBaseApp(t, routerOpt, SetParallelMsgExecution(parallel))
+		app.InitChain(abci.RequestInitChain{})
+
+		ctx := app.deliverState.ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+		res, err := app.runMsgs(ctx, msgs, runTxModeDeliver)
+		require.NoError(t, err)
+		return ctx, res
+	}
+
+	// the second message writes the key declared by the first one, so the
+	// messages are executed sequentially, in order
+	msgs := []sdk.Msg{
+		msgKVSet{Key: "key1", Value: "first"},
+		msgKVSet{Key: "key2", Value: "second", Undeclared: "key1"},
+	}
+	require.True(t, sdk.StoreAccessDisjoint(msgs))
+
+	seqCtx, seqRes := runMsgs(false, msgs)
+	parCtx, parRes := runMsgs(true, msgs)
+
+	require.Equal(t, seqRes, parRes)
+	require.Equal(t, seqCtx.GasMeter().GasConsumed(), parCtx.GasMeter().GasConsumed())
+	require.Equal(t, seqCtx.EventManager().ABCIEvents(), parCtx.EventManager().ABCIEvents())
+	require.Equal(t, []byte("second"), parCtx.KVStore(capKey1).Get([]byte("key1")))
+
+	// the accesses are checked on the branches of the message stores as well
+	store := newAccessCheckMultiStore(
+		parCtx.MultiStore().CacheMultiStore(), []sdk.StoreAccess{sdk.NewStoreAccess(capKey1.Name(), []byte("key1"))},
+	)
+	branch := store.CacheMultiStore().GetKVStore(capKey1)
+	require.NotPanics(t, func() { branch.Set([]byte("key1"), []byte("value")) })
+	require.NotPanics(t, func() { branch.Iterator([]byte("key1"), sdk.PrefixEndBytes([]byte("key1"))).Close() })
+	require.Panics(t, func() { branch.Get([]byte("key2")) })
+	require.Panics(t, func() { branch.Iterator(nil, nil).Close() })
+	require.Panics(t, func() { store.GetKVStore(capKey2).Get([]byte("key1")) })
+}
+
+func TestParallelMsgExecutionOverlappingAccess(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) { bapp.Router().AddRoute(routeMsgKVSet, handlerMsgKVSet) }
+	app := setupBaseApp(t, routerOpt, SetParallelMsgExecution(true))
+	app.InitChain(abci.RequestInitChain{})
+
+	// messages writing a same key are executed sequentially, in order
+	msgs := []sdk.Msg{
+		msgKVSet{Key: "key", Value: "first"},
+		msgKVSet{Key: "key", Value: "second"},
+	}
+	require.False(t, sdk.StoreAccessDisjoint(msgs))
+
+	ctx := app.deliverState.ctx
+	_, err := app.runMsgs(ctx, msgs, runTxModeDeliver)
+	require.NoError(t, err)
+	require.Equal(t, []byte("second"), ctx.KVStore(capKey1).Get([]byte("key")))
+}
@@ -0,0 +1,30 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPeerAllowList returns a peer filter accepting only the given peers, which
+// are either node IDs or address/port pairs depending on the filter it is set
+// as, see SetIDPeerFilter and SetAddrPeerFilter. An empty list accepts every
+// peer.
+//
+// Tendermint only queries the peer filters of the application when its
+// filter_peers option is enabled.
+func NewPeerAllowList(peers []string) sdk.PeerFilter {
+	allowed := make(map[string]bool, len(peers))
+	for _, peer := range peers {
+		allowed[peer] = true
+	}
+
+	return func(info string) abci.ResponseQuery {
+		if len(allowed) == 0 || allowed[info] {
+			return abci.ResponseQuery{}
+		}
+
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "peer %s is not allowed", info))
+	}
+}
@@ -0,0 +1,110 @@
+package baseapp
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (app *BaseApp) setQuerierMiddlewares(middlewares []sdk.QuerierMiddleware) {
+	app.querierMiddlewares = middlewares
+}
+
+// wrapQuerier wraps the querier of a custom query route with the querier
+// middlewares of the BaseApp, the first middleware being the outermost.
+func (app *BaseApp) wrapQuerier(route string, querier sdk.Querier) sdk.Querier {
+	for i := len(app.querierMiddlewares) - 1; i >= 0; i-- {
+		querier = app.querierMiddlewares[i](route, querier)
+	}
+
+	return querier
+}
+
+// QueryRateLimit defines the rate at which the queries of a route are allowed:
+// up to Burst queries may be made at once, and Rate queries per second are
+// allowed on average.
+type QueryRateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// queryRateLimiter implements a token bucket per query route.
+type queryRateLimiter struct {
+	mtx     sync.Mutex
+	limits  map[string]QueryRateLimit
+	buckets map[string]*queryBucket
+	now     func() time.Time
+}
+
+type queryBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewQueryRateLimiter returns a querier middleware limiting the rate of the
+// queries made to each route with a limit, which fail with ErrTooManyRequests
+// once the limit is exceeded. Routes without a limit are not rate limited.
+//
+// The queries are limited per node and not per client, as the client of an
+// ABCI query is not known to the application.
+func NewQueryRateLimiter(limits map[string]QueryRateLimit) sdk.QuerierMiddleware {
+	return newQueryRateLimiter(limits, time.Now).middleware
+}
+
+func newQueryRateLimiter(limits map[string]QueryRateLimit, now func() time.Time) *queryRateLimiter {
+	return &queryRateLimiter{
+		limits:  limits,
+		buckets: make(map[string]*queryBucket),
+		now:     now,
+	}
+}
+
+func (rl *queryRateLimiter) middleware(route string, next sdk.Querier) sdk.Querier {
+	if _, ok := rl.limits[route]; !ok {
+		return next
+	}
+
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if !rl.allow(route) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrTooManyRequests, "query rate limit exceeded for route %s", route)
+		}
+
+		return next(ctx, path, req)
+	}
+}
+
+// allow consumes a token of the bucket of the route, returning false if the
+// bucket is empty.
+func (rl *queryRateLimiter) allow(route string) bool {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	limit := rl.limits[route]
+	now := rl.now()
+
+	bucket, ok := rl.buckets[route]
+	if !ok {
+		bucket = &queryBucket{tokens: float64(limit.Burst), last: now}
+		rl.buckets[route] = bucket
+	}
+
+	// refill the bucket for the time elapsed since the last query
+	if elapsed := now.Sub(bucket.last).Seconds(); elapsed > 0 {
+		bucket.tokens += elapsed * limit.Rate
+		if bucket.tokens > float64(limit.Burst) {
+			bucket.tokens = float64(limit.Burst)
+		}
+	}
+	bucket.last = now
+
+	if bucket.tokens < 1 {
+		return false
+	}
+
+	bucket.tokens--
+	return true
+}
@@ -0,0 +1,86 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestQuerierMiddlewares(t *testing.T) {
+	var calls []string
+	middleware := func(name string) sdk.QuerierMiddleware {
+		return func(route string, next sdk.Querier) sdk.Querier {
+			return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+				calls = append(calls, name+":"+route)
+				return next(ctx, path, req)
+			}
+		}
+	}
+
+	querier := func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		calls = append(calls, "querier")
+		return []byte("result"), nil
+	}
+	queryRouterOpt := func(bapp *BaseApp) { bapp.QueryRouter().AddRoute("middleware", querier) }
+
+	app := setupBaseApp(t, queryRouterOpt, SetQuerierMiddlewares(middleware("first"), middleware("second")))
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.Commit()
+
+	res := app.Query(abci.RequestQuery{Path: "/custom/middleware"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("result"), res.Value)
+
+	// the first middleware is the outermost one
+	require.Equal(t, []string{"first:middleware", "second:middleware", "querier"}, calls)
+}
+
+func TestQueryRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newQueryRateLimiter(map[string]QueryRateLimit{"limited": {Rate: 1, Burst: 2}}, func() time.Time { return now })
+
+	querier := func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		return []byte("result"), nil
+	}
+	limited := rl.middleware("limited", querier)
+	unlimited := rl.middleware("unlimited", querier)
+
+	// the burst of queries is allowed at once
+	for i := 0; i < 2; i++ {
+		_, err := limited(sdk.Context{}, nil, abci.RequestQuery{})
+		require.NoError(t, err)
+	}
+
+	_, err := limited(sdk.Context{}, nil, abci.RequestQuery{})
+	require.True(t, sdkerrors.ErrTooManyRequests.Is(err))
+
+	// routes without a limit are not rate limited
+	for i := 0; i < 10; i++ {
+		_, err := unlimited(sdk.Context{}, nil, abci.RequestQuery{})
+		require.NoError(t, err)
+	}
+
+	// a query is allowed again once a token is refilled
+	now = now.Add(time.Second)
+	_, err = limited(sdk.Context{}, nil, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	_, err = limited(sdk.Context{}, nil, abci.RequestQuery{})
+	require.True(t, sdkerrors.ErrTooManyRequests.Is(err))
+
+	// the bucket is never refilled beyond the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		_, err := limited(sdk.Context{}, nil, abci.RequestQuery{})
+		require.NoError(t, err)
+	}
+
+	_, err = limited(sdk.Context{}, nil, abci.RequestQuery{})
+	require.True(t, sdkerrors.ErrTooManyRequests.Is(err))
+}
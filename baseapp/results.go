@@ -0,0 +1,83 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ResultsHashKey is the key of the results store under which the results hash
+// of the last block is stored, see SetResultsCommitment.
+var ResultsHashKey = []byte("results_hash")
+
+// ResultLeaf returns the leaf committing to the deterministic part of a
+// result: its code, data and events. The log, info and gas fields are left
+// out, the gas being already committed to by Tendermint.
+func ResultLeaf(code uint32, data []byte, events []abci.Event) []byte {
+	res := abci.ResponseDeliverTx{Code: code, Data: data, Events: events}
+
+	bz, err := res.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// ResultLeaves returns the leaves of the results of a block, in order: the
+// BeginBlock events, the result of each tx, and the EndBlock events. The
+// results of a block are returned by the Tendermint block_results RPC
+// endpoint.
+func ResultLeaves(
+	beginBlockEvents []abci.Event, txResults []*abci.ResponseDeliverTx, endBlockEvents []abci.Event,
+) [][]byte {
+	leaves := make([][]byte, 0, len(txResults)+2)
+	leaves = append(leaves, ResultLeaf(0, nil, beginBlockEvents))
+
+	for _, res := range txResults {
+		leaves = append(leaves, ResultLeaf(res.Code, res.Data, res.Events))
+	}
+
+	return append(leaves, ResultLeaf(0, nil, endBlockEvents))
+}
+
+// ResultsHash returns the merkle root of the leaves of the results of a block.
+func ResultsHash(leaves [][]byte) []byte {
+	return merkle.SimpleHashFromByteSlices(leaves)
+}
+
+// ResultsProofs returns the merkle root of the leaves of the results of a
+// block, along with the inclusion proof of each leaf.
+func ResultsProofs(leaves [][]byte) ([]byte, []*merkle.SimpleProof) {
+	return merkle.SimpleProofsFromByteSlices(leaves)
+}
+
+func (app *BaseApp) setResultsStoreKey(key sdk.StoreKey) {
+	app.resultsStoreKey = key
+}
+
+// addResultLeaf adds the leaf of a result of the current block, if the
+// results are committed.
+func (app *BaseApp) addResultLeaf(code uint32, data []byte, events []abci.Event) {
+	if app.resultsStoreKey == nil {
+		return
+	}
+
+	app.resultLeaves = append(app.resultLeaves, ResultLeaf(code, data, events))
+}
+
+// commitResults stores the results hash of the current block in the results
+// store, so that it is committed in the app hash along with the block state.
+func (app *BaseApp) commitResults() {
+	if app.resultsStoreKey == nil {
+		return
+	}
+
+	// the store is accessed without gas meter, as the hash is stored
+	// regardless of the gas consumed by the block
+	store := app.deliverState.ms.GetKVStore(app.resultsStoreKey)
+	store.Set(ResultsHashKey, ResultsHash(app.resultLeaves))
+
+	app.resultLeaves = nil
+}
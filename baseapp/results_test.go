@@ -0,0 +1,96 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestResultsCommitment(t *testing.T) {
+	resultsKey := sdk.NewKVStoreKey("results")
+
+	opts := func(bapp *BaseApp) {
+		bapp.MountStores(resultsKey)
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			return abci.ResponseBeginBlock{Events: counterEvent("begin", 1).ToABCIEvents()}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			return abci.ResponseEndBlock{Events: counterEvent("end", 1).ToABCIEvents()}
+		})
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			return &sdk.Result{Data: []byte("data"), Events: counterEvent("msg", m.Counter).ToABCIEvents()}, nil
+		})
+	}
+
+	app := setupBaseApp(t, opts, SetResultsCommitment(resultsKey))
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: 1}
+	beginBlock := app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	var txResults []*abci.ResponseDeliverTx
+	for i := int64(0); i < 3; i++ {
+		tx := newTxCounter(i, i)
+		tx.setFailOnHandler(i == 1)
+
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		txResults = append(txResults, &res)
+	}
+	require.False(t, txResults[1].IsOK())
+
+	endBlock := app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the results hash stored matches the hash of the block results
+	leaves := ResultLeaves(beginBlock.Events, txResults, endBlock.Events)
+	require.Len(t, leaves, 5)
+
+	root, proofs := ResultsProofs(leaves)
+	require.Equal(t, ResultsHash(leaves), root)
+	require.Equal(t, root, app.cms.GetCommitKVStore(resultsKey).Get(ResultsHashKey))
+
+	for i, leaf := range leaves {
+		require.NoError(t, proofs[i].Verify(root, leaf))
+	}
+
+	// the leaves only commit to the deterministic fields of the results
+	withLog := *txResults[1]
+	withLog.Log, withLog.GasUsed = "other log", 1
+	require.Equal(t, leaves[2], ResultLeaf(withLog.Code, withLog.Data, withLog.Events))
+
+	// the results hash may be queried with a proof against the app hash
+	res := app.Query(abci.RequestQuery{
+		Path:   "/store/results/key",
+		Data:   ResultsHashKey,
+		Height: header.Height,
+		Prove:  true,
+	})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, root, res.Value)
+	require.NotNil(t, res.Proof)
+
+	// without the option, no results are committed
+	app = setupBaseApp(t, opts)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+	require.Nil(t, app.cms.GetCommitKVStore(resultsKey).Get(ResultsHashKey))
+	require.Empty(t, app.resultLeaves)
+}
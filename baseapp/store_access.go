@@ -0,0 +1,164 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// storeAccessViolation is the panic raised by an accessCheckStore on the access
+// of a key which is not declared by the message executed against the store.
+type storeAccessViolation struct {
+	storeName string
+	key       []byte
+}
+
+func (v storeAccessViolation) String() string {
+	return fmt.Sprintf("undeclared access to key %X of store %s", v.key, v.storeName)
+}
+
+// cacheMultiStore aliases sdk.CacheMultiStore so that it can be embedded in
+// accessCheckMultiStore, which overrides its CacheMultiStore method.
+type cacheMultiStore = sdk.CacheMultiStore
+
+// accessCheckMultiStore wraps the cache multi-store a message is executed
+// against in parallel, so that its KV stores, and the ones of its branches,
+// only allow the access of the keys declared by the message.
+type accessCheckMultiStore struct {
+	cacheMultiStore
+
+	accesses []sdk.StoreAccess
+}
+
+func newAccessCheckMultiStore(ms sdk.CacheMultiStore, accesses []sdk.StoreAccess) accessCheckMultiStore {
+	return accessCheckMultiStore{cacheMultiStore: ms, accesses: accesses}
+}
+
+// GetStore implements sdk.MultiStore.
+func (ms accessCheckMultiStore) GetStore(key sdk.StoreKey) sdk.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements sdk.MultiStore.
+func (ms accessCheckMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	var accesses []sdk.StoreAccess
+	for _, access := range ms.accesses {
+		if access.StoreKey == key.Name() {
+			accesses = append(accesses, access)
+		}
+	}
+
+	return accessCheckStore{KVStore: ms.cacheMultiStore.GetKVStore(key), name: key.Name(), accesses: accesses}
+}
+
+// CacheMultiStore implements sdk.MultiStore.
+func (ms accessCheckMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newAccessCheckMultiStore(ms.cacheMultiStore.CacheMultiStore(), ms.accesses)
+}
+
+// accessCheckStore wraps a KV store so that the access of a key which is not
+// declared by the accesses raises a storeAccessViolation panic.
+type accessCheckStore struct {
+	sdk.KVStore
+
+	name     string
+	accesses []sdk.StoreAccess
+}
+
+// Get implements sdk.KVStore.
+func (s accessCheckStore) Get(key []byte) []byte {
+	s.checkKey(key)
+	return s.KVStore.Get(key)
+}
+
+// Has implements sdk.KVStore.
+func (s accessCheckStore) Has(key []byte) bool {
+	s.checkKey(key)
+	return s.KVStore.Has(key)
+}
+
+// Set implements sdk.KVStore.
+func (s accessCheckStore) Set(key, value []byte) {
+	s.checkKey(key)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements sdk.KVStore.
+func (s accessCheckStore) Delete(key []byte) {
+	s.checkKey(key)
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements sdk.KVStore.
+func (s accessCheckStore) Iterator(start, end []byte) sdk.Iterator {
+	s.checkRange(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements sdk.KVStore.
+func (s accessCheckStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	s.checkRange(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// CacheWrap implements sdk.KVStore, branching the checked store.
+func (s accessCheckStore) CacheWrap() sdk.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s accessCheckStore) checkKey(key []byte) {
+	for _, access := range s.accesses {
+		if bytes.HasPrefix(key, access.Prefix) {
+			return
+		}
+	}
+
+	panic(storeAccessViolation{storeName: s.name, key: key})
+}
+
+// checkRange checks that the [start, end) range is within a declared access.
+func (s accessCheckStore) checkRange(start, end []byte) {
+	for _, access := range s.accesses {
+		if len(access.Prefix) == 0 {
+			return
+		}
+
+		if bytes.HasPrefix(start, access.Prefix) && end != nil &&
+			bytes.Compare(end, sdk.PrefixEndBytes(access.Prefix)) <= 0 {
+			return
+		}
+	}
+
+	panic(storeAccessViolation{storeName: s.name, key: start})
+}
+
+// gasConsumption is a gas consumption recorded by a gasRecorder.
+type gasConsumption struct {
+	amount     sdk.Gas
+	descriptor string
+}
+
+// gasRecorder wraps the gas meter of a message executed in parallel to record
+// its gas consumptions, which are replayed on the gas meter of the transaction
+// in the order of the messages, so that the gas is accounted exactly as when
+// the messages are executed sequentially, out of gas panics included.
+type gasRecorder struct {
+	sdk.GasMeter
+
+	consumptions []gasConsumption
+}
+
+// ConsumeGas implements sdk.GasMeter.
+func (g *gasRecorder) ConsumeGas(amount sdk.Gas, descriptor string) {
+	g.consumptions = append(g.consumptions, gasConsumption{amount: amount, descriptor: descriptor})
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// replay consumes the recorded gas on the given gas meter.
+func (g *gasRecorder) replay(meter sdk.GasMeter) {
+	for _, c := range g.consumptions {
+		meter.ConsumeGas(c.amount, c.descriptor)
+	}
+}
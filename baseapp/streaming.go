@@ -0,0 +1,133 @@
+package baseapp
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is the interface that external services, e.g. message queue
+// producers or database indexers, implement in order to receive the ABCI
+// requests and responses processed by a BaseApp.
+//
+// Listeners are invoked synchronously once the BaseApp has processed a request.
+// An error returned by a listener is logged and does not affect consensus, so
+// listeners must not rely on their errors halting the node.
+type ABCIListener interface {
+	// ListenBeginBlock is called after the BeginBlock ABCI method.
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock is called after the EndBlock ABCI method.
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx is called after the DeliverTx ABCI method.
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit is called after the Commit ABCI method, with the context of
+	// the block that was committed.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// ABCIListenerConstructor creates a new ABCIListener. It is registered under a
+// name with RegisterABCIListener so that listeners can be enabled by name from
+// the node configuration.
+type ABCIListenerConstructor func() (ABCIListener, error)
+
+var (
+	abciListenersMtx  sync.RWMutex
+	abciListenerCtors = map[string]ABCIListenerConstructor{}
+)
+
+// RegisterABCIListener registers an ABCIListener constructor under the given
+// name. It is meant to be called from the init function of the package
+// implementing the listener, so that importing that package makes the listener
+// available to LoadABCIListeners.
+//
+// This function PANICs if a listener is already registered under that name.
+func RegisterABCIListener(name string, ctor ABCIListenerConstructor) {
+	abciListenersMtx.Lock()
+	defer abciListenersMtx.Unlock()
+
+	if _, found := abciListenerCtors[name]; found {
+		panic(fmt.Sprintf("ABCI listener %s has already been registered", name))
+	}
+
+	abciListenerCtors[name] = ctor
+}
+
+// RegisteredABCIListeners returns the sorted names of all registered
+// ABCIListener constructors.
+func RegisteredABCIListeners() []string {
+	abciListenersMtx.RLock()
+	defer abciListenersMtx.RUnlock()
+
+	names := make([]string, 0, len(abciListenerCtors))
+	for name := range abciListenerCtors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// LoadABCIListeners creates the ABCIListeners registered under the given names,
+// in order. It returns an error if a name is not registered or if a
+// constructor fails.
+func LoadABCIListeners(names []string) ([]ABCIListener, error) {
+	abciListenersMtx.RLock()
+	defer abciListenersMtx.RUnlock()
+
+	listeners := make([]ABCIListener, 0, len(names))
+	for _, name := range names {
+		ctor, found := abciListenerCtors[name]
+		if !found {
+			return nil, fmt.Errorf("unknown ABCI listener %s", name)
+		}
+
+		listener, err := ctor()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ABCI listener %s: %w", name, err)
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return listeners, nil
+}
+
+func (app *BaseApp) setABCIListeners(listeners []ABCIListener) {
+	app.abciListeners = listeners
+}
+
+func (app *BaseApp) listenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenBeginBlock(ctx, req, res); err != nil {
+			app.logger.Error("ABCI listener failed on BeginBlock", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenEndBlock(ctx, req, res); err != nil {
+			app.logger.Error("ABCI listener failed on EndBlock", "height", req.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenDeliverTx(ctx, req, res); err != nil {
+			app.logger.Error("ABCI listener failed on DeliverTx", "height", ctx.BlockHeight(), "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenCommit(ctx sdk.Context, res abci.ResponseCommit) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenCommit(ctx, res); err != nil {
+			app.logger.Error("ABCI listener failed on Commit", "height", ctx.BlockHeight(), "err", err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package baseapp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockABCIListener struct {
+	err error
+
+	beginBlocks []abci.RequestBeginBlock
+	endBlocks   []abci.RequestEndBlock
+	deliverTxs  []abci.ResponseDeliverTx
+	commits     []int64
+}
+
+func (l *mockABCIListener) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	l.beginBlocks = append(l.beginBlocks, req)
+	return l.err
+}
+
+func (l *mockABCIListener) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	l.endBlocks = append(l.endBlocks, req)
+	return l.err
+}
+
+func (l *mockABCIListener) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	l.deliverTxs = append(l.deliverTxs, res)
+	return l.err
+}
+
+func (l *mockABCIListener) ListenCommit(ctx sdk.Context, _ abci.ResponseCommit) error {
+	l.commits = append(l.commits, ctx.BlockHeight())
+	return l.err
+}
+
+func TestABCIListeners(t *testing.T) {
+	listener := &mockABCIListener{}
+	failing := &mockABCIListener{err: errors.New("listener failure")}
+
+	app := setupBaseApp(t, SetABCIListeners(listener, failing))
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 2; height++ {
+		header := abci.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("invalid tx")})
+		require.False(t, res.IsOK())
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// failing listeners are notified as well and do not halt the app
+	for _, l := range []*mockABCIListener{listener, failing} {
+		require.Len(t, l.beginBlocks, 2)
+		require.Equal(t, int64(2), l.beginBlocks[1].Header.Height)
+		require.Len(t, l.endBlocks, 2)
+		require.Len(t, l.deliverTxs, 2)
+		require.False(t, l.deliverTxs[0].IsOK())
+		require.Equal(t, []int64{1, 2}, l.commits)
+	}
+}
+
+func TestLoadABCIListeners(t *testing.T) {
+	RegisterABCIListener("test-mock", func() (ABCIListener, error) {
+		return &mockABCIListener{}, nil
+	})
+	RegisterABCIListener("test-failing", func() (ABCIListener, error) {
+		return nil, errors.New("cannot connect")
+	})
+
+	require.Panics(t, func() {
+		RegisterABCIListener("test-mock", func() (ABCIListener, error) { return nil, nil })
+	})
+	require.Subset(t, RegisteredABCIListeners(), []string{"test-failing", "test-mock"})
+
+	listeners, err := LoadABCIListeners([]string{"test-mock", "test-mock"})
+	require.NoError(t, err)
+	require.Len(t, listeners, 2)
+
+	listeners, err = LoadABCIListeners(nil)
+	require.NoError(t, err)
+	require.Empty(t, listeners)
+
+	_, err = LoadABCIListeners([]string{"test-mock", "unknown"})
+	require.Error(t, err)
+
+	_, err = LoadABCIListeners([]string{"test-failing"})
+	require.Error(t, err)
+}
@@ -2,6 +2,8 @@ package context
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -48,6 +50,7 @@ type CLIContext struct {
 	SkipConfirm      bool
 	TxGenerator      TxGenerator
 	AccountRetriever AccountRetriever
+	QueryCache       *QueryCache
 
 	// TODO: Deprecated (remove).
 	Codec *codec.Codec
@@ -311,36 +314,19 @@ func (ctx CLIContext) WithAccountRetriever(retriever AccountRetriever) CLIContex
 	return ctx
 }
 
+// WithQueryCache returns the context with an updated QueryCache
+func (ctx CLIContext) WithQueryCache(cache *QueryCache) CLIContext {
+	ctx.QueryCache = cache
+	return ctx
+}
+
 // Println outputs toPrint to the ctx.Output based on ctx.OutputFormat which is
 // either text or json. If text, toPrint will be YAML encoded. Otherwise, toPrint
 // will be JSON encoded using ctx.JSONMarshaler. An error is returned upon failure.
 func (ctx CLIContext) Println(toPrint interface{}) error {
-	var (
-		out []byte
-		err error
-	)
-
-	switch ctx.OutputFormat {
-	case "text":
-		out, err = yaml.Marshal(&toPrint)
-
-	case "json":
-		out, err = ctx.JSONMarshaler.MarshalJSON(toPrint)
-
-		// To JSON indent, we re-encode the already encoded JSON given there is no
-		// error. The re-encoded JSON uses the standard library as the initial encoded
-		// JSON should have the correct output produced by ctx.JSONMarshaler.
-		if ctx.Indent && err == nil {
-			out, err = codec.MarshalIndentFromJSON(out)
-		}
-	}
-
-	if err != nil {
-		return err
-	}
-
-	_, err = fmt.Fprintf(ctx.Output, "%s\n", out)
-	return err
+	return ctx.printOutput(toPrint, func(o interface{}) ([]byte, error) {
+		return ctx.JSONMarshaler.MarshalJSON(o)
+	})
 }
 
 // PrintOutput prints output while respecting output and indent flags
@@ -350,29 +336,65 @@ func (ctx CLIContext) Println(toPrint interface{}) error {
 // TODO: Remove once client-side Protobuf migration has been completed.
 // ref: https://github.com/cosmos/cosmos-sdk/issues/5864
 func (ctx CLIContext) PrintOutput(toPrint interface{}) error {
+	return ctx.printOutput(toPrint, func(o interface{}) ([]byte, error) {
+		return ctx.Codec.MarshalJSON(o)
+	})
+}
+
+// PrintRaw prints already JSON encoded output, e.g. returned by a node, while
+// respecting output and indent flags. If text, it is printed as the equivalent
+// YAML document.
+func (ctx CLIContext) PrintRaw(toPrint json.RawMessage) error {
+	if ctx.OutputFormat == "json" {
+		return ctx.printOutput(toPrint, func(interface{}) ([]byte, error) { return toPrint, nil })
+	}
+
+	var doc interface{}
+	if err := yaml.Unmarshal(toPrint, &doc); err != nil {
+		return err
+	}
+
+	return ctx.printOutput(doc, nil)
+}
+
+// printOutput writes toPrint to ctx.Output, YAML encoded if the output format
+// is text, the default, or JSON encoded with marshalJSON if it is json, so
+// that scripts can parse the output of all the commands the same way.
+func (ctx CLIContext) printOutput(toPrint interface{}, marshalJSON func(interface{}) ([]byte, error)) error {
 	var (
 		out []byte
 		err error
 	)
 
 	switch ctx.OutputFormat {
-	case "text":
+	case "", "text":
 		out, err = yaml.Marshal(&toPrint)
 
 	case "json":
-		if ctx.Indent {
-			out, err = ctx.Codec.MarshalJSONIndent(toPrint, "", "  ")
-		} else {
-			out, err = ctx.Codec.MarshalJSON(toPrint)
+		out, err = marshalJSON(toPrint)
+
+		// To JSON indent, we re-encode the already encoded JSON given there is no
+		// error. The re-encoded JSON uses the standard library as the initial encoded
+		// JSON should have the correct output produced by marshalJSON.
+		if ctx.Indent && err == nil {
+			out, err = codec.MarshalIndentFromJSON(out)
 		}
+
+	default:
+		return fmt.Errorf("unsupported output format %q, expected text or json", ctx.OutputFormat)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(string(out))
-	return nil
+	output := ctx.Output
+	if output == nil {
+		output = os.Stdout
+	}
+
+	_, err = fmt.Fprintf(output, "%s\n", bytes.TrimRight(out, "\n"))
+	return err
 }
 
 // GetFromFields returns a from account address and Keybase name given either
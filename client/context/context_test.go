@@ -1,6 +1,7 @@
 package context_test
 
 import (
+	"bytes"
 	"os"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 func TestCLIContext_WithOffline(t *testing.T) {
@@ -72,6 +74,48 @@ func TestCLIContext_WithKeyring(t *testing.T) {
 	require.Equal(t, kr, ctx.Keyring)
 }
 
+func TestCLIContext_PrintOutput(t *testing.T) {
+	type output struct {
+		Height int64  `json:"height" yaml:"height"`
+		Status string `json:"status" yaml:"status"`
+	}
+
+	printTo := func(format string, indent bool, fn func(context.CLIContext) error) (string, error) {
+		buf := new(bytes.Buffer)
+		ctx := context.CLIContext{OutputFormat: format, Indent: indent}.WithCodec(codec.New()).WithOutput(buf)
+		err := fn(ctx)
+		return buf.String(), err
+	}
+
+	printOutput := func(ctx context.CLIContext) error { return ctx.PrintOutput(output{Height: 42, Status: "ok"}) }
+	printRaw := func(ctx context.CLIContext) error { return ctx.PrintRaw([]byte(`{"status":"ok","height":"42"}`)) }
+
+	out, err := printTo("json", false, printOutput)
+	require.NoError(t, err)
+	require.Equal(t, `{"height":"42","status":"ok"}`+"\n", out)
+
+	out, err = printTo("json", true, printOutput)
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"height\": \"42\",\n  \"status\": \"ok\"\n}\n", out)
+
+	out, err = printTo("text", false, printOutput)
+	require.NoError(t, err)
+	require.Equal(t, "height: 42\nstatus: ok\n", out)
+
+	// raw JSON is printed as is, or as YAML
+	out, err = printTo("json", false, printRaw)
+	require.NoError(t, err)
+	require.Equal(t, `{"status":"ok","height":"42"}`+"\n", out)
+
+	out, err = printTo("", false, printRaw)
+	require.NoError(t, err)
+	require.Equal(t, "height: \"42\"\nstatus: ok\n", out)
+
+	// unknown formats are rejected instead of printing nothing
+	_, err = printTo("xml", false, printOutput)
+	require.Error(t, err)
+}
+
 func TestMain(m *testing.M) {
 	viper.Set(flags.FlagKeyringBackend, keyring.BackendMemory)
 	os.Exit(m.Run())
@@ -0,0 +1,75 @@
+package context
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// StoreProof holds the value of a store key at a height, if any, along with
+// the merkle proof of its membership or non-membership in the application
+// state, e.g. for bridges and light clients to check it against the app hash.
+type StoreProof struct {
+	Height    int64            `json:"height"`
+	StoreName string           `json:"store_name"`
+	Key       tmbytes.HexBytes `json:"key"`
+	// Value is empty if the key is not set, in which case the proof is a
+	// non-membership proof.
+	Value tmbytes.HexBytes `json:"value"`
+	Proof *merkle.Proof    `json:"proof"`
+}
+
+// Verify verifies the proof against the app hash of the height, which is held
+// by the header of the next block.
+func (p StoreProof) Verify(appHash []byte) error {
+	if p.Proof == nil {
+		return errors.New("missing merkle proof")
+	}
+
+	kp := merkle.KeyPath{}.
+		AppendKey([]byte(p.StoreName), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(p.Proof, appHash, kp.String())
+	}
+
+	return prt.VerifyValue(p.Proof, appHash, kp.String(), p.Value)
+}
+
+// QueryStoreProof queries the value of a single key of the given store along
+// with its merkle proof. The proof is requested even if the node is trusted,
+// and verified if it is not. The query cache is bypassed, as it may hold the
+// response of the same query without a proof.
+func (ctx CLIContext) QueryStoreProof(key []byte, storeName string) (StoreProof, error) {
+	if len(key) == 0 {
+		return StoreProof{}, errors.New("empty store key")
+	}
+
+	resp, err := ctx.queryNode(abci.RequestQuery{
+		Path:  fmt.Sprintf("/store/%s/key", storeName),
+		Data:  key,
+		Prove: true,
+	})
+	if err != nil {
+		return StoreProof{}, err
+	}
+
+	if resp.Proof == nil {
+		return StoreProof{}, fmt.Errorf("no proof returned for key %X of store %s", key, storeName)
+	}
+
+	return StoreProof{
+		Height:    resp.Height,
+		StoreName: storeName,
+		Key:       key,
+		Value:     resp.Value,
+		Proof:     resp.Proof,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package context_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestStoreProofVerify(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	key := types.NewKVStoreKey("acc")
+
+	store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	store.GetCommitStore(key).(*iavl.Store).Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := store.Commit()
+
+	queryProof := func(k []byte) context.StoreProof {
+		res := store.Query(abci.RequestQuery{Path: "/acc/key", Data: k, Prove: true})
+		require.NotNil(t, res.Proof)
+
+		return context.StoreProof{
+			Height: res.Height, StoreName: "acc", Key: k, Value: res.Value, Proof: res.Proof,
+		}
+	}
+
+	// membership proof
+	proof := queryProof([]byte("MYKEY"))
+	require.NoError(t, proof.Verify(cid.Hash))
+
+	tampered := proof
+	tampered.Value = []byte("OTHERVALUE")
+	require.Error(t, tampered.Verify(cid.Hash))
+
+	tampered = proof
+	tampered.Value = nil
+	require.Error(t, tampered.Verify(cid.Hash))
+
+	// non-membership proof
+	proof = queryProof([]byte("MYABSENTKEY"))
+	require.Empty(t, proof.Value)
+	require.NoError(t, proof.Verify(cid.Hash))
+
+	tampered = proof
+	tampered.StoreName = "bank"
+	require.Error(t, tampered.Verify(cid.Hash))
+
+	require.Error(t, context.StoreProof{StoreName: "acc", Key: []byte("MYKEY")}.Verify(cid.Hash))
+}
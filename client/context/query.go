@@ -7,7 +7,6 @@ import (
 	"github.com/pkg/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmliteErr "github.com/tendermint/tendermint/lite/errors"
 	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
@@ -78,7 +77,34 @@ func (ctx CLIContext) GetFromName() string {
 	return ctx.FromName
 }
 
+// queryABCI performs the query, serving the queries pinned to a height from the
+// QueryCache of the context, if any.
 func (ctx CLIContext) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error) {
+	if ctx.QueryCache == nil {
+		return ctx.queryNode(req)
+	}
+
+	if ctx.Height > 0 {
+		if res, ok := ctx.QueryCache.Get(req.Path, req.Data, ctx.Height); ok {
+			return res, nil
+		}
+	}
+
+	res, err := ctx.queryNode(req)
+	if err != nil {
+		return res, err
+	}
+
+	if ctx.Height > 0 {
+		ctx.QueryCache.Set(req.Path, req.Data, ctx.Height, res)
+	} else {
+		ctx.QueryCache.ObserveHeight(res.Height)
+	}
+
+	return res, nil
+}
+
+func (ctx CLIContext) queryNode(req abci.RequestQuery) (abci.ResponseQuery, error) {
 	node, err := ctx.GetNode()
 	if err != nil {
 		return abci.ResponseQuery{}, err
@@ -157,28 +183,14 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 		return err
 	}
 
-	// TODO: Instead of reconstructing, stash on CLIContext field?
-	prt := rootmulti.DefaultProofRuntime()
-
 	// TODO: Better convention for path?
 	storeName, err := parseQueryStorePath(queryPath)
 	if err != nil {
 		return err
 	}
 
-	kp := merkle.KeyPath{}
-	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
-
-	if resp.Value == nil {
-		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
-		if err != nil {
-			return errors.Wrap(err, "failed to prove merkle proof")
-		}
-		return nil
-	}
-
-	if err := prt.VerifyValue(resp.Proof, commit.Header.AppHash, kp.String(), resp.Value); err != nil {
+	proof := StoreProof{StoreName: storeName, Key: resp.Key, Value: resp.Value, Proof: resp.Proof}
+	if err := proof.Verify(commit.Header.AppHash); err != nil {
 		return errors.Wrap(err, "failed to prove merkle proof")
 	}
 
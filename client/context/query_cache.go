@@ -0,0 +1,104 @@
+package context
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// QueryCache caches the responses of ABCI queries pinned to a height, keyed by
+// query path, data and height, for a fixed time to live. As a node may prune
+// or roll back the state it serves, all cached responses are invalidated when
+// a query which is not pinned to a height observes a newer latest height.
+//
+// A QueryCache is safe for concurrent use, and is meant to be shared by the
+// CLIContexts of a long-running process such as the REST server.
+type QueryCache struct {
+	mtx          sync.Mutex
+	ttl          time.Duration
+	entries      map[string]queryCacheEntry
+	latestHeight int64
+	now          func() time.Time
+}
+
+type queryCacheEntry struct {
+	res     abci.ResponseQuery
+	expires time.Time
+}
+
+// NewQueryCache returns a new QueryCache keeping responses for the given time
+// to live.
+func NewQueryCache(ttl time.Duration) *QueryCache {
+	return &QueryCache{
+		ttl:     ttl,
+		entries: make(map[string]queryCacheEntry),
+		now:     time.Now,
+	}
+}
+
+func queryCacheKey(path string, data []byte, height int64) string {
+	return fmt.Sprintf("%s/%s/%d", path, hex.EncodeToString(data), height)
+}
+
+// Get returns the cached response of a query at the given height, if any and
+// not expired.
+func (c *QueryCache) Get(path string, data []byte, height int64) (abci.ResponseQuery, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := queryCacheKey(path, data, height)
+	entry, ok := c.entries[key]
+	if !ok {
+		return abci.ResponseQuery{}, false
+	}
+
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return abci.ResponseQuery{}, false
+	}
+
+	return entry.res, true
+}
+
+// Set caches the response of a query at the given height, pruning the
+// expired responses.
+func (c *QueryCache) Set(path string, data []byte, height int64, res abci.ResponseQuery) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := c.now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.entries[queryCacheKey(path, data, height)] = queryCacheEntry{res: res, expires: now.Add(c.ttl)}
+}
+
+// ObserveHeight records the latest height returned by a query which is not
+// pinned to a height, invalidating all cached responses if it is newer than
+// the latest height observed so far.
+func (c *QueryCache) ObserveHeight(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height <= c.latestHeight {
+		return
+	}
+
+	c.latestHeight = height
+	c.entries = make(map[string]queryCacheEntry)
+}
+
+// Len returns the number of cached responses, including expired ones which
+// have not been pruned yet.
+func (c *QueryCache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return len(c.entries)
+}
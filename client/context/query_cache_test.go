@@ -0,0 +1,56 @@
+package context
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := NewQueryCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	res := abci.ResponseQuery{Value: []byte("value"), Height: 10}
+	cache.Set("custom/bank/balance", []byte("data"), 10, res)
+
+	// responses are keyed by path, data and height
+	got, ok := cache.Get("custom/bank/balance", []byte("data"), 10)
+	require.True(t, ok)
+	require.Equal(t, res, got)
+
+	_, ok = cache.Get("custom/bank/balance", []byte("data"), 11)
+	require.False(t, ok)
+	_, ok = cache.Get("custom/bank/balance", []byte("other"), 10)
+	require.False(t, ok)
+	_, ok = cache.Get("custom/bank/supply", []byte("data"), 10)
+	require.False(t, ok)
+
+	// responses expire after the time to live
+	now = now.Add(time.Minute)
+	_, ok = cache.Get("custom/bank/balance", []byte("data"), 10)
+	require.False(t, ok)
+	require.Equal(t, 0, cache.Len())
+
+	// expired responses are pruned when a response is cached
+	cache.Set("custom/bank/balance", []byte("data"), 10, res)
+	now = now.Add(time.Minute)
+	cache.Set("custom/bank/balance", []byte("data"), 11, res)
+	require.Equal(t, 1, cache.Len())
+
+	// a newer latest height invalidates all responses, an older one does not
+	cache.ObserveHeight(12)
+	require.Equal(t, 0, cache.Len())
+
+	cache.Set("custom/bank/balance", []byte("data"), 11, res)
+	cache.ObserveHeight(12)
+	cache.ObserveHeight(11)
+	_, ok = cache.Get("custom/bank/balance", []byte("data"), 11)
+	require.True(t, ok)
+
+	cache.ObserveHeight(13)
+	_, ok = cache.Get("custom/bank/balance", []byte("data"), 11)
+	require.False(t, ok)
+}
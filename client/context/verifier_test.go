@@ -21,6 +21,7 @@ func TestCreateVerifier(t *testing.T) {
 		{"no chain ID", context.CLIContext{}, true},
 		{"no home directory", context.CLIContext{}.WithChainID("test"), true},
 		{"no client or RPC URI", context.CLIContext{HomeDir: tmpDir}.WithChainID("test"), true},
+		{"trusted node", context.CLIContext{}.WithTrustNode(true), false},
 	}
 
 	for _, tc := range testCases {
@@ -29,7 +30,8 @@ func TestCreateVerifier(t *testing.T) {
 			verifier, err := context.CreateVerifier(tc.ctx, context.DefaultVerifierCacheSize)
 			require.Equal(t, tc.expectErr, err != nil, err)
 
-			if !tc.expectErr {
+			// no verifier is needed to query a trusted node
+			if !tc.expectErr && !tc.ctx.TrustNode {
 				require.NotNil(t, verifier)
 			}
 		})
@@ -38,10 +38,12 @@ func GetPassword(prompt string, buf *bufio.Reader) (pass string, err error) {
 
 // GetConfirmation will request user give the confirmation from stdin.
 // "y", "Y", "yes", "YES", and "Yes" all count as confirmations.
-// If the input is not recognized, it returns false and a nil error.
+// If the input is not recognized, it returns false and a nil error. The prompt
+// is written to w, e.g. stderr, so that it does not mix with the output of the
+// command.
 func GetConfirmation(prompt string, r *bufio.Reader, w io.Writer) (bool, error) {
 	if inputIsTty() {
-		fmt.Printf("%s [y/N]: ", prompt)
+		fmt.Fprintf(w, "%s [y/N]: ", prompt)
 	}
 
 	response, err := readLineFromBuf(r)
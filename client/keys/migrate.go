@@ -116,15 +116,15 @@ func runMigrateCmd(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		// NOTE: A passphrase is not actually needed here as when the key information
-		// is imported into the Keyring-based Keybase it only needs the password
-		// (see: writeLocalKey).
+		// NOTE: The private key is only encrypted with migratePassphrase while in
+		// transit, the Keyring-based Keybase encrypting it on its own when it is
+		// imported (see: writeLocalKey).
 		armoredPriv, err := legacyKb.ExportPrivKey(keyName, password, migratePassphrase)
 		if err != nil {
 			return err
 		}
 
-		if err := migrator.Import(keyName, armoredPriv); err != nil {
+		if err := migrator.ImportPrivKey(keyName, armoredPriv, migratePassphrase); err != nil {
 			return err
 		}
 	}
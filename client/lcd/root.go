@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/gorilla/handlers"
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/lcd/statik"
@@ -29,16 +31,27 @@ type RestServer struct {
 	Mux    *mux.Router
 	CliCtx context.CLIContext
 
-	log      log.Logger
-	listener net.Listener
+	log          log.Logger
+	listener     net.Listener
+	swaggerSpecs map[string][]byte
+	chainIDMtx   sync.Mutex
 }
 
+// APIVersionPrefix is the path prefix of the versioned REST API.
+const APIVersionPrefix = "/v1"
+
 // NewRestServer creates a new rest server instance
 func NewRestServer(cdc *codec.Codec) *RestServer {
 	r := mux.NewRouter()
 	cliCtx := context.NewCLIContext().WithCodec(cdc)
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
 
+	// cache the responses of height pinned queries, e.g. of explorers polling
+	// past blocks
+	if ttl := viper.GetDuration(flags.FlagQueryCacheTTL); ttl > 0 {
+		cliCtx = cliCtx.WithQueryCache(context.NewQueryCache(ttl))
+	}
+
 	return &RestServer{
 		Mux:    r,
 		CliCtx: cliCtx,
@@ -96,6 +109,9 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			rs := NewRestServer(cdc)
 
+			// the versioned routes are registered first so that no legacy
+			// route matches their prefix
+			rs.registerVersionedRoutes(registerRoutesFn)
 			registerRoutesFn(rs)
 			rs.registerSwaggerUI()
 
@@ -117,12 +133,84 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 	return flags.RegisterRestServerFlags(cmd)
 }
 
+// RegisterSwaggerSpecs registers the Swagger specifications, keyed by module
+// name, that are merged into the served REST API specification. It must be
+// called before the server starts, typically with the result of
+// BasicManager.SwaggerSpecs.
+func (rs *RestServer) RegisterSwaggerSpecs(specs map[string][]byte) {
+	if rs.swaggerSpecs == nil {
+		rs.swaggerSpecs = make(map[string][]byte)
+	}
+
+	for name, spec := range specs {
+		rs.swaggerSpecs[name] = spec
+	}
+}
+
+// registerVersionedRoutes registers the routes again under the /v1 prefix,
+// where the responses are wrapped in a rest.ResponseEnvelope holding the chain
+// ID and the height of the query. The legacy routes remain unchanged.
+func (rs *RestServer) registerVersionedRoutes(registerRoutesFn func(*RestServer)) {
+	v1 := &RestServer{
+		Mux:    rs.Mux.PathPrefix(APIVersionPrefix).Subrouter(),
+		CliCtx: rs.CliCtx,
+		log:    rs.log,
+	}
+	v1.Mux.Use(rest.EnvelopeMiddleware(rs.chainID))
+
+	registerRoutesFn(v1)
+}
+
+// chainID returns the chain ID set with the --chain-id flag or else the one of
+// the node, which is cached once known.
+func (rs *RestServer) chainID() string {
+	rs.chainIDMtx.Lock()
+	defer rs.chainIDMtx.Unlock()
+
+	if rs.CliCtx.ChainID != "" {
+		return rs.CliCtx.ChainID
+	}
+
+	node, err := rs.CliCtx.GetNode()
+	if err != nil {
+		return ""
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		rs.log.Error("failed to query the chain ID of the node", "err", err)
+		return ""
+	}
+
+	rs.CliCtx.ChainID = status.NodeInfo.Network
+	return rs.CliCtx.ChainID
+}
+
 func (rs *RestServer) registerSwaggerUI() {
 	statikFS, err := fs.New()
 	if err != nil {
 		panic(err)
 	}
 
+	base, err := fs.ReadFile(statikFS, "/swagger.yaml")
+	if err != nil {
+		panic(err)
+	}
+
+	spec, err := MergeSwaggerSpecs(base, rs.swaggerSpecs)
+	if err != nil {
+		panic(err)
+	}
+
+	specHandler := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/x-yaml")
+		_, _ = w.Write(spec)
+	}
+
+	rs.Mux.HandleFunc("/swagger.yaml", specHandler).Methods("GET")
+	rs.Mux.HandleFunc("/swagger/swagger.yaml", specHandler).Methods("GET")
+
 	staticServer := http.FileServer(statikFS)
+	rs.Mux.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
 	rs.Mux.PathPrefix("/").Handler(staticServer)
 }
@@ -0,0 +1,126 @@
+package lcd
+
+import (
+	"fmt"
+	"sort"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Swagger specification sections that are merged key by key.
+var swaggerMapSections = []string{"paths", "definitions", "parameters", "responses"}
+
+// MergeSwaggerSpecs merges the module Swagger specifications, keyed by module
+// name, into the base specification and returns the result YAML encoded. Paths,
+// definitions, parameters and responses are merged key by key while tags are
+// appended unless the base already declares them. An error is returned if two
+// specifications declare the same operation on a path, or the same definition,
+// parameter or response with different contents.
+func MergeSwaggerSpecs(base []byte, specs map[string][]byte) ([]byte, error) {
+	merged := make(map[interface{}]interface{})
+	if err := yaml.Unmarshal(base, &merged); err != nil {
+		return nil, fmt.Errorf("failed to decode base swagger spec: %w", err)
+	}
+
+	// merge the modules in a deterministic order
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		spec := make(map[interface{}]interface{})
+		if err := yaml.Unmarshal(specs[name], &spec); err != nil {
+			return nil, fmt.Errorf("failed to decode %s swagger spec: %w", name, err)
+		}
+
+		for _, section := range swaggerMapSections {
+			if err := mergeSwaggerSection(merged, spec, section); err != nil {
+				return nil, fmt.Errorf("failed to merge %s swagger spec: %w", name, err)
+			}
+		}
+
+		mergeSwaggerTags(merged, spec)
+	}
+
+	return yaml.Marshal(merged)
+}
+
+func mergeSwaggerSection(dst, src map[interface{}]interface{}, section string) error {
+	srcSection, ok := src[section].(map[interface{}]interface{})
+	if !ok {
+		return nil
+	}
+
+	dstSection, ok := dst[section].(map[interface{}]interface{})
+	if !ok {
+		dstSection = make(map[interface{}]interface{})
+		dst[section] = dstSection
+	}
+
+	for key, value := range srcSection {
+		existing, ok := dstSection[key]
+		if !ok {
+			dstSection[key] = value
+			continue
+		}
+
+		if section != "paths" {
+			if !yamlEqual(existing, value) {
+				return fmt.Errorf("conflicting %s entry %v", section, key)
+			}
+			continue
+		}
+
+		// the same path may be shared by several modules as long as each one
+		// registers different operations (HTTP methods)
+		dstOps, ok1 := existing.(map[interface{}]interface{})
+		srcOps, ok2 := value.(map[interface{}]interface{})
+		if !ok1 || !ok2 {
+			return fmt.Errorf("invalid path item %v", key)
+		}
+
+		for method, op := range srcOps {
+			if _, ok := dstOps[method]; ok {
+				return fmt.Errorf("duplicate operation %v %v", method, key)
+			}
+			dstOps[method] = op
+		}
+	}
+
+	return nil
+}
+
+func mergeSwaggerTags(dst, src map[interface{}]interface{}) {
+	srcTags, ok := src["tags"].([]interface{})
+	if !ok {
+		return
+	}
+
+	dstTags, _ := dst["tags"].([]interface{})
+
+	known := make(map[interface{}]bool, len(dstTags))
+	for _, tag := range dstTags {
+		if t, ok := tag.(map[interface{}]interface{}); ok {
+			known[t["name"]] = true
+		}
+	}
+
+	for _, tag := range srcTags {
+		t, ok := tag.(map[interface{}]interface{})
+		if ok && known[t["name"]] {
+			continue
+		}
+		dstTags = append(dstTags, tag)
+	}
+
+	dst["tags"] = dstTags
+}
+
+func yamlEqual(a, b interface{}) bool {
+	bzA, errA := yaml.Marshal(a)
+	bzB, errB := yaml.Marshal(b)
+
+	return errA == nil && errB == nil && string(bzA) == string(bzB)
+}
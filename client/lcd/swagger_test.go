@@ -0,0 +1,84 @@
+package lcd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const baseSpec = `
+swagger: "2.0"
+tags:
+  - name: Bank
+paths:
+  /bank/balances/{address}:
+    get:
+      summary: Get the account balances
+definitions:
+  Coin:
+    type: object
+`
+
+func TestMergeSwaggerSpecs(t *testing.T) {
+	specs := map[string][]byte{
+		"upgrade": []byte(`
+tags:
+  - name: Upgrade
+  - name: Bank
+paths:
+  /upgrade/current:
+    get:
+      summary: Get the current upgrade plan
+  /bank/balances/{address}:
+    post:
+      summary: Send coins
+definitions:
+  Coin:
+    type: object
+  UpgradePlan:
+    type: object
+`),
+	}
+
+	bz, err := MergeSwaggerSpecs([]byte(baseSpec), specs)
+	require.NoError(t, err)
+
+	var merged struct {
+		Tags        []map[string]string               `yaml:"tags"`
+		Paths       map[string]map[string]interface{} `yaml:"paths"`
+		Definitions map[string]interface{}            `yaml:"definitions"`
+	}
+	require.NoError(t, yaml.Unmarshal(bz, &merged))
+
+	require.Equal(t, []map[string]string{{"name": "Bank"}, {"name": "Upgrade"}}, merged.Tags)
+	require.Contains(t, merged.Paths, "/upgrade/current")
+	require.Contains(t, merged.Paths["/bank/balances/{address}"], "get")
+	require.Contains(t, merged.Paths["/bank/balances/{address}"], "post")
+	require.Contains(t, merged.Definitions, "Coin")
+	require.Contains(t, merged.Definitions, "UpgradePlan")
+}
+
+func TestMergeSwaggerSpecsConflicts(t *testing.T) {
+	_, err := MergeSwaggerSpecs([]byte(baseSpec), map[string][]byte{
+		"bank": []byte(`
+paths:
+  /bank/balances/{address}:
+    get:
+      summary: Get the balances again
+`),
+	})
+	require.Error(t, err)
+
+	_, err = MergeSwaggerSpecs([]byte(baseSpec), map[string][]byte{
+		"bank": []byte(`
+definitions:
+  Coin:
+    type: string
+`),
+	})
+	require.Error(t, err)
+
+	_, err = MergeSwaggerSpecs([]byte(baseSpec), map[string][]byte{"bank": []byte("paths: [")})
+	require.Error(t, err)
+}
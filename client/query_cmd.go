@@ -0,0 +1,207 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// QueryEndpoint describes a query endpoint of a module querier, from which
+// NewQueryCmd generates a query command.
+type QueryEndpoint struct {
+	// Use is the name of the command.
+	Use   string
+	Short string
+	// Path is the path of the endpoint, appended to the route of the querier.
+	Path string
+	// Params is the zero value of the struct holding the parameters of the
+	// query, if any. Its exported fields are set, in order, from the arguments
+	// of the command, which are named after the JSON names of the fields.
+	Params interface{}
+	// Output is the zero value of the type of the response, which is decoded
+	// and printed according to the output flag. If nil, the JSON response is
+	// printed as is.
+	Output interface{}
+}
+
+// NewModuleQueryCmd returns the query command of a module, holding the query
+// commands generated from the given endpoints of its querier, so that modules
+// only have to declare their query endpoints.
+func NewModuleQueryCmd(cdc *codec.Codec, moduleName, querierRoute string, endpoints ...QueryEndpoint) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        moduleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", moduleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       ValidateCmd,
+	}
+
+	cmds := make([]*cobra.Command, len(endpoints))
+	for i, endpoint := range endpoints {
+		cmds[i] = NewQueryCmd(cdc, querierRoute, endpoint)
+	}
+
+	cmd.AddCommand(flags.GetCommands(cmds...)...)
+
+	return cmd
+}
+
+// NewQueryCmd generates the query command of the given endpoint of the querier
+// registered under querierRoute. It panics if the parameters of the endpoint
+// are not a struct.
+func NewQueryCmd(cdc *codec.Codec, querierRoute string, endpoint QueryEndpoint) *cobra.Command {
+	argNames := QueryArgNames(endpoint.Params)
+
+	use := endpoint.Use
+	for _, name := range argNames {
+		use += fmt.Sprintf(" [%s]", name)
+	}
+
+	return &cobra.Command{
+		Use:   use,
+		Short: endpoint.Short,
+		Args:  cobra.ExactArgs(len(argNames)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var data []byte
+			if endpoint.Params != nil {
+				params, err := ParseQueryParams(endpoint.Params, args)
+				if err != nil {
+					return err
+				}
+
+				data, err = cdc.MarshalJSON(params)
+				if err != nil {
+					return err
+				}
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", querierRoute, endpoint.Path)
+			res, _, err := cliCtx.QueryWithData(route, data)
+			if err != nil {
+				return err
+			}
+
+			if endpoint.Output == nil {
+				return cliCtx.PrintRaw(res)
+			}
+
+			out := reflect.New(reflect.TypeOf(endpoint.Output))
+			if err := cdc.UnmarshalJSON(res, out.Interface()); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out.Elem().Interface())
+		},
+	}
+}
+
+// QueryArgNames returns the names of the arguments setting the exported fields
+// of the given query parameters, which are the JSON names of the fields with
+// dashes instead of underscores. It panics if params is not a struct.
+func QueryArgNames(params interface{}) []string {
+	if params == nil {
+		return nil
+	}
+
+	t := reflect.TypeOf(params)
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("query parameters must be a struct, got %T", params))
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			name = strings.ToLower(field.Name)
+		}
+
+		names = append(names, strings.ReplaceAll(name, "_", "-"))
+	}
+
+	return names
+}
+
+// ParseQueryParams returns a copy of the given query parameters with their
+// exported fields set, in order, from args. Strings, booleans and integers are
+// parsed as such; other types, e.g. addresses, sdk.Int and time.Time, are
+// decoded from their JSON string encoding.
+func ParseQueryParams(params interface{}, args []string) (interface{}, error) {
+	names := QueryArgNames(params)
+	if len(args) != len(names) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(names), len(args))
+	}
+
+	v := reflect.New(reflect.TypeOf(params)).Elem()
+	v.Set(reflect.ValueOf(params))
+
+	i := 0
+	for j := 0; j < v.NumField(); j++ {
+		if v.Type().Field(j).PkgPath != "" {
+			continue
+		}
+
+		if err := setQueryArg(v.Field(j), args[i]); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", names[i], args[i], err)
+		}
+
+		i++
+	}
+
+	return v.Interface(), nil
+}
+
+func setQueryArg(field reflect.Value, arg string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(arg)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(arg, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(arg, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetUint(n)
+
+	default:
+		bz, err := json.Marshal(arg)
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(bz, field.Addr().Interface())
+	}
+
+	return nil
+}
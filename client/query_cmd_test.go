@@ -0,0 +1,76 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type testQueryParams struct {
+	Delegator sdk.AccAddress `json:"delegator_addr"`
+	Height    int64          `json:"height"`
+	Status    string         `json:"status,omitempty"`
+	Limit     uint64
+	Amount    sdk.Int `json:"amount"`
+	internal  bool
+}
+
+func TestQueryArgNames(t *testing.T) {
+	require.Nil(t, client.QueryArgNames(nil))
+	require.Equal(t,
+		[]string{"delegator-addr", "height", "status", "limit", "amount"},
+		client.QueryArgNames(testQueryParams{}),
+	)
+	require.Panics(t, func() { client.QueryArgNames("params") })
+}
+
+func TestParseQueryParams(t *testing.T) {
+	addr := sdk.AccAddress([]byte("delegator"))
+
+	params, err := client.ParseQueryParams(
+		testQueryParams{internal: true},
+		[]string{addr.String(), "-3", "bonded", "10", "42"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, testQueryParams{
+		Delegator: addr,
+		Height:    -3,
+		Status:    "bonded",
+		Limit:     10,
+		Amount:    sdk.NewInt(42),
+		internal:  true,
+	}, params)
+
+	_, err = client.ParseQueryParams(testQueryParams{}, []string{addr.String()})
+	require.Error(t, err)
+
+	_, err = client.ParseQueryParams(testQueryParams{}, []string{"invalid", "-3", "bonded", "10", "42"})
+	require.Error(t, err)
+
+	_, err = client.ParseQueryParams(testQueryParams{}, []string{addr.String(), "-3", "bonded", "-10", "42"})
+	require.Error(t, err)
+}
+
+func TestNewQueryCmd(t *testing.T) {
+	cdc := codec.New()
+
+	cmd := client.NewQueryCmd(cdc, "staking", client.QueryEndpoint{Use: "delegation", Params: testQueryParams{}})
+	require.Equal(t, "delegation [delegator-addr] [height] [status] [limit] [amount]", cmd.Use)
+	require.Error(t, cmd.Args(cmd, []string{"a"}))
+	require.NoError(t, cmd.Args(cmd, []string{"a", "b", "c", "d", "e"}))
+
+	cmd = client.NewQueryCmd(cdc, "staking", client.QueryEndpoint{Use: "params"})
+	require.Equal(t, "params", cmd.Use)
+	require.NoError(t, cmd.Args(cmd, nil))
+
+	moduleCmd := client.NewModuleQueryCmd(cdc, "staking", "staking",
+		client.QueryEndpoint{Use: "params"},
+		client.QueryEndpoint{Use: "pool"},
+	)
+	require.Equal(t, "staking", moduleCmd.Use)
+	require.Len(t, moduleCmd.Commands(), 2)
+}
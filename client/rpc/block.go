@@ -1,7 +1,6 @@
 package rpc
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
 
@@ -12,11 +11,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// ResultBlockOutput is a block as returned by Tendermint along with the bech32
+// consensus address of its proposer.
+type ResultBlockOutput struct {
+	BlockID         tmtypes.BlockID `json:"block_id"`
+	Block           *tmtypes.Block  `json:"block"`
+	ProposerAddress sdk.ConsAddress `json:"proposer_address"`
+}
+
+// NewResultBlockOutput returns the output of the given Tendermint block result.
+func NewResultBlockOutput(res *ctypes.ResultBlock) ResultBlockOutput {
+	return ResultBlockOutput{
+		BlockID:         res.BlockID,
+		Block:           res.Block,
+		ProposerAddress: sdk.ConsAddress(res.Block.ProposerAddress),
+	}
+}
+
 //BlockCommand returns the verified block data for a given heights
 func BlockCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -64,11 +83,12 @@ func getBlock(cliCtx context.CLIContext, height *int64) ([]byte, error) {
 		}
 	}
 
+	output := NewResultBlockOutput(res)
 	if cliCtx.Indent {
-		return codec.Cdc.MarshalJSONIndent(res, "", "  ")
+		return codec.Cdc.MarshalJSONIndent(output, "", "  ")
 	}
 
-	return codec.Cdc.MarshalJSON(res)
+	return codec.Cdc.MarshalJSON(output)
 }
 
 // get the current blockchain height
@@ -103,13 +123,14 @@ func printBlock(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	output, err := getBlock(context.NewCLIContext(), height)
+	cliCtx := context.NewCLIContext()
+
+	output, err := getBlock(cliCtx, height)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(string(output))
-	return nil
+	return cliCtx.PrintRaw(output)
 }
 
 // REST
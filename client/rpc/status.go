@@ -1,7 +1,6 @@
 package rpc
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/spf13/cobra"
@@ -53,18 +52,12 @@ func printNodeStatus(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
-	var output []byte
-	if cliCtx.Indent {
-		output, err = codec.Cdc.MarshalJSONIndent(status, "", "  ")
-	} else {
-		output, err = codec.Cdc.MarshalJSON(status)
-	}
+	output, err := codec.Cdc.MarshalJSON(status)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(string(output))
-	return nil
+	return cliCtx.PrintRaw(output)
 }
 
 // NodeInfoResponse defines a response type that contains node status and version
@@ -0,0 +1,248 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	// EventTypeKey is the query parameter used to select the Tendermint event
+	// type (e.g. NewBlock or Tx) a subscription listens to.
+	EventTypeKey = "event"
+
+	// subscriptionOutCapacity is the buffer size of the channel Tendermint
+	// pushes subscription results onto.
+	subscriptionOutCapacity = 100
+
+	// writeWait is the time allowed to write a single message to the client.
+	writeWait = 10 * time.Second
+
+	// apiUnsafeCORSKey is the configuration key enabling CORS on the API server
+	// started along with the node.
+	apiUnsafeCORSKey = "api.enabled-unsafe-cors"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     checkOrigin,
+}
+
+// checkOrigin only accepts WebSocket connections from the origin of the server,
+// unless CORS is enabled, either on the REST server (--unsafe-cors) or on the
+// API server of the node (enabled-unsafe-cors).
+func checkOrigin(r *http.Request) bool {
+	if viper.GetBool(flags.FlagUnsafeCORS) || viper.GetBool(apiUnsafeCORSKey) {
+		return true
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		// not a browser request
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// EventResponse defines the JSON payload pushed to a subscribed WebSocket
+// client for every event matching its query.
+type EventResponse struct {
+	Query  string              `json:"query"`
+	Events map[string][]string `json:"events"`
+	Data   json.RawMessage     `json:"data"`
+}
+
+// TxEventData defines the JSON decoded representation of a transaction event
+// where the raw transaction bytes are replaced by the decoded transaction.
+type TxEventData struct {
+	Height int64                  `json:"height"`
+	TxHash string                 `json:"txhash"`
+	Index  uint32                 `json:"index"`
+	Tx     sdk.Tx                 `json:"tx"`
+	Result abci.ResponseDeliverTx `json:"result"`
+}
+
+// SubscribeRequestHandlerFn implements a REST handler that upgrades the
+// connection to a WebSocket, if the request comes from the origin of the server
+// or CORS is enabled, and proxies the matching Tendermint event
+// subscription to the client. The subscription query is built from the
+// event query parameter (defaults to Tx) and any additional query parameters,
+// which are interpreted as {eventType}.{eventAttribute}={value} filters.
+// Alternatively, a raw Tendermint query may be provided via the query parameter.
+func SubscribeRequestHandlerFn(cliCtx clientcontext.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := parseSubscriptionQuery(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		node, err := cliCtx.GetNode()
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		// the websocket client of the node must be running in order to subscribe
+		if !node.IsRunning() {
+			if err := node.Start(); rest.CheckInternalServerError(w, err) {
+				return
+			}
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader already replied to the client
+			return
+		}
+		defer conn.Close()
+
+		// clear the deadlines inherited from the HTTP server
+		conn.SetReadDeadline(time.Time{}) // nolint: errcheck
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		subscriber := fmt.Sprintf("rest-server-%s", r.RemoteAddr)
+
+		out, err := node.Subscribe(ctx, subscriber, query, subscriptionOutCapacity)
+		if err != nil {
+			writeCloseMessage(conn, websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+		defer node.Unsubscribe(context.Background(), subscriber, query) // nolint: errcheck
+
+		// the client is not expected to send anything; reading is only required
+		// to process control messages and detect when the connection is closed
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case ev, ok := <-out:
+				if !ok {
+					writeCloseMessage(conn, websocket.CloseGoingAway, "subscription cancelled")
+					return
+				}
+
+				bz, err := formatEvent(cliCtx, ev)
+				if err != nil {
+					writeCloseMessage(conn, websocket.CloseInternalServerErr, err.Error())
+					return
+				}
+
+				conn.SetWriteDeadline(time.Now().Add(writeWait)) // nolint: errcheck
+				if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// parseSubscriptionQuery builds a Tendermint event query out of the request's
+// query parameters.
+func parseSubscriptionQuery(r *http.Request) (string, error) {
+	if err := r.ParseForm(); err != nil {
+		return "", fmt.Errorf("failed to parse query parameters: %w", err)
+	}
+
+	if query := r.FormValue("query"); query != "" {
+		return query, nil
+	}
+
+	eventType := r.FormValue(EventTypeKey)
+	if eventType == "" {
+		eventType = tmtypes.EventTx
+	}
+
+	events := []string{fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, eventType)}
+
+	// sort the filters so that the same request always yields the same query
+	keys := make([]string, 0, len(r.Form))
+	for key := range r.Form {
+		if key != EventTypeKey {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// the values are already decoded by ParseForm
+	for _, key := range keys {
+		value := r.Form[key][0]
+		if key == tmtypes.TxHeightKey {
+			events = append(events, fmt.Sprintf("%s=%s", key, value))
+		} else {
+			events = append(events, fmt.Sprintf("%s='%s'", key, value))
+		}
+	}
+
+	return strings.Join(events, " AND "), nil
+}
+
+// formatEvent JSON encodes an event result. Transaction events are decoded with
+// the context's codec so that clients do not have to deal with raw amino bytes.
+func formatEvent(cliCtx clientcontext.CLIContext, ev ctypes.ResultEvent) ([]byte, error) {
+	var data interface{} = ev.Data
+
+	if txEvent, ok := ev.Data.(tmtypes.EventDataTx); ok {
+		var tx sdk.Tx
+		if err := cliCtx.Codec.UnmarshalBinaryBare(txEvent.Tx, &tx); err != nil {
+			return nil, err
+		}
+
+		data = TxEventData{
+			Height: txEvent.Height,
+			TxHash: fmt.Sprintf("%X", txEvent.Tx.Hash()),
+			Index:  txEvent.Index,
+			Tx:     tx,
+			Result: txEvent.Result,
+		}
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(EventResponse{
+		Query:  ev.Query,
+		Events: ev.Events,
+		Data:   bz,
+	})
+}
+
+func writeCloseMessage(conn *websocket.Conn, code int, text string) {
+	msg := websocket.FormatCloseMessage(code, text)
+	conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeWait)) // nolint: errcheck
+}
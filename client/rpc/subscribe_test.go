@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestParseSubscriptionQuery(t *testing.T) {
+	testCases := []struct {
+		name   string
+		target string
+		query  string
+	}{
+		{"default event", "/subscribe", "tm.event='Tx'"},
+		{"raw query", "/subscribe?query=tm.event%3D%27NewBlock%27", "tm.event='NewBlock'"},
+		{
+			"filters",
+			"/subscribe?event=Tx&tx.height=10&message.sender=cosmos1abc",
+			"tm.event='Tx' AND message.sender='cosmos1abc' AND tx.height=10",
+		},
+		// the values are only decoded once
+		{"encoded value", "/subscribe?transfer.memo=a%252Bb", "tm.event='Tx' AND transfer.memo='a%2Bb'"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := parseSubscriptionQuery(httptest.NewRequest("GET", tc.target, nil))
+			require.NoError(t, err)
+			require.Equal(t, tc.query, query)
+		})
+	}
+}
+
+func TestCheckOrigin(t *testing.T) {
+	testCases := []struct {
+		name    string
+		origin  string
+		cors    string
+		allowed bool
+	}{
+		{"no origin", "", "", true},
+		{"same origin", "http://localhost:1317", "", true},
+		{"cross origin", "http://example.com", "", false},
+		{"invalid origin", "://", "", false},
+		{"cross origin with unsafe cors", "http://example.com", flags.FlagUnsafeCORS, true},
+		{"cross origin with api unsafe cors", "http://example.com", apiUnsafeCORSKey, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.cors != "" {
+				viper.Set(tc.cors, true)
+				defer viper.Set(tc.cors, false)
+			}
+
+			r := httptest.NewRequest("GET", "http://localhost:1317/subscribe", nil)
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+
+			require.Equal(t, tc.allowed, checkOrigin(r))
+		})
+	}
+}
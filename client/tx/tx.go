@@ -29,6 +29,10 @@ func GenerateOrBroadcastTx(ctx context.CLIContext, msgs ...sdk.Msg) error {
 // GenerateOrBroadcastTxWithFactory will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxWithFactory(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
+	if ctx.Simulate {
+		return DryRunTx(ctx, txf, msgs...)
+	}
+
 	if ctx.GenerateOnly {
 		return GenerateTx(ctx, txf, msgs...)
 	}
@@ -63,16 +67,52 @@ func GenerateTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
 	return ctx.Println(tx.GetTx())
 }
 
+// DryRunTx builds the transaction that BroadcastTx would sign and broadcast,
+// with its gas simulated, and prints it unsigned to the writer specified by
+// ctx.Output, so that its messages and fees can be reviewed. Its gas estimate
+// and signers are printed to os.Stderr. Nothing is signed nor broadcast.
+func DryRunTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
+	if ctx.Offline {
+		return errors.New("cannot perform a dry run in offline mode")
+	}
+
+	txf, err := PrepareFactory(ctx, txf)
+	if err != nil {
+		return err
+	}
+
+	_, adjusted, err := CalculateGas(ctx.QueryWithData, txf, msgs...)
+	if err != nil {
+		return err
+	}
+
+	txf = txf.WithGas(adjusted)
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", DryRunResponse{GasEstimate: txf.Gas(), Signers: msgsSigners(msgs)})
+
+	return ctx.Println(tx.GetTx())
+}
+
 // BroadcastTx attempts to generate, sign and broadcast a transaction with the
 // given set of messages. It will also simulate gas requirements if necessary.
-// It will return an error upon failure.
+// If a dry run was requested, the transaction is only printed. It will return
+// an error upon failure.
 func BroadcastTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
+	if ctx.Simulate {
+		return DryRunTx(ctx, txf, msgs...)
+	}
+
 	txf, err := PrepareFactory(ctx, txf)
 	if err != nil {
 		return err
 	}
 
-	if txf.SimulateAndExecute() || ctx.Simulate {
+	if txf.SimulateAndExecute() {
 		_, adjusted, err := CalculateGas(ctx.QueryWithData, txf, msgs...)
 		if err != nil {
 			return err
@@ -82,10 +122,6 @@ func BroadcastTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
 
-	if ctx.Simulate {
-		return nil
-	}
-
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -349,3 +385,37 @@ type GasEstimateResponse struct {
 func (gr GasEstimateResponse) String() string {
 	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
 }
+
+// DryRunResponse defines the summary of a dry run printed along with the
+// unsigned transaction: its gas estimate and the addresses that must sign it.
+type DryRunResponse struct {
+	GasEstimate uint64           `json:"gas_estimate" yaml:"gas_estimate"`
+	Signers     []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+func (dr DryRunResponse) String() string {
+	signers := make([]string, len(dr.Signers))
+	for i, signer := range dr.Signers {
+		signers[i] = signer.String()
+	}
+
+	return fmt.Sprintf("gas estimate: %d\nsigners: %s", dr.GasEstimate, strings.Join(signers, ", "))
+}
+
+// msgsSigners returns the signers of the given messages, without duplicates,
+// in the order they must sign the transaction.
+func msgsSigners(msgs []sdk.Msg) []sdk.AccAddress {
+	var signers []sdk.AccAddress
+	seen := map[string]bool{}
+
+	for _, msg := range msgs {
+		for _, addr := range msg.GetSigners() {
+			if !seen[addr.String()] {
+				signers = append(signers, addr)
+				seen[addr.String()] = true
+			}
+		}
+	}
+
+	return signers
+}
@@ -10,6 +10,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 )
 
 // Cdc defines a global generic sealed Amino codec to be used throughout sdk. It
@@ -45,6 +46,7 @@ func New() *Codec {
 // codec.
 func RegisterCrypto(cdc *Codec) {
 	cryptoamino.RegisterAmino(cdc.Amino)
+	secp256r1.RegisterAmino(cdc.Amino)
 }
 
 // RegisterEvidences registers Tendermint evidence types with the provided Amino
@@ -1,9 +1,15 @@
 package hd
 
 import (
+	"crypto/hmac"
+	"crypto/sha512"
+
 	"github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 )
 
 // PubKeyType defines an algorithm to derive key-pairs which can be used for cryptographic signing.
@@ -19,11 +25,18 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters, as secure enclaves and
+	// WebAuthn authenticators do.
+	Secp256r1Type = PubKeyType("secp256r1")
 )
 
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
+	// Sr25519 uses the Schnorrkel signature scheme over Ristretto25519.
+	Sr25519 = sr25519Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -66,3 +79,59 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return secp256k1.PrivKeySecp256k1(bzArr)
 	}
 }
+
+// deriveSecret returns a secret derived from the BIP39 seed of the mnemonic and
+// the HD path. BIP32 derivation is only defined for secp256k1, so the keys of
+// the other algorithms are generated from the HMAC-SHA512 of the seed keyed
+// with the HD path.
+func deriveSecret(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha512.New, []byte(hdPath))
+	mac.Write(seed) // nolint: errcheck
+
+	return mac.Sum(nil)[:32], nil
+}
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secret of the secp256r1 private key for the
+// given seed and HD path.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return deriveSecret
+}
+
+// Generate generates a secp256r1 private key from the given secret.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		return secp256r1.GenPrivKeyFromSecret(bz)
+	}
+}
+
+type sr25519Algo struct {
+}
+
+func (s sr25519Algo) Name() PubKeyType {
+	return Sr25519Type
+}
+
+// Derive derives and returns the secret of the sr25519 private key for the
+// given seed and HD path.
+func (s sr25519Algo) Derive() DeriveFn {
+	return deriveSecret
+}
+
+// Generate generates a sr25519 private key from the given secret.
+func (s sr25519Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		return sr25519.GenPrivKeyFromSecret(bz)
+	}
+}
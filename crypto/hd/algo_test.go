@@ -13,4 +13,28 @@ func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
+	require.Equal(t, hd.PubKeyType("secp256r1"), hd.Secp256r1Type)
+}
+
+func TestNonBIP32Algos(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+	for _, algo := range []interface {
+		Derive() hd.DeriveFn
+		Generate() hd.GenerateFn
+	}{hd.Secp256r1, hd.Sr25519} {
+		secret, err := algo.Derive()(mnemonic, "", "44'/118'/0'/0/0")
+		require.NoError(t, err)
+
+		otherSecret, err := algo.Derive()(mnemonic, "", "44'/118'/0'/0/1")
+		require.NoError(t, err)
+		require.NotEqual(t, secret, otherSecret)
+
+		privKey := algo.Generate()(secret)
+		require.True(t, privKey.Equals(algo.Generate()(secret)))
+
+		sig, err := privKey.Sign([]byte("msg"))
+		require.NoError(t, err)
+		require.True(t, privKey.PubKey().VerifyBytes([]byte("msg"), sig))
+	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 )
 
 // CryptoCdc defines the codec required for keys and info
@@ -13,6 +14,7 @@ var CryptoCdc *codec.Codec
 func init() {
 	CryptoCdc = codec.New()
 	cryptoAmino.RegisterAmino(CryptoCdc.Amino)
+	secp256r1.RegisterAmino(CryptoCdc.Amino)
 	RegisterCodec(CryptoCdc)
 	CryptoCdc.Seal()
 }
@@ -169,7 +169,7 @@ type keystore struct {
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1, hd.Sr25519},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
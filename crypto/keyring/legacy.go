@@ -188,8 +188,12 @@ func infoKey(name string) []byte { return []byte(fmt.Sprintf("%s.%s", name, info
 // InfoImporter is implemented by those types that want to provide functions necessary
 // to migrate keys from LegacyKeybase types to Keyring types.
 type InfoImporter interface {
-	// Import imports ASCII-armored private keys.
+	// Import imports ASCII-armored key infos.
 	Import(uid string, armor string) error
+
+	// ImportPrivKey imports an ASCII-armored private key, encrypted with the
+	// given passphrase, as a local key.
+	ImportPrivKey(uid, armor, passphrase string) error
 }
 
 type keyringMigrator struct {
@@ -228,6 +232,10 @@ func (m keyringMigrator) Import(uid string, armor string) error {
 	return m.kr.writeInfo(info)
 }
 
+func (m keyringMigrator) ImportPrivKey(uid, armor, passphrase string) error {
+	return m.kr.ImportPrivKey(uid, armor, passphrase)
+}
+
 // KeybaseOption overrides options for the db.
 type KeybaseOption func(*kbOptions)
 
@@ -8,8 +8,10 @@ import (
 	"github.com/otiai10/copy"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestNewLegacyKeyBase(t *testing.T) {
@@ -57,3 +59,33 @@ func TestLegacyKeybase(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, `public key already exist in keybase`, err.Error())
 }
+
+func TestInfoImporterImportPrivKey(t *testing.T) {
+	kr, err := keyring.New("cosmos", keyring.BackendMemory, "", nil)
+	require.NoError(t, err)
+
+	info, _, err := kr.NewMnemonic("foo", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	armor, err := kr.ExportPrivKeyArmor("foo", "passphrase")
+	require.NoError(t, err)
+
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	importer, err := keyring.NewInfoImporter("cosmos", keyring.BackendTest, dir, nil)
+	require.NoError(t, err)
+
+	require.Error(t, importer.ImportPrivKey("foo", armor, "wrong"))
+	require.NoError(t, importer.ImportPrivKey("foo", armor, "passphrase"))
+	require.Error(t, importer.ImportPrivKey("foo", armor, "passphrase"))
+
+	// the imported key is a local key with the same name and public key
+	migrated, err := keyring.New("cosmos", keyring.BackendTest, dir, nil)
+	require.NoError(t, err)
+
+	imported, err := migrated.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeLocal, imported.GetType())
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+}
@@ -13,11 +13,19 @@ type SignatureAlgo interface {
 }
 
 func NewSigningAlgoFromString(str string) (SignatureAlgo, error) {
-	if str != string(hd.Secp256k1.Name()) {
+	switch str {
+	case string(hd.Secp256k1.Name()):
+		return hd.Secp256k1, nil
+
+	case string(hd.Secp256r1.Name()):
+		return hd.Secp256r1, nil
+
+	case string(hd.Sr25519.Name()):
+		return hd.Sr25519, nil
+
+	default:
 		return nil, fmt.Errorf("provided algorithm `%s` is not supported", str)
 	}
-
-	return hd.Secp256k1, nil
 }
 
 type SigningAlgoList []SignatureAlgo
@@ -25,6 +25,20 @@ func TestNewSigningAlgoByString(t *testing.T) {
 			hd.Secp256k1,
 			nil,
 		},
+		{
+			"secp256r1",
+			"secp256r1",
+			true,
+			hd.Secp256r1,
+			nil,
+		},
+		{
+			"sr25519",
+			"sr25519",
+			true,
+			hd.Sr25519,
+			nil,
+		},
 		{
 			"not supported",
 			"notsupportedalgo",
@@ -38,7 +52,7 @@ func TestNewSigningAlgoByString(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			algorithm, err := NewSigningAlgoFromString(tt.algoStr)
 			if tt.isSupported {
-				require.Equal(t, hd.Secp256k1, algorithm)
+				require.Equal(t, tt.expectedAlgo, algorithm)
 			} else {
 				require.EqualError(t, err, tt.expectedErr.Error())
 			}
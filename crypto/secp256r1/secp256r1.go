@@ -0,0 +1,258 @@
+// Package secp256r1 implements the secp256r1 (NIST P-256) ECDSA signature
+// scheme, as used by secure enclaves and WebAuthn authenticators, for
+// transaction signing.
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+const (
+	// PrivKeyAminoName is the amino route of secp256r1 private keys.
+	PrivKeyAminoName = "cosmos/PrivKeySecp256r1"
+	// PubKeyAminoName is the amino route of secp256r1 public keys.
+	PubKeyAminoName = "cosmos/PubKeySecp256r1"
+
+	// PrivKeySize is the size in bytes of a private key.
+	PrivKeySize = 32
+	// PubKeySize is the size in bytes of a compressed public key.
+	PubKeySize = 33
+	// SignatureSize is the size in bytes of a signature, i.e. of the R and S
+	// values of the ECDSA signature.
+	SignatureSize = 64
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+
+	// allow the keys to be decoded with the Tendermint amino codec
+	cryptoamino.RegisterKeyType(PubKeySecp256r1{}, PubKeyAminoName)
+	cryptoamino.RegisterKeyType(PrivKeySecp256r1{}, PrivKeyAminoName)
+}
+
+// RegisterAmino registers the secp256r1 keys in the given amino codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+}
+
+var (
+	curve     = elliptic.P256()
+	curveHalf = new(big.Int).Rsh(curve.Params().N, 1)
+)
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 is a secp256r1 private key, the big endian encoding of its
+// scalar.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// GenPrivKey generates a new private key from a secure random source.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(rand.Reader)
+}
+
+func genPrivKey(r io.Reader) PrivKeySecp256r1 {
+	key, err := ecdsa.GenerateKey(curve, r)
+	if err != nil {
+		panic(err)
+	}
+
+	var privKey PrivKeySecp256r1
+	copy(privKey[:], padScalar(key.D))
+	return privKey
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA256 and derives a private key
+// from the hash, reduced to the range [1, N-1] of valid scalars. It is meant
+// to derive keys from a high entropy secret, e.g. a seed.
+func GenPrivKeyFromSecret(secret []byte) PrivKeySecp256r1 {
+	one := big.NewInt(1)
+	n := new(big.Int).Sub(curve.Params().N, one)
+
+	hash := sha256.Sum256(secret)
+	d := new(big.Int).SetBytes(hash[:])
+	d.Mod(d, n)
+	d.Add(d, one)
+
+	var privKey PrivKeySecp256r1
+	copy(privKey[:], padScalar(d))
+	return privKey
+}
+
+// Bytes returns the amino encoding of the private key.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign signs the SHA256 hash of the message. The signature is the R and S
+// values, in that order, with S in the lower half of the curve order to
+// prevent malleability.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+
+	r, s, err := ecdsa.Sign(rand.Reader, privKey.toECDSA(), hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(curveHalf) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	return append(padScalar(r), padScalar(s)...), nil
+}
+
+// PubKey returns the public key of the private key.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	key := privKey.toECDSA()
+	return compressPubKey(key.X, key.Y)
+}
+
+// Equals returns whether the given key is the same private key, in constant
+// time.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	otherSecp, ok := other.(PrivKeySecp256r1)
+	if !ok {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+}
+
+func (privKey PrivKeySecp256r1) toECDSA() *ecdsa.PrivateKey {
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey[:])}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(privKey[:])
+	return key
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 is a secp256r1 public key in compressed form, i.e. a 0x02 or
+// 0x03 byte depending on the parity of Y, followed by X.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the first 20 bytes of the SHA256 hash of the public key.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes returns the amino encoding of the public key.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a signature made with Sign. Signatures whose S value is
+// in the upper half of the curve order are rejected.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	x, y := decompressPubKey(pubKey)
+	if x == nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(curveHalf) > 0 {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, hash[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals returns whether the given key is the same public key.
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	otherSecp, ok := other.(PubKeySecp256r1)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(pubKey[:], otherSecp[:])
+}
+
+//-------------------------------------
+
+// padScalar returns the 32 bytes big endian encoding of the given scalar.
+func padScalar(i *big.Int) []byte {
+	bz := make([]byte, 32)
+	b := i.Bytes()
+	copy(bz[32-len(b):], b)
+	return bz
+}
+
+func compressPubKey(x, y *big.Int) PubKeySecp256r1 {
+	var pubKey PubKeySecp256r1
+	pubKey[0] = 0x02 + byte(y.Bit(0))
+	copy(pubKey[1:], padScalar(x))
+	return pubKey
+}
+
+// decompressPubKey returns the coordinates of the given public key, or nil if
+// it is not a valid point of the curve.
+func decompressPubKey(pubKey PubKeySecp256r1) (x, y *big.Int) {
+	if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+		return nil, nil
+	}
+
+	params := curve.Params()
+	x = new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil
+	}
+
+	// y² = x³ - 3x + b
+	x3 := new(big.Int).Mul(x, x)
+	x3.Mul(x3, x)
+
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+
+	y2 := new(big.Int).Sub(x3, threeX)
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+
+	y = new(big.Int).ModSqrt(y2, params.P)
+	if y == nil {
+		return nil, nil
+	}
+
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil
+	}
+
+	return x, y
+}
@@ -0,0 +1,72 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes([]byte("other message"), sig))
+	require.False(t, GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// the malleable signature with the high S value is rejected
+	s := new(big.Int).SetBytes(sig[32:])
+	highS := append(append([]byte{}, sig[:32]...), padScalar(new(big.Int).Sub(curve.Params().N, s))...)
+	require.False(t, pubKey.VerifyBytes(msg, highS))
+
+	// but it is a valid ECDSA signature
+	hash := sha256.Sum256(msg)
+	key := privKey.toECDSA()
+	require.True(t, ecdsa.Verify(&key.PublicKey, hash[:], new(big.Int).SetBytes(highS[:32]), new(big.Int).SetBytes(highS[32:])))
+}
+
+func TestPubKeyCompression(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		key := GenPrivKey().toECDSA()
+		pubKey := compressPubKey(key.X, key.Y)
+
+		x, y := decompressPubKey(pubKey)
+		require.Equal(t, key.X, x)
+		require.Equal(t, key.Y, y)
+	}
+
+	var invalid PubKeySecp256r1
+	invalid[0] = 0x04
+	x, _ := decompressPubKey(invalid)
+	require.Nil(t, x)
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("secret")
+	require.Equal(t, GenPrivKeyFromSecret(secret), GenPrivKeyFromSecret(secret))
+	require.NotEqual(t, GenPrivKeyFromSecret(secret), GenPrivKeyFromSecret([]byte("other secret")))
+}
+
+func TestAminoEncoding(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	decodedPriv, err := cryptoamino.PrivKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decodedPriv))
+
+	decodedPub, err := cryptoamino.PubKeyFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(decodedPub))
+	require.Equal(t, crypto.AddressHash(pubKey.(PubKeySecp256r1)[:]), decodedPub.Address())
+}
@@ -0,0 +1,55 @@
+package server
+
+// DONTCOVER
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// APIRegistrar is implemented by applications exposing REST routes through the
+// API server started along with the node. The provided CLIContext is connected
+// to the in-process Tendermint node; applications are expected to set their
+// codecs on it before registering the routes.
+type APIRegistrar interface {
+	RegisterAPIRoutes(r *mux.Router, cliCtx context.CLIContext)
+}
+
+// startAPIServer starts serving the router on the address of the API
+// configuration in a separate goroutine. The returned listener must be closed
+// to stop the server.
+func startAPIServer(apiCfg config.APIConfig, router *mux.Router, logger log.Logger) (net.Listener, error) {
+	cfg := tmrpcserver.DefaultConfig()
+	cfg.MaxOpenConnections = int(apiCfg.MaxOpenConnections)
+	cfg.ReadTimeout = time.Duration(apiCfg.RPCReadTimeout) * time.Second
+	cfg.WriteTimeout = time.Duration(apiCfg.RPCWriteTimeout) * time.Second
+	cfg.MaxBodyBytes = int64(apiCfg.RPCMaxBodyBytes)
+
+	listener, err := tmrpcserver.Listen(apiCfg.Address, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var h http.Handler = router
+	if apiCfg.EnableUnsafeCORS {
+		h = handlers.CORS()(h)
+	}
+
+	go func() {
+		logger.Info("starting API server", "address", apiCfg.Address)
+		if err := tmrpcserver.Serve(listener, h, logger, cfg); err != nil {
+			logger.Error("API server stopped", "err", err)
+		}
+	}()
+
+	return listener, nil
+}
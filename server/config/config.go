@@ -1,15 +1,30 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	defaultMinGasPrices = ""
+
+	// LogFormatPlain defines a logging format used for human-readable text logs.
+	LogFormatPlain = "plain"
+
+	// LogFormatJSON defines a logging format used for JSON logs ingested by log
+	// aggregation pipelines.
+	LogFormatJSON = "json"
+
+	// DefaultAPIAddress defines the default address the API server binds to.
+	DefaultAPIAddress = "tcp://0.0.0.0:1317"
+
+	// DefaultGRPCAddress defines the default address the gRPC server binds to.
+	DefaultGRPCAddress = "0.0.0.0:9090"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -22,27 +37,115 @@ type BaseConfig struct {
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
-	// Note: Commitment of state will be attempted on the corresponding block.
+	// Note: The block at the halt height is committed, while the node refuses
+	// to commit the blocks above it.
 	HaltHeight uint64 `mapstructure:"halt-height"`
 
 	// HaltTime contains a non-zero minimum block time (in Unix seconds) at which
 	// a node will gracefully halt and shutdown that can be used to assist
 	// upgrades and testing.
 	//
-	// Note: Commitment of state will be attempted on the corresponding block.
+	// Note: The node halts without executing the first block at or after the
+	// halt time.
 	HaltTime uint64 `mapstructure:"halt-time"`
 
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// IAVLFastIndex enables a flat index of the latest version of the IAVL
+	// stores, serving reads and iterations without traversing the trees. The
+	// index is built on the first startup with it enabled.
+	IAVLFastIndex bool `mapstructure:"iavl-fast-index"`
+
+	// AppDBBackend defines the backend of the application DB, e.g. goleveldb,
+	// cleveldb, rocksdb, boltdb, badgerdb or memdb. Backends other than goleveldb
+	// and memdb must be compiled in with the matching build tag. An empty value
+	// selects the backend the binary was built with.
+	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// QueryGasLimit defines the maximum gas a custom query may consume reading
+	// from the stores. 0 means queries are not gas limited.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
+	// QueryMaxResultBytes defines the maximum size in bytes of a custom query
+	// result. 0 means the result size is not limited.
+	QueryMaxResultBytes uint64 `mapstructure:"query-max-result-bytes"`
+
 	Pruning              string `mapstructure:"pruning"`
 	PruningKeepEvery     string `mapstructure:"pruning-keep-every"`
 	PruningSnapshotEvery string `mapstructure:"pruning-snapshot-every"`
 }
 
+// LogConfig defines the logging configuration
+type LogConfig struct {
+	// Level defines the log level filtering, either globally (e.g. "info") or
+	// per module (e.g. "main:info,x/bank:debug,*:error"). It overrides the
+	// Tendermint log level when set.
+	Level string `mapstructure:"level"`
+
+	// Format defines the log output format: "plain" or "json". It overrides the
+	// Tendermint log format when set.
+	Format string `mapstructure:"format"`
+}
+
+// APIConfig defines the API server configuration
+type APIConfig struct {
+	// Enable defines if the API server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the API server to listen on
+	Address string `mapstructure:"address"`
+
+	// MaxOpenConnections defines the number of maximum open connections
+	MaxOpenConnections uint `mapstructure:"max-open-connections"`
+
+	// RPCReadTimeout defines the Tendermint RPC read timeout (in seconds)
+	RPCReadTimeout uint `mapstructure:"rpc-read-timeout"`
+
+	// RPCWriteTimeout defines the Tendermint RPC write timeout (in seconds)
+	RPCWriteTimeout uint `mapstructure:"rpc-write-timeout"`
+
+	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
+	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
+
+	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
+	EnableUnsafeCORS bool `mapstructure:"enabled-unsafe-cors"`
+}
+
+// GRPCConfig defines the gRPC server configuration
+type GRPCConfig struct {
+	// Enable defines if the gRPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the gRPC server address to bind to.
+	Address string `mapstructure:"address"`
+}
+
+// StreamingConfig defines the ABCI streaming configuration
+type StreamingConfig struct {
+	// ABCIListeners defines the names of the registered ABCI listeners which
+	// are notified of the ABCI requests and responses processed by the app.
+	ABCIListeners []string `mapstructure:"abci-listeners"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// Log defines the logging configuration
+	Log LogConfig `mapstructure:"log"`
+
+	// API defines the API server configuration
+	API APIConfig `mapstructure:"api"`
+
+	// GRPC defines the gRPC server configuration
+	GRPC GRPCConfig `mapstructure:"grpc"`
+
+	// Streaming defines the ABCI streaming configuration
+	Streaming StreamingConfig `mapstructure:"streaming"`
+
+	// Telemetry defines the application telemetry configuration
+	Telemetry telemetry.Config `mapstructure:"telemetry"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -72,15 +175,61 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 	return gasPrices
 }
 
+// ValidateBasic performs stateless validation of the configuration.
+func (c *Config) ValidateBasic() error {
+	if c.MinGasPrices != "" {
+		for _, s := range strings.Split(c.MinGasPrices, ";") {
+			if _, err := sdk.ParseDecCoin(s); err != nil {
+				return fmt.Errorf("failed to parse minimum gas price coin (%s): %w", s, err)
+			}
+		}
+	}
+
+	switch c.Log.Format {
+	case "", LogFormatPlain, LogFormatJSON:
+	default:
+		return fmt.Errorf("unsupported log format: %s", c.Log.Format)
+	}
+
+	if c.API.Enable && c.API.Address == "" {
+		return errors.New("API server address cannot be empty when the API server is enabled")
+	}
+
+	if c.GRPC.Enable && c.GRPC.Address == "" {
+		return errors.New("gRPC server address cannot be empty when the gRPC server is enabled")
+	}
+
+	return nil
+}
+
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseConfig{
+		BaseConfig: BaseConfig{
 			MinGasPrices:         defaultMinGasPrices,
 			InterBlockCache:      true,
 			Pruning:              store.PruningStrategySyncable,
 			PruningKeepEvery:     "0",
 			PruningSnapshotEvery: "0",
 		},
+		API: APIConfig{
+			Enable:             false,
+			Address:            DefaultAPIAddress,
+			MaxOpenConnections: 1000,
+			RPCReadTimeout:     10,
+			RPCWriteTimeout:    10,
+			RPCMaxBodyBytes:    1000000,
+		},
+		GRPC: GRPCConfig{
+			Enable:  false,
+			Address: DefaultGRPCAddress,
+		},
+		Streaming: StreamingConfig{
+			ABCIListeners: []string{},
+		},
+		Telemetry: telemetry.Config{
+			Enabled:      false,
+			GlobalLabels: [][]string{},
+		},
 	}
 }
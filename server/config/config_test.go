@@ -18,3 +18,19 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestValidateBasic(t *testing.T) {
+	cfg := DefaultConfig()
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.MinGasPrices = "0.25token1;0.0001token2"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.MinGasPrices = "token"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultConfig()
+	cfg.GRPC.Enable = true
+	cfg.GRPC.Address = ""
+	require.Error(t, cfg.ValidateBasic())
+}
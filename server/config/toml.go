@@ -21,19 +21,42 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
-# Note: Commitment of state will be attempted on the corresponding block.
+# Note: The block at the halt height is committed, while the node refuses
+# to commit the blocks above it.
 halt-height = {{ .BaseConfig.HaltHeight }}
 
 # HaltTime contains a non-zero minimum block time (in Unix seconds) at which
 # a node will gracefully halt and shutdown that can be used to assist upgrades
 # and testing.
 #
-# Note: Commitment of state will be attempted on the corresponding block.
+# Note: The node halts without executing the first block at or after the
+# halt time.
 halt-time = {{ .BaseConfig.HaltTime }}
 
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# IAVLFastIndex enables a flat index of the latest version of the IAVL stores,
+# serving reads and iterations without traversing the trees. The index is built
+# on the first startup with it enabled, which can take a while on large states.
+iavl-fast-index = {{ .BaseConfig.IAVLFastIndex }}
+
+# AppDBBackend defines the backend of the application DB: goleveldb, cleveldb,
+# rocksdb, boltdb, badgerdb or memdb. Backends other than goleveldb and memdb
+# must be compiled in with the matching build tag. An empty value selects the
+# backend the binary was built with. An existing DB can be converted to another
+# backend with the 'db migrate' command.
+app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
+
+# QueryGasLimit defines the maximum gas a custom query may consume reading from
+# the stores. Nodes serving public endpoints should set it to bound the cost of
+# a single query. 0 means queries are not gas limited.
+query-gas-limit = {{ .BaseConfig.QueryGasLimit }}
+
+# QueryMaxResultBytes defines the maximum size in bytes of a custom query
+# result. 0 means the result size is not limited.
+query-max-result-bytes = {{ .BaseConfig.QueryMaxResultBytes }}
+
 # Pruning sets the pruning strategy: syncable, nothing, everything, custom
 # syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
@@ -44,6 +67,109 @@ pruning = "{{ .BaseConfig.Pruning }}"
 # These are applied if and only if the pruning strategy is custom.
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
 pruning-snapshot-every = "{{ .BaseConfig.PruningSnapshotEvery }}"
+
+###############################################################################
+###                          Logging Configuration                          ###
+###############################################################################
+
+[log]
+
+# Level defines the log level filtering, either globally (e.g. "info") or per
+# module (e.g. "main:info,x/bank:debug,*:error"). It overrides the Tendermint
+# log_level when set.
+level = "{{ .Log.Level }}"
+
+# Format defines the log output format: plain or json. It overrides the
+# Tendermint log_format when set.
+format = "{{ .Log.Format }}"
+
+###############################################################################
+###                         Telemetry Configuration                         ###
+###############################################################################
+
+[telemetry]
+
+# Prefixed with keys to separate services.
+service-name = "{{ .Telemetry.ServiceName }}"
+
+# Enabled enables the application telemetry functionality. When enabled,
+# an in-memory sink is also enabled by default. Operators may also enable
+# other sinks such as Prometheus.
+enabled = {{ .Telemetry.Enabled }}
+
+# Enable prefixing gauge values with hostname.
+enable-hostname = {{ .Telemetry.EnableHostname }}
+
+# Enable adding hostname to labels.
+enable-hostname-label = {{ .Telemetry.EnableHostnameLabel }}
+
+# Enable adding service to labels.
+enable-service-label = {{ .Telemetry.EnableServiceLabel }}
+
+# PrometheusRetentionTime, when positive, enables a Prometheus metrics sink
+# served by the API server at /metrics?format=prometheus.
+prometheus-retention-time = {{ .Telemetry.PrometheusRetentionTime }}
+
+# GlobalLabels defines a global set of name/value label tuples applied to all
+# metrics emitted using the wrapper functions defined in telemetry package.
+#
+# Example:
+# [["chain_id", "cosmoshub-1"]]
+global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
+  ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
+]
+
+###############################################################################
+###                           API Configuration                             ###
+###############################################################################
+
+[api]
+
+# Enable defines if the API server should be enabled.
+enable = {{ .API.Enable }}
+
+# Address defines the API server to listen on.
+address = "{{ .API.Address }}"
+
+# MaxOpenConnections defines the number of maximum open connections.
+max-open-connections = {{ .API.MaxOpenConnections }}
+
+# RPCReadTimeout defines the Tendermint RPC read timeout (in seconds).
+rpc-read-timeout = {{ .API.RPCReadTimeout }}
+
+# RPCWriteTimeout defines the Tendermint RPC write timeout (in seconds).
+rpc-write-timeout = {{ .API.RPCWriteTimeout }}
+
+# RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes).
+rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
+
+# EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
+enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
+
+###############################################################################
+###                           gRPC Configuration                            ###
+###############################################################################
+
+[grpc]
+
+# Enable defines if the gRPC server should be enabled.
+enable = {{ .GRPC.Enable }}
+
+# Address defines the gRPC server address to bind to.
+address = "{{ .GRPC.Address }}"
+
+###############################################################################
+###                         Streaming Configuration                         ###
+###############################################################################
+
+[streaming]
+
+# ABCIListeners defines the names of the registered ABCI listeners which are
+# notified of the ABCI requests and responses processed by the app.
+#
+# Example:
+# ["kafka", "postgres"]
+abci-listeners = [{{ range .Streaming.ABCIListeners }}"{{ . }}", {{ end }}]
 `
 
 var configTemplate *template.Template
@@ -67,6 +193,22 @@ func ParseConfig() (*Config, error) {
 	return conf, err
 }
 
+// GetConfig returns the application configuration loaded from app.toml, the
+// environment and the command line flags, in increasing order of precedence.
+// It returns an error if the configuration is invalid.
+func GetConfig() (*Config, error) {
+	conf, err := ParseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conf.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
 // WriteConfigFile renders config using the template and writes it to
 // configFilePath.
 func WriteConfigFile(configFilePath string, config *Config) {
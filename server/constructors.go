@@ -1,11 +1,11 @@
 package server
 
 import (
-	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -19,15 +19,27 @@ type (
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer) abci.Application
 
-	// AppExporter is a function that dumps all app state to
-	// JSON-serializable structure and returns the current validator set.
-	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error)
+	// AppExporter is a function that writes all app state as JSON to the
+	// given writer and returns the current validator set. It takes the height
+	// to export at, whether to prepare the state to start at height zero, the
+	// validators to not jail, and the modules to export. The app state is
+	// written to the last argument so that it needs not be held in memory.
+	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, []string, io.Writer) ([]tmtypes.GenesisValidator, *abci.ConsensusParams, error)
 )
 
 func openDB(rootDir string) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	db, err := sdk.NewLevelDB("application", dataDir)
-	return db, err
+	return sdk.NewDB("application", appDBBackend(), dataDir)
+}
+
+// appDBBackend returns the backend of the application DB set with the
+// app-db-backend option, or the default backend if none is set.
+func appDBBackend() dbm.BackendType {
+	if backend := viper.GetString(FlagAppDBBackend); backend != "" {
+		return dbm.BackendType(backend)
+	}
+
+	return sdk.DefaultDBBackend()
 }
 
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
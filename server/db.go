@@ -0,0 +1,131 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagOutputDir = "output-dir"
+
+	// migrateBatchSize is the number of entries written per batch when
+	// migrating a DB.
+	migrateBatchSize = 10000
+)
+
+// DBCmd returns the application DB subcommands.
+func DBCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Application DB subcommands",
+	}
+
+	cmd.AddCommand(DBMigrateCmd(ctx))
+
+	return cmd
+}
+
+// DBMigrateCmd copies the application DB to a new DB of another backend.
+func DBMigrateCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-backend]",
+		Short: "Copy the application DB to a new DB of another backend",
+		Long: `Copy every entry of the application DB, opened with the configured backend, to a new
+DB of the target backend written to '--output-dir', '<home>/data-<target-backend>' by default.
+
+The node must be stopped. Once migrated, replace the 'application.db' directory of the data
+directory with the one of the output directory and set 'app-db-backend' in app.toml to the
+target backend.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			target := dbm.BackendType(args[0])
+			if target == appDBBackend() {
+				return fmt.Errorf("the application DB already uses the %s backend", target)
+			}
+
+			outputDir := viper.GetString(flagOutputDir)
+			if outputDir == "" {
+				outputDir = filepath.Join(config.RootDir, fmt.Sprintf("data-%s", target))
+			}
+
+			if _, err := os.Stat(filepath.Join(outputDir, "application.db")); err == nil {
+				return fmt.Errorf("%s already contains an application DB", outputDir)
+			}
+
+			src, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer src.Close()
+
+			dst, err := sdk.NewDB("application", target, outputDir)
+			if err != nil {
+				return err
+			}
+			defer dst.Close()
+
+			n, err := migrateDB(src, dst, migrateBatchSize)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "copied %d entries from the %s application DB to %s\n", n, appDBBackend(), outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagOutputDir, "", "Directory of the migrated DB (defaults to <home>/data-<target-backend>)")
+
+	return cmd
+}
+
+// migrateDB copies all the entries of src to dst, writing them in batches of
+// the given size, and returns the number of copied entries.
+func migrateDB(src, dst dbm.DB, batchSize int) (n int, err error) {
+	iter, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	batch := dst.NewBatch()
+	size := 0
+
+	for ; iter.Valid(); iter.Next() {
+		batch.Set(iter.Key(), iter.Value())
+		size++
+		n++
+
+		if size == batchSize {
+			if err := writeAndClose(batch); err != nil {
+				return n, err
+			}
+
+			batch = dst.NewBatch()
+			size = 0
+		}
+	}
+
+	if err := writeAndClose(batch); err != nil {
+		return n, err
+	}
+
+	return n, iter.Error()
+}
+
+func writeAndClose(batch dbm.Batch) error {
+	defer batch.Close()
+	return batch.WriteSync()
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateDB(t *testing.T) {
+	src, dst := dbm.NewMemDB(), dbm.NewMemDB()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+
+	n, err := migrateDB(src, dst, 3)
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+
+	for i := 0; i < 10; i++ {
+		value, err := dst.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+}
+
+func TestAppDBBackend(t *testing.T) {
+	t.Cleanup(func() { viper.Set(FlagAppDBBackend, "") })
+
+	require.Equal(t, sdk.DefaultDBBackend(), appDBBackend())
+
+	viper.Set(FlagAppDBBackend, string(dbm.MemDBBackend))
+	require.Equal(t, dbm.MemDBBackend, appDBBackend())
+}
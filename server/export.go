@@ -3,7 +3,9 @@ package server
 // DONTCOVER
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 
@@ -17,9 +19,11 @@ import (
 )
 
 const (
-	flagHeight        = "height"
-	flagForZeroHeight = "for-zero-height"
-	flagJailWhitelist = "jail-whitelist"
+	flagHeight           = "height"
+	flagForZeroHeight    = "for-zero-height"
+	flagJailAllowedAddrs = "jail-allowed-addrs"
+	flagJailWhitelist    = "jail-whitelist"
+	flagModulesToExport  = "modules-to-export"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -27,6 +31,18 @@ func ExportCmd(ctx *Context, cdc codec.JSONMarshaler, appExporter AppExporter) *
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export state to JSON",
+		Long: `Export the application state at the given height, or at the latest height, as a
+genesis file printed to STDOUT. The application state is streamed as it is exported, so
+that it is never held in memory as a whole.
+
+With '--for-zero-height', the state is prepared to start a new chain at height zero: rewards
+and commissions are withdrawn, and the slashing, unbonding and signing info heights are reset.
+All the validators are kept, and the jailed ones stay jailed. If '--jail-allowed-addrs' is
+provided, the validators whose operator address is not in it are jailed as well.
+
+The exported application state can be restricted to a subset of modules with
+'--modules-to-export', e.g. to perform genesis surgery for a hard fork.
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config := ctx.Config
 			config.SetRoot(viper.GetString(flags.FlagHome))
@@ -59,19 +75,33 @@ func ExportCmd(ctx *Context, cdc codec.JSONMarshaler, appExporter AppExporter) *
 
 			height := viper.GetInt64(flagHeight)
 			forZeroHeight := viper.GetBool(flagForZeroHeight)
-			jailWhiteList := viper.GetStringSlice(flagJailWhitelist)
-
-			appState, validators, cp, err := appExporter(ctx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList)
-			if err != nil {
-				return fmt.Errorf("error exporting state: %v", err)
+			jailAllowedAddrs := viper.GetStringSlice(flagJailAllowedAddrs)
+			if len(jailAllowedAddrs) == 0 {
+				jailAllowedAddrs = viper.GetStringSlice(flagJailWhitelist)
 			}
 
+			modulesToExport := viper.GetStringSlice(flagModulesToExport)
+
 			doc, err := tmtypes.GenesisDocFromFile(ctx.Config.GenesisFile())
 			if err != nil {
 				return err
 			}
+			doc.AppState = nil
+
+			// the application state is streamed first, directly to the output, so
+			// that it is never held in memory
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			if _, err := io.WriteString(out, `{"app_state":`); err != nil {
+				return err
+			}
+
+			validators, cp, err := appExporter(
+				ctx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, modulesToExport, out,
+			)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
 
-			doc.AppState = appState
 			doc.Validators = validators
 			doc.ConsensusParams = &tmtypes.ConsensusParams{
 				Block: tmtypes.BlockParams{
@@ -92,14 +122,22 @@ func ExportCmd(ctx *Context, cdc codec.JSONMarshaler, appExporter AppExporter) *
 				return err
 			}
 
-			fmt.Println(string(sdk.MustSortJSON(encoded)))
-			return nil
+			// the other fields of the genesis file follow the application state
+			encoded = sdk.MustSortJSON(encoded)
+			if _, err := fmt.Fprintf(out, ",%s\n", encoded[1:]); err != nil {
+				return err
+			}
+
+			return out.Flush()
 		},
 	}
 
 	cmd.Flags().Int64(flagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(flagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(flagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of validators to not jail on state export")
 	cmd.Flags().StringSlice(flagJailWhitelist, []string{}, "List of validators to not jail state export")
+	cmd.Flags().StringSlice(flagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, all modules are exported")
+	cmd.Flags().MarkDeprecated(flagJailWhitelist, "use --jail-allowed-addrs instead") //nolint:errcheck
 
 	return cmd
 }
@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	golangproto "github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// serviceFile is the path of the proto file describing the Service, as set in
+// the metadata of its gRPC service description.
+const serviceFile = "cosmos_sdk/tx/v1/service.proto"
+
+// protoFileAliases maps the import paths of the proto files to the paths their
+// packages registered them at in the golang proto registry, when they differ.
+var protoFileAliases = map[string]string{
+	"descriptor.proto": "google/protobuf/descriptor.proto",
+	"third_party/proto/tendermint/abci/types/types.proto": "abci/types/types.proto",
+}
+
+// gogoProtoFiles maps the import paths of the proto files only registered in
+// the gogo proto registry to their paths there, when they differ.
+var gogoProtoFiles = map[string]string{
+	"third_party/proto/gogoproto/gogo.proto": "gogo.proto",
+}
+
+// The gRPC server reflection service looks the proto files up in the golang
+// proto registry, whereas the SDK types are registered in the gogo proto one.
+// Register the Service file there along with the gogo files it depends on, so
+// that reflection clients can resolve all the types of the Service.
+func init() {
+	if err := registerFile(serviceFile, serviceFileDescriptor()); err != nil {
+		panic(err)
+	}
+}
+
+// serviceFileDescriptor returns the descriptor of the proto file describing
+// the Service and its request and response types.
+func serviceFileDescriptor() *descpb.FileDescriptorProto {
+	field := func(name string, number int32, typ descpb.FieldDescriptorProto_Type) *descpb.FieldDescriptorProto {
+		return &descpb.FieldDescriptorProto{
+			Name:   golangproto.String(name),
+			Number: golangproto.Int32(number),
+			Label:  descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   typ.Enum(),
+		}
+	}
+	method := func(name, input, output string) *descpb.MethodDescriptorProto {
+		return &descpb.MethodDescriptorProto{
+			Name:       golangproto.String(name),
+			InputType:  golangproto.String(input),
+			OutputType: golangproto.String(output),
+		}
+	}
+
+	return &descpb.FileDescriptorProto{
+		Name:       golangproto.String(serviceFile),
+		Package:    golangproto.String("cosmos_sdk.tx.v1"),
+		Dependency: []string{"types/types.proto"},
+		Syntax:     golangproto.String("proto3"),
+		MessageType: []*descpb.DescriptorProto{
+			{
+				Name: golangproto.String("SimulateRequest"),
+				Field: []*descpb.FieldDescriptorProto{
+					field("tx_bytes", 1, descpb.FieldDescriptorProto_TYPE_BYTES),
+				},
+			},
+			{
+				Name: golangproto.String("BroadcastTxRequest"),
+				Field: []*descpb.FieldDescriptorProto{
+					field("tx_bytes", 1, descpb.FieldDescriptorProto_TYPE_BYTES),
+					field("mode", 2, descpb.FieldDescriptorProto_TYPE_STRING),
+				},
+			},
+			{
+				Name: golangproto.String("BroadcastTxResponse"),
+				Field: []*descpb.FieldDescriptorProto{
+					field("height", 1, descpb.FieldDescriptorProto_TYPE_INT64),
+					field("txhash", 2, descpb.FieldDescriptorProto_TYPE_STRING),
+					field("codespace", 3, descpb.FieldDescriptorProto_TYPE_STRING),
+					field("code", 4, descpb.FieldDescriptorProto_TYPE_UINT32),
+					field("raw_log", 5, descpb.FieldDescriptorProto_TYPE_STRING),
+					field("gas_wanted", 6, descpb.FieldDescriptorProto_TYPE_INT64),
+					field("gas_used", 7, descpb.FieldDescriptorProto_TYPE_INT64),
+				},
+			},
+		},
+		Service: []*descpb.ServiceDescriptorProto{
+			{
+				Name: golangproto.String("Service"),
+				Method: []*descpb.MethodDescriptorProto{
+					method("Simulate", ".cosmos_sdk.tx.v1.SimulateRequest", ".cosmos_sdk.v1.SimulationResponse"),
+					method("BroadcastTx", ".cosmos_sdk.tx.v1.BroadcastTxRequest", ".cosmos_sdk.tx.v1.BroadcastTxResponse"),
+				},
+			},
+		},
+	}
+}
+
+// registerFile registers the file descriptor in the golang proto registry
+// after the files it imports, which are renamed to their registered paths.
+func registerFile(path string, fd *descpb.FileDescriptorProto) error {
+	for i, dep := range fd.Dependency {
+		registered, err := registerImport(dep)
+		if err != nil {
+			return fmt.Errorf("failed to register the imports of %s: %w", path, err)
+		}
+
+		fd.Dependency[i] = registered
+	}
+
+	fd.Name = golangproto.String(path)
+	bz, err := golangproto.Marshal(fd)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(bz); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	golangproto.RegisterFile(path, buf.Bytes())
+	return nil
+}
+
+// registerImport makes sure that the file imported at the given path and the
+// files it imports are in the golang proto registry, registering the missing
+// ones from the descriptors of the gogo proto registry. It returns the path of
+// the file in the golang proto registry.
+func registerImport(path string) (string, error) {
+	if alias, ok := protoFileAliases[path]; ok {
+		path = alias
+	}
+
+	// the imports of the files registered by other packages may be missing
+	if gz := golangproto.FileDescriptor(path); gz != nil {
+		fd, err := decodeFileDescriptor(gz)
+		if err != nil {
+			return "", err
+		}
+
+		for _, dep := range fd.Dependency {
+			if _, err := registerImport(dep); err != nil {
+				return "", err
+			}
+		}
+
+		return path, nil
+	}
+
+	gogoPath := path
+	if p, ok := gogoProtoFiles[path]; ok {
+		gogoPath = p
+	}
+
+	gz := gogoproto.FileDescriptor(gogoPath)
+	if gz == nil {
+		return "", fmt.Errorf("unknown proto file %s", path)
+	}
+
+	fd, err := decodeFileDescriptor(gz)
+	if err != nil {
+		return "", err
+	}
+
+	return path, registerFile(path, fd)
+}
+
+// decodeFileDescriptor decodes a gzipped file descriptor.
+func decodeFileDescriptor(gz []byte) (*descpb.FileDescriptorProto, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := new(descpb.FileDescriptorProto)
+	if err := golangproto.Unmarshal(bz, fd); err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"net"
+
+	"github.com/tendermint/tendermint/libs/log"
+	gogogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// StartGRPCServer starts a gRPC server on the given address serving the
+// transaction Service along with the gRPC server reflection service, so that
+// generic tools (e.g. grpcurl) can discover the Service and its types. It
+// returns once the server listens on the address, the errors stopping the
+// server afterwards being logged.
+func StartGRPCServer(
+	app Simulator, cliCtx clientcontext.CLIContext, address string, logger log.Logger,
+) (*gogogrpc.Server, error) {
+	grpcSrv := gogogrpc.NewServer()
+	RegisterService(grpcSrv, NewService(app, cliCtx))
+	reflection.Register(grpcSrv)
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		logger.Info("starting gRPC server", "address", address)
+		if err := grpcSrv.Serve(listener); err != nil {
+			logger.Error("gRPC server stopped", "err", err)
+		}
+	}()
+
+	return grpcSrv, nil
+}
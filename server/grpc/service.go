@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+
+	gogogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ServiceName is the fully qualified name of the transaction service.
+const ServiceName = "cosmos_sdk.tx.v1.Service"
+
+// Simulator defines the application functionality required to simulate
+// transactions. It is implemented by BaseApp.
+type Simulator interface {
+	SimulateTxBytes(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+}
+
+// Service defines the gRPC service used to simulate and broadcast transactions.
+type Service interface {
+	// Simulate simulates the execution of a transaction against the latest
+	// committed state and returns the gas used and the resulting events.
+	Simulate(context.Context, *SimulateRequest) (*sdk.SimulationResponse, error)
+	// BroadcastTx broadcasts a signed transaction to the node.
+	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
+}
+
+type service struct {
+	app    Simulator
+	cliCtx clientcontext.CLIContext
+}
+
+var _ Service = service{}
+
+// NewService returns a Service simulating transactions against the
+// application and broadcasting them through the client of the CLIContext.
+func NewService(app Simulator, cliCtx clientcontext.CLIContext) Service {
+	return service{app: app, cliCtx: cliCtx}
+}
+
+// Simulate implements the Service interface.
+func (s service) Simulate(_ context.Context, req *SimulateRequest) (*sdk.SimulationResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty transaction")
+	}
+
+	gasInfo, res, err := s.app.SimulateTxBytes(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &sdk.SimulationResponse{GasInfo: gasInfo, Result: res}, nil
+}
+
+// BroadcastTx implements the Service interface.
+func (s service) BroadcastTx(_ context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty transaction")
+	}
+
+	mode := req.Mode
+	if mode == "" {
+		mode = flags.BroadcastSync
+	}
+
+	switch mode {
+	case flags.BroadcastSync, flags.BroadcastAsync, flags.BroadcastBlock:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported broadcast mode %q", mode)
+	}
+
+	res, err := s.cliCtx.WithBroadcastMode(mode).BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &BroadcastTxResponse{
+		Height:    res.Height,
+		TxHash:    res.TxHash,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		RawLog:    res.RawLog,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+	}, nil
+}
+
+// RegisterService registers the Service implementation on the gRPC server.
+func RegisterService(s *gogogrpc.Server, srv Service) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+func simulateHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor gogogrpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(Service).Simulate(ctx, in)
+	}
+
+	info := &gogogrpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Simulate(ctx, req.(*SimulateRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func broadcastTxHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor gogogrpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(Service).BroadcastTx(ctx, in)
+	}
+
+	info := &gogogrpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/BroadcastTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).BroadcastTx(ctx, req.(*BroadcastTxRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+var serviceDesc = gogogrpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*Service)(nil),
+	Methods: []gogogrpc.MethodDesc{
+		{MethodName: "Simulate", Handler: simulateHandler},
+		{MethodName: "BroadcastTx", Handler: broadcastTxHandler},
+	},
+	Streams:  []gogogrpc.StreamDesc{},
+	Metadata: serviceFile,
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	golangproto "github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	gogogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockSimulator struct{}
+
+func (mockSimulator) SimulateTxBytes(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	if string(txBytes) == "invalid" {
+		return sdk.GasInfo{}, nil, errors.New("failed to decode tx")
+	}
+
+	return sdk.GasInfo{GasWanted: 100, GasUsed: 42}, &sdk.Result{Log: "ok"}, nil
+}
+
+func TestSimulate(t *testing.T) {
+	srv := NewService(mockSimulator{}, clientcontext.CLIContext{})
+
+	res, err := srv.Simulate(context.Background(), &SimulateRequest{TxBytes: []byte("tx")})
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), res.GasUsed)
+	require.Equal(t, "ok", res.Result.Log)
+
+	_, err = srv.Simulate(context.Background(), &SimulateRequest{TxBytes: []byte("invalid")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.Simulate(context.Background(), &SimulateRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBroadcastTxInvalidRequest(t *testing.T) {
+	srv := NewService(mockSimulator{}, clientcontext.CLIContext{})
+
+	_, err := srv.BroadcastTx(context.Background(), &BroadcastTxRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.BroadcastTx(context.Background(), &BroadcastTxRequest{TxBytes: []byte("tx"), Mode: "later"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStartGRPCServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+
+	// the server fails to start on an address in use
+	_, err = StartGRPCServer(mockSimulator{}, clientcontext.CLIContext{}, address, log.NewNopLogger())
+	require.Error(t, err)
+
+	// and serves the Service as soon as it returns
+	require.NoError(t, listener.Close())
+	srv, err := StartGRPCServer(mockSimulator{}, clientcontext.CLIContext{}, address, log.NewNopLogger())
+	require.NoError(t, err)
+	defer srv.Stop()
+
+	conn, err := gogogrpc.Dial(address, gogogrpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	res := new(sdk.SimulationResponse)
+	req := &SimulateRequest{TxBytes: []byte("tx")}
+	require.NoError(t, conn.Invoke(context.Background(), "/"+ServiceName+"/Simulate", req, res))
+	require.Equal(t, uint64(42), res.GasUsed)
+}
+
+func TestServerReflection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	srv, err := StartGRPCServer(mockSimulator{}, clientcontext.CLIContext{}, address, log.NewNopLogger())
+	require.NoError(t, err)
+	defer srv.Stop()
+
+	conn, err := gogogrpc.Dial(address, gogogrpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	defer stream.CloseSend() //nolint:errcheck
+
+	query := func(req *rpb.ServerReflectionRequest) *rpb.ServerReflectionResponse {
+		require.NoError(t, stream.Send(req))
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Nil(t, res.GetErrorResponse(), "%v", req)
+		return res
+	}
+
+	res := query(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	})
+	var services []string
+	for _, svc := range res.GetListServicesResponse().Service {
+		services = append(services, svc.Name)
+	}
+	require.Contains(t, services, ServiceName)
+
+	// the file of the Service and all the files it imports resolve
+	res = query(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: ServiceName},
+	})
+	files := res.GetFileDescriptorResponse().FileDescriptorProto
+	for len(files) > 0 {
+		fd := new(descpb.FileDescriptorProto)
+		require.NoError(t, golangproto.Unmarshal(files[0], fd))
+		files = files[1:]
+
+		for _, dep := range fd.Dependency {
+			res = query(&rpb.ServerReflectionRequest{
+				MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: dep},
+			})
+			files = append(files, res.GetFileDescriptorResponse().FileDescriptorProto...)
+		}
+	}
+}
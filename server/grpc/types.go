@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"github.com/gogo/protobuf/proto"
+)
+
+// SimulateRequest is the request type of the Service.Simulate RPC method.
+type SimulateRequest struct {
+	// TxBytes is the amino or protobuf encoded transaction to simulate.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
+func (m *SimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateRequest) ProtoMessage()    {}
+
+// BroadcastTxRequest is the request type of the Service.BroadcastTx RPC method.
+type BroadcastTxRequest struct {
+	// TxBytes is the signed and encoded transaction to broadcast.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// Mode is the broadcast mode (sync, async or block); it defaults to sync.
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (m *BroadcastTxRequest) Reset()         { *m = BroadcastTxRequest{} }
+func (m *BroadcastTxRequest) String() string { return proto.CompactTextString(m) }
+func (*BroadcastTxRequest) ProtoMessage()    {}
+
+// BroadcastTxResponse is the response type of the Service.BroadcastTx RPC
+// method.
+type BroadcastTxResponse struct {
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash    string `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	RawLog    string `protobuf:"bytes,5,opt,name=raw_log,json=rawLog,proto3" json:"raw_log,omitempty"`
+	GasWanted int64  `protobuf:"varint,6,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed   int64  `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *BroadcastTxResponse) Reset()         { *m = BroadcastTxResponse{} }
+func (m *BroadcastTxResponse) String() string { return proto.CompactTextString(m) }
+func (*BroadcastTxResponse) ProtoMessage()    {}
@@ -0,0 +1,300 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	flagEndHeight  = "end-height"
+	flagMemProfile = "mem-profile"
+)
+
+// ReplayCmd replays the blocks of the Tendermint block store against the
+// application and reports timings.
+func ReplayCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay blocks from the Tendermint block store against the application",
+		Long: `Replay the blocks of the Tendermint block store, from the height following the last
+height committed by the application up to '--end-height' or the last stored block, and
+verify the resulting application hashes.
+
+Once done, the time spent in each ABCI call and in the BeginBlock, EndBlock and message
+handlers of every module is reported. CPU and heap profiles can be written with the
+'--cpu-profile' and '--mem-profile' flags.
+
+The application state is committed while replaying, so run this command against a copy of
+the node's data directory, e.g. one restored from a snapshot taken before the blocks of
+interest.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			return replay(
+				ctx, appCreator, cmd.OutOrStdout(), viper.GetInt64(flagEndHeight),
+				viper.GetString(flagCPUProfile), viper.GetString(flagMemProfile),
+			)
+		},
+	}
+
+	cmd.Flags().Int64(flagEndHeight, 0, "Last height to replay (0 means the last block of the block store)")
+	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
+	cmd.Flags().String(flagMemProfile, "", "Write a heap profile to the provided file once replayed")
+
+	return cmd
+}
+
+func replay(ctx *Context, appCreator AppCreator, out io.Writer, endHeight int64, cpuProfile, memProfile string) error {
+	config := ctx.Config
+
+	db, err := openDB(config.RootDir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	// gather the module timings emitted through the telemetry package
+	timings := newReplayTimings()
+
+	metricsConf := metrics.DefaultConfig("")
+	metricsConf.EnableHostname = false
+	metricsConf.EnableRuntimeMetrics = false
+
+	if _, err := metrics.NewGlobal(metricsConf, timings); err != nil {
+		return err
+	}
+
+	app := appCreator(ctx.Logger, db, nil)
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(timedApp{Application: app, timings: timings}))
+	if err := proxyApp.Start(); err != nil {
+		return err
+	}
+	defer proxyApp.Stop() //nolint:errcheck
+
+	info, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
+	if err != nil {
+		return err
+	}
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	startHeight := info.LastBlockHeight + 1
+	if endHeight <= 0 || endHeight > blockStore.Height() {
+		endHeight = blockStore.Height()
+	}
+
+	if startHeight < blockStore.Base() {
+		return fmt.Errorf("block %d has been pruned from the block store, whose base is %d", startHeight, blockStore.Base())
+	}
+
+	if startHeight > endHeight {
+		return fmt.Errorf("no blocks to replay: the application is at height %d and the end height is %d", info.LastBlockHeight, endHeight)
+	}
+
+	if cpuProfile != "" {
+		f, err := os.Create(cpuProfile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return err
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	ctx.Logger.Info("replaying blocks", "start", startHeight, "end", endHeight)
+	start := time.Now()
+
+	for height := startHeight; height <= endHeight; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block %d not found in the block store", height)
+		}
+
+		appHash, err := sm.ExecCommitBlock(proxyApp.Consensus(), block, ctx.Logger, stateDB)
+		if err != nil {
+			return fmt.Errorf("failed to replay block %d: %w", height, err)
+		}
+
+		// the application hash of a block is included in the header of the next one
+		if meta := blockStore.LoadBlockMeta(height + 1); meta != nil && !bytes.Equal(meta.Header.AppHash, appHash) {
+			return fmt.Errorf("application hash mismatch at height %d: expected %X, got %X", height, meta.Header.AppHash, appHash)
+		}
+	}
+
+	elapsed := time.Since(start)
+
+	if memProfile != "" {
+		f, err := os.Create(memProfile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		runtime.GC()
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			return err
+		}
+	}
+
+	numBlocks := endHeight - startHeight + 1
+	fmt.Fprintf(out, "replayed %d blocks in %s (%.2f blocks/s)\n\n", numBlocks, elapsed, float64(numBlocks)/elapsed.Seconds())
+
+	return timings.Report(out)
+}
+
+// timedApp wraps an ABCI application to measure the time spent in the ABCI
+// calls made when executing blocks.
+type timedApp struct {
+	abci.Application
+
+	timings *replayTimings
+}
+
+func (app timedApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	defer app.timings.measureSince("abci.begin_block", time.Now())
+	return app.Application.BeginBlock(req)
+}
+
+func (app timedApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	defer app.timings.measureSince("abci.deliver_tx", time.Now())
+	return app.Application.DeliverTx(req)
+}
+
+func (app timedApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	defer app.timings.measureSince("abci.end_block", time.Now())
+	return app.Application.EndBlock(req)
+}
+
+func (app timedApp) Commit() abci.ResponseCommit {
+	defer app.timings.measureSince("abci.commit", time.Now())
+	return app.Application.Commit()
+}
+
+var _ metrics.MetricSink = (*replayTimings)(nil)
+
+// replayTimings aggregates timing samples by name. It implements a metrics
+// sink so that the per module timings emitted through the telemetry package are
+// aggregated as well, while all other metrics are discarded.
+type replayTimings struct {
+	mtx     sync.Mutex
+	samples map[string]*timingSample
+}
+
+type timingSample struct {
+	count   int
+	totalMs float64
+}
+
+func newReplayTimings() *replayTimings {
+	return &replayTimings{samples: make(map[string]*timingSample)}
+}
+
+func (rt *replayTimings) measureSince(name string, start time.Time) {
+	rt.add(name, float64(time.Since(start))/float64(time.Millisecond))
+}
+
+func (rt *replayTimings) add(name string, ms float64) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	sample, ok := rt.samples[name]
+	if !ok {
+		sample = &timingSample{}
+		rt.samples[name] = sample
+	}
+
+	sample.count++
+	sample.totalMs += ms
+}
+
+// Report writes the aggregated timings, sorted by decreasing total time.
+func (rt *replayTimings) Report(w io.Writer) error {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	names := make([]string, 0, len(rt.samples))
+	for name := range rt.samples {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if rt.samples[names[i]].totalMs == rt.samples[names[j]].totalMs {
+			return names[i] < names[j]
+		}
+		return rt.samples[names[i]].totalMs > rt.samples[names[j]].totalMs
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCOUNT\tTOTAL (ms)\tAVERAGE (ms)")
+
+	for _, name := range names {
+		sample := rt.samples[name]
+		fmt.Fprintf(tw, "%s\t%d\t%.3f\t%.3f\n", name, sample.count, sample.totalMs, sample.totalMs/float64(sample.count))
+	}
+
+	return tw.Flush()
+}
+
+// AddSampleWithLabels aggregates the timings measured by the telemetry
+// package, e.g. the BeginBlock duration of a module, by key and module.
+func (rt *replayTimings) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := strings.Join(key, ".")
+	for _, label := range labels {
+		if label.Name == telemetry.MetricLabelNameModule {
+			name = fmt.Sprintf("%s.%s", label.Value, name)
+		}
+	}
+
+	// go-metrics measures durations in milliseconds
+	rt.add(name, float64(val))
+}
+
+func (rt *replayTimings) AddSample(key []string, val float32) {
+	rt.AddSampleWithLabels(key, val, nil)
+}
+
+func (*replayTimings) SetGauge([]string, float32)                               {}
+func (*replayTimings) SetGaugeWithLabels([]string, float32, []metrics.Label)    {}
+func (*replayTimings) EmitKey([]string, float32)                                {}
+func (*replayTimings) IncrCounter([]string, float32)                            {}
+func (*replayTimings) IncrCounterWithLabels([]string, float32, []metrics.Label) {}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+func TestReplayTimings(t *testing.T) {
+	timings := newReplayTimings()
+
+	timings.add("abci.commit", 1)
+	timings.AddSampleWithLabels(
+		[]string{telemetry.MetricKeyBeginBlocker}, 2,
+		[]metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, "staking")},
+	)
+	timings.AddSampleWithLabels(
+		[]string{telemetry.MetricKeyBeginBlocker}, 4,
+		[]metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, "staking")},
+	)
+	timings.IncrCounter([]string{"tx", "count"}, 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, timings.Report(&buf))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.True(t, strings.HasPrefix(lines[0], "NAME"))
+	require.Equal(t, []string{"staking.begin_blocker", "2", "6.000", "3.000"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"abci.commit", "1", "1.000", "1.000"}, strings.Fields(lines[2]))
+}
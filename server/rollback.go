@@ -0,0 +1,54 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// RollbackCmd rolls the application state back by one block.
+func RollbackCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the application state by one block",
+		Long: `Delete the latest committed version of the application state, so that the
+application is back at the previous height and can commit the latest block again.
+
+This command is meant to recover from a non-deterministic commit, along with Tendermint's
+rollback of its own state, without resyncing the whole node. The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			abciApp := appCreator(ctx.Logger, db, nil)
+			app, ok := abciApp.(interface {
+				LastBlockHeight() int64
+				RollbackToVersion(version int64) error
+			})
+			if !ok {
+				return fmt.Errorf("application %T does not support rollbacks", abciApp)
+			}
+
+			height := app.LastBlockHeight()
+			if err := app.RollbackToVersion(height - 1); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "rolled back the application state from height %d to %d\n", height, height-1)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package rosetta
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Rosetta server flags
+const (
+	FlagRosetta    = "rosetta"
+	FlagAddr       = "addr"
+	FlagBlockchain = "blockchain"
+	FlagNetwork    = "network"
+)
+
+// ConfigFromFlags returns the Rosetta server configuration set through the
+// command line flags.
+func ConfigFromFlags() Config {
+	return Config{
+		Blockchain: viper.GetString(FlagBlockchain),
+		Network:    viper.GetString(FlagNetwork),
+		Offline:    viper.GetBool(flags.FlagOffline),
+	}
+}
+
+// NewCLIContext returns a CLIContext configured with the codecs and account
+// retriever required by the Rosetta server.
+func NewCLIContext(cliCtx context.CLIContext, cdc *codec.Codec, appCodec codec.Marshaler) context.CLIContext {
+	return cliCtx.
+		WithCodec(cdc).
+		WithJSONMarshaler(appCodec).
+		WithAccountRetriever(authtypes.NewAccountRetriever(appCodec))
+}
+
+// RegisterFlags registers the flags configuring the Rosetta API on a command
+// serving it in-process, e.g. the REST server command. The flags are bound to
+// viper when the command runs, before its own PreRunE if any, so that they do
+// not override the flags of the same name of the other commands.
+func RegisterFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Bool(FlagRosetta, false, "Serve the Rosetta Data and Construction APIs alongside the other routes")
+	cmd.Flags().String(FlagBlockchain, "app", "The blockchain name reported in the Rosetta network identifier")
+	cmd.Flags().String(FlagNetwork, "", "The network name reported in the Rosetta network identifier (defaults to the chain ID)")
+
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := bindFlags(cmd, FlagRosetta, FlagBlockchain, FlagNetwork); err != nil {
+			return err
+		}
+
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+// ServeCommand returns a command that starts a standalone Rosetta API server
+// connected to a Tendermint node.
+func ServeCommand(cdc *codec.Codec, appCodec codec.Marshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rosetta",
+		Short: "Start a Rosetta Data and Construction API server",
+		Long: `Start a server implementing the Rosetta Data and Construction APIs backed by
+the bank, auth and staking modules. With --offline, only the construction endpoints
+which do not require a connection to a node are available.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return bindFlags(
+				cmd, FlagAddr, FlagBlockchain, FlagNetwork, flags.FlagOffline, flags.FlagNode, flags.FlagTrustNode,
+			)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rosetta")
+
+			cliCtx := NewCLIContext(context.NewCLIContext(), cdc, appCodec)
+			server := NewServer(cliCtx, ConfigFromFlags())
+
+			addr := viper.GetString(FlagAddr)
+			logger.Info("starting Rosetta API server", "addr", addr, "network", server.NetworkIdentifier().Network)
+
+			return http.ListenAndServe(addr, server.Router())
+		},
+	}
+
+	cmd.Flags().String(FlagAddr, ":8080", "The address for the Rosetta API server to listen on")
+	cmd.Flags().String(FlagBlockchain, "app", "The blockchain name reported in the network identifier")
+	cmd.Flags().String(FlagNetwork, "", "The network name reported in the network identifier (defaults to the chain ID)")
+	cmd.Flags().Bool(flags.FlagOffline, false, "Only serve the endpoints which do not require a connection to a node")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().Bool(flags.FlagTrustNode, true, "Trust connected full node (don't verify proofs for responses)")
+
+	return cmd
+}
+
+// bindFlags binds the given flags of the command being run to viper.
+func bindFlags(cmd *cobra.Command, names ...string) error {
+	for _, name := range names {
+		if err := viper.BindPFlag(name, cmd.Flags().Lookup(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
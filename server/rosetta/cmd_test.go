@@ -0,0 +1,37 @@
+package rosetta
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func TestFlagsBoundOnRun(t *testing.T) {
+	defer viper.Reset()
+
+	var ran bool
+	restCmd := RegisterFlags(&cobra.Command{
+		Use: "rest-server",
+		PreRunE: func(*cobra.Command, []string) error {
+			ran = true
+			return nil
+		},
+	})
+	serveCmd := ServeCommand(codec.New(), nil)
+
+	// the flags of the command constructed last do not override the other one
+	require.NoError(t, restCmd.Flags().Set(FlagNetwork, "rest"))
+	require.NoError(t, serveCmd.Flags().Set(FlagNetwork, "serve"))
+	require.Empty(t, viper.GetString(FlagNetwork))
+
+	require.NoError(t, restCmd.PreRunE(restCmd, nil))
+	require.True(t, ran)
+	require.Equal(t, "rest", viper.GetString(FlagNetwork))
+
+	require.NoError(t, serveCmd.PreRunE(serveCmd, nil))
+	require.Equal(t, "serve", viper.GetString(FlagNetwork))
+}
@@ -0,0 +1,428 @@
+package rosetta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// construction options and metadata keys
+const (
+	optFrom          = "from"
+	optGas           = "gas"
+	optFee           = "fee"
+	optMemo          = "memo"
+	optChainID       = "chain_id"
+	optAccountNumber = "account_number"
+	optSequence      = "sequence"
+
+	// DefaultGas is the gas limit used by transactions whose construction
+	// metadata does not specify one.
+	DefaultGas = 200000
+)
+
+// unsignedTx defines the unsigned transaction exchanged between the
+// construction endpoints. It carries everything required to rebuild the sign
+// bytes of the transaction.
+type unsignedTx struct {
+	Tx            authtypes.StdTx `json:"tx"`
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number"`
+	Sequence      uint64          `json:"sequence"`
+}
+
+func (utx unsignedTx) signBytes() []byte {
+	return authtypes.StdSignBytes(utx.ChainID, utx.AccountNumber, utx.Sequence, utx.Tx.Fee, utx.Tx.Msgs, utx.Tx.Memo)
+}
+
+func (s *Server) constructionDerive(r *http.Request) (interface{}, *Error) {
+	var req ConstructionDeriveRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	pk, rerr := parsePubKey(req.PublicKey)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	return ConstructionDeriveResponse{Address: sdk.AccAddress(pk.Address()).String()}, nil
+}
+
+func (s *Server) constructionPreprocess(r *http.Request) (interface{}, *Error) {
+	var req ConstructionPreprocessRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	signer, rerr := singleSigner(req.Operations)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	options := map[string]interface{}{optFrom: signer.String()}
+	for _, key := range []string{optGas, optFee, optMemo} {
+		if value, ok := req.Metadata[key]; ok {
+			options[key] = value
+		}
+	}
+
+	return ConstructionPreprocessResponse{
+		Options:            options,
+		RequiredPublicKeys: []AccountIdentifier{{Address: signer.String()}},
+	}, nil
+}
+
+func (s *Server) constructionMetadata(r *http.Request) (interface{}, *Error) {
+	var req ConstructionMetadataRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	from, _ := req.Options[optFrom].(string)
+	addr, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrap(err)
+	}
+
+	accNum, seq, err := s.cliCtx.AccountRetriever.GetAccountNumberSequence(s.cliCtx, addr)
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	metadata := map[string]interface{}{
+		optChainID:       s.cliCtx.ChainID,
+		optAccountNumber: strconv.FormatUint(accNum, 10),
+		optSequence:      strconv.FormatUint(seq, 10),
+	}
+	for _, key := range []string{optGas, optFee, optMemo} {
+		if value, ok := req.Options[key]; ok {
+			metadata[key] = value
+		}
+	}
+
+	return ConstructionMetadataResponse{Metadata: metadata}, nil
+}
+
+func (s *Server) constructionPayloads(r *http.Request) (interface{}, *Error) {
+	var req ConstructionPayloadsRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	signer, rerr := singleSigner(req.Operations)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	msgs, err := OperationsToMsgs(req.Operations)
+	if err != nil {
+		return nil, ErrInvalidOperation.Wrap(err)
+	}
+
+	utx, err := newUnsignedTx(msgs, req.Metadata)
+	if err != nil {
+		return nil, ErrInvalidRequest.Wrap(err)
+	}
+
+	if err := utx.Tx.ValidateBasic(); err != nil {
+		return nil, ErrInvalidOperation.Wrap(err)
+	}
+
+	bz, err := s.cliCtx.Codec.MarshalJSON(utx)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	digest := sha256.Sum256(utx.signBytes())
+
+	return ConstructionPayloadsResponse{
+		UnsignedTransaction: hex.EncodeToString(bz),
+		Payloads: []SigningPayload{{
+			AccountIdentifier: &AccountIdentifier{Address: signer.String()},
+			HexBytes:          hex.EncodeToString(digest[:]),
+			SignatureType:     SignatureEcdsa,
+		}},
+	}, nil
+}
+
+func (s *Server) constructionCombine(r *http.Request) (interface{}, *Error) {
+	var req ConstructionCombineRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	utx, rerr := s.decodeUnsignedTx(req.UnsignedTransaction)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	signers := utx.Tx.GetSigners()
+	if len(req.Signatures) != len(signers) {
+		return nil, ErrInvalidSignature.Wrap(fmt.Errorf("expected %d signatures, got %d", len(signers), len(req.Signatures)))
+	}
+
+	signBytes := utx.signBytes()
+	sigs := make([]authtypes.StdSignature, len(signers))
+
+	for i, sig := range req.Signatures {
+		if sig.SignatureType != SignatureEcdsa {
+			return nil, ErrInvalidSignature.Wrap(fmt.Errorf("unsupported signature type %q", sig.SignatureType))
+		}
+
+		pk, rerr := parsePubKey(sig.PublicKey)
+		if rerr != nil {
+			return nil, rerr
+		}
+
+		if !signers[i].Equals(sdk.AccAddress(pk.Address())) {
+			return nil, ErrInvalidSignature.Wrap(fmt.Errorf("public key does not belong to signer %s", signers[i]))
+		}
+
+		sigBytes, err := hex.DecodeString(sig.HexBytes)
+		if err != nil {
+			return nil, ErrInvalidSignature.Wrap(err)
+		}
+
+		if !pk.VerifyBytes(signBytes, sigBytes) {
+			return nil, ErrInvalidSignature.Wrap(fmt.Errorf("signature verification failed for signer %s", signers[i]))
+		}
+
+		sigs[i] = authtypes.NewStdSignature(pk, sigBytes)
+	}
+
+	stdTx := authtypes.NewStdTx(utx.Tx.Msgs, utx.Tx.Fee, sigs, utx.Tx.Memo)
+
+	bz, err := s.cliCtx.Codec.MarshalBinaryBare(stdTx)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return ConstructionCombineResponse{SignedTransaction: hex.EncodeToString(bz)}, nil
+}
+
+func (s *Server) constructionParse(r *http.Request) (interface{}, *Error) {
+	var req ConstructionParseRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	var (
+		stdTx   authtypes.StdTx
+		signers []AccountIdentifier
+	)
+
+	if req.Signed {
+		bz, err := hex.DecodeString(req.Transaction)
+		if err != nil {
+			return nil, ErrInvalidTransaction.Wrap(err)
+		}
+
+		if err := s.cliCtx.Codec.UnmarshalBinaryBare(bz, &stdTx); err != nil {
+			return nil, ErrInvalidTransaction.Wrap(err)
+		}
+
+		for _, signer := range stdTx.GetSigners() {
+			signers = append(signers, AccountIdentifier{Address: signer.String()})
+		}
+	} else {
+		utx, rerr := s.decodeUnsignedTx(req.Transaction)
+		if rerr != nil {
+			return nil, rerr
+		}
+
+		stdTx = utx.Tx
+	}
+
+	// operations of transactions under construction carry no status
+	return ConstructionParseResponse{
+		Operations:               TxToOperations(stdTx, ""),
+		AccountIdentifierSigners: signers,
+	}, nil
+}
+
+func (s *Server) constructionHash(r *http.Request) (interface{}, *Error) {
+	var req ConstructionHashRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	bz, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return TransactionIdentifierResponse{
+		TransactionIdentifier: TransactionIdentifier{Hash: txHash(tmtypes.Tx(bz))},
+	}, nil
+}
+
+func (s *Server) constructionSubmit(r *http.Request) (interface{}, *Error) {
+	var req ConstructionSubmitRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	bz, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	res, err := s.cliCtx.BroadcastTxSync(bz)
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	if res.Code != 0 {
+		return nil, ErrBroadcastFailed.Wrap(fmt.Errorf("%s", res.RawLog))
+	}
+
+	return TransactionIdentifierResponse{
+		TransactionIdentifier: TransactionIdentifier{Hash: res.TxHash},
+	}, nil
+}
+
+func (s *Server) decodeUnsignedTx(hexTx string) (unsignedTx, *Error) {
+	var utx unsignedTx
+
+	bz, err := hex.DecodeString(hexTx)
+	if err != nil {
+		return utx, ErrInvalidTransaction.Wrap(err)
+	}
+
+	if err := s.cliCtx.Codec.UnmarshalJSON(bz, &utx); err != nil {
+		return utx, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return utx, nil
+}
+
+// newUnsignedTx builds an unsigned transaction out of the messages and the
+// construction metadata.
+func newUnsignedTx(msgs []sdk.Msg, metadata map[string]interface{}) (unsignedTx, error) {
+	chainID, _ := metadata[optChainID].(string)
+	if chainID == "" {
+		return unsignedTx{}, fmt.Errorf("%s is required", optChainID)
+	}
+
+	accNum, err := metadataUint(metadata, optAccountNumber, 0, true)
+	if err != nil {
+		return unsignedTx{}, err
+	}
+
+	seq, err := metadataUint(metadata, optSequence, 0, true)
+	if err != nil {
+		return unsignedTx{}, err
+	}
+
+	gas, err := metadataUint(metadata, optGas, DefaultGas, false)
+	if err != nil {
+		return unsignedTx{}, err
+	}
+
+	var fees sdk.Coins
+	if feeStr, _ := metadata[optFee].(string); feeStr != "" {
+		if fees, err = sdk.ParseCoins(feeStr); err != nil {
+			return unsignedTx{}, err
+		}
+	}
+
+	memo, _ := metadata[optMemo].(string)
+
+	return unsignedTx{
+		Tx:            authtypes.NewStdTx(msgs, authtypes.NewStdFee(gas, fees), nil, memo),
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+	}, nil
+}
+
+// metadataUint reads an unsigned integer from the metadata, which may be
+// encoded either as a JSON number or as a string.
+func metadataUint(metadata map[string]interface{}, key string, defaultValue uint64, required bool) (uint64, error) {
+	switch value := metadata[key].(type) {
+	case nil:
+		if required {
+			return 0, fmt.Errorf("%s is required", key)
+		}
+		return defaultValue, nil
+
+	case float64:
+		if value < 0 || value != float64(uint64(value)) {
+			return 0, fmt.Errorf("invalid %s: %v", key, value)
+		}
+		return uint64(value), nil
+
+	case string:
+		return strconv.ParseUint(value, 10, 64)
+
+	default:
+		return 0, fmt.Errorf("invalid %s: %v", key, value)
+	}
+}
+
+// singleSigner returns the only account debited by the operations. Multiple
+// signers are not supported since each would require its own account number
+// and sequence.
+func singleSigner(ops []Operation) (sdk.AccAddress, *Error) {
+	var signer sdk.AccAddress
+
+	for _, op := range ops {
+		if op.Account == nil || op.Amount == nil {
+			continue
+		}
+
+		coin, debit, err := AmountToCoin(*op.Amount)
+		if err != nil {
+			return nil, ErrInvalidOperation.Wrap(err)
+		}
+		if !debit || coin.IsZero() {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(op.Account.Address)
+		if err != nil {
+			return nil, ErrInvalidAddress.Wrap(err)
+		}
+
+		if signer != nil && !signer.Equals(addr) {
+			return nil, ErrInvalidOperation.Wrap(fmt.Errorf("operations must be signed by a single account"))
+		}
+		signer = addr
+	}
+
+	if signer == nil {
+		return nil, ErrInvalidOperation.Wrap(fmt.Errorf("no account is debited by the operations"))
+	}
+
+	return signer, nil
+}
+
+func parsePubKey(pk PublicKey) (secp256k1.PubKeySecp256k1, *Error) {
+	var pubKey secp256k1.PubKeySecp256k1
+
+	if pk.CurveType != CurveSecp256k1 {
+		return pubKey, ErrUnsupportedCurve
+	}
+
+	bz, err := hex.DecodeString(pk.HexBytes)
+	if err != nil {
+		return pubKey, ErrInvalidPubKey.Wrap(err)
+	}
+
+	if len(bz) != secp256k1.PubKeySecp256k1Size {
+		return pubKey, ErrInvalidPubKey.Wrap(fmt.Errorf("expected a %d bytes compressed public key", secp256k1.PubKeySecp256k1Size))
+	}
+
+	copy(pubKey[:], bz)
+
+	return pubKey, nil
+}
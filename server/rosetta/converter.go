@@ -0,0 +1,246 @@
+package rosetta
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// metadata keys used by operations
+const (
+	metaValidatorAddress = "validator_address"
+	metaMsgType          = "msg_type"
+	metaMsgRoute         = "msg_route"
+)
+
+// CoinToAmount converts an sdk.Coin into a Rosetta amount. Debits are
+// represented by negative values.
+func CoinToAmount(coin sdk.Coin, debit bool) Amount {
+	value := coin.Amount
+	if debit {
+		value = value.Neg()
+	}
+
+	return Amount{
+		Value:    value.String(),
+		Currency: Currency{Symbol: coin.Denom},
+	}
+}
+
+// CoinsToAmounts converts sdk.Coins into Rosetta amounts.
+func CoinsToAmounts(coins sdk.Coins) []Amount {
+	amounts := make([]Amount, len(coins))
+	for i, coin := range coins {
+		amounts[i] = CoinToAmount(coin, false)
+	}
+
+	return amounts
+}
+
+// AmountToCoin converts a Rosetta amount into an sdk.Coin. It returns the
+// absolute value of the amount and whether it was a debit.
+func AmountToCoin(amount Amount) (coin sdk.Coin, debit bool, err error) {
+	value, ok := sdk.NewIntFromString(amount.Value)
+	if !ok {
+		return sdk.Coin{}, false, fmt.Errorf("invalid amount value %q", amount.Value)
+	}
+
+	if value.IsNegative() {
+		debit = true
+		value = value.Neg()
+	}
+
+	if err := sdk.ValidateDenom(amount.Currency.Symbol); err != nil {
+		return sdk.Coin{}, false, err
+	}
+
+	return sdk.NewCoin(amount.Currency.Symbol, value), debit, nil
+}
+
+// TxToOperations converts the fee and messages of a transaction into Rosetta
+// operations with the given status. Messages that do not move funds are
+// reported as operations without an account or amount.
+func TxToOperations(tx authtypes.StdTx, status string) []Operation {
+	var ops []Operation
+
+	appendOp := func(opType string, addr sdk.AccAddress, amount *Amount, metadata map[string]interface{}, related bool) {
+		op := Operation{
+			OperationIdentifier: OperationIdentifier{Index: int64(len(ops))},
+			Type:                opType,
+			Status:              status,
+			Amount:              amount,
+			Metadata:            metadata,
+		}
+		if addr != nil {
+			op.Account = &AccountIdentifier{Address: addr.String()}
+		}
+		if related && len(ops) > 0 {
+			op.RelatedOperations = []OperationIdentifier{ops[len(ops)-1].OperationIdentifier}
+		}
+
+		ops = append(ops, op)
+	}
+
+	// fees are always deducted, even if the transaction fails
+	for _, coin := range tx.Fee.Amount {
+		amount := CoinToAmount(coin, true)
+		appendOp(OpFee, tx.FeePayer(), &amount, nil, false)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case banktypes.MsgSend:
+			for _, coin := range msg.Amount {
+				debit, credit := CoinToAmount(coin, true), CoinToAmount(coin, false)
+				appendOp(OpTransfer, msg.FromAddress, &debit, nil, false)
+				appendOp(OpTransfer, msg.ToAddress, &credit, nil, true)
+			}
+
+		case banktypes.MsgMultiSend:
+			for _, in := range msg.Inputs {
+				for _, coin := range in.Coins {
+					amount := CoinToAmount(coin, true)
+					appendOp(OpTransfer, in.Address, &amount, nil, false)
+				}
+			}
+			for _, out := range msg.Outputs {
+				for _, coin := range out.Coins {
+					amount := CoinToAmount(coin, false)
+					appendOp(OpTransfer, out.Address, &amount, nil, false)
+				}
+			}
+
+		case stakingtypes.MsgDelegate:
+			amount := CoinToAmount(msg.Amount, true)
+			metadata := map[string]interface{}{metaValidatorAddress: msg.ValidatorAddress.String()}
+			appendOp(OpDelegate, msg.DelegatorAddress, &amount, metadata, false)
+
+		case stakingtypes.MsgUndelegate:
+			// the funds are only credited once the unbonding period ends
+			metadata := map[string]interface{}{
+				metaValidatorAddress: msg.ValidatorAddress.String(),
+				"amount":             msg.Amount.String(),
+			}
+			appendOp(OpUndelegate, msg.DelegatorAddress, nil, metadata, false)
+
+		default:
+			metadata := map[string]interface{}{metaMsgRoute: msg.Route(), metaMsgType: msg.Type()}
+			appendOp(msg.Type(), nil, nil, metadata, false)
+		}
+	}
+
+	return ops
+}
+
+// OperationsToMsgs converts Rosetta operations into messages. Transfers must be
+// expressed as a debit operation immediately followed by a credit operation of
+// the same amount, and delegations as a single debit operation carrying the
+// validator address in its metadata. Fee operations are ignored as fees are
+// provided through the construction metadata.
+func OperationsToMsgs(ops []Operation) ([]sdk.Msg, error) {
+	sorted := make([]Operation, len(ops))
+	copy(sorted, ops)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].OperationIdentifier.Index < sorted[j].OperationIdentifier.Index
+	})
+
+	var msgs []sdk.Msg
+
+	for i := 0; i < len(sorted); i++ {
+		op := sorted[i]
+
+		switch op.Type {
+		case OpFee:
+			continue
+
+		case OpTransfer:
+			if i+1 >= len(sorted) || sorted[i+1].Type != OpTransfer {
+				return nil, fmt.Errorf("transfer operation %d has no matching credit", op.OperationIdentifier.Index)
+			}
+
+			from, debit, err := parseOperation(op)
+			if err != nil {
+				return nil, err
+			}
+			to, credit, err := parseOperation(sorted[i+1])
+			if err != nil {
+				return nil, err
+			}
+
+			if !debit.IsNegative() || !credit.IsPositive() || !debit.Neg().IsEqual(credit) {
+				return nil, fmt.Errorf("transfer operations %d and %d do not balance", op.OperationIdentifier.Index, sorted[i+1].OperationIdentifier.Index)
+			}
+
+			msgs = append(msgs, banktypes.NewMsgSend(from, to, sdk.NewCoins(credit.Coin())))
+			i++
+
+		case OpDelegate:
+			delegator, amount, err := parseOperation(op)
+			if err != nil {
+				return nil, err
+			}
+			if !amount.IsNegative() {
+				return nil, fmt.Errorf("delegate operation %d must debit the delegator", op.OperationIdentifier.Index)
+			}
+
+			valStr, _ := op.Metadata[metaValidatorAddress].(string)
+			valAddr, err := sdk.ValAddressFromBech32(valStr)
+			if err != nil {
+				return nil, fmt.Errorf("delegate operation %d: %w", op.OperationIdentifier.Index, err)
+			}
+
+			msgs = append(msgs, stakingtypes.NewMsgDelegate(delegator, valAddr, amount.Neg().Coin()))
+
+		default:
+			return nil, fmt.Errorf("unsupported operation type %q", op.Type)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no operations to construct a transaction from")
+	}
+
+	return msgs, nil
+}
+
+// signedCoin is a coin whose amount may be negative.
+type signedCoin struct {
+	denom  string
+	amount sdk.Int
+}
+
+func (c signedCoin) IsNegative() bool { return c.amount.IsNegative() }
+func (c signedCoin) IsPositive() bool { return c.amount.IsPositive() }
+func (c signedCoin) Neg() signedCoin  { return signedCoin{c.denom, c.amount.Neg()} }
+func (c signedCoin) Coin() sdk.Coin   { return sdk.NewCoin(c.denom, c.amount) }
+
+func (c signedCoin) IsEqual(other signedCoin) bool {
+	return c.denom == other.denom && c.amount.Equal(other.amount)
+}
+
+func parseOperation(op Operation) (sdk.AccAddress, signedCoin, error) {
+	if op.Account == nil || op.Amount == nil {
+		return nil, signedCoin{}, fmt.Errorf("operation %d must have an account and an amount", op.OperationIdentifier.Index)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(op.Account.Address)
+	if err != nil {
+		return nil, signedCoin{}, err
+	}
+
+	coin, debit, err := AmountToCoin(*op.Amount)
+	if err != nil {
+		return nil, signedCoin{}, err
+	}
+
+	amount := signedCoin{coin.Denom, coin.Amount}
+	if debit {
+		amount = amount.Neg()
+	}
+
+	return addr, amount, nil
+}
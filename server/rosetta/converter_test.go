@@ -0,0 +1,125 @@
+package rosetta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	addr1   = sdk.AccAddress([]byte("addr1_______________"))
+	addr2   = sdk.AccAddress([]byte("addr2_______________"))
+	valAddr = sdk.ValAddress([]byte("validator___________"))
+)
+
+func TestAmountConversion(t *testing.T) {
+	coin := sdk.NewInt64Coin("stake", 100)
+
+	debit := CoinToAmount(coin, true)
+	require.Equal(t, "-100", debit.Value)
+	require.Equal(t, "stake", debit.Currency.Symbol)
+
+	res, isDebit, err := AmountToCoin(debit)
+	require.NoError(t, err)
+	require.True(t, isDebit)
+	require.Equal(t, coin, res)
+
+	_, _, err = AmountToCoin(Amount{Value: "1.5", Currency: Currency{Symbol: "stake"}})
+	require.Error(t, err)
+	_, _, err = AmountToCoin(Amount{Value: "1", Currency: Currency{Symbol: "!"}})
+	require.Error(t, err)
+}
+
+func TestTxOperationsRoundTrip(t *testing.T) {
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		stakingtypes.NewMsgDelegate(addr1, valAddr, sdk.NewInt64Coin("stake", 5)),
+	}
+	fee := authtypes.NewStdFee(DefaultGas, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	tx := authtypes.NewStdTx(msgs, fee, nil, "")
+
+	ops := TxToOperations(tx, StatusSuccess)
+	require.Len(t, ops, 4)
+
+	require.Equal(t, OpFee, ops[0].Type)
+	require.Equal(t, "-1", ops[0].Amount.Value)
+
+	require.Equal(t, OpTransfer, ops[1].Type)
+	require.Equal(t, addr1.String(), ops[1].Account.Address)
+	require.Equal(t, "-10", ops[1].Amount.Value)
+	require.Equal(t, OpTransfer, ops[2].Type)
+	require.Equal(t, addr2.String(), ops[2].Account.Address)
+	require.Equal(t, []OperationIdentifier{{Index: 1}}, ops[2].RelatedOperations)
+
+	require.Equal(t, OpDelegate, ops[3].Type)
+	require.Equal(t, valAddr.String(), ops[3].Metadata[metaValidatorAddress])
+
+	for i, op := range ops {
+		require.Equal(t, int64(i), op.OperationIdentifier.Index)
+		require.Equal(t, StatusSuccess, op.Status)
+	}
+
+	res, err := OperationsToMsgs(ops)
+	require.NoError(t, err)
+	require.Equal(t, msgs, res)
+
+	signer, rerr := singleSigner(ops)
+	require.Nil(t, rerr)
+	require.Equal(t, addr1, signer)
+}
+
+func TestOperationsToMsgsInvalid(t *testing.T) {
+	debit := CoinToAmount(sdk.NewInt64Coin("stake", 10), true)
+	credit := CoinToAmount(sdk.NewInt64Coin("stake", 9), false)
+
+	testCases := []struct {
+		name string
+		ops  []Operation
+	}{
+		{"no operations", nil},
+		{"unsupported type", []Operation{{Type: "swap"}}},
+		{"transfer without credit", []Operation{
+			{OperationIdentifier: OperationIdentifier{Index: 0}, Type: OpTransfer, Account: &AccountIdentifier{Address: addr1.String()}, Amount: &debit},
+		}},
+		{"unbalanced transfer", []Operation{
+			{OperationIdentifier: OperationIdentifier{Index: 0}, Type: OpTransfer, Account: &AccountIdentifier{Address: addr1.String()}, Amount: &debit},
+			{OperationIdentifier: OperationIdentifier{Index: 1}, Type: OpTransfer, Account: &AccountIdentifier{Address: addr2.String()}, Amount: &credit},
+		}},
+		{"delegation without validator", []Operation{
+			{OperationIdentifier: OperationIdentifier{Index: 0}, Type: OpDelegate, Account: &AccountIdentifier{Address: addr1.String()}, Amount: &debit},
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := OperationsToMsgs(tc.ops)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewUnsignedTx(t *testing.T) {
+	msgs := []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))}
+
+	utx, err := newUnsignedTx(msgs, map[string]interface{}{
+		optChainID:       "test-chain",
+		optAccountNumber: "3",
+		optSequence:      float64(7),
+		optFee:           "5stake",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", utx.ChainID)
+	require.Equal(t, uint64(3), utx.AccountNumber)
+	require.Equal(t, uint64(7), utx.Sequence)
+	require.Equal(t, uint64(DefaultGas), utx.Tx.Fee.Gas)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), utx.Tx.Fee.Amount)
+
+	_, err = newUnsignedTx(msgs, map[string]interface{}{optChainID: "test-chain", optSequence: "1"})
+	require.Error(t, err)
+}
@@ -0,0 +1,317 @@
+package rosetta
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mempoolLimit is the maximum number of unconfirmed transactions returned by
+// the mempool endpoint.
+const mempoolLimit = 100
+
+func (s *Server) networkList(r *http.Request) (interface{}, *Error) {
+	return NetworkListResponse{NetworkIdentifiers: []NetworkIdentifier{s.NetworkIdentifier()}}, nil
+}
+
+func (s *Server) networkOptions(r *http.Request) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	version := VersionInfo{RosettaVersion: Version}
+	if !s.config.Offline {
+		node, err := s.cliCtx.GetNode()
+		if err != nil {
+			return nil, ErrNodeUnavailable.Wrap(err)
+		}
+
+		status, err := node.Status()
+		if err != nil {
+			return nil, ErrNodeUnavailable.Wrap(err)
+		}
+
+		version.NodeVersion = status.NodeInfo.Version
+	}
+
+	return NetworkOptionsResponse{
+		Version: version,
+		Allow: Allow{
+			OperationStatuses: []OperationStatus{
+				{Status: StatusSuccess, Successful: true},
+				{Status: StatusReverted, Successful: false},
+			},
+			OperationTypes:          []string{OpTransfer, OpDelegate, OpUndelegate, OpFee},
+			Errors:                  allErrors,
+			HistoricalBalanceLookup: true,
+		},
+	}, nil
+}
+
+func (s *Server) networkStatus(r *http.Request) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.cliCtx.GetNode()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	genesisHeight := int64(1)
+	genesis, err := node.Block(&genesisHeight)
+	if err != nil {
+		return nil, ErrBlockNotFound.Wrap(err)
+	}
+
+	netInfo, err := node.NetInfo()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	peers := make([]Peer, len(netInfo.Peers))
+	for i, peer := range netInfo.Peers {
+		peers[i] = Peer{PeerID: string(peer.NodeInfo.ID())}
+	}
+
+	return NetworkStatusResponse{
+		CurrentBlockIdentifier: BlockIdentifier{
+			Index: status.SyncInfo.LatestBlockHeight,
+			Hash:  status.SyncInfo.LatestBlockHash.String(),
+		},
+		CurrentBlockTimestamp:  status.SyncInfo.LatestBlockTime.UnixNano() / 1e6,
+		GenesisBlockIdentifier: blockIdentifier(genesis),
+		Peers:                  peers,
+	}, nil
+}
+
+func (s *Server) accountBalance(r *http.Request) (interface{}, *Error) {
+	var req AccountBalanceRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.AccountIdentifier.Address)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrap(err)
+	}
+
+	var height *int64
+	if req.BlockIdentifier != nil {
+		height = req.BlockIdentifier.Index
+	}
+
+	resBlock, rerr := s.getBlock(height, req.BlockIdentifier)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	bz, err := s.cliCtx.Codec.MarshalJSON(banktypes.NewQueryAllBalancesParams(addr))
+	if err != nil {
+		return nil, ErrInvalidRequest.Wrap(err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", banktypes.QuerierRoute, banktypes.QueryAllBalances)
+	res, _, err := s.cliCtx.WithHeight(resBlock.Block.Height).QueryWithData(route, bz)
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	var balances sdk.Coins
+	if err := s.cliCtx.Codec.UnmarshalJSON(res, &balances); err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	return AccountBalanceResponse{
+		BlockIdentifier: blockIdentifier(resBlock),
+		Balances:        CoinsToAmounts(balances),
+	}, nil
+}
+
+func (s *Server) block(r *http.Request) (interface{}, *Error) {
+	var req BlockRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	resBlock, rerr := s.getBlock(req.BlockIdentifier.Index, &req.BlockIdentifier)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	node, err := s.cliCtx.GetNode()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	results, err := node.BlockResults(&resBlock.Block.Height)
+	if err != nil {
+		return nil, ErrBlockNotFound.Wrap(err)
+	}
+
+	txs := make([]Transaction, len(resBlock.Block.Txs))
+	for i, txBytes := range resBlock.Block.Txs {
+		var code uint32
+		if i < len(results.TxsResults) {
+			code = results.TxsResults[i].Code
+		}
+
+		tx, rerr := s.convertTx(txBytes, code)
+		if rerr != nil {
+			return nil, rerr
+		}
+
+		txs[i] = tx
+	}
+
+	parent := BlockIdentifier{
+		Index: resBlock.Block.Height - 1,
+		Hash:  resBlock.Block.LastBlockID.Hash.String(),
+	}
+	if resBlock.Block.Height == 1 {
+		// by convention the genesis block is its own parent
+		parent = blockIdentifier(resBlock)
+	}
+
+	return BlockResponse{
+		Block: Block{
+			BlockIdentifier:       blockIdentifier(resBlock),
+			ParentBlockIdentifier: parent,
+			Timestamp:             resBlock.Block.Time.UnixNano() / 1e6,
+			Transactions:          txs,
+		},
+	}, nil
+}
+
+func (s *Server) blockTransaction(r *http.Request) (interface{}, *Error) {
+	var req BlockTransactionRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	hash, err := hex.DecodeString(req.TransactionIdentifier.Hash)
+	if err != nil {
+		return nil, ErrInvalidRequest.Wrap(err)
+	}
+
+	node, err := s.cliCtx.GetNode()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	resTx, err := node.Tx(hash, false)
+	if err != nil {
+		return nil, ErrTxNotFound.Wrap(err)
+	}
+
+	if resTx.Height != req.BlockIdentifier.Index {
+		return nil, ErrTxNotFound
+	}
+
+	tx, rerr := s.convertTx(resTx.Tx, resTx.TxResult.Code)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	return BlockTransactionResponse{Transaction: tx}, nil
+}
+
+func (s *Server) mempool(r *http.Request) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := s.decodeRequest(r, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.cliCtx.GetNode()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	res, err := node.UnconfirmedTxs(mempoolLimit)
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	ids := make([]TransactionIdentifier, len(res.Txs))
+	for i, tx := range res.Txs {
+		ids[i] = TransactionIdentifier{Hash: txHash(tx)}
+	}
+
+	return MempoolResponse{TransactionIdentifiers: ids}, nil
+}
+
+// getBlock returns the block at the given height, or the latest block if the
+// height is nil, and checks it matches the hash of the identifier if any.
+func (s *Server) getBlock(height *int64, id *PartialBlockIdentifier) (*ctypes.ResultBlock, *Error) {
+	node, err := s.cliCtx.GetNode()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err)
+	}
+
+	if height == nil && id != nil && id.Hash != nil {
+		// blocks can only be looked up by height
+		return nil, ErrInvalidRequest.Wrap(fmt.Errorf("block index is required"))
+	}
+
+	resBlock, err := node.Block(height)
+	if err != nil {
+		return nil, ErrBlockNotFound.Wrap(err)
+	}
+
+	if id != nil && id.Hash != nil && !strings.EqualFold(*id.Hash, resBlock.BlockID.Hash.String()) {
+		return nil, ErrBlockNotFound
+	}
+
+	return resBlock, nil
+}
+
+// convertTx decodes a transaction and converts it into a Rosetta transaction.
+// Fees are always charged while the remaining operations are reverted if the
+// transaction failed.
+func (s *Server) convertTx(txBytes tmtypes.Tx, code uint32) (Transaction, *Error) {
+	var stdTx authtypes.StdTx
+	if err := s.cliCtx.Codec.UnmarshalBinaryBare(txBytes, &stdTx); err != nil {
+		return Transaction{}, ErrInvalidTransaction.Wrap(err)
+	}
+
+	status := StatusSuccess
+	if code != 0 {
+		status = StatusReverted
+	}
+
+	ops := TxToOperations(stdTx, status)
+	for i := range ops {
+		if ops[i].Type == OpFee {
+			ops[i].Status = StatusSuccess
+		}
+	}
+
+	return Transaction{
+		TransactionIdentifier: TransactionIdentifier{Hash: txHash(txBytes)},
+		Operations:            ops,
+		Metadata:              map[string]interface{}{"memo": stdTx.Memo},
+	}, nil
+}
+
+func blockIdentifier(resBlock *ctypes.ResultBlock) BlockIdentifier {
+	return BlockIdentifier{Index: resBlock.Block.Height, Hash: resBlock.BlockID.Hash.String()}
+}
+
+func txHash(tx tmtypes.Tx) string {
+	return fmt.Sprintf("%X", tx.Hash())
+}
@@ -0,0 +1,58 @@
+package rosetta
+
+import "fmt"
+
+// Error defines a Rosetta API error. Every error the server may return is
+// listed in the network options so that clients can handle them.
+type Error struct {
+	Code      int32                  `json:"code"`
+	Message   string                 `json:"message"`
+	Retriable bool                   `json:"retriable"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// Wrap returns a copy of the error carrying the provided error message as
+// details.
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Details = map[string]interface{}{"error": err.Error()}
+
+	return &wrapped
+}
+
+// Errors returned by the Rosetta server
+var (
+	ErrInvalidRequest     = &Error{Code: 1, Message: "invalid request"}
+	ErrInvalidNetwork     = &Error{Code: 2, Message: "network identifier is not supported"}
+	ErrNodeUnavailable    = &Error{Code: 3, Message: "node is unavailable", Retriable: true}
+	ErrBlockNotFound      = &Error{Code: 4, Message: "block not found", Retriable: true}
+	ErrTxNotFound         = &Error{Code: 5, Message: "transaction not found", Retriable: true}
+	ErrInvalidAddress     = &Error{Code: 6, Message: "invalid address"}
+	ErrInvalidPubKey      = &Error{Code: 7, Message: "invalid public key"}
+	ErrUnsupportedCurve   = &Error{Code: 8, Message: "unsupported curve type"}
+	ErrInvalidOperation   = &Error{Code: 9, Message: "invalid operations"}
+	ErrInvalidTransaction = &Error{Code: 10, Message: "invalid transaction"}
+	ErrInvalidSignature   = &Error{Code: 11, Message: "invalid signature"}
+	ErrBroadcastFailed    = &Error{Code: 12, Message: "transaction broadcast failed"}
+	ErrOfflineMode        = &Error{Code: 13, Message: "endpoint is not available in offline mode"}
+
+	allErrors = []*Error{
+		ErrInvalidRequest,
+		ErrInvalidNetwork,
+		ErrNodeUnavailable,
+		ErrBlockNotFound,
+		ErrTxNotFound,
+		ErrInvalidAddress,
+		ErrInvalidPubKey,
+		ErrUnsupportedCurve,
+		ErrInvalidOperation,
+		ErrInvalidTransaction,
+		ErrInvalidSignature,
+		ErrBroadcastFailed,
+		ErrOfflineMode,
+	}
+)
@@ -0,0 +1,121 @@
+package rosetta
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// Config defines the configuration of a Rosetta server.
+type Config struct {
+	// Blockchain is the blockchain name reported in the network identifier.
+	Blockchain string
+	// Network is the network name reported in the network identifier; it
+	// defaults to the chain ID.
+	Network string
+	// Offline disables every endpoint requiring a connection to a node.
+	Offline bool
+}
+
+// Server implements the Rosetta Data and Construction APIs on top of the bank,
+// auth and staking modules. The CLIContext must carry the amino codec used to
+// encode transactions, the JSON marshaler used for queries and an account
+// retriever.
+type Server struct {
+	cliCtx context.CLIContext
+	config Config
+}
+
+// NewServer returns a new Rosetta server.
+func NewServer(cliCtx context.CLIContext, config Config) *Server {
+	if config.Network == "" {
+		config.Network = cliCtx.ChainID
+	}
+
+	return &Server{cliCtx: cliCtx, config: config}
+}
+
+// NetworkIdentifier returns the identifier of the network served.
+func (s *Server) NetworkIdentifier() NetworkIdentifier {
+	return NetworkIdentifier{Blockchain: s.config.Blockchain, Network: s.config.Network}
+}
+
+// RegisterRoutes registers the Rosetta API endpoints on the router. This allows
+// the Rosetta API to be served in-process by another HTTP server, e.g. the
+// REST server.
+func (s *Server) RegisterRoutes(r *mux.Router) {
+	routes := map[string]func(*http.Request) (interface{}, *Error){
+		// data API
+		"/network/list":      s.networkList,
+		"/network/options":   s.networkOptions,
+		"/network/status":    s.online(s.networkStatus),
+		"/account/balance":   s.online(s.accountBalance),
+		"/block":             s.online(s.block),
+		"/block/transaction": s.online(s.blockTransaction),
+		"/mempool":           s.online(s.mempool),
+
+		// construction API
+		"/construction/derive":     s.constructionDerive,
+		"/construction/preprocess": s.constructionPreprocess,
+		"/construction/metadata":   s.online(s.constructionMetadata),
+		"/construction/payloads":   s.constructionPayloads,
+		"/construction/combine":    s.constructionCombine,
+		"/construction/parse":      s.constructionParse,
+		"/construction/hash":       s.constructionHash,
+		"/construction/submit":     s.online(s.constructionSubmit),
+	}
+
+	for path, handler := range routes {
+		r.HandleFunc(path, handle(handler)).Methods("POST")
+	}
+}
+
+// Router returns a new router serving the Rosetta API.
+func (s *Server) Router() *mux.Router {
+	r := mux.NewRouter()
+	s.RegisterRoutes(r)
+
+	return r
+}
+
+func (s *Server) online(handler func(*http.Request) (interface{}, *Error)) func(*http.Request) (interface{}, *Error) {
+	return func(r *http.Request) (interface{}, *Error) {
+		if s.config.Offline {
+			return nil, ErrOfflineMode
+		}
+
+		return handler(r)
+	}
+}
+
+// decodeRequest decodes the request body into req and validates the network
+// identifier it carries.
+func (s *Server) decodeRequest(r *http.Request, req interface{}, network *NetworkIdentifier) *Error {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return ErrInvalidRequest.Wrap(err)
+	}
+
+	if network != nil && *network != s.NetworkIdentifier() {
+		return ErrInvalidNetwork
+	}
+
+	return nil
+}
+
+func handle(handler func(*http.Request) (interface{}, *Error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		res, rerr := handler(r)
+		if rerr != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(rerr)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
@@ -0,0 +1,258 @@
+package rosetta
+
+// The types below implement the subset of the Rosetta API models
+// (https://www.rosetta-api.org/docs/Reference.html) served by this package.
+
+// Version defines the Rosetta specification version implemented by the server.
+const Version = "1.4.0"
+
+// Operation types and statuses
+const (
+	OpTransfer   = "transfer"
+	OpDelegate   = "delegate"
+	OpUndelegate = "undelegate"
+	OpFee        = "fee"
+
+	StatusSuccess  = "Success"
+	StatusReverted = "Reverted"
+)
+
+// CurveSecp256k1 and SignatureEcdsa are the only key curve and signature type
+// supported by the construction API.
+const (
+	CurveSecp256k1 = "secp256k1"
+	SignatureEcdsa = "ecdsa"
+)
+
+type NetworkIdentifier struct {
+	Blockchain string `json:"blockchain"`
+	Network    string `json:"network"`
+}
+
+type BlockIdentifier struct {
+	Index int64  `json:"index"`
+	Hash  string `json:"hash"`
+}
+
+type PartialBlockIdentifier struct {
+	Index *int64  `json:"index,omitempty"`
+	Hash  *string `json:"hash,omitempty"`
+}
+
+type TransactionIdentifier struct {
+	Hash string `json:"hash"`
+}
+
+type OperationIdentifier struct {
+	Index int64 `json:"index"`
+}
+
+type AccountIdentifier struct {
+	Address string `json:"address"`
+}
+
+type Currency struct {
+	Symbol   string `json:"symbol"`
+	Decimals int32  `json:"decimals"`
+}
+
+type Amount struct {
+	Value    string   `json:"value"`
+	Currency Currency `json:"currency"`
+}
+
+type Operation struct {
+	OperationIdentifier OperationIdentifier    `json:"operation_identifier"`
+	RelatedOperations   []OperationIdentifier  `json:"related_operations,omitempty"`
+	Type                string                 `json:"type"`
+	Status              string                 `json:"status"`
+	Account             *AccountIdentifier     `json:"account,omitempty"`
+	Amount              *Amount                `json:"amount,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type Transaction struct {
+	TransactionIdentifier TransactionIdentifier  `json:"transaction_identifier"`
+	Operations            []Operation            `json:"operations"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type Block struct {
+	BlockIdentifier       BlockIdentifier `json:"block_identifier"`
+	ParentBlockIdentifier BlockIdentifier `json:"parent_block_identifier"`
+	Timestamp             int64           `json:"timestamp"`
+	Transactions          []Transaction   `json:"transactions"`
+}
+
+type PublicKey struct {
+	HexBytes  string `json:"hex_bytes"`
+	CurveType string `json:"curve_type"`
+}
+
+type SigningPayload struct {
+	AccountIdentifier *AccountIdentifier `json:"account_identifier,omitempty"`
+	HexBytes          string             `json:"hex_bytes"`
+	SignatureType     string             `json:"signature_type,omitempty"`
+}
+
+type Signature struct {
+	SigningPayload SigningPayload `json:"signing_payload"`
+	PublicKey      PublicKey      `json:"public_key"`
+	SignatureType  string         `json:"signature_type"`
+	HexBytes       string         `json:"hex_bytes"`
+}
+
+type Peer struct {
+	PeerID string `json:"peer_id"`
+}
+
+type OperationStatus struct {
+	Status     string `json:"status"`
+	Successful bool   `json:"successful"`
+}
+
+type Allow struct {
+	OperationStatuses       []OperationStatus `json:"operation_statuses"`
+	OperationTypes          []string          `json:"operation_types"`
+	Errors                  []*Error          `json:"errors"`
+	HistoricalBalanceLookup bool              `json:"historical_balance_lookup"`
+}
+
+type VersionInfo struct {
+	RosettaVersion string `json:"rosetta_version"`
+	NodeVersion    string `json:"node_version"`
+}
+
+// Data API requests and responses
+
+type NetworkRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+}
+
+type NetworkListResponse struct {
+	NetworkIdentifiers []NetworkIdentifier `json:"network_identifiers"`
+}
+
+type NetworkOptionsResponse struct {
+	Version VersionInfo `json:"version"`
+	Allow   Allow       `json:"allow"`
+}
+
+type NetworkStatusResponse struct {
+	CurrentBlockIdentifier BlockIdentifier `json:"current_block_identifier"`
+	CurrentBlockTimestamp  int64           `json:"current_block_timestamp"`
+	GenesisBlockIdentifier BlockIdentifier `json:"genesis_block_identifier"`
+	Peers                  []Peer          `json:"peers"`
+}
+
+type AccountBalanceRequest struct {
+	NetworkIdentifier NetworkIdentifier       `json:"network_identifier"`
+	AccountIdentifier AccountIdentifier       `json:"account_identifier"`
+	BlockIdentifier   *PartialBlockIdentifier `json:"block_identifier,omitempty"`
+}
+
+type AccountBalanceResponse struct {
+	BlockIdentifier BlockIdentifier        `json:"block_identifier"`
+	Balances        []Amount               `json:"balances"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type BlockRequest struct {
+	NetworkIdentifier NetworkIdentifier      `json:"network_identifier"`
+	BlockIdentifier   PartialBlockIdentifier `json:"block_identifier"`
+}
+
+type BlockResponse struct {
+	Block Block `json:"block"`
+}
+
+type BlockTransactionRequest struct {
+	NetworkIdentifier     NetworkIdentifier     `json:"network_identifier"`
+	BlockIdentifier       BlockIdentifier       `json:"block_identifier"`
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+}
+
+type BlockTransactionResponse struct {
+	Transaction Transaction `json:"transaction"`
+}
+
+type MempoolResponse struct {
+	TransactionIdentifiers []TransactionIdentifier `json:"transaction_identifiers"`
+}
+
+// Construction API requests and responses
+
+type ConstructionDeriveRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	PublicKey         PublicKey         `json:"public_key"`
+}
+
+type ConstructionDeriveResponse struct {
+	Address string `json:"address"`
+}
+
+type ConstructionPreprocessRequest struct {
+	NetworkIdentifier NetworkIdentifier      `json:"network_identifier"`
+	Operations        []Operation            `json:"operations"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type ConstructionPreprocessResponse struct {
+	Options            map[string]interface{} `json:"options"`
+	RequiredPublicKeys []AccountIdentifier    `json:"required_public_keys"`
+}
+
+type ConstructionMetadataRequest struct {
+	NetworkIdentifier NetworkIdentifier      `json:"network_identifier"`
+	Options           map[string]interface{} `json:"options"`
+}
+
+type ConstructionMetadataResponse struct {
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+type ConstructionPayloadsRequest struct {
+	NetworkIdentifier NetworkIdentifier      `json:"network_identifier"`
+	Operations        []Operation            `json:"operations"`
+	Metadata          map[string]interface{} `json:"metadata"`
+}
+
+type ConstructionPayloadsResponse struct {
+	UnsignedTransaction string           `json:"unsigned_transaction"`
+	Payloads            []SigningPayload `json:"payloads"`
+}
+
+type ConstructionCombineRequest struct {
+	NetworkIdentifier   NetworkIdentifier `json:"network_identifier"`
+	UnsignedTransaction string            `json:"unsigned_transaction"`
+	Signatures          []Signature       `json:"signatures"`
+}
+
+type ConstructionCombineResponse struct {
+	SignedTransaction string `json:"signed_transaction"`
+}
+
+type ConstructionParseRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	Signed            bool              `json:"signed"`
+	Transaction       string            `json:"transaction"`
+}
+
+type ConstructionParseResponse struct {
+	Operations               []Operation         `json:"operations"`
+	AccountIdentifierSigners []AccountIdentifier `json:"account_identifier_signers"`
+}
+
+type ConstructionHashRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	SignedTransaction string            `json:"signed_transaction"`
+}
+
+type ConstructionSubmitRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	SignedTransaction string            `json:"signed_transaction"`
+}
+
+type TransactionIdentifierResponse struct {
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+}
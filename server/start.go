@@ -4,18 +4,26 @@ package server
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"runtime/pprof"
 
+	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
-	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	rpclocal "github.com/tendermint/tendermint/rpc/client/local"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Tendermint full-node start flags
@@ -31,7 +39,15 @@ const (
 	FlagHaltHeight           = "halt-height"
 	FlagHaltTime             = "halt-time"
 	FlagInterBlockCache      = "inter-block-cache"
+	FlagIAVLFastIndex        = "iavl-fast-index"
+	FlagAppDBBackend         = "app-db-backend"
+	FlagQueryGasLimit        = "query-gas-limit"
+	FlagQueryMaxResultBytes  = "query-max-result-bytes"
 	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+	flagGRPCEnable           = "grpc.enable"
+	flagGRPCAddress          = "grpc.address"
+	flagAPIEnable            = "api.enable"
+	flagAPIAddress           = "api.address"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -41,7 +57,12 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 		Use:   "start",
 		Short: "Run the full node",
 		Long: `Run the full node application with Tendermint in or out of process. By
-default, the application will run with Tendermint in process.
+default, the application will run with Tendermint in process, communicating with it
+through a local ABCI client rather than a socket. Use '--with-tendermint=false' to only
+run the ABCI application and connect an external Tendermint process to '--address'.
+
+On SIGINT or SIGTERM, the services are stopped gracefully and the application database
+is closed before exiting.
 
 Pruning options can be provided via the '--pruning' flag or alternatively with '--pruning-snapshot-every' and 'pruning-keep-every' together.
 
@@ -52,13 +73,25 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: all saved states will be deleted, storing only the current state
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
-the ABCI Commit phase, the node will check if the current block height is greater than or equal to
-the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
-node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
-will not be able to commit subsequent blocks.
+the ABCI Commit phase, the node will attempt to gracefully shutdown once it committed the block at
+the halt-height. In the ABCI BeginBlock phase, the node will attempt to gracefully shutdown without
+executing the block if its height is greater than the halt-height or its time is greater than or
+equal to the halt-time, so that all the nodes sharing the halt configuration stop at the same state,
+e.g. to export it, including when restarted with the same halt configuration. The
+node can be restarted to process blocks once the halt configuration has been reset or moved to a
+more distant value.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+When running in-process with Tendermint, a gRPC server exposing transaction simulation and
+broadcasting can be enabled via the '--grpc.enable' flag. It listens on the address provided by '--grpc.address'. Similarly, the API server serving the
+application's REST routes can be enabled via the '--api.enable' flag and listens on '--api.address'.
+When telemetry is enabled in the application configuration, the API server also serves the gathered
+metrics at '/metrics', in the Prometheus exposition format with the 'format=prometheus' query.
+
+Every option above may also be set in the application configuration file ($HOME/config/app.toml),
+which is created with default values on first run. Command line flags take precedence over it.
 `,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			_, err := GetPruningOptionsFromFlags()
@@ -92,7 +125,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().String(FlagAppDBBackend, "", "Application DB backend (goleveldb|cleveldb|rocksdb|boltdb|badgerdb|memdb), defaults to the build's backend")
+	cmd.Flags().Bool(FlagIAVLFastIndex, false, "Serve reads of the latest state from a flat index of the IAVL stores, built on first startup")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a custom query may consume reading from the stores (0 means unlimited)")
+	cmd.Flags().Uint64(FlagQueryMaxResultBytes, 0, "Maximum size in bytes of a custom query result (0 means unlimited)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
+	cmd.Flags().Bool(flagGRPCEnable, false, "Enable the gRPC server (only available when running in-process with Tendermint)")
+	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "The gRPC server address to listen on")
+	cmd.Flags().Bool(flagAPIEnable, false, "Enable the API server (only available when running in-process with Tendermint)")
+	cmd.Flags().String(flagAPIAddress, config.DefaultAPIAddress, "The API server address to listen on")
 
 	viper.BindPFlag(flagPruning, cmd.Flags().Lookup(flagPruning))
 	viper.BindPFlag(flagPruningKeepEvery, cmd.Flags().Lookup(flagPruningKeepEvery))
@@ -126,21 +167,18 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 
 	svr.SetLogger(ctx.Logger.With("module", "abci-server"))
 
-	err = svr.Start()
-	if err != nil {
-		tmos.Exit(err.Error())
+	if err := svr.Start(); err != nil {
+		return err
 	}
 
-	tmos.TrapSignal(ctx.Logger, func() {
-		// cleanup
-		err = svr.Stop()
-		if err != nil {
-			tmos.Exit(err.Error())
-		}
-	})
+	sig := WaitForQuitSignals()
+	ctx.Logger.Info("caught signal, shutting down...", "signal", sig)
+
+	if err := svr.Stop(); err != nil {
+		return err
+	}
 
-	// run forever (the node will not be returned)
-	select {}
+	return db.Close()
 }
 
 func startInProcess(ctx *Context, appCreator AppCreator) error {
@@ -165,13 +203,19 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 		return err
 	}
 
+	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
+	genDoc, err := genDocProvider()
+	if err != nil {
+		return err
+	}
+
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		cfg,
 		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
-		node.DefaultGenesisDocProviderFunc(cfg),
+		genDocProvider,
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		ctx.Logger.With("module", "node"),
@@ -184,6 +228,53 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 		return err
 	}
 
+	cliCtx := context.CLIContext{}.
+		WithClient(rpclocal.New(tmNode)).
+		WithChainID(genDoc.ChainID).
+		WithTrustNode(true)
+
+	metrics, err := telemetry.New(ctx.AppConfig.Telemetry)
+	if err != nil {
+		return err
+	}
+
+	var apiListener net.Listener
+
+	if ctx.AppConfig.API.Enable {
+		registrar, ok := app.(APIRegistrar)
+		if !ok {
+			return fmt.Errorf("application %T does not support API routes registration", app)
+		}
+
+		router := mux.NewRouter()
+		registrar.RegisterAPIRoutes(router, cliCtx)
+
+		if metrics != nil {
+			router.HandleFunc("/metrics", metrics.Handler()).Methods("GET")
+		}
+
+		apiListener, err = startAPIServer(ctx.AppConfig.API, router, ctx.Logger.With("module", "api-server"))
+		if err != nil {
+			return err
+		}
+	}
+
+	var grpcSrv *grpc.Server
+
+	if ctx.AppConfig.GRPC.Enable {
+		simulator, ok := app.(servergrpc.Simulator)
+		if !ok {
+			return fmt.Errorf("application %T does not support transaction simulation", app)
+		}
+
+		grpcSrv, err = servergrpc.StartGRPCServer(
+			simulator, cliCtx, ctx.AppConfig.GRPC.Address, ctx.Logger.With("module", "grpc-server"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -204,18 +295,33 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 		}
 	}
 
-	TrapSignal(func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
+	// block until a quit signal is received and then shut down gracefully,
+	// stopping the services in the reverse order they were started
+	sig := WaitForQuitSignals()
+	ctx.Logger.Info("caught signal, shutting down...", "signal", sig)
+
+	if grpcSrv != nil {
+		grpcSrv.GracefulStop()
+	}
+
+	if apiListener != nil {
+		if err := apiListener.Close(); err != nil {
+			ctx.Logger.Error("failed to close API server listener", "err", err)
 		}
+	}
 
-		if cpuProfileCleanup != nil {
-			cpuProfileCleanup()
+	if tmNode.IsRunning() {
+		if err := tmNode.Stop(); err != nil {
+			ctx.Logger.Error("failed to stop Tendermint node", "err", err)
 		}
+		tmNode.Wait()
+	}
+
+	if cpuProfileCleanup != nil {
+		cpuProfileCleanup()
+	}
 
-		ctx.Logger.Info("exiting...")
-	})
+	ctx.Logger.Info("exiting...")
 
-	// run forever (the node will not be returned)
-	select {}
+	return db.Close()
 }
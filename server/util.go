@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
@@ -22,14 +23,21 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/server/config"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+// Logging flags
+const (
+	flagLogLevel  = "log_level"
+	flagLogFormat = "log_format"
+)
+
 // server context
 type Context struct {
-	Config *cfg.Config
-	Logger log.Logger
+	Config    *cfg.Config
+	AppConfig *serverconfig.Config
+	Logger    log.Logger
 }
 
 func NewDefaultContext() *Context {
@@ -40,7 +48,7 @@ func NewDefaultContext() *Context {
 }
 
 func NewContext(config *cfg.Config, logger log.Logger) *Context {
-	return &Context{config, logger}
+	return &Context{config, serverconfig.DefaultConfig(), logger}
 }
 
 //___________________________________________________________________________________
@@ -59,8 +67,12 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 			return err
 		}
 
-		logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
-		logger, err = tmflags.ParseLogLevel(config.LogLevel, logger, cfg.DefaultLogLevel())
+		appConfig, err := serverconfig.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		logger, err := newLogger(cmd, config, appConfig)
 		if err != nil {
 			return err
 		}
@@ -71,12 +83,38 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 
 		logger = logger.With("module", "main")
 		context.Config = config
+		context.AppConfig = appConfig
 		context.Logger = logger
 
 		return nil
 	}
 }
 
+// newLogger returns the logger of the server. The log level and format set in
+// the application configuration take precedence over the Tendermint ones,
+// unless they are provided as command line flags.
+func newLogger(cmd *cobra.Command, config *cfg.Config, appConfig *serverconfig.Config) (log.Logger, error) {
+	logLevel, logFormat := config.LogLevel, config.LogFormat
+	if appConfig.Log.Level != "" && !cmd.Flags().Changed(flagLogLevel) {
+		logLevel = appConfig.Log.Level
+	}
+	if appConfig.Log.Format != "" && !cmd.Flags().Changed(flagLogFormat) {
+		logFormat = appConfig.Log.Format
+	}
+
+	var logger log.Logger
+	switch logFormat {
+	case serverconfig.LogFormatJSON:
+		logger = log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout))
+	case "", serverconfig.LogFormatPlain:
+		logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unsupported log format: %s", logFormat)
+	}
+
+	return tmflags.ParseLogLevel(logLevel, logger, cfg.DefaultLogLevel())
+}
+
 // If a new config is created, change some of the default tendermint settings
 func interceptLoadConfig() (conf *cfg.Config, err error) {
 	tmpConf := cfg.DefaultConfig()
@@ -110,8 +148,8 @@ func interceptLoadConfig() (conf *cfg.Config, err error) {
 
 	appConfigFilePath := filepath.Join(rootDir, "config/app.toml")
 	if _, err := os.Stat(appConfigFilePath); os.IsNotExist(err) {
-		appConf, _ := config.ParseConfig()
-		config.WriteConfigFile(appConfigFilePath, appConf)
+		appConf, _ := serverconfig.ParseConfig()
+		serverconfig.WriteConfigFile(appConfigFilePath, appConf)
 	}
 
 	viper.SetConfigName("app")
@@ -126,7 +164,8 @@ func AddCommands(
 	rootCmd *cobra.Command,
 	appCreator AppCreator, appExport AppExporter) {
 
-	rootCmd.PersistentFlags().String("log_level", ctx.Config.LogLevel, "Log level")
+	rootCmd.PersistentFlags().String(flagLogLevel, ctx.Config.LogLevel, "Log level, either global or per module (e.g. main:info,x/bank:debug,*:error)")
+	rootCmd.PersistentFlags().String(flagLogFormat, ctx.Config.LogFormat, "Log format (plain|json)")
 
 	tendermintCmd := &cobra.Command{
 		Use:   "tendermint",
@@ -146,6 +185,9 @@ func AddCommands(
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		ReplayCmd(ctx, appCreator),
+		RollbackCmd(ctx, appCreator),
+		DBCmd(ctx),
 		flags.LineBreak,
 		version.Cmd,
 	)
@@ -227,6 +269,23 @@ func TrapSignal(cleanupFunc func()) {
 	}()
 }
 
+// WaitForQuitSignals blocks until SIGINT or SIGTERM is received and returns
+// the received signal. The handler stays installed and ignores the signals
+// received afterwards, e.g. the SIGTERM following the SIGINT of a halt, so
+// that they do not kill the process while the caller cleans up.
+func WaitForQuitSignals() os.Signal {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	sig := <-sigs
+	go func() {
+		for range sigs {
+		}
+	}()
+
+	return sig
+}
+
 func skipInterface(iface net.Interface) bool {
 	if iface.Flags&net.FlagUp == 0 {
 		return true // interface down
@@ -2,11 +2,18 @@ package server
 
 import (
 	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
+	cfg "github.com/tendermint/tendermint/config"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 )
 
 func TestInsertKeyJSON(t *testing.T) {
@@ -39,3 +46,59 @@ func TestInsertKeyJSON(t *testing.T) {
 
 	require.Equal(t, bar, resBar, "appended: %v", appended)
 }
+
+func TestWaitForQuitSignals(t *testing.T) {
+	// keep the signals sent before WaitForQuitSignals listens from killing the test
+	guard := make(chan os.Signal, 1)
+	signal.Notify(guard, syscall.SIGINT, syscall.SIGTERM)
+
+	sigCh := make(chan os.Signal)
+	go func() { sigCh <- WaitForQuitSignals() }()
+
+	var sig os.Signal
+	for sig == nil {
+		require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGINT))
+
+		select {
+		case sig = <-sigCh:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	require.Equal(t, syscall.SIGINT, sig)
+	signal.Stop(guard)
+
+	// the signals received during the cleanup, such as the SIGTERM sent along
+	// with the SIGINT of a halt, do not kill the process
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+	time.Sleep(100 * time.Millisecond)
+}
+
+func TestNewLogger(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String(flagLogLevel, "", "")
+	cmd.Flags().String(flagLogFormat, "", "")
+
+	tmConfig := cfg.DefaultConfig()
+	appConfig := serverconfig.DefaultConfig()
+
+	_, err := newLogger(cmd, tmConfig, appConfig)
+	require.NoError(t, err)
+
+	appConfig.Log.Level = "main:info,x/bank:debug,*:error"
+	appConfig.Log.Format = serverconfig.LogFormatJSON
+	_, err = newLogger(cmd, tmConfig, appConfig)
+	require.NoError(t, err)
+
+	appConfig.Log.Level = "x/bank:unknown"
+	_, err = newLogger(cmd, tmConfig, appConfig)
+	require.Error(t, err)
+
+	// the flag takes precedence over the application configuration
+	require.NoError(t, cmd.Flags().Set(flagLogLevel, "info"))
+	_, err = newLogger(cmd, tmConfig, appConfig)
+	require.NoError(t, err)
+
+	appConfig.Log.Format = "xml"
+	_, err = newLogger(cmd, tmConfig, appConfig)
+	require.Error(t, err)
+}
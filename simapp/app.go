@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/gorilla/mux"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -12,6 +13,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,28 +22,47 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
 	transfer "github.com/cosmos/cosmos-sdk/x/ibc-transfer"
 	ibcclient "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
 	port "github.com/cosmos/cosmos-sdk/x/ibc/05-port"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+	"github.com/cosmos/cosmos-sdk/x/wasm"
 )
 
-const appName = "SimApp"
+const (
+	appName = "SimApp"
+
+	// UpgradeDenomOwners is the name of the upgrade indexing the holders of
+	// every denomination of the balances set before the x/bank denom owners
+	// index existed.
+	UpgradeDenomOwners = "denom-owners"
+
+	// UpgradeTransferEscrows is the name of the upgrade moving the funds
+	// escrowed by x/ibc-transfer to the escrow addresses which separate the
+	// port and channel identifiers.
+	UpgradeTransferEscrows = "transfer-escrows"
+)
 
 var (
 	// DefaultCLIHome default home directories for the application CLI
@@ -70,11 +92,23 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		epochs.AppModuleBasic{},
+		scheduler.AppModuleBasic{},
+		nft.AppModuleBasic{},
+		group.AppModuleBasic{},
+		circuit.AppModuleBasic{},
+		wasm.AppModuleBasic{},
 	)
 
+	// WasmVM is the VM running the contracts of the wasm module. SimApp ships
+	// without a VM, so no code can be stored unless it is replaced, e.g. by a
+	// mock in tests, before the app is created.
+	WasmVM wasm.VM = wasm.NoVM{}
+
 	// module account permissions
 	maccPerms = map[string][]string{
 		auth.FeeCollectorName:     nil,
+		auth.TipCollectorName:     nil,
 		distr.ModuleName:          nil,
 		mint.ModuleName:           {auth.Minter},
 		staking.BondedPoolName:    {auth.Burner, auth.Staking},
@@ -124,6 +158,12 @@ type SimApp struct {
 	IBCKeeper        *ibc.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper   evidence.Keeper
 	TransferKeeper   transfer.Keeper
+	EpochsKeeper     epochs.Keeper
+	SchedulerKeeper  scheduler.Keeper
+	NFTKeeper        nft.Keeper
+	GroupKeeper      group.Keeper
+	CircuitKeeper    circuit.Keeper
+	WasmKeeper       wasm.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capability.ScopedKeeper
@@ -149,14 +189,11 @@ func NewSimApp(
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
-	keys := sdk.NewKVStoreKeys(
-		auth.StoreKey, bank.StoreKey, staking.StoreKey,
-		mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, ibc.StoreKey, upgrade.StoreKey,
-		evidence.StoreKey, transfer.StoreKey, capability.StoreKey,
-	)
-	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
-	memKeys := sdk.NewMemoryStoreKeys(capability.MemStoreKey)
+	// the modules declare the stores they use
+	storeKeys := ModuleBasics.StoreKeys()
+	keys := storeKeys.KVStoreKeys()
+	tkeys := storeKeys.TransientStoreKeys()
+	memKeys := storeKeys.MemoryStoreKeys()
 
 	app := &SimApp{
 		BaseApp:        bApp,
@@ -213,13 +250,26 @@ func NewSimApp(
 		app.subspaces[crisis.ModuleName], invCheckPeriod, app.BankKeeper, auth.FeeCollectorName,
 	)
 	app.UpgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], appCodec, homePath)
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeDenomOwners, func(ctx sdk.Context, _ upgrade.Plan) {
+		app.BankKeeper.IndexDenomOwners(ctx)
+	})
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeTransferEscrows, func(ctx sdk.Context, _ upgrade.Plan) {
+		if err := app.TransferKeeper.MigrateEscrowAccounts(ctx); err != nil {
+			panic(err)
+		}
+	})
+
+	// the keepers executing the messages of other modules dispatch them
+	// through the application router
+	msgRouter := baseapp.NewMsgRouterService(app.Router())
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(gov.ExecRouterKey, gov.NewExecProposalHandler(msgRouter))
 	app.GovKeeper = gov.NewKeeper(
 		appCodec, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -261,6 +311,25 @@ func NewSimApp(
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.EpochsKeeper = epochs.NewKeeper(app.cdc, keys[epochs.StoreKey])
+
+	// modules scheduling callbacks register their routes on the scheduler router
+	app.SchedulerKeeper = scheduler.NewKeeper(app.cdc, keys[scheduler.StoreKey])
+	app.SchedulerKeeper.SetRouter(scheduler.NewRouter())
+
+	app.NFTKeeper = nft.NewKeeper(app.cdc, keys[nft.StoreKey])
+
+	// the group keeper routes the messages of the accepted proposals with the
+	// app router, and stores them with the app codec
+	app.GroupKeeper = group.NewKeeper(app.cdc, keys[group.StoreKey], app.AccountKeeper, msgRouter)
+
+	app.CircuitKeeper = circuit.NewKeeper(app.cdc, keys[circuit.StoreKey])
+
+	// contracts dispatch their messages and queries through the app routers
+	app.WasmKeeper = wasm.NewKeeper(
+		app.cdc, keys[wasm.StoreKey], app.AccountKeeper, app.BankKeeper, WasmVM, msgRouter, app.QueryRouter(),
+	)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -279,17 +348,26 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
+		epochs.NewAppModule(app.EpochsKeeper),
+		scheduler.NewAppModule(app.SchedulerKeeper),
+		nft.NewAppModule(app.NFTKeeper),
+		group.NewAppModule(app.GroupKeeper),
+		circuit.NewAppModule(app.CircuitKeeper),
+		wasm.NewAppModule(app.WasmKeeper),
 	)
 
+	// The upgrade module applies upgrades before any other module logic runs.
+	app.mm.SetOrderPreBlockers(upgrade.ModuleName)
+
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
-		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
-		evidence.ModuleName, staking.ModuleName, ibc.ModuleName,
+		epochs.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
+		evidence.ModuleName, staking.ModuleName, ibc.ModuleName, auth.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, scheduler.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -300,6 +378,8 @@ func NewSimApp(
 		capability.ModuleName, auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, crisis.ModuleName,
 		ibc.ModuleName, genutil.ModuleName, evidence.ModuleName, transfer.ModuleName,
+		epochs.ModuleName, scheduler.ModuleName, nft.ModuleName, group.ModuleName,
+		circuit.ModuleName, wasm.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -327,18 +407,24 @@ func NewSimApp(
 	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
-	app.MountKVStores(keys)
-	app.MountTransientStores(tkeys)
-	app.MountMemoryStores(memKeys)
+	app.MountStores(storeKeys.Keys()...)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
+	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer,
-		),
+
+	// the circuit breaker rejects the transactions with disabled messages
+	// before any other ante decorator is run
+	anteHandler := ante.NewAnteHandler(
+		app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer,
+		auth.DefaultSignModeHandler(),
 	)
+	circuitBreaker := circuit.NewCircuitBreakerDecorator(app.CircuitKeeper)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return circuitBreaker.AnteHandle(ctx, tx, simulate, anteHandler)
+	})
+
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -376,6 +462,11 @@ func MakeCodecs() (*std.Codec, *codec.Codec) {
 // Name returns the name of the App
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
+// PreBlocker application updates every pre block
+func (app *SimApp) PreBlocker(ctx sdk.Context, req abci.RequestBeginBlock) (sdk.ResponsePreBlock, error) {
+	return app.mm.PreBlock(ctx, req)
+}
+
 // BeginBlocker application updates every begin block
 func (app *SimApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -467,6 +558,16 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// RegisterAPIRoutes registers all application module routes with the provided
+// API server router.
+func (app *SimApp) RegisterAPIRoutes(r *mux.Router, cliCtx context.CLIContext) {
+	cliCtx = cliCtx.WithCodec(app.cdc).WithJSONMarshaler(app.appCodec)
+
+	rpc.RegisterRPCRoutes(cliCtx, r)
+	authrest.RegisterTxRoutes(cliCtx, r)
+	ModuleBasics.RegisterRESTRoutes(cliCtx, r)
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
@@ -1,6 +1,8 @@
 package simapp
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -32,8 +34,28 @@ func TestSimAppExport(t *testing.T) {
 
 	// Making a new app object with the db, so that initchain hasn't been called
 	app2 := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
-	_, _, _, err = app2.ExportAppStateAndValidators(false, []string{})
+	_, _, _, err = app2.ExportAppStateAndValidators(false, []string{}, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
+
+	// the streamed export holds the same modules
+	appState, _, _, err := app2.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, _, err = app2.ExportAppStateAndValidatorsTo(&buf, false, []string{}, []string{})
+	require.NoError(t, err)
+
+	var genState, streamedGenState GenesisState
+	require.NoError(t, json.Unmarshal(appState, &genState))
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &streamedGenState))
+	require.Equal(t, len(genState), len(streamedGenState))
+	for moduleName := range genState {
+		require.Contains(t, streamedGenState, moduleName)
+	}
+
+	// the streamed state can be imported
+	app3 := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0)
+	app3.InitChain(abci.RequestInitChain{AppStateBytes: buf.Bytes()})
 }
 
 // ensure that black listed addresses are properly set in bank keeper
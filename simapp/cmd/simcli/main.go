@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/lcd"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
@@ -66,7 +67,8 @@ func main() {
 		queryCmd(cdc),
 		txCmd(cdc),
 		flags.LineBreak,
-		lcd.ServeCommand(cdc, registerRoutes),
+		rosetta.RegisterFlags(lcd.ServeCommand(cdc, registerRoutes)),
+		rosetta.ServeCommand(cdc, appCodec),
 		flags.LineBreak,
 		keys.Commands(),
 		flags.LineBreak,
@@ -150,6 +152,12 @@ func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authrest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
 	simapp.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
+	rs.RegisterSwaggerSpecs(simapp.ModuleBasics.SwaggerSpecs())
+
+	if viper.GetBool(rosetta.FlagRosetta) {
+		cliCtx := rosetta.NewCLIContext(rs.CliCtx, cdc, appCodec)
+		rosetta.NewServer(cliCtx, rosetta.ConfigFromFlags()).RegisterRoutes(rs.Mux)
+	}
 }
 
 func initConfig(cmd *cobra.Command) error {
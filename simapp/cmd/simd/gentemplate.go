@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+const flagOutput = "output"
+
+// NetworkTemplate specifies the genesis file of a network. The same template
+// always produces the same genesis file, byte for byte.
+type NetworkTemplate struct {
+	ChainID     string    `yaml:"chain_id"`
+	GenesisTime time.Time `yaml:"genesis_time"`
+	Accounts    []struct {
+		Address string `yaml:"address"`
+		Coins   string `yaml:"coins"`
+	} `yaml:"accounts"`
+	// GenTxs are the paths of the genesis transactions of the validators,
+	// relative to the template file.
+	GenTxs []string `yaml:"gentxs"`
+	// AppState holds the values to set in the default genesis state of the
+	// modules, e.g. their parameters, indexed by module name.
+	AppState map[string]interface{} `yaml:"app_state"`
+}
+
+// GenesisFromTemplateCmd returns the genesis-from-template cobra Command.
+func GenesisFromTemplateCmd(
+	ctx *server.Context, depCdc *codec.Codec, cdc *std.Codec, mbm module.BasicManager, defaultNodeHome string,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "genesis-from-template [template-file]",
+		Short: "Generate a deterministic genesis.json from a network template",
+		Long: `Generate a genesis file from a YAML network template holding the chain ID, the
+genesis time, the genesis accounts and their balances, the genesis transactions of the
+validators, and the values to set in the default genesis state of the modules, written
+as in the genesis JSON (e.g. 64-bit integers and durations are quoted strings). The
+genesis file is canonical, so that everyone generating it from the same template gets
+the same file, byte for byte. Its SHA256 hash is printed to compare it.
+
+Example template:
+
+chain_id: testchain
+genesis_time: 2020-07-01T12:00:00Z
+accounts:
+  - address: cosmos1...
+    coins: 1000000000stake
+gentxs:
+  - gentxs/gentx-validator1.json
+app_state:
+  staking:
+    params:
+      bond_denom: stake
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var tmpl NetworkTemplate
+			if err := yaml.UnmarshalStrict(bz, &tmpl); err != nil {
+				return fmt.Errorf("failed to parse network template: %w", err)
+			}
+
+			genDoc, err := genesisFromTemplate(depCdc, cdc, mbm, tmpl, filepath.Dir(args[0]))
+			if err != nil {
+				return err
+			}
+
+			genFile := viper.GetString(flagOutput)
+			if genFile == "" {
+				genFile = config.GenesisFile()
+			}
+
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			genesis, err := ioutil.ReadFile(genFile)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%X  %s\n", sha256.Sum256(genesis), genFile)
+			return err
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagOutput, "", "Genesis file to write, defaults to the genesis file of the node")
+
+	return cmd
+}
+
+// genesisFromTemplate builds the genesis document specified by the given
+// template. The paths of the genesis transactions are relative to baseDir.
+func genesisFromTemplate(
+	depCdc *codec.Codec, cdc *std.Codec, mbm module.BasicManager, tmpl NetworkTemplate, baseDir string,
+) (*tmtypes.GenesisDoc, error) {
+
+	if tmpl.ChainID == "" {
+		return nil, fmt.Errorf("the network template must set the chain ID")
+	}
+
+	// the genesis time is completed with the current time if it is not set,
+	// which would not be reproducible
+	if tmpl.GenesisTime.IsZero() {
+		return nil, fmt.Errorf("the network template must set the genesis time")
+	}
+
+	appState := mbm.DefaultGenesis(depCdc)
+
+	// the modules are updated in a fixed order to report the same errors
+	moduleNames := make([]string, 0, len(tmpl.AppState))
+	for moduleName := range tmpl.AppState {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		state, err := mergeTemplateState(appState[moduleName], tmpl.AppState[moduleName])
+		if err != nil {
+			return nil, fmt.Errorf("failed to set %s genesis state: %w", moduleName, err)
+		}
+
+		appState[moduleName] = state
+	}
+
+	if err := addTemplateAccounts(depCdc, cdc, appState, tmpl); err != nil {
+		return nil, err
+	}
+
+	genTxs := make([]auth.StdTx, len(tmpl.GenTxs))
+	for i, path := range tmpl.GenTxs {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := depCdc.UnmarshalJSON(bz, &genTxs[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode genesis transaction %s: %w", path, err)
+		}
+	}
+
+	appState, err := genutil.SetGenTxsInAppGenesisState(depCdc, appState, genTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mbm.ValidateGenesis(depCdc, appState); err != nil {
+		return nil, fmt.Errorf("invalid genesis state: %w", err)
+	}
+
+	appStateJSON, err := depCdc.MarshalJSON(appState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmtypes.GenesisDoc{
+		GenesisTime: tmpl.GenesisTime.UTC(),
+		ChainID:     tmpl.ChainID,
+		AppState:    sdk.MustSortJSON(appStateJSON),
+	}, nil
+}
+
+// addTemplateAccounts adds the accounts of the template and their balances to
+// the auth and bank genesis states, sorted by address.
+func addTemplateAccounts(
+	depCdc *codec.Codec, cdc *std.Codec, appState map[string]json.RawMessage, tmpl NetworkTemplate,
+) error {
+
+	authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState := bank.GetGenesisStateFromAppState(depCdc, appState)
+
+	for _, acc := range tmpl.Accounts {
+		addr, err := sdk.AccAddressFromBech32(acc.Address)
+		if err != nil {
+			return err
+		}
+
+		if authGenState.Accounts.Contains(addr) {
+			return fmt.Errorf("duplicate genesis account %s", addr)
+		}
+
+		coins, err := sdk.ParseCoins(acc.Coins)
+		if err != nil {
+			return fmt.Errorf("failed to parse coins of %s: %w", addr, err)
+		}
+
+		authGenState.Accounts = append(authGenState.Accounts, auth.NewBaseAccount(addr, nil, 0, 0))
+		bankGenState.Balances = append(bankGenState.Balances, bank.Balance{Address: addr, Coins: coins.Sort()})
+	}
+
+	sort.SliceStable(authGenState.Accounts, func(i, j int) bool {
+		return bytes.Compare(authGenState.Accounts[i].GetAddress(), authGenState.Accounts[j].GetAddress()) < 0
+	})
+	bankGenState.Balances = bank.SanitizeGenesisBalances(bankGenState.Balances)
+
+	authGenStateBz, err := cdc.MarshalJSON(authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	appState[auth.ModuleName] = authGenStateBz
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	appState[bank.ModuleName] = bankGenStateBz
+
+	return nil
+}
+
+// mergeTemplateState sets the values of the template in the given genesis
+// state of a module. Objects are merged key by key, other values replace the
+// ones of the genesis state.
+func mergeTemplateState(state json.RawMessage, values interface{}) (json.RawMessage, error) {
+	if state == nil {
+		return nil, fmt.Errorf("unknown module")
+	}
+
+	var current interface{}
+	if err := json.Unmarshal(state, &current); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergeTemplateValue(current, values))
+}
+
+func mergeTemplateValue(current, value interface{}) interface{} {
+	values, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return templateJSONValue(value)
+	}
+
+	currentObj, ok := current.(map[string]interface{})
+	if !ok {
+		return templateJSONValue(value)
+	}
+
+	for k, v := range values {
+		key := fmt.Sprint(k)
+		currentObj[key] = mergeTemplateValue(currentObj[key], v)
+	}
+
+	return currentObj
+}
+
+// templateJSONValue converts a value decoded from YAML into a value that can
+// be encoded to JSON, whose objects must have string keys.
+func templateJSONValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			obj[fmt.Sprint(k)] = templateJSONValue(v)
+		}
+
+		return obj
+
+	case []interface{}:
+		arr := make([]interface{}, len(value))
+		for i, v := range value {
+			arr[i] = templateJSONValue(v)
+		}
+
+		return arr
+
+	default:
+		return value
+	}
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"encoding/json"
 	"io"
 
 	"github.com/spf13/cobra"
@@ -54,7 +53,10 @@ func main() {
 			bank.GenesisBalancesIterator{}, simapp.DefaultNodeHome, simapp.DefaultCLIHome,
 		),
 		genutilcli.ValidateGenesisCmd(ctx, cdc, simapp.ModuleBasics),
+		genutilcli.GenesisHashCmd(ctx),
 		AddGenesisAccountCmd(ctx, cdc, appCodec, simapp.DefaultNodeHome, simapp.DefaultCLIHome),
+		GenesisFromTemplateCmd(ctx, cdc, appCodec, simapp.ModuleBasics, simapp.DefaultNodeHome),
+		TestnetCmd(ctx, cdc, appCodec, simapp.ModuleBasics, bank.GenesisBalancesIterator{}),
 		flags.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(cdc))
 
@@ -82,6 +84,11 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		skipUpgradeHeights[int64(h)] = true
 	}
 
+	abciListeners, err := baseapp.LoadABCIListeners(viper.GetStringSlice("streaming.abci-listeners"))
+	if err != nil {
+		panic(err)
+	}
+
 	return simapp.NewSimApp(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		viper.GetString(flags.FlagHome), invCheckPeriod,
@@ -90,22 +97,27 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		baseapp.SetHaltHeight(viper.GetUint64(server.FlagHaltHeight)),
 		baseapp.SetHaltTime(viper.GetUint64(server.FlagHaltTime)),
 		baseapp.SetInterBlockCache(cache),
+		baseapp.SetIAVLFastIndex(viper.GetBool(server.FlagIAVLFastIndex)),
+		baseapp.SetQueryGasLimit(viper.GetUint64(server.FlagQueryGasLimit)),
+		baseapp.SetQueryMaxResultBytes(viper.GetUint64(server.FlagQueryMaxResultBytes)),
+		baseapp.SetABCIListeners(abciListeners...),
 	)
 }
 
 func exportAppStateAndTMValidators(
-	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
-) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
+	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string,
+	modulesToExport []string, appState io.Writer,
+) ([]tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
 
 	var simApp *simapp.SimApp
 	if height != -1 {
 		simApp = simapp.NewSimApp(logger, db, traceStore, false, map[int64]bool{}, "", uint(1))
 		err := simApp.LoadHeight(height)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, err
 		}
 	} else {
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, "", uint(1))
 	}
-	return simApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	return simApp.ExportAppStateAndValidatorsTo(appState, forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
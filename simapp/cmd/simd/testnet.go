@@ -0,0 +1,543 @@
+package main
+
+// DONTCOVER
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+const (
+	flagNodeDirPrefix     = "node-dir-prefix"
+	flagNumValidators     = "v"
+	flagOutputDir         = "output-dir"
+	flagNodeDaemonHome    = "node-daemon-home"
+	flagNodeCLIHome       = "node-cli-home"
+	flagStartingIPAddress = "starting-ip-address"
+	flagPortIncrement     = "port-increment"
+	flagDockerImage       = "docker-image"
+	flagDockerSubnet      = "docker-subnet"
+)
+
+// default ports of the node services, shifted by the port increment for each
+// node when all nodes share the same host
+const (
+	defaultP2PPort   = 26656
+	defaultRPCPort   = 26657
+	defaultABCIPort  = 26658
+	defaultProfPort  = 6060
+	defaultAPIPort   = 1317
+	defaultGRPCPort  = 9090
+	dockerComposeYML = "docker-compose.yml"
+
+	// dockerHostPortIncrement shifts the host ports the ports of each node
+	// container are published on, so that they do not collide on the host
+	dockerHostPortIncrement = 100
+)
+
+// testnetNode holds the settings of a node generated by the testnet command.
+type testnetNode struct {
+	Name     string
+	IP       string
+	Home     string
+	P2PPort  int
+	RPCPort  int
+	APIPort  int
+	GRPCPort int
+}
+
+// TestnetCmd initializes all files for tendermint testnet and application
+func TestnetCmd(ctx *server.Context, depCdc *codec.Codec, cdc *std.Codec,
+	mbm module.BasicManager, genBalIterator genutiltypes.GenesisBalancesIterator,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize files for a simapp testnet",
+		Long: `testnet will create "v" number of directories and populate each with
+necessary files (private validator, genesis, config, etc.).
+
+Note, strict routability for addresses is turned off in the config file.
+
+By default, every node is given its own IP address, starting from --starting-ip-address,
+and the default ports, which fits networks of containers. A docker-compose file starting
+such a network is written to the output directory when --docker-image is provided, the
+ports of the node containers being published on the host shifted by 100 for each node
+(26656, 26756, ... for the P2P ports). Alternatively, --port-increment shifts the ports of
+each node so that all nodes may run on the same host, without docker.
+
+Example:
+	simd testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	simd testnet --v 4 --output-dir ./output --starting-ip-address 127.0.0.1 --port-increment 100
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+
+			outputDir := viper.GetString(flagOutputDir)
+			chainID := viper.GetString(flags.FlagChainID)
+			minGasPrices := viper.GetString(server.FlagMinGasPrices)
+			nodeDirPrefix := viper.GetString(flagNodeDirPrefix)
+			nodeDaemonHome := viper.GetString(flagNodeDaemonHome)
+			nodeCLIHome := viper.GetString(flagNodeCLIHome)
+			startingIPAddress := viper.GetString(flagStartingIPAddress)
+			portIncrement := viper.GetInt(flagPortIncrement)
+			numValidators := viper.GetInt(flagNumValidators)
+			image := viper.GetString(flagDockerImage)
+
+			if image != "" && portIncrement != 0 {
+				return fmt.Errorf("--%s cannot be used with --%s: every docker node has its own IP address", flagPortIncrement, flagDockerImage)
+			}
+
+			nodes, err := InitTestnet(
+				cmd, config, depCdc, cdc, mbm, genBalIterator, outputDir, chainID, minGasPrices,
+				nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, portIncrement, numValidators,
+			)
+			if err != nil {
+				return err
+			}
+
+			if image != "" {
+				if err := writeDockerCompose(outputDir, image, viper.GetString(flagDockerSubnet), nodes); err != nil {
+					return err
+				}
+			}
+
+			cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./mytestnet", "Directory to store initialization data for the testnet")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, "simd", "Home directory of the node's daemon configuration")
+	cmd.Flags().String(flagNodeCLIHome, "simcli", "Home directory of the node's cli configuration")
+	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1", "Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
+	cmd.Flags().Int(flagPortIncrement, 0, "Shift the ports of each node by this increment to run all nodes on the same host; 0 assigns the default ports to every node")
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom), "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagDockerImage, "", "Write a docker-compose file running every node with the given image")
+	cmd.Flags().String(flagDockerSubnet, "192.168.0.0/16", "Subnet of the docker-compose network, which must contain the node IP addresses")
+
+	return cmd
+}
+
+// InitTestnet initializes the home directories of the validators of a testnet
+// along with a shared genesis file including their genesis transactions, and
+// returns the settings of the nodes generated.
+func InitTestnet(
+	cmd *cobra.Command, config *tmconfig.Config, depCdc *codec.Codec, cdc *std.Codec,
+	mbm module.BasicManager, genBalIterator genutiltypes.GenesisBalancesIterator,
+	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
+	nodeCLIHome, startingIPAddress string, portIncrement, numValidators int,
+) ([]testnetNode, error) {
+
+	if chainID == "" {
+		chainID = "chain-" + tmrand.Str(6)
+	}
+
+	nodes := make([]testnetNode, numValidators)
+	monikers := make([]string, numValidators)
+	nodeIDs := make([]string, numValidators)
+	valPubKeys := make([]crypto.PubKey, numValidators)
+
+	simappConfig := srvconfig.DefaultConfig()
+	simappConfig.MinGasPrices = minGasPrices
+
+	var (
+		genAccounts []auth.GenesisAccount
+		genBalances []bank.Balance
+		genFiles    []string
+	)
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+
+	// generate private keys, node IDs, and initial transactions
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		clientDir := filepath.Join(outputDir, nodeDirName, nodeCLIHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+
+		config.SetRoot(nodeDir)
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), 0755); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		if err := os.MkdirAll(clientDir, 0755); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		ip := startingIPAddress
+		if portIncrement == 0 {
+			var err error
+			if ip, err = calculateIP(startingIPAddress, i); err != nil {
+				_ = os.RemoveAll(outputDir)
+				return nil, err
+			}
+		}
+
+		offset := i * portIncrement
+		nodes[i] = testnetNode{
+			Name:     nodeDirName,
+			IP:       ip,
+			Home:     nodeDaemonHome,
+			P2PPort:  defaultP2PPort + offset,
+			RPCPort:  defaultRPCPort + offset,
+			APIPort:  defaultAPIPort + offset,
+			GRPCPort: defaultGRPCPort + offset,
+		}
+
+		config.Moniker = nodeDirName
+		config.P2P.AddrBookStrict = false
+		config.P2P.AllowDuplicateIP = true
+		config.P2P.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", nodes[i].P2PPort)
+		config.RPC.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", nodes[i].RPCPort)
+		config.ProxyApp = fmt.Sprintf("tcp://127.0.0.1:%d", defaultABCIPort+offset)
+		config.ProfListenAddress = fmt.Sprintf("localhost:%d", defaultProfPort+offset)
+		monikers[i] = nodeDirName
+
+		var err error
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		memo := fmt.Sprintf("%s@%s:%d", nodeIDs[i], ip, nodes[i].P2PPort)
+		genFiles = append(genFiles, config.GenesisFile())
+
+		kb, err := keyring.New(
+			sdk.KeyringServiceName(),
+			viper.GetString(flags.FlagKeyringBackend),
+			clientDir,
+			inBuf,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, secret, err := server.GenerateSaveCoinKey(kb, nodeDirName, clientkeys.DefaultKeyPass, true)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		info := map[string]string{"secret": secret}
+
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return nil, err
+		}
+
+		// save private key seed words
+		if err := writeFile(fmt.Sprintf("%v.json", "key_seed"), clientDir, cliPrint); err != nil {
+			return nil, err
+		}
+
+		accTokens := sdk.TokensFromConsensusPower(1000)
+		accStakingTokens := sdk.TokensFromConsensusPower(500)
+		coins := sdk.Coins{
+			sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), accTokens),
+			sdk.NewCoin(sdk.DefaultBondDenom, accStakingTokens),
+		}
+
+		genBalances = append(genBalances, bank.Balance{Address: addr, Coins: coins.Sort()})
+		genAccounts = append(genAccounts, auth.NewBaseAccount(addr, nil, 0, 0))
+
+		valTokens := sdk.TokensFromConsensusPower(100)
+		msg := staking.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			valPubKeys[i],
+			sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+			staking.NewDescription(nodeDirName, "", "", "", ""),
+			staking.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			sdk.OneInt(),
+		)
+
+		tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, []auth.StdSignature{}, memo)
+		txBldr, err := auth.NewTxBuilderFromCLI(inBuf)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+		txBldr = txBldr.WithChainID(chainID).WithMemo(memo).WithKeybase(kb)
+
+		signedTx, err := txBldr.SignStdTx(nodeDirName, tx, false)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		txBytes, err := depCdc.MarshalJSON(signedTx)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		// gather gentxs folder
+		if err := writeFile(fmt.Sprintf("%v.json", nodeDirName), gentxsDir, txBytes); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return nil, err
+		}
+
+		simappConfig.API.Address = fmt.Sprintf("tcp://0.0.0.0:%d", nodes[i].APIPort)
+		simappConfig.GRPC.Address = fmt.Sprintf("0.0.0.0:%d", nodes[i].GRPCPort)
+		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), simappConfig)
+	}
+
+	if err := initGenFiles(depCdc, cdc, mbm, chainID, genAccounts, genBalances, genFiles, numValidators); err != nil {
+		return nil, err
+	}
+
+	err := collectGenFiles(
+		depCdc, config, chainID, monikers, nodeIDs, valPubKeys, numValidators,
+		outputDir, nodeDirPrefix, nodeDaemonHome, genBalIterator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func initGenFiles(
+	depCdc *codec.Codec, cdc *std.Codec, mbm module.BasicManager, chainID string,
+	genAccounts []auth.GenesisAccount, genBalances []bank.Balance,
+	genFiles []string, numValidators int,
+) error {
+
+	appGenState := mbm.DefaultGenesis(cdc)
+
+	// set the accounts in the genesis state
+	authGenState := auth.GetGenesisStateFromAppState(cdc, appGenState)
+	authGenState.Accounts = genAccounts
+
+	authGenStateBz, err := cdc.MarshalJSON(authGenState)
+	if err != nil {
+		return err
+	}
+	appGenState[auth.ModuleName] = authGenStateBz
+
+	// set the balances in the genesis state
+	bankGenState := bank.GetGenesisStateFromAppState(depCdc, appGenState)
+	bankGenState.Balances = genBalances
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return err
+	}
+	appGenState[bank.ModuleName] = bankGenStateBz
+
+	appGenStateJSON, err := codec.MarshalJSONIndent(depCdc, appGenState)
+	if err != nil {
+		return err
+	}
+
+	genDoc := types.GenesisDoc{
+		ChainID:    chainID,
+		AppState:   appGenStateJSON,
+		Validators: nil,
+	}
+
+	// generate empty genesis files for each validator and save
+	for i := 0; i < numValidators; i++ {
+		if err := genDoc.SaveAs(genFiles[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func collectGenFiles(
+	cdc *codec.Codec, config *tmconfig.Config, chainID string,
+	monikers, nodeIDs []string, valPubKeys []crypto.PubKey,
+	numValidators int, outputDir, nodeDirPrefix, nodeDaemonHome string,
+	genBalIterator genutiltypes.GenesisBalancesIterator,
+) error {
+
+	var appState json.RawMessage
+	genTime := tmtime.Now()
+
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+		moniker := monikers[i]
+		config.Moniker = nodeDirName
+
+		config.SetRoot(nodeDir)
+
+		nodeID, valPubKey := nodeIDs[i], valPubKeys[i]
+		initCfg := genutil.NewInitConfig(chainID, gentxsDir, moniker, nodeID, valPubKey)
+
+		genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(cdc, config, initCfg, *genDoc, genBalIterator)
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		genFile := config.GenesisFile()
+
+		// overwrite each validator's genesis file to have a canonical genesis time
+		if err := genutil.ExportGenesisFileWithTime(genFile, chainID, nil, appState, genTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dockerComposeNode holds the settings of a node container of the
+// docker-compose file.
+type dockerComposeNode struct {
+	testnetNode
+
+	HostPortOffset int
+}
+
+// HostPort returns the host port a port of the node container is published on.
+func (n dockerComposeNode) HostPort(port int) int {
+	return port + n.HostPortOffset
+}
+
+// dockerComposeTemplate renders a docker-compose file running every node of
+// the testnet in its own container with a static IP address, publishing its
+// ports on distinct host ports.
+var dockerComposeTemplate = template.Must(template.New("docker-compose").Parse(`version: '3'
+
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    container_name: {{ .Name }}
+    image: "{{ $.Image }}"
+    command: ["start", "--home", "/data/{{ .Name }}/{{ .Home }}"]
+    ports:
+      - "{{ .HostPort .P2PPort }}:{{ .P2PPort }}"
+      - "{{ .HostPort .RPCPort }}:{{ .RPCPort }}"
+      - "{{ .HostPort .APIPort }}:{{ .APIPort }}"
+      - "{{ .HostPort .GRPCPort }}:{{ .GRPCPort }}"
+    volumes:
+      - ./{{ .Name }}:/data/{{ .Name }}:Z
+    networks:
+      localnet:
+        ipv4_address: {{ .IP }}
+{{ end }}
+networks:
+  localnet:
+    driver: bridge
+    ipam:
+      driver: default
+      config:
+        - subnet: {{ .Subnet }}
+`))
+
+// writeDockerCompose writes the docker-compose file of the testnet nodes to
+// the output directory. The nodes must have distinct IP addresses within the
+// subnet.
+func writeDockerCompose(outputDir, image, subnet string, nodes []testnetNode) error {
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return fmt.Errorf("invalid docker subnet %s: %w", subnet, err)
+	}
+
+	composeNodes := make([]dockerComposeNode, len(nodes))
+	ips := make(map[string]bool, len(nodes))
+	for i, node := range nodes {
+		if ip := net.ParseIP(node.IP); ip == nil || !ipNet.Contains(ip) {
+			return fmt.Errorf("IP address %s of %s is not in the docker subnet %s", node.IP, node.Name, subnet)
+		}
+
+		if ips[node.IP] {
+			return fmt.Errorf("IP address %s of %s is already used by another node", node.IP, node.Name)
+		}
+		ips[node.IP] = true
+
+		composeNodes[i] = dockerComposeNode{testnetNode: node, HostPortOffset: i * dockerHostPortIncrement}
+	}
+
+	f, err := os.Create(filepath.Join(outputDir, dockerComposeYML))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return dockerComposeTemplate.Execute(f, struct {
+		Image  string
+		Subnet string
+		Nodes  []dockerComposeNode
+	}{image, subnet, composeNodes})
+}
+
+func calculateIP(ip string, i int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", fmt.Errorf("%v: non ipv4 address", ip)
+	}
+
+	for j := 0; j < i; j++ {
+		ipv4[3]++
+	}
+
+	return ipv4.String(), nil
+}
+
+func writeFile(name string, dir string, contents []byte) error {
+	writePath := filepath.Join(dir)
+	file := filepath.Join(writePath, name)
+
+	err := tmos.EnsureDir(writePath, 0700)
+	if err != nil {
+		return err
+	}
+
+	err = tmos.WriteFile(file, contents, 0600)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
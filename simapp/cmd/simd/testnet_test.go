@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func testnetNodes(t *testing.T, n int) []testnetNode {
+	nodes := make([]testnetNode, n)
+	for i := range nodes {
+		ip, err := calculateIP("192.168.10.2", i)
+		require.NoError(t, err)
+
+		nodes[i] = testnetNode{
+			Name:     fmt.Sprintf("node%d", i),
+			IP:       ip,
+			Home:     "simd",
+			P2PPort:  defaultP2PPort,
+			RPCPort:  defaultRPCPort,
+			APIPort:  defaultAPIPort,
+			GRPCPort: defaultGRPCPort,
+		}
+	}
+
+	return nodes
+}
+
+func TestWriteDockerCompose(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	nodes := testnetNodes(t, 4)
+	require.NoError(t, writeDockerCompose(dir, "simd:test", "192.168.10.0/16", nodes))
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, dockerComposeYML))
+	require.NoError(t, err)
+
+	var compose struct {
+		Services map[string]struct {
+			Image    string   `yaml:"image"`
+			Ports    []string `yaml:"ports"`
+			Networks map[string]struct {
+				IPv4Address string `yaml:"ipv4_address"`
+			} `yaml:"networks"`
+		} `yaml:"services"`
+	}
+	require.NoError(t, yaml.Unmarshal(bz, &compose))
+	require.Len(t, compose.Services, len(nodes))
+
+	ips := make(map[string]bool)
+	hostPorts := make(map[string]bool)
+	for i, node := range nodes {
+		service, ok := compose.Services[node.Name]
+		require.True(t, ok, node.Name)
+		require.Equal(t, "simd:test", service.Image)
+
+		ip := service.Networks["localnet"].IPv4Address
+		require.Equal(t, node.IP, ip)
+		require.False(t, ips[ip], "IP address %s used twice", ip)
+		ips[ip] = true
+
+		require.Len(t, service.Ports, 4)
+		for _, port := range service.Ports {
+			parts := strings.Split(port, ":")
+			require.Len(t, parts, 2)
+			require.False(t, hostPorts[parts[0]], "host port %s used twice", parts[0])
+			hostPorts[parts[0]] = true
+		}
+
+		require.Equal(t, fmt.Sprintf("%d:%d", defaultP2PPort+i*dockerHostPortIncrement, defaultP2PPort), service.Ports[0])
+	}
+}
+
+func TestWriteDockerComposeInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	nodes := testnetNodes(t, 2)
+	require.Error(t, writeDockerCompose(dir, "simd:test", "invalid", nodes))
+	require.Error(t, writeDockerCompose(dir, "simd:test", "10.0.0.0/8", nodes))
+
+	nodes[1].IP = nodes[0].IP
+	require.Error(t, writeDockerCompose(dir, "simd:test", "192.168.10.0/16", nodes))
+}
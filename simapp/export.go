@@ -2,6 +2,7 @@ package simapp
 
 import (
 	"encoding/json"
+	"io"
 	"log"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -15,19 +16,24 @@ import (
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
-// file.
+// file. If modulesToExport is not empty, only the state of these modules is
+// exported.
 func (app *SimApp) ExportAppStateAndValidators(
-	forZeroHeight bool, jailWhiteList []string,
+	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
 ) (appState json.RawMessage, validators []tmtypes.GenesisValidator, cp *abci.ConsensusParams, err error) {
 
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
 
 	if forZeroHeight {
-		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	}
+
+	genState, err := app.mm.ExportGenesisForModules(ctx, app.cdc, modulesToExport)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.cdc)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
 		return nil, nil, nil, err
@@ -37,25 +43,45 @@ func (app *SimApp) ExportAppStateAndValidators(
 	return appState, validators, app.BaseApp.GetConsensusParams(ctx), nil
 }
 
+// ExportAppStateAndValidatorsTo exports the state of the application for a
+// genesis file like ExportAppStateAndValidators, but writes the application
+// state as JSON to w instead of building it in memory.
+func (app *SimApp) ExportAppStateAndValidatorsTo(
+	w io.Writer, forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
+) ([]tmtypes.GenesisValidator, *abci.ConsensusParams, error) {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	if forZeroHeight {
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	}
+
+	if err := app.mm.ExportGenesisTo(ctx, app.cdc, w, modulesToExport); err != nil {
+		return nil, nil, err
+	}
+
+	validators := staking.WriteValidators(ctx, app.StakingKeeper)
+	return validators, app.BaseApp.GetConsensusParams(ctx), nil
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
-func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) {
-	applyWhiteList := false
+func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
+	applyAllowedAddrs := false
 
-	//Check if there is a whitelist
-	if len(jailWhiteList) > 0 {
-		applyWhiteList = true
+	// check if there is an allowed address list
+	if len(jailAllowedAddrs) > 0 {
+		applyAllowedAddrs = true
 	}
 
-	whiteListMap := make(map[string]bool)
+	allowedAddrsMap := make(map[string]bool)
 
-	for _, addr := range jailWhiteList {
+	for _, addr := range jailAllowedAddrs {
 		_, err := sdk.ValAddressFromBech32(addr)
 		if err != nil {
 			log.Fatal(err)
 		}
-		whiteListMap[addr] = true
+		allowedAddrsMap[addr] = true
 	}
 
 	/* Just to be safe, assert the invariants on current state. */
@@ -140,7 +166,7 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []str
 		}
 
 		validator.UnbondingHeight = 0
-		if applyWhiteList && !whiteListMap[addr.String()] {
+		if applyAllowedAddrs && !allowedAddrsMap[addr.String()] {
 			validator.Jailed = true
 		}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,31 @@ type StoreKeysPrefixes struct {
 	Prefixes [][]byte
 }
 
+// storesDiffLog returns the decoded key/value pairs that differ between the
+// stores of two apps at their last committed height.
+func storesDiffLog(appA, appB *SimApp) (log string) {
+	ctxA := appA.NewContext(true, abci.Header{Height: appA.LastBlockHeight()})
+	ctxB := appB.NewContext(true, abci.Header{Height: appB.LastBlockHeight()})
+
+	names := make([]string, 0, len(appA.keys))
+	for name := range appA.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		kvAs, kvBs := sdk.DiffKVStores(ctxA.KVStore(appA.keys[name]), ctxB.KVStore(appB.keys[name]), nil)
+		if len(kvAs) == 0 {
+			continue
+		}
+
+		log += fmt.Sprintf("%d different key/value pairs in store %s:\n", len(kvAs), name)
+		log += GetSimulationLog(name, appA.SimulationManager().StoreDecoders, kvAs, kvBs)
+	}
+
+	return log
+}
+
 // fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
 // an IAVLStore for faster simulation speed.
 func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
@@ -120,7 +146,7 @@ func TestAppImportExport(t *testing.T) {
 
 	fmt.Printf("exporting genesis...\n")
 
-	appState, _, consensusParams, err := app.ExportAppStateAndValidators(false, []string{})
+	appState, _, consensusParams, err := app.ExportAppStateAndValidators(false, []string{}, []string{})
 	require.NoError(t, err)
 
 	fmt.Printf("importing genesis...\n")
@@ -216,7 +242,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	fmt.Printf("exporting genesis...\n")
 
-	appState, _, _, err := app.ExportAppStateAndValidators(true, []string{})
+	appState, _, _, err := app.ExportAppStateAndValidators(true, []string{}, []string{})
 	require.NoError(t, err)
 
 	fmt.Printf("importing genesis...\n")
@@ -265,6 +291,9 @@ func TestAppStateDeterminism(t *testing.T) {
 	for i := 0; i < numSeeds; i++ {
 		config.Seed = rand.Int63()
 
+		// keep the first app of each seed to decode store differences
+		var firstApp *SimApp
+
 		for j := 0; j < numTimesToRunPerSeed; j++ {
 			var logger log.Logger
 			if FlagVerboseValue {
@@ -295,10 +324,15 @@ func TestAppStateDeterminism(t *testing.T) {
 			appHash := app.LastCommitID().Hash
 			appHashList[j] = appHash
 
-			if j != 0 {
-				require.Equal(
-					t, string(appHashList[0]), string(appHashList[j]),
-					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			if j == 0 {
+				firstApp = app
+				continue
+			}
+
+			if string(appHashList[0]) != string(appHashList[j]) {
+				require.FailNow(
+					t, "non-determinism", "seed %d: %d/%d, attempt: %d/%d\n%s",
+					config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed, storesDiffLog(firstApp, app),
 				)
 			}
 		}
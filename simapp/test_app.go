@@ -0,0 +1,112 @@
+package simapp
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// DefaultTestBlockTime is the time elapsed between two blocks of a TestApp.
+const DefaultTestBlockTime = 5 * time.Second
+
+// TestAccount is a funded genesis account of a TestApp along with its private
+// key.
+type TestAccount struct {
+	PrivKey crypto.PrivKey
+	Address sdk.AccAddress
+}
+
+// TestApp is a test harness wrapping a SimApp backed by an in-memory database.
+// It holds funded test accounts, signs and delivers transactions on their
+// behalf and advances blocks. Keepers are accessible through the embedded
+// SimApp and can be inspected at the current block with Ctx.
+type TestApp struct {
+	*SimApp
+
+	Accounts []TestAccount
+	header   abci.Header
+}
+
+// NewTestApp creates a TestApp with numAccounts genesis accounts, each funded
+// with the given coins. The returned app is at the beginning of its first
+// block after genesis.
+func NewTestApp(numAccounts int, coins sdk.Coins) *TestApp {
+	accounts := make([]TestAccount, numAccounts)
+	genAccs := make([]auth.GenesisAccount, numAccounts)
+	balances := make([]bank.Balance, numAccounts)
+
+	for i := range accounts {
+		priv := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(priv.PubKey().Address())
+
+		accounts[i] = TestAccount{PrivKey: priv, Address: addr}
+		genAccs[i] = auth.NewBaseAccount(addr, priv.PubKey(), 0, 0)
+		balances[i] = bank.Balance{Address: addr, Coins: coins}
+	}
+
+	app := SetupWithGenesisAccounts(genAccs, balances...)
+
+	return &TestApp{
+		SimApp:   app,
+		Accounts: accounts,
+		header:   abci.Header{Height: app.LastBlockHeight() + 1},
+	}
+}
+
+// Header returns the header of the current block.
+func (ta *TestApp) Header() abci.Header {
+	return ta.header
+}
+
+// Ctx returns a context on the deliver state of the current block.
+func (ta *TestApp) Ctx() sdk.Context {
+	return ta.BaseApp.NewContext(false, ta.header)
+}
+
+// DeliverMsgs signs the given messages with the given account, using its
+// current account number and sequence, and delivers them in the current block.
+func (ta *TestApp) DeliverMsgs(signer TestAccount, msgs ...sdk.Msg) (*sdk.Result, error) {
+	acc := ta.AccountKeeper.GetAccount(ta.Ctx(), signer.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", signer.Address)
+	}
+
+	tx := helpers.GenTx(
+		msgs,
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		helpers.DefaultGenTxGas,
+		ta.header.ChainID,
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		signer.PrivKey,
+	)
+
+	_, res, err := ta.Deliver(tx)
+	return res, err
+}
+
+// NextBlock ends and commits the current block and begins the next one,
+// DefaultTestBlockTime later.
+func (ta *TestApp) NextBlock() {
+	ta.EndBlock(abci.RequestEndBlock{Height: ta.header.Height})
+	ta.Commit()
+
+	ta.header.Height++
+	ta.header.Time = ta.header.Time.Add(DefaultTestBlockTime)
+	ta.BeginBlock(abci.RequestBeginBlock{Header: ta.header})
+}
+
+// AdvanceBlocks calls NextBlock n times.
+func (ta *TestApp) AdvanceBlocks(n int) {
+	for i := 0; i < n; i++ {
+		ta.NextBlock()
+	}
+}
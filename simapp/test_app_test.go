@@ -0,0 +1,43 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestTestApp(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	app := NewTestApp(2, coins)
+	require.Len(t, app.Accounts, 2)
+
+	from, to := app.Accounts[0], app.Accounts[1]
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(app.Ctx(), from.Address))
+
+	// several transactions from the same signer in a single block
+	send := bank.NewMsgSend(from.Address, to.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, err := app.DeliverMsgs(from, send)
+	require.NoError(t, err)
+	_, err = app.DeliverMsgs(from, send)
+	require.NoError(t, err)
+
+	height := app.Header().Height
+	app.NextBlock()
+	require.Equal(t, height+1, app.Header().Height)
+	require.Equal(t, height, app.LastBlockHeight())
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80)), app.BankKeeper.GetAllBalances(app.Ctx(), from.Address))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 120)), app.BankKeeper.GetAllBalances(app.Ctx(), to.Address))
+	require.Equal(t, uint64(2), app.AccountKeeper.GetAccount(app.Ctx(), from.Address).GetSequence())
+
+	// insufficient funds
+	_, err = app.DeliverMsgs(to, bank.NewMsgSend(to.Address, from.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	require.Error(t, err)
+
+	app.AdvanceBlocks(3)
+	require.Equal(t, height+3, app.LastBlockHeight())
+	require.Equal(t, height+4, app.Ctx().BlockHeight())
+}
@@ -76,7 +76,7 @@ func CheckExportSimulation(
 ) error {
 	if config.ExportStatePath != "" {
 		fmt.Println("exporting app state...")
-		appState, _, _, err := app.ExportAppStateAndValidators(false, nil)
+		appState, _, _, err := app.ExportAppStateAndValidators(false, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -118,11 +118,26 @@ func GetSimulationLog(storeName string, sdr sdk.StoreDecoderRegistry, kvAs, kvBs
 
 		decoder, ok := sdr[storeName]
 		if ok {
-			log += decoder(kvAs[i], kvBs[i])
-		} else {
-			log += fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", kvAs[i].Key, kvAs[i].Value, kvBs[i].Key, kvBs[i].Value)
+			if decoded, err := decodeKVPairs(decoder, kvAs[i], kvBs[i]); err == nil {
+				log += decoded
+				continue
+			}
 		}
+
+		log += fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", kvAs[i].Key, kvAs[i].Value, kvBs[i].Key, kvBs[i].Value)
 	}
 
 	return log
 }
+
+// decodeKVPairs runs a store decoder, recovering from the panics decoders
+// raise on keys they do not know so that the raw pairs can be logged instead.
+func decodeKVPairs(decoder func(kvA, kvB tmkv.Pair) string, kvA, kvB tmkv.Pair) (decoded string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to decode key/value pair: %v", r)
+		}
+	}()
+
+	return decoder(kvA, kvB), nil
+}
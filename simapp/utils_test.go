@@ -18,6 +18,7 @@ func TestGetSimulationLog(t *testing.T) {
 
 	decoders := make(sdk.StoreDecoderRegistry)
 	decoders[auth.StoreKey] = func(kvAs, kvBs tmkv.Pair) string { return "10" }
+	decoders["PanicStore"] = func(kvAs, kvBs tmkv.Pair) string { panic("invalid key") }
 
 	tests := []struct {
 		store       string
@@ -39,6 +40,11 @@ func TestGetSimulationLog(t *testing.T) {
 			[]tmkv.Pair{{Key: []byte("key"), Value: []byte("value")}},
 			fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", []byte("key"), []byte("value"), []byte("key"), []byte("value")),
 		},
+		{
+			"PanicStore",
+			[]tmkv.Pair{{Key: []byte("key"), Value: []byte("value")}},
+			fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", []byte("key"), []byte("value"), []byte("key"), []byte("value")),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,131 @@
+package iavl
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	// fastIndexPrefix prefixes the flat copy of the latest key/value pairs. It
+	// must not collide with the prefixes of the IAVL node database.
+	fastIndexPrefix = []byte("f/")
+	// fastIndexVersionKey holds the tree version the fast index matches.
+	fastIndexVersionKey = []byte("fv")
+)
+
+// fastIndex is a flat key/value copy of the latest version of an IAVL tree,
+// letting point reads and iterations skip the tree traversal. Writes made since
+// the last commit are cached on top of the flat copy, which is updated on
+// commit. Historical versions and proofs are still served by the tree.
+type fastIndex struct {
+	db    dbm.DB
+	cache *cachekv.Store
+}
+
+// loadFastIndex loads the fast index stored in db, rebuilding it from the given
+// tree if it does not match the tree version, e.g. on first use or after an
+// interrupted commit.
+func loadFastIndex(db dbm.DB, tree *iavl.MutableTree) (*fastIndex, error) {
+	fi := &fastIndex{db: db}
+	fi.cache = cachekv.NewStore(dbadapter.Store{DB: dbm.NewPrefixDB(db, fastIndexPrefix)})
+
+	version, err := fi.version()
+	if err != nil {
+		return nil, err
+	}
+
+	if version != tree.Version() {
+		if err := fi.rebuild(tree); err != nil {
+			return nil, fmt.Errorf("failed to rebuild IAVL fast index: %w", err)
+		}
+	}
+
+	return fi, nil
+}
+
+func (fi *fastIndex) version() (int64, error) {
+	bz, err := fi.db.Get(fastIndexVersionKey)
+	if err != nil || bz == nil {
+		return -1, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func (fi *fastIndex) setVersion(batch dbm.Batch, version int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	batch.Set(fastIndexVersionKey, bz)
+}
+
+// rebuild replaces the content of the fast index with the latest version of
+// the tree.
+func (fi *fastIndex) rebuild(tree *iavl.MutableTree) error {
+	prefixDB := dbm.NewPrefixDB(fi.db, fastIndexPrefix)
+
+	// clear the previous index
+	iter, err := prefixDB.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	batch := prefixDB.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+
+	tree.Iterate(func(key, value []byte) bool {
+		batch.Set(key, value)
+		return false
+	})
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	versionBatch := fi.db.NewBatch()
+	defer versionBatch.Close()
+
+	fi.setVersion(versionBatch, tree.Version())
+	return versionBatch.Write()
+}
+
+// commit writes the changes made since the last commit to the flat copy and
+// records the new tree version.
+func (fi *fastIndex) commit(version int64) {
+	fi.cache.Write()
+
+	batch := fi.db.NewBatch()
+	defer batch.Close()
+
+	fi.setVersion(batch, version)
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+}
+
+func (fi *fastIndex) Get(key []byte) []byte { return fi.cache.Get(key) }
+func (fi *fastIndex) Has(key []byte) bool   { return fi.cache.Has(key) }
+func (fi *fastIndex) Set(key, value []byte) { fi.cache.Set(key, value) }
+func (fi *fastIndex) Delete(key []byte)     { fi.cache.Delete(key) }
+func (fi *fastIndex) Iterator(start, end []byte) types.Iterator {
+	return fi.cache.Iterator(start, end)
+}
+func (fi *fastIndex) ReverseIterator(start, end []byte) types.Iterator {
+	return fi.cache.ReverseIterator(start, end)
+}
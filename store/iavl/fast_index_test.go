@@ -0,0 +1,71 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func collectIterator(iter types.Iterator) (kvs [][2]string) {
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kvs = append(kvs, [2]string{string(iter.Key()), string(iter.Value())})
+	}
+
+	return kvs
+}
+
+func TestFastIndex(t *testing.T) {
+	db := dbm.NewMemDB()
+	_, cID := newAlohaTree(t, db)
+
+	// the index is built from the tree when first loaded
+	store, err := LoadStoreWithFastIndex(db, cID, types.PruneNothing, false)
+	require.NoError(t, err)
+	require.NotNil(t, store.(*Store).fast)
+
+	version, err := store.(*Store).fast.version()
+	require.NoError(t, err)
+	require.Equal(t, cID.Version, version)
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+
+	// uncommitted writes are served by the index
+	store.Set([]byte("hello"), []byte("adios"))
+	store.Set([]byte("hola"), []byte("mundo"))
+	store.Delete([]byte("aloha"))
+	require.Equal(t, []byte("adios"), store.Get([]byte("hello")))
+	require.False(t, store.Has([]byte("aloha")))
+
+	expected := [][2]string{{"hello", "adios"}, {"hola", "mundo"}}
+	require.Equal(t, expected, collectIterator(store.Iterator(nil, nil)))
+	require.Equal(t, [][2]string{expected[1], expected[0]}, collectIterator(store.ReverseIterator(nil, nil)))
+
+	cID = store.Commit()
+
+	// the index and the tree agree
+	treeStore, err := LoadStore(db, cID, types.PruneNothing, false)
+	require.NoError(t, err)
+	require.Equal(t, expected, collectIterator(treeStore.Iterator(nil, nil)))
+
+	// a committed index is reused when reloaded
+	store, err = LoadStoreWithFastIndex(db, cID, types.PruneNothing, false)
+	require.NoError(t, err)
+	require.Equal(t, expected, collectIterator(store.Iterator(nil, nil)))
+
+	// an index out of sync with the tree is rebuilt
+	treeStore.Set([]byte("hello"), []byte("goodbye"))
+	cID = treeStore.Commit()
+
+	store, err = LoadStoreWithFastIndex(db, cID, types.PruneNothing, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+	require.Equal(t, [][2]string{{"hello", "goodbye"}, {"hola", "mundo"}}, collectIterator(store.Iterator(nil, nil)))
+
+	version, err = store.(*Store).fast.version()
+	require.NoError(t, err)
+	require.Equal(t, cID.Version, version)
+}
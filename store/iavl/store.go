@@ -34,6 +34,10 @@ var (
 type Store struct {
 	tree    Tree
 	pruning types.PruningOptions
+
+	// fast is an optional flat index of the latest version, see
+	// LoadStoreWithFastIndex.
+	fast *fastIndex
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -85,6 +89,27 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 	}, nil
 }
 
+// LoadStoreWithFastIndex loads an IAVL Store like LoadStore, along with a flat
+// index of its latest version which serves the reads and iterations of the
+// store without traversing the tree. The index is stored in the same DB as the
+// tree and is built from the tree the first time it is loaded, or whenever it
+// is out of sync with the loaded version, which can take a while for large
+// stores. Queries and historical versions are still served by the tree.
+func LoadStoreWithFastIndex(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyLoading bool) (types.CommitKVStore, error) {
+	store, err := LoadStore(db, id, pruning, lazyLoading)
+	if err != nil {
+		return nil, err
+	}
+
+	st := store.(*Store)
+	st.fast, err = loadFastIndex(db, st.tree.(*iavl.MutableTree))
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
 // IAVL tree reference. It should only be used for testing purposes.
 //
@@ -119,6 +144,30 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}, nil
 }
 
+// LoadVersionForOverwriting loads the given version of the store and deletes all
+// the versions above it, so that they can be committed again.
+func (st *Store) LoadVersionForOverwriting(version int64) error {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return fmt.Errorf("cannot overwrite versions of an immutable IAVL tree")
+	}
+
+	if _, err := tree.LoadVersionForOverwriting(version); err != nil {
+		return err
+	}
+
+	if st.fast != nil {
+		fast, err := loadFastIndex(st.fast.db, tree)
+		if err != nil {
+			return err
+		}
+
+		st.fast = fast
+	}
+
+	return nil
+}
+
 // Commit commits the current store state and returns a CommitID with the new
 // version and hash.
 func (st *Store) Commit() types.CommitID {
@@ -128,6 +177,10 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	if st.fast != nil {
+		st.fast.commit(version)
+	}
+
 	// If the version we saved got flushed to disk, check if previous flushed
 	// version should be deleted.
 	if st.pruning.FlushVersion(version) {
@@ -187,26 +240,46 @@ func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 func (st *Store) Set(key, value []byte) {
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
+
+	if st.fast != nil {
+		st.fast.Set(key, value)
+	}
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
+	if st.fast != nil {
+		return st.fast.Get(key)
+	}
+
 	_, value := st.tree.Get(key)
 	return value
 }
 
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
+	if st.fast != nil {
+		return st.fast.Has(key)
+	}
+
 	return st.tree.Has(key)
 }
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
 	st.tree.Remove(key)
+
+	if st.fast != nil {
+		st.fast.Delete(key)
+	}
 }
 
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
+	if st.fast != nil {
+		return st.fast.Iterator(start, end)
+	}
+
 	var iTree *iavl.ImmutableTree
 
 	switch tree := st.tree.(type) {
@@ -221,6 +294,10 @@ func (st *Store) Iterator(start, end []byte) types.Iterator {
 
 // Implements types.KVStore.
 func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	if st.fast != nil {
+		return st.fast.ReverseIterator(start, end)
+	}
+
 	var iTree *iavl.ImmutableTree
 
 	switch tree := st.tree.(type) {
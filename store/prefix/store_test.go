@@ -235,6 +235,54 @@ func TestPrefixStoreReverseIteratorEdgeCase(t *testing.T) {
 	iter.Close()
 }
 
+func TestNestedPrefixStoreIterate(t *testing.T) {
+	db := dbm.NewMemDB()
+	baseStore := dbadapter.Store{DB: db}
+
+	// neighbouring namespaces sharing the outer prefix
+	baseStore.Set(bz("balances/addr1/atom"), bz("1"))
+	baseStore.Set(bz("balances/addr1/muon"), bz("2"))
+	baseStore.Set(bz("balances/addr2/atom"), bz("3"))
+	baseStore.Set(bz("balancez/addr1/atom"), bz("4"))
+
+	outer := NewStore(baseStore, bz("balances/"))
+	inner := NewStore(outer, bz("addr1/"))
+
+	iter := inner.Iterator(nil, nil)
+	checkDomain(t, iter, nil, nil)
+	checkItem(t, iter, bz("atom"), bz("1"))
+	checkNext(t, iter, true)
+	checkItem(t, iter, bz("muon"), bz("2"))
+	checkNext(t, iter, false)
+	checkInvalid(t, iter)
+	iter.Close()
+
+	iter = inner.ReverseIterator(nil, nil)
+	checkItem(t, iter, bz("muon"), bz("2"))
+	checkNext(t, iter, true)
+	checkItem(t, iter, bz("atom"), bz("1"))
+	checkNext(t, iter, false)
+	checkInvalid(t, iter)
+	iter.Close()
+
+	// deleting the iterated keys only affects the inner namespace
+	var keys [][]byte
+	iter = inner.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		inner.Delete(key)
+	}
+
+	checkValue(t, baseStore, bz("balances/addr1/atom"), nil)
+	checkValue(t, baseStore, bz("balances/addr1/muon"), nil)
+	checkValue(t, baseStore, bz("balances/addr2/atom"), bz("3"))
+	checkValue(t, baseStore, bz("balancez/addr1/atom"), bz("4"))
+}
+
 // Tests below are ported from https://github.com/tendermint/tendermint/blob/master/libs/db/prefix_db_test.go
 
 func mockStoreWithStuff() types.KVStore {
@@ -39,6 +39,7 @@ type Store struct {
 	stores         map[types.StoreKey]types.CommitKVStore
 	keysByName     map[string]types.StoreKey
 	lazyLoading    bool
+	iavlFastIndex  bool
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
@@ -81,6 +82,13 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetIAVLFastIndex sets if the iavl stores should be loaded with a fast index of
+// their latest version, see iavl.LoadStoreWithFastIndex. It must be called before
+// the stores are loaded.
+func (rs *Store) SetIAVLFastIndex(enabled bool) {
+	rs.iavlFastIndex = enabled
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -208,6 +216,69 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	return nil
 }
 
+// RollbackToVersion implements CommitMultiStore. The IAVL stores are loaded at
+// the target version and all their versions above it are deleted along with
+// the matching commit infos. Stores mounted after the target version are left
+// untouched. Nothing is deleted unless the target version exists in all the
+// IAVL stores, so that a failed rollback leaves them consistent.
+func (rs *Store) RollbackToVersion(target int64) error {
+	latest := getLatestVersion(rs.db)
+	if target <= 0 || target >= latest {
+		return fmt.Errorf("invalid rollback version %d: the latest version is %d", target, latest)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return err
+	}
+
+	infos := make(map[string]storeInfo)
+	for _, storeInfo := range cInfo.StoreInfos {
+		infos[storeInfo.Name] = storeInfo
+	}
+
+	// check all the stores before overwriting any of them
+	stores := make(map[types.StoreKey]*iavl.Store)
+	for key, storeParams := range rs.storesParams {
+		if storeParams.typ != types.StoreTypeIAVL {
+			continue
+		}
+
+		id := rs.getCommitID(infos, key.Name())
+		if id.Version == 0 {
+			continue
+		}
+
+		store, err := iavl.LoadStore(rs.paramsDB(storeParams), types.CommitID{}, rs.pruningOpts, false)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load store %s", key.Name())
+		}
+
+		if !store.(*iavl.Store).VersionExists(id.Version) {
+			return fmt.Errorf("failed to roll back store %s: version %d does not exist", key.Name(), id.Version)
+		}
+
+		stores[key] = store.(*iavl.Store)
+	}
+
+	for key, store := range stores {
+		version := rs.getCommitID(infos, key.Name()).Version
+		if err := store.LoadVersionForOverwriting(version); err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s", key.Name())
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	for ver := target + 1; ver <= latest; ver++ {
+		batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, ver)))
+	}
+	setLatestVersion(batch, target)
+
+	return batch.Write()
+}
+
 func (rs *Store) getCommitID(infos map[string]storeInfo, name string) types.CommitID {
 	info, ok := infos[name]
 	if !ok {
@@ -488,21 +559,19 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 //----------------------------------------
 // Note: why do we use key and params.key in different places. Seems like there should be only one key used.
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
-	}
+	db := rs.paramsDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		store, err := iavl.LoadStore(db, id, rs.pruningOpts, rs.lazyLoading)
+		loadStore := iavl.LoadStore
+		if rs.iavlFastIndex {
+			loadStore = iavl.LoadStoreWithFastIndex
+		}
+
+		store, err := loadStore(db, id, rs.pruningOpts, rs.lazyLoading)
 		if err != nil {
 			return nil, err
 		}
@@ -539,6 +608,17 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
+// paramsDB returns the database holding the data of the store with the given
+// params.
+func (rs *Store) paramsDB(params storeParams) dbm.DB {
+	if params.db != nil {
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
+	}
+
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
 //----------------------------------------
 // storeParams
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	tmiavl "github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
@@ -173,6 +174,77 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultistoreRollbackToVersion(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	k := []byte("key")
+	value := func(i byte) []byte { return []byte{'v', i} }
+
+	for i := byte(1); i <= 3; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set(k, value(i))
+		store.Commit()
+	}
+
+	require.Error(t, store.RollbackToVersion(0))
+	require.Error(t, store.RollbackToVersion(3))
+
+	// roll back the last commit
+	require.NoError(t, store.RollbackToVersion(2))
+
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, int64(2), store.LastCommitID().Version)
+	require.Equal(t, value(2), store.getStoreByName("store1").(types.KVStore).Get(k))
+
+	_, err := getCommitInfo(db, 3)
+	require.Error(t, err)
+
+	// the rolled back version can be committed again with different data
+	store.getStoreByName("store1").(types.KVStore).Set(k, value(4))
+	commitID := store.Commit()
+	checkStore(t, store, getExpectedCommitID(store, 3), commitID)
+
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, value(4), store.getStoreByName("store1").(types.KVStore).Get(k))
+}
+
+func TestMultistoreRollbackToMissingVersion(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	k := []byte("key")
+	value := func(i byte) []byte { return []byte{'v', i} }
+
+	for i := byte(1); i <= 3; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set(k, value(i))
+		store.getStoreByName("store2").(types.KVStore).Set(k, value(i))
+		store.Commit()
+	}
+
+	// drop the target version from a single store
+	tree, err := tmiavl.NewMutableTree(dbm.NewPrefixDB(db, []byte("s/k:store2/")), 100)
+	require.NoError(t, err)
+	_, err = tree.LoadVersion(0)
+	require.NoError(t, err)
+	require.NoError(t, tree.DeleteVersion(2))
+
+	require.Error(t, store.RollbackToVersion(2))
+
+	// none of the stores was rolled back
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, int64(3), store.LastCommitID().Version)
+	require.Equal(t, value(3), store.getStoreByName("store1").(types.KVStore).Get(k))
+	require.Equal(t, value(3), store.getStoreByName("store2").(types.KVStore).Get(k))
+
+	_, err = getCommitInfo(db, 3)
+	require.NoError(t, err)
+}
+
 func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
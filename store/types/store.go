@@ -159,9 +159,19 @@ type CommitMultiStore interface {
 	// undefined.
 	LoadVersion(ver int64) error
 
+	// RollbackToVersion deletes all the persisted versions above the given one,
+	// which becomes the latest version, so that the following versions can be
+	// committed again. The store must be loaded again afterwards.
+	RollbackToVersion(ver int64) error
+
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// SetIAVLFastIndex sets whether the IAVL stores are loaded with a flat index
+	// of their latest version, serving reads and iterations without traversing
+	// the tree.
+	SetIAVLFastIndex(enabled bool)
 }
 
 //---------subsp-------------------------------
@@ -0,0 +1,146 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	metricsprom "github.com/armon/go-metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Supported metrics formats
+const (
+	FormatDefault    = ""
+	FormatPrometheus = "prometheus"
+	FormatText       = "text"
+)
+
+// Config defines the configuration options for application telemetry.
+type Config struct {
+	// Prefixed with keys to separate services
+	ServiceName string `mapstructure:"service-name"`
+
+	// Enabled enables the application telemetry functionality. When enabled,
+	// an in-memory sink is also enabled by default. Operators may also enable
+	// other sinks such as Prometheus.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Enable prefixing gauge values with hostname
+	EnableHostname bool `mapstructure:"enable-hostname"`
+
+	// Enable adding hostname to labels
+	EnableHostnameLabel bool `mapstructure:"enable-hostname-label"`
+
+	// Enable adding service to labels
+	EnableServiceLabel bool `mapstructure:"enable-service-label"`
+
+	// PrometheusRetentionTime, when positive, enables a Prometheus metrics sink.
+	// It defines the retention duration in seconds.
+	PrometheusRetentionTime int64 `mapstructure:"prometheus-retention-time"`
+
+	// GlobalLabels defines a global set of name/value label tuples applied to all
+	// metrics emitted using the wrapper functions defined in telemetry package.
+	//
+	// Example:
+	// [["chain_id", "cosmoshub-1"]]
+	GlobalLabels [][]string `mapstructure:"global-labels"`
+}
+
+// Metrics defines a wrapper around application telemetry functionality. It
+// allows metrics to be gathered at any point in time. When creating a Metrics
+// object, internally, a global metrics is registered with a set of sinks as
+// configured by the operator. In addition to the sinks, when a process gets a
+// SIGUSR1, a dump of formatted recent metrics will be sent to STDERR.
+type Metrics struct {
+	memSink           *metrics.InmemSink
+	prometheusEnabled bool
+}
+
+// New creates a new instance of Metrics and registers it as the global
+// metrics instance used by the wrapper functions of the telemetry package.
+func New(cfg Config) (*Metrics, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	if numGlobalLabels := len(cfg.GlobalLabels); numGlobalLabels > 0 {
+		parsedGlobalLabels := make([]metrics.Label, numGlobalLabels)
+		for i, gl := range cfg.GlobalLabels {
+			if len(gl) != 2 {
+				return nil, fmt.Errorf("invalid global label %v: expected a name/value pair", gl)
+			}
+
+			parsedGlobalLabels[i] = NewLabel(gl[0], gl[1])
+		}
+
+		globalLabels = parsedGlobalLabels
+	}
+
+	metricsConf := metrics.DefaultConfig(cfg.ServiceName)
+	metricsConf.EnableHostname = cfg.EnableHostname
+	metricsConf.EnableHostnameLabel = cfg.EnableHostnameLabel
+	metricsConf.EnableServiceLabel = cfg.EnableServiceLabel
+
+	memSink := metrics.NewInmemSink(10*time.Second, time.Minute)
+	metrics.DefaultInmemSignal(memSink)
+
+	m := &Metrics{memSink: memSink}
+	fanout := metrics.FanoutSink{memSink}
+
+	if cfg.PrometheusRetentionTime > 0 {
+		m.prometheusEnabled = true
+		prometheusOpts := metricsprom.PrometheusOpts{
+			Expiration: time.Duration(cfg.PrometheusRetentionTime) * time.Second,
+		}
+
+		promSink, err := metricsprom.NewPrometheusSinkFrom(prometheusOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		fanout = append(fanout, promSink)
+	}
+
+	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Handler returns an HTTP handler serving the gathered metrics. The format is
+// selected through the 'format' query parameter: 'prometheus' serves metrics
+// in the Prometheus exposition format, provided the Prometheus sink is enabled,
+// while the default format serves a JSON dump of the in-memory sink.
+func (m *Metrics) Handler() http.HandlerFunc {
+	promHandler := promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{})
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch format := r.URL.Query().Get("format"); format {
+		case FormatPrometheus:
+			if !m.prometheusEnabled {
+				http.Error(w, "prometheus metrics are not enabled", http.StatusBadRequest)
+				return
+			}
+
+			promHandler.ServeHTTP(w, r)
+
+		case FormatDefault, FormatText:
+			summary, err := m.memSink.DisplayMetrics(w, r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(summary)
+
+		default:
+			http.Error(w, fmt.Sprintf("unsupported metrics format: %s", format), http.StatusBadRequest)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisabled(t *testing.T) {
+	m, err := New(Config{Enabled: false})
+	require.NoError(t, err)
+	require.Nil(t, m)
+}
+
+func TestMetricsHandler(t *testing.T) {
+	m, err := New(Config{
+		ServiceName:             "test",
+		Enabled:                 true,
+		EnableServiceLabel:      true,
+		PrometheusRetentionTime: 60,
+		GlobalLabels:            [][]string{{"chain_id", "test-chain"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, m)
+
+	IncrCounter(1, "dummy_counter")
+	ModuleMeasureSince("bank", time.Now(), MetricKeyBeginBlocker)
+
+	handler := m.Handler()
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var summary map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summary))
+	require.Contains(t, rec.Body.String(), "dummy_counter")
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/metrics?format=prometheus", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "dummy_counter")
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/metrics?format=unknown", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestNewInvalidGlobalLabels(t *testing.T) {
+	_, err := New(Config{Enabled: true, GlobalLabels: [][]string{{"chain_id"}}})
+	require.Error(t, err)
+}
@@ -5,6 +5,19 @@ import abci "github.com/tendermint/tendermint/abci/types"
 // InitChainer initializes application state at genesis
 type InitChainer func(ctx Context, req abci.RequestInitChain) abci.ResponseInitChain
 
+// PreBlocker runs code before the BeginBlocker, e.g. to apply an upgrade before
+// any other module logic touches the state. A failure halts the node.
+type PreBlocker func(ctx Context, req abci.RequestBeginBlock) (ResponsePreBlock, error)
+
+// ResponsePreBlock defines the result of a PreBlocker.
+type ResponsePreBlock struct {
+	// ConsensusParamsChanged signals that the consensus parameters changed, in
+	// which case the new parameters apply to the block and are reported to
+	// Tendermint at the end of the block.
+	ConsensusParamsChanged bool
+	Events                 []abci.Event
+}
+
 // BeginBlocker runs code before the transactions in a block
 //
 // Note: applications which set create_empty_blocks=false will not have regular block timing and should use
@@ -17,5 +30,13 @@ type BeginBlocker func(ctx Context, req abci.RequestBeginBlock) abci.ResponseBeg
 // e.g. BFT timestamps rather than block height for any periodic EndBlock logic
 type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBlock
 
+// ProposalFilter decides whether a tx may be included in the blocks, e.g. to
+// enforce the ordering rules of a top of block auction, and returns an error to
+// reject it. It runs when the tx is checked, so that the node neither keeps nor
+// proposes the txs it rejects, and when the tx is delivered, so that the txs
+// other validators proposed against the rules fail. It must be deterministic,
+// and its writes to the state are discarded.
+type ProposalFilter func(ctx Context, tx Tx) error
+
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
@@ -116,15 +116,15 @@ func VerifyAddressFormat(bz []byte) error {
 	return nil
 }
 
-// AccAddressFromBech32 creates an AccAddress from a Bech32 string.
+// AccAddressFromBech32 creates an AccAddress from its string representation,
+// a Bech32 string unless another address codec is set with
+// Config.SetAddressCodec.
 func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
 		return AccAddress{}, nil
 	}
 
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixAccAddr)
+	bz, err := GetConfig().GetAddressCodec().StringToBytes(address)
 	if err != nil {
 		return nil, err
 	}
@@ -218,20 +218,19 @@ func (aa AccAddress) Bytes() []byte {
 	return aa
 }
 
-// String implements the Stringer interface.
+// String implements the Stringer interface. Addresses are encoded with the
+// address codec of the config, Bech32 by default.
 func (aa AccAddress) String() string {
 	if aa.Empty() {
 		return ""
 	}
 
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixAccAddr, aa.Bytes())
+	addr, err := GetConfig().GetAddressCodec().BytesToString(aa.Bytes())
 	if err != nil {
 		panic(err)
 	}
 
-	return bech32Addr
+	return addr
 }
 
 // Format implements the fmt.Formatter interface.
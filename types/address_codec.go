@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// AddressCodec encodes account addresses to and decodes them from their string
+// representation. The codec of the application is set with
+// Config.SetAddressCodec and defaults to Bech32 with the account address prefix.
+type AddressCodec interface {
+	// StringToBytes decodes the given string representation of an address.
+	StringToBytes(text string) ([]byte, error)
+	// BytesToString encodes the given address bytes to their string
+	// representation.
+	BytesToString(bz []byte) (string, error)
+}
+
+var (
+	_ AddressCodec = Bech32Codec{}
+	_ AddressCodec = HexCodec{}
+	_ AddressCodec = Base58Codec{}
+)
+
+// Bech32Codec encodes addresses with Bech32 and a human readable prefix.
+type Bech32Codec struct {
+	Prefix string
+}
+
+// NewBech32Codec returns a Bech32 address codec using the given prefix.
+func NewBech32Codec(prefix string) Bech32Codec {
+	return Bech32Codec{Prefix: prefix}
+}
+
+// StringToBytes implements AddressCodec.
+func (bc Bech32Codec) StringToBytes(text string) ([]byte, error) {
+	return GetFromBech32(text, bc.Prefix)
+}
+
+// BytesToString implements AddressCodec.
+func (bc Bech32Codec) BytesToString(bz []byte) (string, error) {
+	return bech32.ConvertAndEncode(bc.Prefix, bz)
+}
+
+// HexCodec encodes addresses in hexadecimal with a 0x prefix, e.g. as Ethereum
+// addresses. Decoding is case insensitive and the prefix is optional.
+type HexCodec struct{}
+
+// StringToBytes implements AddressCodec.
+func (HexCodec) StringToBytes(text string) ([]byte, error) {
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "0x"), "0X")
+	if len(text) == 0 {
+		return nil, errors.New("decoding hex address failed: must provide an address")
+	}
+
+	return hex.DecodeString(text)
+}
+
+// BytesToString implements AddressCodec.
+func (HexCodec) BytesToString(bz []byte) (string, error) {
+	return "0x" + hex.EncodeToString(bz), nil
+}
+
+// Base58Codec encodes addresses in Base58 with the Bitcoin alphabet.
+type Base58Codec struct{}
+
+// StringToBytes implements AddressCodec.
+func (Base58Codec) StringToBytes(text string) ([]byte, error) {
+	bz := base58.Decode(text)
+	if len(bz) == 0 {
+		return nil, errors.New("decoding base58 address failed: invalid or empty address")
+	}
+
+	return bz, nil
+}
+
+// BytesToString implements AddressCodec.
+func (Base58Codec) BytesToString(bz []byte) (string, error) {
+	return base58.Encode(bz), nil
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAddressCodecs(t *testing.T) {
+	addr := []byte{0xde, 0xad, 0xbe, 0xef, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+	testCases := []struct {
+		name    string
+		codec   sdk.AddressCodec
+		encoded string
+	}{
+		{"bech32", sdk.NewBech32Codec("cosmos"), "cosmos1m6kmamcqqypqxpq9qcrsszg2pvxq6rs0vvfl2n"},
+		{"hex", sdk.HexCodec{}, "0xdeadbeef000102030405060708090a0b0c0d0e0f"},
+		{"base58", sdk.Base58Codec{}, "46w6iHiRwrkz7Ywo3j5nML5B8tkn"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := tc.codec.BytesToString(addr)
+			require.NoError(t, err)
+			require.Equal(t, tc.encoded, encoded)
+
+			decoded, err := tc.codec.StringToBytes(encoded)
+			require.NoError(t, err)
+			require.Equal(t, addr, decoded)
+
+			_, err = tc.codec.StringToBytes("")
+			require.Error(t, err)
+		})
+	}
+
+	// hex decoding is case insensitive and the prefix is optional
+	decoded, err := sdk.HexCodec{}.StringToBytes("DEADBEEF000102030405060708090A0B0C0D0E0F")
+	require.NoError(t, err)
+	require.Equal(t, addr, decoded)
+}
+
+func TestAccAddressWithAddressCodec(t *testing.T) {
+	config := sdk.GetConfig()
+	t.Cleanup(func() { config.SetAddressCodec(nil) })
+
+	addr := sdk.AccAddress([]byte{0xde, 0xad, 0xbe, 0xef, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15})
+	require.Equal(t, sdk.NewBech32Codec(config.GetBech32AccountAddrPrefix()), config.GetAddressCodec())
+
+	config.SetAddressCodec(sdk.HexCodec{})
+	require.Equal(t, "0xdeadbeef000102030405060708090a0b0c0d0e0f", addr.String())
+
+	decoded, err := sdk.AccAddressFromBech32(addr.String())
+	require.NoError(t, err)
+	require.Equal(t, addr, decoded)
+
+	bz, err := addr.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `"0xdeadbeef000102030405060708090a0b0c0d0e0f"`, string(bz))
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// VoteInfo is the vote of a validator for the previous block, as reported by
+// Tendermint at the beginning of a block.
+type VoteInfo struct {
+	ConsAddress     ConsAddress
+	Power           int64
+	SignedLastBlock bool
+}
+
+// Misbehavior is the evidence of a misbehavior of a validator, e.g. a double
+// sign, submitted by Tendermint at the beginning of a block.
+type Misbehavior struct {
+	// Type is the Tendermint evidence type, e.g. duplicate/vote.
+	Type        string
+	ConsAddress ConsAddress
+	// Power is the voting power of the validator at the height of the
+	// misbehavior, and TotalVotingPower the one of the validator set.
+	Power            int64
+	Height           int64
+	Time             time.Time
+	TotalVotingPower int64
+}
+
+// VoteInfosFromABCI returns the votes of the validators for the previous
+// block held by the given commit info.
+func VoteInfosFromABCI(info abci.LastCommitInfo) []VoteInfo {
+	votes := make([]VoteInfo, len(info.Votes))
+	for i, vote := range info.Votes {
+		votes[i] = VoteInfo{
+			ConsAddress:     ConsAddress(vote.Validator.Address),
+			Power:           vote.Validator.Power,
+			SignedLastBlock: vote.SignedLastBlock,
+		}
+	}
+
+	return votes
+}
+
+// MisbehaviorsFromABCI returns the misbehaviors of the given evidence of
+// byzantine validators.
+func MisbehaviorsFromABCI(evidence []abci.Evidence) []Misbehavior {
+	misbehaviors := make([]Misbehavior, len(evidence))
+	for i, ev := range evidence {
+		misbehaviors[i] = Misbehavior{
+			Type:             ev.Type,
+			ConsAddress:      ConsAddress(ev.Validator.Address),
+			Power:            ev.Validator.Power,
+			Height:           ev.Height,
+			Time:             ev.Time,
+			TotalVotingPower: ev.TotalVotingPower,
+		}
+	}
+
+	return misbehaviors
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CanonicalJSON returns the canonical encoding of the given JSON, so that
+// documents holding the same values, e.g. genesis files or sign docs, have the
+// same encoding regardless of the implementation which produced them:
+//
+//   - the keys of the objects are sorted in byte order, and duplicate keys are
+//     rejected;
+//   - there is no whitespace outside of the strings;
+//   - strings are escaped as by encoding/json, i.e. <, > and & are escaped;
+//   - integers are written in decimal without exponent, regardless of their
+//     size, and the other numbers in the shortest form of the float64 closest to
+//     them, as by encoding/json, and zero is always written as 0.
+//
+// An error is returned if the JSON is invalid or holds more than one value.
+func CanonicalJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, dec); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON: trailing data after the top-level value")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// MustCanonicalJSON is like CanonicalJSON but panics if an error occurs.
+func MustCanonicalJSON(bz []byte) []byte {
+	js, err := CanonicalJSON(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return js
+}
+
+// writeCanonicalJSON writes the canonical encoding of the next value of the
+// decoder to buf.
+func writeCanonicalJSON(buf *bytes.Buffer, dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		switch tok {
+		case '{':
+			return writeCanonicalObject(buf, dec)
+		case '[':
+			return writeCanonicalArray(buf, dec)
+		default:
+			return fmt.Errorf("invalid JSON: unexpected delimiter %s", tok)
+		}
+
+	case json.Number:
+		num, err := canonicalNumber(tok)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(num)
+
+	default:
+		// strings, booleans and null
+		bz, err := json.Marshal(tok)
+		if err != nil {
+			return err
+		}
+
+		buf.Write(bz)
+	}
+
+	return nil
+}
+
+func writeCanonicalObject(buf *bytes.Buffer, dec *json.Decoder) error {
+	members := make(map[string][]byte)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid JSON: object key %v is not a string", tok)
+		}
+
+		if _, ok := members[key]; ok {
+			return fmt.Errorf("invalid JSON: duplicate object key %q", key)
+		}
+
+		var value bytes.Buffer
+		if err := writeCanonicalJSON(&value, dec); err != nil {
+			return err
+		}
+
+		members[key] = value.Bytes()
+	}
+
+	// consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		bz, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+
+		buf.Write(bz)
+		buf.WriteByte(':')
+		buf.Write(members[key])
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func writeCanonicalArray(buf *bytes.Buffer, dec *json.Decoder) error {
+	buf.WriteByte('[')
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		if err := writeCanonicalJSON(buf, dec); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	// consume the closing delimiter
+	_, err := dec.Token()
+	return err
+}
+
+// canonicalNumber returns the canonical form of the given number: integers are
+// kept exact, while the other numbers are formatted as float64s.
+func canonicalNumber(num json.Number) (string, error) {
+	s := num.String()
+	if !strings.ContainsAny(s, ".eE") {
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return "", fmt.Errorf("invalid JSON: invalid number %s", s)
+		}
+
+		return i.String(), nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON: invalid number %s: %w", s, err)
+	}
+
+	// negative zero is written as 0, like the integer -0
+	if f == 0 {
+		f = 0
+	}
+
+	bz, err := json.Marshal(f)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	cases := []struct {
+		name    string
+		json    string
+		want    string
+		wantErr bool
+	}{
+		{"sorted keys", `{"b":{"d":1, "c":2}, "a":[{"f":null,"e":true}]}`, `{"a":[{"e":true,"f":null}],"b":{"c":2,"d":1}}`, false},
+		{"whitespace", "\n{ \"a\" :\t[ 1 , \"b c\" ] }\n", `{"a":[1,"b c"]}`, false},
+		{"escaped strings", `{"a":"<&>\u00e9"}`, `{"a":"\u003c\u0026\u003eé"}`, false},
+		{"large integers", `{"a":123456789012345678901234567890,"b":-0}`, `{"a":123456789012345678901234567890,"b":0}`, false},
+		{"floats", `[2.50,1e2,1.5E-7,-0.0]`, `[2.5,100,1.5e-7,0]`, false},
+		{"duplicate keys", `{"a":1,"a":2}`, "", true},
+		{"trailing data", `{"a":1}{"b":2}`, "", true},
+		{"out of range", `{"a":1e400}`, "", true},
+		{"invalid", `{"a":}`, "", true},
+		{"empty", ``, "", true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sdk.CanonicalJSON([]byte(tc.json))
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Panics(t, func() { sdk.MustCanonicalJSON([]byte(tc.json)) })
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(got))
+
+			// the canonical encoding is a fixed point
+			require.Equal(t, got, sdk.MustCanonicalJSON(got))
+		})
+	}
+}
@@ -49,12 +49,15 @@ func validate(denom string, amount Int) error {
 	return nil
 }
 
+// Validate returns an error if the Coin has a negative amount or if
+// the denom is invalid.
+func (coin Coin) Validate() error {
+	return validate(coin.Denom, coin.Amount)
+}
+
 // IsValid returns true if the Coin has a non-negative amount and the denom is vaild.
 func (coin Coin) IsValid() bool {
-	if err := validate(coin.Denom, coin.Amount); err != nil {
-		return false
-	}
-	return true
+	return coin.Validate() == nil
 }
 
 // IsZero returns if this represents no money
@@ -182,43 +185,57 @@ func (coins Coins) String() string {
 	return out[:len(out)-1]
 }
 
-// IsValid asserts the Coins are sorted, have positive amount,
-// and Denom does not contain upper case characters.
-func (coins Coins) IsValid() bool {
+// Validate checks that the Coins are sorted, have positive amount, with a valid
+// and unique denomination (i.e no duplicates). Otherwise, it returns an error.
+func (coins Coins) Validate() error {
 	switch len(coins) {
 	case 0:
-		return true
+		return nil
+
 	case 1:
 		if err := ValidateDenom(coins[0].Denom); err != nil {
-			return false
+			return err
 		}
-		return coins[0].IsPositive()
+		if !coins[0].IsPositive() {
+			return fmt.Errorf("coin %s amount is not positive", coins[0])
+		}
+		return nil
+
 	default:
 		// check single coin case
-		if !(Coins{coins[0]}).IsValid() {
-			return false
+		if err := (Coins{coins[0]}).Validate(); err != nil {
+			return err
 		}
 
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
-				return false
+			if err := ValidateDenom(coin.Denom); err != nil {
+				return err
+			}
+			if coin.Denom == lowDenom {
+				return fmt.Errorf("duplicate denomination %s", coin.Denom)
 			}
-			if coin.Denom <= lowDenom {
-				return false
+			if coin.Denom < lowDenom {
+				return fmt.Errorf("denomination %s is not sorted", coin.Denom)
 			}
 			if !coin.IsPositive() {
-				return false
+				return fmt.Errorf("coin %s amount is not positive", coin)
 			}
 
 			// we compare each coin against the last denom
 			lowDenom = coin.Denom
 		}
 
-		return true
+		return nil
 	}
 }
 
+// IsValid asserts the Coins are sorted, have positive amount, and have a
+// valid and unique denomination.
+func (coins Coins) IsValid() bool {
+	return coins.Validate() == nil
+}
+
 // Add adds two sets of coins.
 //
 // e.g.
@@ -329,6 +346,82 @@ func (coins Coins) SafeSub(coinsB Coins) (Coins, bool) {
 	return diff, diff.IsAnyNegative()
 }
 
+// Min returns the Coins where the amount of every denom is the minimum of its
+// amount in coins and coinsB. A denom which is missing from either set of
+// coins is thus missing from the result.
+//
+// e.g.
+// {2A, 3B}.Min({1A, 4C}) = {1A}
+//
+// NOTE: Min operates under the invariant that coins are sorted by
+// denominations.
+func (coins Coins) Min(coinsB Coins) Coins {
+	min := make([]Coin, 0)
+	for indexA, indexB := 0, 0; indexA < len(coins) && indexB < len(coinsB); {
+		coinA, coinB := coins[indexA], coinsB[indexB]
+
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1: // denom missing from coinsB
+			indexA++
+
+		case 0: // same denom in both
+			minCoin := coinA
+			if coinB.IsLT(minCoin) {
+				minCoin = coinB
+			}
+			min = append(min, minCoin)
+			indexA++
+			indexB++
+
+		case 1: // denom missing from coins
+			indexB++
+		}
+	}
+
+	return NewCoins(min...)
+}
+
+// Max returns the Coins where the amount of every denom is the maximum of its
+// amount in coins and coinsB. A denom which is missing from one set of coins
+// has the amount of the other set in the result.
+//
+// e.g.
+// {2A, 3B}.Max({1A, 4C}) = {2A, 3B, 4C}
+//
+// NOTE: Max operates under the invariant that coins are sorted by
+// denominations.
+func (coins Coins) Max(coinsB Coins) Coins {
+	max := make([]Coin, 0)
+	indexA, indexB := 0, 0
+	for indexA < len(coins) && indexB < len(coinsB) {
+		coinA, coinB := coins[indexA], coinsB[indexB]
+
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1: // denom missing from coinsB
+			max = append(max, coinA)
+			indexA++
+
+		case 0: // same denom in both
+			maxCoin := coinA
+			if coinA.IsLT(coinB) {
+				maxCoin = coinB
+			}
+			max = append(max, maxCoin)
+			indexA++
+			indexB++
+
+		case 1: // denom missing from coins
+			max = append(max, coinB)
+			indexB++
+		}
+	}
+
+	max = append(max, coins[indexA:]...)
+	max = append(max, coinsB[indexB:]...)
+
+	return NewCoins(max...)
+}
+
 // IsAllGT returns true if for every denom in coinsB,
 // the denom is present at a greater amount in coins.
 func (coins Coins) IsAllGT(coinsB Coins) bool {
@@ -589,6 +682,24 @@ var (
 	reDecCoin   = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reDnmString))
 )
 
+// DefaultCoinDenomRegex returns the default regex string used to validate
+// denominations.
+func DefaultCoinDenomRegex() string {
+	return reDnmString
+}
+
+// SetCoinDenomRegex overrides the regex string used to validate denominations,
+// e.g. to allow the upper case hashes of IBC denominations. The regex must not
+// match any leading digits, as these are parsed as the amount of a coin.
+//
+// NOTE: This is a global setting and should only be called once, before any
+// coins are validated or parsed, typically in the main function of the app.
+func SetCoinDenomRegex(reFn func() string) {
+	reDnm = regexp.MustCompile(fmt.Sprintf(`^%s$`, reFn()))
+	reCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reAmt, reSpc, reFn()))
+	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reFn()))
+}
+
 // ValidateDenom validates a denomination string returning an error if it is
 // invalid.
 func ValidateDenom(denom string) error {
@@ -349,6 +349,75 @@ func TestCoins(t *testing.T) {
 	assert.False(t, neg.IsValid(), "Negative first-denom coin")
 }
 
+func TestCoinsValidate(t *testing.T) {
+	testCases := []struct {
+		input  Coins
+		expErr string
+	}{
+		{Coins{}, ""},
+		{Coins{{"gas", NewInt(1)}, {"mineral", NewInt(1)}}, ""},
+		{Coins{{"gAs", NewInt(1)}}, "invalid denom"},
+		{Coins{{"gas", NewInt(0)}}, "not positive"},
+		{Coins{{"gas", NewInt(1)}, {"gas", NewInt(1)}}, "duplicate denomination"},
+		{Coins{{"tree", NewInt(1)}, {"gas", NewInt(1)}}, "not sorted"},
+		{Coins{{"gas", NewInt(1)}, {"tree", NewInt(-1)}}, "not positive"},
+		{Coins{{"gas", NewInt(1)}, {"TREE", NewInt(1)}}, "invalid denom"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.input.Validate()
+		if tc.expErr == "" {
+			require.NoError(t, err, "tc #%d", i)
+		} else {
+			require.Error(t, err, "tc #%d", i)
+			require.Contains(t, err.Error(), tc.expErr, "tc #%d", i)
+		}
+		require.Equal(t, err == nil, tc.input.IsValid(), "tc #%d", i)
+	}
+}
+
+func TestCoinsMinMax(t *testing.T) {
+	one := NewInt(1)
+	two := NewInt(2)
+
+	testCases := []struct {
+		input1, input2 Coins
+		min, max       Coins
+	}{
+		{Coins{}, Coins{}, Coins{}, Coins{}},
+		{Coins{{testDenom1, one}}, Coins{}, Coins{}, Coins{{testDenom1, one}}},
+		{Coins{{testDenom1, one}}, Coins{{testDenom1, two}}, Coins{{testDenom1, one}}, Coins{{testDenom1, two}}},
+		{Coins{{testDenom1, one}}, Coins{{testDenom2, two}}, Coins{}, Coins{{testDenom1, one}, {testDenom2, two}}},
+		{
+			Coins{{testDenom1, two}, {testDenom2, one}},
+			Coins{{testDenom1, one}, {testDenom2, two}},
+			Coins{{testDenom1, one}, {testDenom2, one}},
+			Coins{{testDenom1, two}, {testDenom2, two}},
+		},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.min, tc.input1.Min(tc.input2), "tc #%d", i)
+		require.Equal(t, tc.min, tc.input2.Min(tc.input1), "tc #%d", i)
+		require.Equal(t, tc.max, tc.input1.Max(tc.input2), "tc #%d", i)
+		require.Equal(t, tc.max, tc.input2.Max(tc.input1), "tc #%d", i)
+	}
+}
+
+func TestSetCoinDenomRegex(t *testing.T) {
+	ibcDenom := "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+	require.Error(t, ValidateDenom(ibcDenom))
+
+	SetCoinDenomRegex(func() string { return `[a-zA-Z][a-zA-Z0-9/]{2,127}` })
+	defer SetCoinDenomRegex(DefaultCoinDenomRegex)
+
+	require.NoError(t, ValidateDenom(ibcDenom))
+	coins, err := ParseCoins("10" + ibcDenom + ",5atom")
+	require.NoError(t, err)
+	require.Equal(t, Coins{{"atom", NewInt(5)}, {ibcDenom, NewInt(10)}}, coins)
+	require.NoError(t, coins.Validate())
+}
+
 func TestCoinsGT(t *testing.T) {
 	one := NewInt(1)
 	two := NewInt(2)
@@ -17,6 +17,7 @@ type Config struct {
 	bech32AddressPrefix map[string]string
 	txEncoder           TxEncoder
 	addressVerifier     func([]byte) error
+	addressCodec        AddressCodec
 	mtx                 sync.RWMutex
 	coinType            uint32
 	sealed              bool
@@ -112,6 +113,13 @@ func (config *Config) SetAddressVerifier(addressVerifier func([]byte) error) {
 	config.addressVerifier = addressVerifier
 }
 
+// SetAddressCodec builds the Config with the codec encoding account addresses to
+// and from strings, e.g. to use hex addresses instead of Bech32 ones
+func (config *Config) SetAddressCodec(codec AddressCodec) {
+	config.assertNotSealed()
+	config.addressCodec = codec
+}
+
 // Set the BIP-0044 CoinType code on the config
 func (config *Config) SetCoinType(coinType uint32) {
 	config.assertNotSealed()
@@ -181,6 +189,16 @@ func (config *Config) GetAddressVerifier() func([]byte) error {
 	return config.addressVerifier
 }
 
+// GetAddressCodec returns the codec of account addresses, which defaults to
+// Bech32 with the account address prefix.
+func (config *Config) GetAddressCodec() AddressCodec {
+	if config.addressCodec != nil {
+		return config.addressCodec
+	}
+
+	return NewBech32Codec(config.GetBech32AccountAddrPrefix())
+}
+
 // GetCoinType returns the BIP-0044 CoinType code on the config.
 func (config *Config) GetCoinType() uint32 {
 	return config.coinType
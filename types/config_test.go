@@ -45,6 +45,46 @@ func TestConfig_SetFullFundraiserPath(t *testing.T) {
 	require.Panics(t, func() { config.SetFullFundraiserPath("x/test/path") })
 }
 
+func TestConfig_SetBech32Prefixes(t *testing.T) {
+	config := sdk.NewConfig()
+	require.Equal(t, sdk.Bech32PrefixAccAddr, config.GetBech32AccountAddrPrefix())
+	require.Equal(t, sdk.Bech32PrefixValPub, config.GetBech32ValidatorPubPrefix())
+
+	config.SetBech32PrefixForAccount("terra", "terrapub")
+	config.SetBech32PrefixForValidator("terravaloper", "terravaloperpub")
+	config.SetBech32PrefixForConsensusNode("terravalcons", "terravalconspub")
+
+	require.Equal(t, "terra", config.GetBech32AccountAddrPrefix())
+	require.Equal(t, "terrapub", config.GetBech32AccountPubPrefix())
+	require.Equal(t, "terravaloper", config.GetBech32ValidatorAddrPrefix())
+	require.Equal(t, "terravaloperpub", config.GetBech32ValidatorPubPrefix())
+	require.Equal(t, "terravalcons", config.GetBech32ConsensusAddrPrefix())
+	require.Equal(t, "terravalconspub", config.GetBech32ConsensusPubPrefix())
+
+	config.Seal()
+	require.Panics(t, func() { config.SetBech32PrefixForAccount("cosmos", "cosmospub") })
+	require.Panics(t, func() { config.SetBech32PrefixForValidator("cosmosvaloper", "cosmosvaloperpub") })
+	require.Panics(t, func() { config.SetBech32PrefixForConsensusNode("cosmosvalcons", "cosmosvalconspub") })
+}
+
+func TestConfig_SetAddressVerifier(t *testing.T) {
+	config := sdk.NewConfig()
+	require.Nil(t, config.GetAddressVerifier())
+
+	verifier := func(bz []byte) error {
+		if len(bz) != 32 {
+			return errors.New("invalid address length")
+		}
+		return nil
+	}
+	config.SetAddressVerifier(verifier)
+	require.Error(t, config.GetAddressVerifier()(make([]byte, 20)))
+	require.NoError(t, config.GetAddressVerifier()(make([]byte, 32)))
+
+	config.Seal()
+	require.Panics(t, func() { config.SetAddressVerifier(verifier) })
+}
+
 func TestKeyringServiceName(t *testing.T) {
 	require.Equal(t, sdk.DefaultKeyringServiceName, sdk.KeyringServiceName())
 }
@@ -30,9 +30,13 @@ type Context struct {
 	voteInfo      []abci.VoteInfo
 	gasMeter      GasMeter
 	blockGasMeter GasMeter
+	kvGasConfig   GasConfig
+	tkvGasConfig  GasConfig
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
+	simulate      bool // if simulate == true, then checkTx must also be true
 	minGasPrice   DecCoins
+	priority      int64
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
 }
@@ -40,7 +44,42 @@ type Context struct {
 // Proposed rename, not done to avoid API breakage
 type Request = Context
 
+// ExecMode defines the execution mode of a context, which allows modules to
+// adapt their behavior, e.g. to skip expensive work that has no effect on the
+// state outside of DeliverTx.
+type ExecMode uint8
+
+const (
+	ExecModeDeliver  ExecMode = iota // Deliver a transaction or a block
+	ExecModeCheck                    // Check a transaction
+	ExecModeReCheck                  // Recheck a (pending) transaction after a commit
+	ExecModeSimulate                 // Simulate a transaction
+)
+
+// String implements fmt.Stringer.
+func (m ExecMode) String() string {
+	switch m {
+	case ExecModeDeliver:
+		return "deliver"
+	case ExecModeCheck:
+		return "check"
+	case ExecModeReCheck:
+		return "recheck"
+	case ExecModeSimulate:
+		return "simulate"
+	default:
+		return "unknown"
+	}
+}
+
 // Read-only accessors
+//
+// BlockHeight, BlockTime, ChainID and ProposerAddress return the data of the
+// block being processed, set by baseapp from the block header in BeginBlock,
+// DeliverTx and EndBlock, and from the last committed block header in CheckTx.
+// They are identical on all the nodes processing a block, and are the only
+// deterministic sources of time and block data: modules must not use the wall
+// clock (see WallClockNow).
 func (c Context) Context() context.Context    { return c.ctx }
 func (c Context) MultiStore() MultiStore      { return c.ms }
 func (c Context) BlockHeight() int64          { return c.header.Height }
@@ -53,9 +92,39 @@ func (c Context) GasMeter() GasMeter          { return c.gasMeter }
 func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
+func (c Context) IsSimulate() bool            { return c.simulate }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
 
+// ExecMode returns the execution mode of the context.
+func (c Context) ExecMode() ExecMode {
+	switch {
+	case c.simulate:
+		return ExecModeSimulate
+	case c.recheckTx:
+		return ExecModeReCheck
+	case c.checkTx:
+		return ExecModeCheck
+	default:
+		return ExecModeDeliver
+	}
+}
+
+// KVGasConfig returns the gas costs charged for operations on the KVStores
+// fetched from the context.
+func (c Context) KVGasConfig() GasConfig { return c.kvGasConfig }
+
+// TransientKVGasConfig returns the gas costs charged for operations on the
+// transient stores fetched from the context.
+func (c Context) TransientKVGasConfig() GasConfig { return c.tkvGasConfig }
+
+// Priority returns the priority of the transaction being processed, as set by
+// the ante handler, e.g. from the fee it pays per unit of gas.
+func (c Context) Priority() int64 { return c.priority }
+
+// ProposerAddress returns the consensus address of the proposer of the block.
+func (c Context) ProposerAddress() ConsAddress { return c.header.ProposerAddress }
+
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
 	var msg = proto.Clone(&c.header).(*abci.Header)
@@ -78,6 +147,8 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 		checkTx:      isCheckTx,
 		logger:       logger,
 		gasMeter:     stypes.NewInfiniteGasMeter(),
+		kvGasConfig:  stypes.KVGasConfig(),
+		tkvGasConfig: stypes.TransientGasConfig(),
 		minGasPrice:  DecCoins{},
 		eventManager: NewEventManager(),
 	}
@@ -149,8 +220,26 @@ func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	return c
 }
 
+// WithKVGasConfig sets the gas costs charged for operations on the KVStores
+// fetched from the context.
+func (c Context) WithKVGasConfig(gasConfig GasConfig) Context {
+	c.kvGasConfig = gasConfig
+	return c
+}
+
+// WithTransientKVGasConfig sets the gas costs charged for operations on the
+// transient stores fetched from the context.
+func (c Context) WithTransientKVGasConfig(gasConfig GasConfig) Context {
+	c.tkvGasConfig = gasConfig
+	return c
+}
+
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
+	if !isCheckTx {
+		c.recheckTx = false
+		c.simulate = false
+	}
 	return c
 }
 
@@ -164,11 +253,26 @@ func (c Context) WithIsReCheckTx(isRecheckTx bool) Context {
 	return c
 }
 
+// WithExecMode sets the execution mode of the context, along with the checkTx
+// and recheckTx flags it implies.
+func (c Context) WithExecMode(mode ExecMode) Context {
+	c.checkTx = mode != ExecModeDeliver
+	c.recheckTx = mode == ExecModeReCheck
+	c.simulate = mode == ExecModeSimulate
+	return c
+}
+
 func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	c.minGasPrice = gasPrices
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority.
+func (c Context) WithPriority(priority int64) Context {
+	c.priority = priority
+	return c
+}
+
 func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	c.consParams = params
 	return c
@@ -203,25 +307,52 @@ func (c Context) Value(key interface{}) interface{} {
 	return c.ctx.Value(key)
 }
 
+type sdkContextKeyType string
+
+// SdkContextKey is the key under which the Context is stored in the
+// context.Context wrapping it.
+const SdkContextKey sdkContextKeyType = "sdk-context"
+
+// WrapSDKContext returns a context.Context wrapping the Context, which allows
+// passing it through APIs only accepting a context.Context, such as gRPC
+// service methods. Use UnwrapSDKContext to retrieve the Context.
+func WrapSDKContext(ctx Context) context.Context {
+	return context.WithValue(ctx.ctx, SdkContextKey, ctx)
+}
+
+// UnwrapSDKContext retrieves the Context from a context.Context created with
+// WrapSDKContext.
+func UnwrapSDKContext(goCtx context.Context) Context {
+	return goCtx.Value(SdkContextKey).(Context)
+}
+
 // ----------------------------------------------------------------------------
 // Store / Caching
 // ----------------------------------------------------------------------------
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.tkvGasConfig)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
-// EventManager. The cached context is written to the context when writeCache
-// is called.
+// EventManager. It allows to execute operations which are discarded as a
+// whole on failure, by not calling writeCache. When writeCache is called, the
+// cached state is written to the context multi-store and the events emitted
+// on the cached context are emitted on the context EventManager.
 func (c Context) CacheContext() (cc Context, writeCache func()) {
 	cms := c.MultiStore().CacheMultiStore()
 	cc = c.WithMultiStore(cms).WithEventManager(NewEventManager())
-	return cc, cms.Write
+
+	writeCache = func() {
+		c.EventManager().EmitEvents(cc.EventManager().Events())
+		cms.Write()
+	}
+
+	return cc, writeCache
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -77,9 +78,21 @@ func TestCacheContext(t *testing.T) {
 	require.Equal(t, v2, cstore.Get(k2))
 	require.Nil(t, store.Get(k2))
 
+	cctx.EventManager().EmitEvent(types.NewEvent("cached"))
+	require.Empty(t, ctx.EventManager().Events())
+
 	write()
 
 	require.Equal(t, v2, store.Get(k2))
+	require.Equal(t, types.Events{types.NewEvent("cached")}, ctx.EventManager().Events())
+
+	// discarded cached contexts neither write state nor emit events
+	cctx, _ = ctx.CacheContext()
+	cctx.KVStore(key).Delete(k1)
+	cctx.EventManager().EmitEvent(types.NewEvent("discarded"))
+
+	require.Equal(t, v1, store.Get(k1))
+	require.Len(t, ctx.EventManager().Events(), 1)
 }
 
 func TestLogContext(t *testing.T) {
@@ -125,6 +138,7 @@ func TestContextWithCustom(t *testing.T) {
 		WithVoteInfos(voteinfos).
 		WithGasMeter(meter).
 		WithMinGasPrices(minGasPrices).
+		WithPriority(10).
 		WithBlockGasMeter(blockGasMeter)
 	require.Equal(t, height, ctx.BlockHeight())
 	require.Equal(t, chainid, ctx.ChainID())
@@ -134,6 +148,7 @@ func TestContextWithCustom(t *testing.T) {
 	require.Equal(t, voteinfos, ctx.VoteInfos())
 	require.Equal(t, meter, ctx.GasMeter())
 	require.Equal(t, minGasPrices, ctx.MinGasPrices())
+	require.Equal(t, int64(10), ctx.Priority())
 	require.Equal(t, blockGasMeter, ctx.BlockGasMeter())
 
 	require.False(t, ctx.WithIsCheckTx(false).IsCheckTx())
@@ -155,6 +170,42 @@ func TestContextWithCustom(t *testing.T) {
 	require.NotEqual(t, ctx.Context(), ctx.WithContext(newContext).Context())
 }
 
+func TestContextExecMode(t *testing.T) {
+	ctx := types.NewContext(nil, abci.Header{}, false, nil)
+	require.Equal(t, types.ExecModeDeliver, ctx.ExecMode())
+
+	ctx = types.NewContext(nil, abci.Header{}, true, nil)
+	require.Equal(t, types.ExecModeCheck, ctx.ExecMode())
+
+	ctx = ctx.WithIsReCheckTx(true)
+	require.Equal(t, types.ExecModeReCheck, ctx.ExecMode())
+
+	ctx = ctx.WithExecMode(types.ExecModeSimulate)
+	require.Equal(t, types.ExecModeSimulate, ctx.ExecMode())
+	require.True(t, ctx.IsCheckTx())
+	require.False(t, ctx.IsReCheckTx())
+	require.True(t, ctx.IsSimulate())
+
+	ctx = ctx.WithIsCheckTx(false)
+	require.Equal(t, types.ExecModeDeliver, ctx.ExecMode())
+	require.False(t, ctx.IsSimulate())
+	require.Equal(t, "deliver", ctx.ExecMode().String())
+}
+
+func TestContextKVGasConfig(t *testing.T) {
+	key := types.NewKVStoreKey(t.Name())
+	ctx := defaultContext(t, key)
+	require.Equal(t, storetypes.KVGasConfig(), ctx.KVGasConfig())
+	require.Equal(t, storetypes.TransientGasConfig(), ctx.TransientKVGasConfig())
+
+	gasConfig := types.GasConfig{WriteCostFlat: 10, WriteCostPerByte: 1}
+	ctx = ctx.WithKVGasConfig(gasConfig).WithGasMeter(types.NewGasMeter(100))
+	require.Equal(t, gasConfig, ctx.KVGasConfig())
+
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+	require.Equal(t, types.Gas(15), ctx.GasMeter().GasConsumed())
+}
+
 // Testing saving/loading of header fields to/from the context
 func TestContextHeader(t *testing.T) {
 	var ctx types.Context
@@ -174,6 +225,7 @@ func TestContextHeader(t *testing.T) {
 	require.Equal(t, height, ctx.BlockHeader().Height)
 	require.Equal(t, time.UTC(), ctx.BlockHeader().Time)
 	require.Equal(t, proposer.Bytes(), ctx.BlockHeader().ProposerAddress)
+	require.Equal(t, proposer, ctx.ProposerAddress())
 }
 
 func TestContextHeaderClone(t *testing.T) {
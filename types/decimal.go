@@ -25,6 +25,10 @@ const (
 	// bytes required to represent the above precision
 	// Ceiling[Log2[999 999 999 999 999 999]]
 	DecimalPrecisionBits = 60
+
+	// maxDecBitLen is the maximum bit length of the underlying integer of a
+	// Dec produced by arithmetic operations.
+	maxDecBitLen = maxBitLen + DecimalPrecisionBits
 )
 
 var (
@@ -216,7 +220,7 @@ func (d Dec) BigInt() *big.Int {
 func (d Dec) Add(d2 Dec) Dec {
 	res := new(big.Int).Add(d.i, d2.i)
 
-	if res.BitLen() > 255+DecimalPrecisionBits {
+	if res.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{res}
@@ -226,7 +230,7 @@ func (d Dec) Add(d2 Dec) Dec {
 func (d Dec) Sub(d2 Dec) Dec {
 	res := new(big.Int).Sub(d.i, d2.i)
 
-	if res.BitLen() > 255+DecimalPrecisionBits {
+	if res.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{res}
@@ -237,7 +241,7 @@ func (d Dec) Mul(d2 Dec) Dec {
 	mul := new(big.Int).Mul(d.i, d2.i)
 	chopped := chopPrecisionAndRound(mul)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -248,7 +252,7 @@ func (d Dec) MulTruncate(d2 Dec) Dec {
 	mul := new(big.Int).Mul(d.i, d2.i)
 	chopped := chopPrecisionAndTruncate(mul)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -258,7 +262,7 @@ func (d Dec) MulTruncate(d2 Dec) Dec {
 func (d Dec) MulInt(i Int) Dec {
 	mul := new(big.Int).Mul(d.i, i.i)
 
-	if mul.BitLen() > 255+DecimalPrecisionBits {
+	if mul.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{mul}
@@ -268,7 +272,7 @@ func (d Dec) MulInt(i Int) Dec {
 func (d Dec) MulInt64(i int64) Dec {
 	mul := new(big.Int).Mul(d.i, big.NewInt(i))
 
-	if mul.BitLen() > 255+DecimalPrecisionBits {
+	if mul.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{mul}
@@ -283,7 +287,7 @@ func (d Dec) Quo(d2 Dec) Dec {
 	quo := new(big.Int).Quo(mul, d2.i)
 	chopped := chopPrecisionAndRound(quo)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -298,7 +302,7 @@ func (d Dec) QuoTruncate(d2 Dec) Dec {
 	quo := new(big.Int).Quo(mul, d2.i)
 	chopped := chopPrecisionAndTruncate(quo)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -313,12 +317,54 @@ func (d Dec) QuoRoundUp(d2 Dec) Dec {
 	quo := new(big.Int).Quo(mul, d2.i)
 	chopped := chopPrecisionAndRoundUp(quo)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
 }
 
+// SafeAdd adds two decimals and returns an error if the result overflows.
+func (d Dec) SafeAdd(d2 Dec) (Dec, error) {
+	res := new(big.Int).Add(d.i, d2.i)
+
+	if res.BitLen() > maxDecBitLen {
+		return Dec{}, ErrIntOverflow
+	}
+	return Dec{res}, nil
+}
+
+// SafeSub subtracts two decimals and returns an error if the result overflows.
+func (d Dec) SafeSub(d2 Dec) (Dec, error) {
+	res := new(big.Int).Sub(d.i, d2.i)
+
+	if res.BitLen() > maxDecBitLen {
+		return Dec{}, ErrIntOverflow
+	}
+	return Dec{res}, nil
+}
+
+// SafeMul multiplies two decimals, using bankers rounding, and returns an
+// error if the result overflows.
+func (d Dec) SafeMul(d2 Dec) (Dec, error) {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	chopped := chopPrecisionAndRound(mul)
+
+	if chopped.BitLen() > maxDecBitLen {
+		return Dec{}, ErrIntOverflow
+	}
+	return Dec{chopped}, nil
+}
+
+// SafeQuo divides two decimals, using bankers rounding, and returns an error
+// on division by zero or if the result overflows.
+func (d Dec) SafeQuo(d2 Dec) (Dec, error) {
+	if d2.IsZero() {
+		return Dec{}, ErrDivideByZero
+	}
+
+	return d.SafeQuoWithRounding(d2, RoundBankers)
+}
+
 // quotient
 func (d Dec) QuoInt(i Int) Dec {
 	mul := new(big.Int).Quo(d.i, i.i)
@@ -607,6 +653,94 @@ func (d Dec) Ceil() Dec {
 
 //___________________________________________________________________________________
 
+// RoundingMode defines how the digits of a decimal beyond the supported
+// precision, or beyond the integer part, are discarded.
+type RoundingMode int
+
+const (
+	// RoundTruncate discards the extra digits, rounding towards zero.
+	RoundTruncate RoundingMode = iota
+	// RoundBankers rounds to the nearest value, and to the nearest even value
+	// on a tie.
+	RoundBankers
+	// RoundCeil rounds towards positive infinity.
+	RoundCeil
+)
+
+func chopPrecisionWithRounding(d *big.Int, mode RoundingMode) *big.Int {
+	switch mode {
+	case RoundTruncate:
+		return chopPrecisionAndTruncate(d)
+	case RoundBankers:
+		return chopPrecisionAndRound(d)
+	case RoundCeil:
+		return chopPrecisionAndRoundUp(d)
+	default:
+		panic(fmt.Sprintf("invalid rounding mode %d", mode))
+	}
+}
+
+// RoundIntWithRounding returns the integer part of the decimal, rounded using
+// the given rounding mode.
+func (d Dec) RoundIntWithRounding(mode RoundingMode) Int {
+	return NewIntFromBigInt(chopPrecisionWithRounding(new(big.Int).Set(d.i), mode))
+}
+
+// MulWithRounding multiplies two decimals, rounding the result using the given
+// rounding mode.
+func (d Dec) MulWithRounding(d2 Dec, mode RoundingMode) Dec {
+	res, err := d.SafeMulWithRounding(d2, mode)
+	if err != nil {
+		panic("Int overflow")
+	}
+	return res
+}
+
+// SafeMulWithRounding multiplies two decimals, rounding the result using the
+// given rounding mode, and returns an error if the result overflows.
+func (d Dec) SafeMulWithRounding(d2 Dec, mode RoundingMode) (Dec, error) {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	chopped := chopPrecisionWithRounding(mul, mode)
+
+	if chopped.BitLen() > maxDecBitLen {
+		return Dec{}, ErrIntOverflow
+	}
+	return Dec{chopped}, nil
+}
+
+// QuoWithRounding divides two decimals, rounding the result using the given
+// rounding mode.
+func (d Dec) QuoWithRounding(d2 Dec, mode RoundingMode) Dec {
+	res, err := d.SafeQuoWithRounding(d2, mode)
+	if err != nil {
+		panic(err.Error())
+	}
+	return res
+}
+
+// SafeQuoWithRounding divides two decimals, rounding the result using the
+// given rounding mode, and returns an error on division by zero or if the
+// result overflows.
+func (d Dec) SafeQuoWithRounding(d2 Dec, mode RoundingMode) (Dec, error) {
+	if d2.IsZero() {
+		return Dec{}, ErrDivideByZero
+	}
+
+	// multiply precision twice
+	mul := new(big.Int).Mul(d.i, precisionReuse)
+	mul.Mul(mul, precisionReuse)
+
+	quo := new(big.Int).Quo(mul, d2.i)
+	chopped := chopPrecisionWithRounding(quo, mode)
+
+	if chopped.BitLen() > maxDecBitLen {
+		return Dec{}, ErrIntOverflow
+	}
+	return Dec{chopped}, nil
+}
+
+//___________________________________________________________________________________
+
 // MaxSortableDec is the largest Dec that can be passed into SortableDecBytes()
 // Its negative form is the least Dec that can be passed in.
 var MaxSortableDec = OneDec().Quo(SmallestDec())
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -270,6 +271,112 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestRoundingModes(t *testing.T) {
+	tests := []struct {
+		d1                               Dec
+		expTruncate, expBankers, expCeil int64
+	}{
+		{mustNewDecFromStr(t, "0"), 0, 0, 0},
+		{mustNewDecFromStr(t, "0.25"), 0, 0, 1},
+		{mustNewDecFromStr(t, "0.5"), 0, 0, 1},
+		{mustNewDecFromStr(t, "1.5"), 1, 2, 2},
+		{mustNewDecFromStr(t, "2.5"), 2, 2, 3},
+		{mustNewDecFromStr(t, "7.6"), 7, 8, 8},
+		{mustNewDecFromStr(t, "-0.25"), 0, 0, 0},
+		{mustNewDecFromStr(t, "-1.5"), -1, -2, -1},
+		{mustNewDecFromStr(t, "-7.6"), -7, -8, -7},
+		{mustNewDecFromStr(t, "3"), 3, 3, 3},
+	}
+
+	for tcIndex, tc := range tests {
+		require.Equal(t, tc.expTruncate, tc.d1.RoundIntWithRounding(RoundTruncate).Int64(), "truncate tc %d", tcIndex)
+		require.Equal(t, tc.expBankers, tc.d1.RoundIntWithRounding(RoundBankers).Int64(), "bankers tc %d", tcIndex)
+		require.Equal(t, tc.expCeil, tc.d1.RoundIntWithRounding(RoundCeil).Int64(), "ceil tc %d", tcIndex)
+	}
+
+	require.Panics(t, func() { OneDec().RoundIntWithRounding(RoundingMode(-1)) })
+}
+
+// Tests below use randomness to check properties of the rounding modes and
+// of the overflow-checked operations over arbitrary decimals.
+func randomDec() Dec {
+	return NewDecWithPrec(rand.Int63()-rand.Int63(), int64(rand.Intn(Precision+1)))
+}
+
+func TestRoundingModesProperties(t *testing.T) {
+	for n := 0; n < 1000; n++ {
+		d1, d2 := randomDec(), randomDec()
+
+		truncate := d1.RoundIntWithRounding(RoundTruncate)
+		bankers := d1.RoundIntWithRounding(RoundBankers)
+		ceil := d1.RoundIntWithRounding(RoundCeil)
+
+		require.True(t, truncate.Equal(d1.TruncateInt()), "d1 %s", d1)
+		require.True(t, bankers.Equal(d1.RoundInt()), "d1 %s", d1)
+		require.True(t, ceil.Equal(d1.Ceil().TruncateInt()), "d1 %s", d1)
+
+		// the rounded value is at most one away from the decimal
+		require.True(t, d1.Sub(bankers.ToDec()).Abs().LTE(OneDec()), "d1 %s", d1)
+		require.True(t, ceil.ToDec().GTE(d1), "d1 %s", d1)
+		require.True(t, ceil.ToDec().Sub(d1).LT(OneDec()), "d1 %s", d1)
+		if d1.IsInteger() {
+			require.True(t, truncate.Equal(ceil), "d1 %s", d1)
+		}
+
+		require.True(t, d1.MulWithRounding(d2, RoundBankers).Equal(d1.Mul(d2)), "d1 %s, d2 %s", d1, d2)
+		require.True(t, d1.MulWithRounding(d2, RoundTruncate).Equal(d1.MulTruncate(d2)), "d1 %s, d2 %s", d1, d2)
+
+		if d2.IsZero() {
+			continue
+		}
+
+		require.True(t, d1.QuoWithRounding(d2, RoundBankers).Equal(d1.Quo(d2)), "d1 %s, d2 %s", d1, d2)
+		require.True(t, d1.QuoWithRounding(d2, RoundTruncate).Equal(d1.QuoTruncate(d2)), "d1 %s, d2 %s", d1, d2)
+		require.True(t, d1.QuoWithRounding(d2, RoundCeil).Equal(d1.QuoRoundUp(d2)), "d1 %s, d2 %s", d1, d2)
+		require.True(t, d1.QuoWithRounding(d2, RoundCeil).GTE(d1.QuoWithRounding(d2, RoundTruncate)), "d1 %s, d2 %s", d1, d2)
+	}
+}
+
+func TestDecSafeArithmetic(t *testing.T) {
+	for n := 0; n < 1000; n++ {
+		d1, d2 := randomDec(), randomDec()
+
+		res, err := d1.SafeAdd(d2)
+		require.NoError(t, err)
+		require.True(t, d1.Add(d2).Equal(res))
+
+		res, err = d1.SafeSub(d2)
+		require.NoError(t, err)
+		require.True(t, d1.Sub(d2).Equal(res))
+
+		res, err = d1.SafeMul(d2)
+		require.NoError(t, err)
+		require.True(t, d1.Mul(d2).Equal(res))
+
+		if d2.IsZero() {
+			continue
+		}
+
+		res, err = d1.SafeQuo(d2)
+		require.NoError(t, err)
+		require.True(t, d1.Quo(d2).Equal(res))
+	}
+
+	largest := NewDecFromBigInt(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil))
+
+	_, err := largest.SafeMul(largest)
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = largest.SafeAdd(largest)
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = largest.Neg().SafeSub(largest)
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = largest.SafeQuo(SmallestDec())
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = OneDec().SafeQuo(ZeroDec())
+	require.Equal(t, ErrDivideByZero, err)
+	require.Panics(t, func() { OneDec().QuoWithRounding(ZeroDec(), RoundCeil) })
+}
+
 var cdc = codec.New()
 
 func TestDecMarshalJSON(t *testing.T) {
@@ -106,6 +106,13 @@ var (
 	// ErrInvalidType defines an error an invalid type.
 	ErrInvalidType = Register(RootCodespace, 29, "invalid type")
 
+	// ErrUnknownExtensionOptions defines an error for unknown extension options.
+	ErrUnknownExtensionOptions = Register(RootCodespace, 30, "unknown extension options")
+
+	// ErrTooManyRequests defines an error when a client exceeds the rate at
+	// which it may query a node.
+	ErrTooManyRequests = Register(RootCodespace, 31, "too many requests")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
@@ -120,9 +127,8 @@ var (
 //
 // Use this function only during a program startup phase.
 func Register(codespace string, code uint32, description string) *Error {
-	// TODO - uniqueness is (codespace, code) combo
 	if e := getUsed(codespace, code); e != nil {
-		panic(fmt.Sprintf("error with code %d is already registered: %q", code, e.desc))
+		panic(fmt.Sprintf("error with codespace %q and code %d is already registered: %q", codespace, code, e.desc))
 	}
 
 	err := New(codespace, code, description)
@@ -194,6 +200,14 @@ func (e Error) Codespace() string {
 	return e.codespace
 }
 
+// Wrap extends this error with an additional information.
+// It's a handy function to call Wrap with sdk errors.
+func (e *Error) Wrap(desc string) error { return Wrap(e, desc) }
+
+// Wrapf extends this error with an additional information.
+// It's a handy function to call Wrapf with sdk errors.
+func (e *Error) Wrapf(desc string, args ...interface{}) error { return Wrapf(e, desc, args...) }
+
 // Is check if given error instance is of a given kind/type. This involves
 // unwrapping given error using the Cause method if available.
 func (e *Error) Is(err error) bool {
@@ -315,6 +329,17 @@ func (e *wrappedError) Unwrap() error {
 	return e.parent
 }
 
+// IsOf checks if a received error is caused by one of the target errors.
+// It extends the errors.Is functionality to a list of errors.
+func IsOf(received error, targets ...error) bool {
+	for _, t := range targets {
+		if errors.Is(received, t) {
+			return true
+		}
+	}
+	return false
+}
+
 // Recover captures a panic and stop its propagation. If panic happens it is
 // transformed into a ErrPanic instance and assigned to given error. Call this
 // function using defer in order to work as expected.
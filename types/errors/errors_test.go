@@ -214,6 +214,32 @@ func TestABCIError(t *testing.T) {
 	require.Equal(t, "custom: unknown", ABCIError("unknown", 1, "custom").Error())
 }
 
+func TestErrorWrap(t *testing.T) {
+	err := ErrInsufficientFunds.Wrap("90 is smaller than 100")
+	require.Equal(t, "90 is smaller than 100: insufficient funds", err.Error())
+	require.True(t, ErrInsufficientFunds.Is(err))
+
+	err = ErrInsufficientFunds.Wrapf("%d is smaller than %d", 90, 100)
+	require.Equal(t, "90 is smaller than 100: insufficient funds", err.Error())
+	require.True(t, ErrInsufficientFunds.Is(err))
+}
+
+func TestIsOf(t *testing.T) {
+	var errNil *Error
+	err := ErrInvalidAddress.Wrap("bad address")
+
+	require.False(t, IsOf(err))
+	require.False(t, IsOf(err, errNil))
+	require.False(t, IsOf(err, ErrUnauthorized, ErrInsufficientFunds))
+	require.True(t, IsOf(err, ErrUnauthorized, ErrInvalidAddress))
+	require.True(t, IsOf(ErrInvalidAddress, ErrInvalidAddress))
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	require.Panics(t, func() { Register(RootCodespace, ErrTxDecode.ABCICode(), "duplicate") })
+	require.NotPanics(t, func() { Register(t.Name(), ErrTxDecode.ABCICode(), "other codespace") })
+}
+
 func ExampleWrap() {
 	err1 := Wrap(ErrInsufficientFunds, "90 is smaller than 100")
 	err2 := errors.Wrap(ErrInsufficientFunds, "90 is smaller than 100")
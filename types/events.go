@@ -132,12 +132,17 @@ func toBytes(i interface{}) []byte {
 
 // Common event types and attribute keys
 var (
-	EventTypeMessage = "message"
-
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	EventTypeMessage      = "message"
+	EventTypeBlockSummary = "block_summary"
+
+	AttributeKeyAction    = "action"
+	AttributeKeyModule    = "module"
+	AttributeKeySender    = "sender"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyNumTxs    = "num_txs"
+	AttributeKeyGasWanted = "gas_wanted"
+	AttributeKeyGasUsed   = "gas_used"
+	AttributeKeyProposer  = "proposer"
 )
 
 type (
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -11,6 +12,12 @@ import (
 
 const maxBitLen = 255
 
+// Arithmetic errors returned by the overflow-checked operations of Int and Dec.
+var (
+	ErrIntOverflow  = errors.New("integer overflow")
+	ErrDivideByZero = errors.New("division by zero")
+)
+
 func newIntegerFromString(s string) (*big.Int, bool) {
 	return new(big.Int).SetString(s, 0)
 }
@@ -217,14 +224,23 @@ func (i Int) LTE(i2 Int) bool {
 
 // Add adds Int from another
 func (i Int) Add(i2 Int) (res Int) {
-	res = Int{add(i.i, i2.i)}
-	// Check overflow
-	if res.i.BitLen() > maxBitLen {
+	res, err := i.SafeAdd(i2)
+	if err != nil {
 		panic("Int overflow")
 	}
 	return
 }
 
+// SafeAdd adds Int from another and returns an error if the result overflows.
+func (i Int) SafeAdd(i2 Int) (Int, error) {
+	res := Int{add(i.i, i2.i)}
+	// Check overflow
+	if res.i.BitLen() > maxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+	return res, nil
+}
+
 // AddRaw adds int64 to Int
 func (i Int) AddRaw(i2 int64) Int {
 	return i.Add(NewInt(i2))
@@ -232,14 +248,24 @@ func (i Int) AddRaw(i2 int64) Int {
 
 // Sub subtracts Int from another
 func (i Int) Sub(i2 Int) (res Int) {
-	res = Int{sub(i.i, i2.i)}
-	// Check overflow
-	if res.i.BitLen() > maxBitLen {
+	res, err := i.SafeSub(i2)
+	if err != nil {
 		panic("Int overflow")
 	}
 	return
 }
 
+// SafeSub subtracts Int from another and returns an error if the result
+// overflows.
+func (i Int) SafeSub(i2 Int) (Int, error) {
+	res := Int{sub(i.i, i2.i)}
+	// Check overflow
+	if res.i.BitLen() > maxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+	return res, nil
+}
+
 // SubRaw subtracts int64 from Int
 func (i Int) SubRaw(i2 int64) Int {
 	return i.Sub(NewInt(i2))
@@ -247,16 +273,25 @@ func (i Int) SubRaw(i2 int64) Int {
 
 // Mul multiples two Ints
 func (i Int) Mul(i2 Int) (res Int) {
+	res, err := i.SafeMul(i2)
+	if err != nil {
+		panic("Int overflow")
+	}
+	return
+}
+
+// SafeMul multiples two Ints and returns an error if the result overflows.
+func (i Int) SafeMul(i2 Int) (Int, error) {
 	// Check overflow
 	if i.i.BitLen()+i2.i.BitLen()-1 > maxBitLen {
-		panic("Int overflow")
+		return Int{}, ErrIntOverflow
 	}
-	res = Int{mul(i.i, i2.i)}
+	res := Int{mul(i.i, i2.i)}
 	// Check overflow if sign of both are same
 	if res.i.BitLen() > maxBitLen {
-		panic("Int overflow")
+		return Int{}, ErrIntOverflow
 	}
-	return
+	return res, nil
 }
 
 // MulRaw multipies Int and int64
@@ -273,6 +308,14 @@ func (i Int) Quo(i2 Int) (res Int) {
 	return Int{div(i.i, i2.i)}
 }
 
+// SafeQuo divides Int with Int and returns an error on division by zero.
+func (i Int) SafeQuo(i2 Int) (Int, error) {
+	if i2.i.Sign() == 0 {
+		return Int{}, ErrDivideByZero
+	}
+	return Int{div(i.i, i2.i)}, nil
+}
+
 // QuoRaw divides Int with int64
 func (i Int) QuoRaw(i2 int64) Int {
 	return i.Quo(NewInt(i2))
@@ -83,6 +83,27 @@ func TestIntPanic(t *testing.T) {
 	require.Panics(t, func() { i1.Quo(NewInt(0)) })
 }
 
+func TestSafeArithInt(t *testing.T) {
+	t.Parallel()
+	i3 := NewIntWithDecimal(3, 76)
+	intmax := NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1)))
+
+	_, err := i3.SafeAdd(i3)
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = i3.Neg().SafeSub(i3)
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = i3.SafeMul(i3)
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = intmax.SafeAdd(OneInt())
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = i3.SafeQuo(ZeroInt())
+	require.Equal(t, ErrDivideByZero, err)
+
+	res, err := intmax.SafeAdd(ZeroInt())
+	require.NoError(t, err)
+	require.True(t, intmax.Equal(res))
+}
+
 // Tests below uses randomness
 // Since we are using *big.Int as underlying value
 // and (U/)Int is immutable value(see TestImmutability(U/)Int)
@@ -152,6 +173,23 @@ func TestArithInt(t *testing.T) {
 		for tcnum, tc := range cases {
 			require.Equal(t, tc.nres, tc.ires.Int64(), "Int arithmetic operation does not match with int64 operation. tc #%d", tcnum)
 		}
+
+		// the overflow-checked operations match the panicking ones
+		safeCases := []struct {
+			fn  func(Int) (Int, error)
+			exp Int
+		}{
+			{i1.SafeAdd, i1.Add(i2)},
+			{i1.SafeSub, i1.Sub(i2)},
+			{i1.SafeMul, i1.Mul(i2)},
+			{i1.SafeQuo, i1.Quo(i2)},
+		}
+
+		for tcnum, tc := range safeCases {
+			res, err := tc.fn(i2)
+			require.NoError(t, err, "tc #%d", tcnum)
+			require.True(t, tc.exp.Equal(res), "Safe operation does not match with operation. tc #%d", tcnum)
+		}
 	}
 
 }
@@ -0,0 +1,89 @@
+package mempool
+
+import (
+	"container/list"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*FIFOMempool)(nil)
+
+// FIFOMempool is a Mempool selecting transactions in their insertion order.
+type FIFOMempool struct {
+	mtx   sync.Mutex
+	maxTx int
+	txs   *list.List
+	index map[string]*list.Element
+}
+
+// NewFIFOMempool returns a new FIFOMempool holding up to maxTx transactions. A
+// non-positive maxTx means the mempool size is unbounded.
+func NewFIFOMempool(maxTx int) *FIFOMempool {
+	return &FIFOMempool{
+		maxTx: maxTx,
+		txs:   list.New(),
+		index: make(map[string]*list.Element),
+	}
+}
+
+// Insert implements Mempool.Insert.
+func (mp *FIFOMempool) Insert(_ sdk.Context, _ sdk.Tx, txBytes []byte) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := txKey(txBytes)
+	if _, found := mp.index[key]; found {
+		return sdkerrors.ErrTxInMempoolCache
+	}
+
+	if mp.maxTx > 0 && mp.txs.Len() >= mp.maxTx {
+		return sdkerrors.ErrMempoolIsFull
+	}
+
+	mp.index[key] = mp.txs.PushBack(txBytes)
+	return nil
+}
+
+// Select implements Mempool.Select.
+func (mp *FIFOMempool) Select(maxBytes int64) [][]byte {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	var (
+		selected [][]byte
+		size     int64
+		fits     = true
+	)
+
+	for e := mp.txs.Front(); e != nil && fits; e = e.Next() {
+		selected, fits = selectTxs(selected, &size, maxBytes, e.Value.([]byte))
+	}
+
+	return selected
+}
+
+// CountTx implements Mempool.CountTx.
+func (mp *FIFOMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.txs.Len()
+}
+
+// Remove implements Mempool.Remove.
+func (mp *FIFOMempool) Remove(txBytes []byte) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := txKey(txBytes)
+	e, found := mp.index[key]
+	if !found {
+		return ErrTxNotFound
+	}
+
+	mp.txs.Remove(e)
+	delete(mp.index, key)
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+Package mempool defines an application side mempool, which keeps track of the
+transactions which passed CheckTx so that the application is able to order and
+select the transactions included in the blocks it proposes, instead of relying
+solely on the ordering of the Tendermint mempool.
+
+Three implementations are provided:
+
+  - FIFOMempool, which selects transactions in their insertion order
+  - PriorityMempool, which selects transactions by decreasing priority, e.g.
+    the fee offered per unit of gas
+  - SenderNonceMempool, which selects transactions by increasing nonce for
+    each sender, alternating between senders
+
+A Mempool is registered on a BaseApp with the baseapp.SetMempool option.
+*/
+package mempool
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrTxNotFound is returned when removing a transaction which is not in the
+// mempool.
+var ErrTxNotFound = errors.New("tx not found in mempool")
+
+// Mempool defines the interface of an application side mempool. Transactions
+// are identified by their raw bytes. Implementations must be safe for
+// concurrent use.
+type Mempool interface {
+	// Insert adds a transaction to the mempool. The context is the CheckTx
+	// context of the transaction, from before the transaction is run.
+	Insert(ctx sdk.Context, tx sdk.Tx, txBytes []byte) error
+
+	// Select returns the raw bytes of the transactions to include in a block,
+	// in order, up to a total of maxBytes. A non-positive maxBytes selects all
+	// the transactions of the mempool.
+	Select(maxBytes int64) [][]byte
+
+	// CountTx returns the number of transactions in the mempool.
+	CountTx() int
+
+	// Remove removes a transaction from the mempool. It returns ErrTxNotFound
+	// if the transaction is not in the mempool.
+	Remove(txBytes []byte) error
+}
+
+// txKey returns the key identifying a transaction in a mempool.
+func txKey(txBytes []byte) string {
+	hash := sha256.Sum256(txBytes)
+	return string(hash[:])
+}
+
+// selectTxs appends the given transactions to selected while their total size
+// does not exceed maxBytes, and returns whether more transactions fit.
+func selectTxs(selected [][]byte, size *int64, maxBytes int64, txs ...[]byte) ([][]byte, bool) {
+	for _, bz := range txs {
+		if maxBytes > 0 && *size+int64(len(bz)) > maxBytes {
+			return selected, false
+		}
+
+		*size += int64(len(bz))
+		selected = append(selected, bz)
+	}
+
+	return selected, true
+}
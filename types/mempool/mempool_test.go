@@ -0,0 +1,202 @@
+package mempool_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+type testTx struct {
+	sender string
+	nonce  uint64
+	fee    sdk.Coins
+	gas    uint64
+}
+
+var _ mempool.FeeTx = testTx{}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return nil }
+func (tx testTx) ValidateBasic() error { return nil }
+func (tx testTx) GetFee() sdk.Coins    { return tx.fee }
+func (tx testTx) GetGas() uint64       { return tx.gas }
+
+func (tx testTx) Bytes() []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s/%d", tx.sender, tx.nonce, tx.fee, tx.gas))
+}
+
+// noFeeTx is a transaction which neither pays fees nor has a sender.
+type noFeeTx struct{}
+
+func (noFeeTx) GetMsgs() []sdk.Msg   { return nil }
+func (noFeeTx) ValidateBasic() error { return nil }
+
+func testSenderNonce(_ sdk.Context, tx sdk.Tx) (string, uint64, error) {
+	ttx, ok := tx.(testTx)
+	if !ok {
+		return "", 0, errors.New("invalid tx")
+	}
+
+	return ttx.sender, ttx.nonce, nil
+}
+
+func newTestTx(sender string, nonce uint64, feeAmount int64) testTx {
+	return testTx{
+		sender: sender,
+		nonce:  nonce,
+		fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", feeAmount)),
+		gas:    10,
+	}
+}
+
+func testContext() sdk.Context {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	return sdk.NewContext(ms, abci.Header{}, true, log.NewNopLogger())
+}
+
+func insertTxs(t *testing.T, mp mempool.Mempool, txs ...testTx) {
+	ctx := testContext()
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx, tx.Bytes()))
+	}
+}
+
+func txsBytes(txs ...testTx) [][]byte {
+	bzs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bzs[i] = tx.Bytes()
+	}
+
+	return bzs
+}
+
+func TestMempools(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mempool func(maxTx int) mempool.Mempool
+	}{
+		{"fifo", func(maxTx int) mempool.Mempool { return mempool.NewFIFOMempool(maxTx) }},
+		{"priority", func(maxTx int) mempool.Mempool {
+			return mempool.NewPriorityMempool(mempool.FeePriority("stake"), maxTx)
+		}},
+		{"sender-nonce", func(maxTx int) mempool.Mempool {
+			return mempool.NewSenderNonceMempool(testSenderNonce, maxTx)
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testContext()
+			mp := tc.mempool(2)
+
+			tx1, tx2, tx3 := newTestTx("a", 0, 100), newTestTx("b", 0, 100), newTestTx("c", 0, 100)
+			insertTxs(t, mp, tx1, tx2)
+			require.Equal(t, 2, mp.CountTx())
+
+			err := mp.Insert(ctx, tx1, tx1.Bytes())
+			require.True(t, sdkerrors.ErrTxInMempoolCache.Is(err))
+
+			err = mp.Insert(ctx, tx3, tx3.Bytes())
+			require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+
+			require.Equal(t, txsBytes(tx1, tx2), mp.Select(0))
+			require.Equal(t, txsBytes(tx1), mp.Select(int64(len(tx1.Bytes())+1)))
+			require.Empty(t, mp.Select(1))
+
+			require.NoError(t, mp.Remove(tx1.Bytes()))
+			require.True(t, errors.Is(mp.Remove(tx1.Bytes()), mempool.ErrTxNotFound))
+			require.Equal(t, 1, mp.CountTx())
+			require.Equal(t, txsBytes(tx2), mp.Select(0))
+
+			insertTxs(t, mp, tx3)
+			require.Equal(t, txsBytes(tx2, tx3), mp.Select(0))
+		})
+	}
+}
+
+func TestFIFOMempool(t *testing.T) {
+	mp := mempool.NewFIFOMempool(0)
+
+	txs := []testTx{newTestTx("b", 1, 1), newTestTx("a", 0, 100), newTestTx("b", 0, 10)}
+	insertTxs(t, mp, txs...)
+
+	require.Equal(t, txsBytes(txs...), mp.Select(0))
+}
+
+func TestPriorityMempool(t *testing.T) {
+	mp := mempool.NewPriorityMempool(mempool.FeePriority("stake"), 0)
+
+	low, mid1, mid2, high := newTestTx("a", 0, 10), newTestTx("b", 0, 50), newTestTx("c", 0, 50), newTestTx("d", 0, 1000)
+	insertTxs(t, mp, low, mid1, high, mid2)
+
+	// equal priorities keep their insertion order
+	require.Equal(t, txsBytes(high, mid1, mid2, low), mp.Select(0))
+
+	require.NoError(t, mp.Remove(mid1.Bytes()))
+	require.Equal(t, txsBytes(high, mid2, low), mp.Select(0))
+
+	// a full mempool evicts its lowest priority tx for a higher priority one
+	mp = mempool.NewPriorityMempool(mempool.FeePriority("stake"), 2)
+	insertTxs(t, mp, low, mid1)
+	insertTxs(t, mp, high)
+	require.Equal(t, txsBytes(high, mid1), mp.Select(0))
+
+	err := mp.Insert(testContext(), low, low.Bytes())
+	require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+
+	// txs which do not pay fees cannot be prioritized by fee
+	err = mp.Insert(testContext(), noFeeTx{}, []byte("no fee"))
+	require.Error(t, err)
+}
+
+func TestFeePriority(t *testing.T) {
+	priorityFn := mempool.FeePriority("stake")
+
+	priority, err := priorityFn(testContext(), newTestTx("a", 0, 105))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), priority)
+
+	priority, err = priorityFn(testContext(), testTx{fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), gas: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), priority)
+
+	priority, err = priorityFn(testContext(), testTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 7))})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), priority)
+}
+
+func TestSenderNonceMempool(t *testing.T) {
+	mp := mempool.NewSenderNonceMempool(testSenderNonce, 0)
+
+	a0, a1, a2 := newTestTx("a", 0, 1), newTestTx("a", 1, 1), newTestTx("a", 2, 1)
+	b5, b6 := newTestTx("b", 5, 1), newTestTx("b", 6, 1)
+	insertTxs(t, mp, a2, b6, a0, b5, a1)
+
+	// senders alternate, each in nonce order
+	require.Equal(t, txsBytes(a0, b5, a1, b6, a2), mp.Select(0))
+
+	// a nonce of a sender cannot be in the mempool twice
+	conflict := newTestTx("a", 1, 1000)
+	err := mp.Insert(testContext(), conflict, conflict.Bytes())
+	require.True(t, sdkerrors.ErrInvalidSequence.Is(err))
+
+	require.NoError(t, mp.Remove(a0.Bytes()))
+	require.NoError(t, mp.Remove(b5.Bytes()))
+	require.NoError(t, mp.Remove(b6.Bytes()))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, txsBytes(a1, a2), mp.Select(0))
+
+	// the nonce function errors are returned
+	err = mp.Insert(testContext(), noFeeTx{}, []byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package mempool
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*PriorityMempool)(nil)
+
+// PriorityFunc returns the priority of a transaction inserted in a
+// PriorityMempool. Transactions with a higher priority are selected first.
+type PriorityFunc func(ctx sdk.Context, tx sdk.Tx) (int64, error)
+
+// FeeTx defines the interface of a transaction paying a fee, which is
+// prioritized by FeePriority.
+type FeeTx interface {
+	sdk.Tx
+	GetGas() uint64
+	GetFee() sdk.Coins
+}
+
+// FeePriority returns a PriorityFunc prioritizing transactions by the amount of
+// the given fee denomination they pay per unit of gas. Transactions paying no
+// fee in that denomination have the lowest priority.
+func FeePriority(denom string) PriorityFunc {
+	return func(_ sdk.Context, tx sdk.Tx) (int64, error) {
+		feeTx, ok := tx.(FeeTx)
+		if !ok {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (FeeTx)(nil), tx)
+		}
+
+		priority := feeTx.GetFee().AmountOf(denom)
+		if gas := feeTx.GetGas(); gas > 0 {
+			priority = priority.Quo(sdk.NewIntFromUint64(gas))
+		}
+
+		if !priority.IsInt64() {
+			return math.MaxInt64, nil
+		}
+
+		return priority.Int64(), nil
+	}
+}
+
+type priorityTx struct {
+	priority int64
+	seq      uint64
+	key      string
+	txBytes  []byte
+}
+
+// before returns true if tx is selected before other.
+func (tx priorityTx) before(other priorityTx) bool {
+	if tx.priority != other.priority {
+		return tx.priority > other.priority
+	}
+
+	return tx.seq < other.seq
+}
+
+// PriorityMempool is a Mempool selecting transactions by decreasing priority.
+// Transactions of equal priority are selected in their insertion order.
+//
+// NOTE: the priority does not account for the nonces of the transactions of a
+// sender, so transactions of a sender are expected to be submitted in order.
+type PriorityMempool struct {
+	mtx        sync.Mutex
+	maxTx      int
+	priorityFn PriorityFunc
+	seq        uint64
+	txs        []priorityTx
+	index      map[string]priorityTx
+}
+
+// NewPriorityMempool returns a new PriorityMempool ordering transactions with
+// the given PriorityFunc and holding up to maxTx transactions. A non-positive
+// maxTx means the mempool size is unbounded. When full, a transaction is only
+// inserted if its priority is higher than the lowest one of the mempool, which
+// is then evicted.
+func NewPriorityMempool(priorityFn PriorityFunc, maxTx int) *PriorityMempool {
+	return &PriorityMempool{
+		maxTx:      maxTx,
+		priorityFn: priorityFn,
+		index:      make(map[string]priorityTx),
+	}
+}
+
+// Insert implements Mempool.Insert.
+func (mp *PriorityMempool) Insert(ctx sdk.Context, tx sdk.Tx, txBytes []byte) error {
+	priority, err := mp.priorityFn(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := txKey(txBytes)
+	if _, found := mp.index[key]; found {
+		return sdkerrors.ErrTxInMempoolCache
+	}
+
+	if mp.maxTx > 0 && len(mp.txs) >= mp.maxTx {
+		lowest := mp.txs[len(mp.txs)-1]
+		if priority <= lowest.priority {
+			return sdkerrors.ErrMempoolIsFull
+		}
+
+		mp.remove(lowest)
+	}
+
+	ptx := priorityTx{priority: priority, seq: mp.seq, key: key, txBytes: txBytes}
+	mp.seq++
+
+	i := sort.Search(len(mp.txs), func(i int) bool { return ptx.before(mp.txs[i]) })
+	mp.txs = append(mp.txs, priorityTx{})
+	copy(mp.txs[i+1:], mp.txs[i:])
+	mp.txs[i] = ptx
+	mp.index[key] = ptx
+
+	return nil
+}
+
+// Select implements Mempool.Select.
+func (mp *PriorityMempool) Select(maxBytes int64) [][]byte {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	var (
+		selected [][]byte
+		size     int64
+		fits     = true
+	)
+
+	for i := 0; i < len(mp.txs) && fits; i++ {
+		selected, fits = selectTxs(selected, &size, maxBytes, mp.txs[i].txBytes)
+	}
+
+	return selected
+}
+
+// CountTx implements Mempool.CountTx.
+func (mp *PriorityMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return len(mp.txs)
+}
+
+// Remove implements Mempool.Remove.
+func (mp *PriorityMempool) Remove(txBytes []byte) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	ptx, found := mp.index[txKey(txBytes)]
+	if !found {
+		return ErrTxNotFound
+	}
+
+	mp.remove(ptx)
+	return nil
+}
+
+func (mp *PriorityMempool) remove(ptx priorityTx) {
+	i := sort.Search(len(mp.txs), func(i int) bool { return !mp.txs[i].before(ptx) })
+	mp.txs = append(mp.txs[:i], mp.txs[i+1:]...)
+	delete(mp.index, ptx.key)
+}
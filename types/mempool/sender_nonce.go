@@ -0,0 +1,157 @@
+package mempool
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*SenderNonceMempool)(nil)
+
+// SenderNonceFunc returns the sender of a transaction inserted in a
+// SenderNonceMempool and its nonce, e.g. the sequence of the account signing
+// the transaction.
+type SenderNonceFunc func(ctx sdk.Context, tx sdk.Tx) (sender string, nonce uint64, err error)
+
+type senderNonceTx struct {
+	sender  string
+	nonce   uint64
+	txBytes []byte
+}
+
+// SenderNonceMempool is a Mempool selecting the transactions of each sender by
+// increasing nonce. Senders are selected in turn, in lexicographic order, so
+// that a single sender cannot fill a block while others are waiting.
+type SenderNonceMempool struct {
+	mtx     sync.Mutex
+	maxTx   int
+	nonceFn SenderNonceFunc
+	senders map[string][]senderNonceTx
+	index   map[string]senderNonceTx
+}
+
+// NewSenderNonceMempool returns a new SenderNonceMempool using the given
+// SenderNonceFunc and holding up to maxTx transactions. A non-positive maxTx
+// means the mempool size is unbounded.
+func NewSenderNonceMempool(nonceFn SenderNonceFunc, maxTx int) *SenderNonceMempool {
+	return &SenderNonceMempool{
+		maxTx:   maxTx,
+		nonceFn: nonceFn,
+		senders: make(map[string][]senderNonceTx),
+		index:   make(map[string]senderNonceTx),
+	}
+}
+
+// Insert implements Mempool.Insert. It returns an ErrInvalidSequence error if
+// a transaction with the same sender and nonce is already in the mempool.
+func (mp *SenderNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx, txBytes []byte) error {
+	sender, nonce, err := mp.nonceFn(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := txKey(txBytes)
+	if _, found := mp.index[key]; found {
+		return sdkerrors.ErrTxInMempoolCache
+	}
+
+	if mp.maxTx > 0 && len(mp.index) >= mp.maxTx {
+		return sdkerrors.ErrMempoolIsFull
+	}
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	if i < len(txs) && txs[i].nonce == nonce {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidSequence, "tx with nonce %d of sender %s already in mempool", nonce, sender,
+		)
+	}
+
+	sntx := senderNonceTx{sender: sender, nonce: nonce, txBytes: txBytes}
+	txs = append(txs, senderNonceTx{})
+	copy(txs[i+1:], txs[i:])
+	txs[i] = sntx
+
+	mp.senders[sender] = txs
+	mp.index[key] = sntx
+
+	return nil
+}
+
+// Select implements Mempool.Select. Each round selects the transaction with
+// the next lowest nonce of every sender, and selection stops at the first
+// transaction which does not fit.
+func (mp *SenderNonceMempool) Select(maxBytes int64) [][]byte {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senders := make([]string, 0, len(mp.senders))
+	for sender := range mp.senders {
+		senders = append(senders, sender)
+	}
+
+	sort.Strings(senders)
+
+	var (
+		selected [][]byte
+		size     int64
+		fits     = true
+	)
+
+	for round, more := 0, true; more && fits; round++ {
+		more = false
+
+		for _, sender := range senders {
+			txs := mp.senders[sender]
+			if round >= len(txs) {
+				continue
+			}
+
+			if selected, fits = selectTxs(selected, &size, maxBytes, txs[round].txBytes); !fits {
+				break
+			}
+
+			more = true
+		}
+	}
+
+	return selected
+}
+
+// CountTx implements Mempool.CountTx.
+func (mp *SenderNonceMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return len(mp.index)
+}
+
+// Remove implements Mempool.Remove.
+func (mp *SenderNonceMempool) Remove(txBytes []byte) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	key := txKey(txBytes)
+	sntx, found := mp.index[key]
+	if !found {
+		return ErrTxNotFound
+	}
+
+	txs := mp.senders[sntx.sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= sntx.nonce })
+	txs = append(txs[:i], txs[i+1:]...)
+
+	if len(txs) == 0 {
+		delete(mp.senders, sntx.sender)
+	} else {
+		mp.senders[sntx.sender] = txs
+	}
+
+	delete(mp.index, key)
+	return nil
+}
@@ -0,0 +1,20 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlockVotesHandler is implemented by the modules handling the votes of
+// the validators for the previous block, e.g. to track their liveness. The
+// Manager calls HandleVotes right before the BeginBlock method of the module.
+type BeginBlockVotesHandler interface {
+	HandleVotes(ctx sdk.Context, votes []sdk.VoteInfo)
+}
+
+// BeginBlockMisbehaviorHandler is implemented by the modules handling the
+// evidence of misbehavior of the validators submitted by Tendermint. The
+// Manager calls HandleMisbehaviors right before the BeginBlock method of the
+// module.
+type BeginBlockMisbehaviorHandler interface {
+	HandleMisbehaviors(ctx sdk.Context, misbehaviors []sdk.Misbehavior)
+}
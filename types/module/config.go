@@ -0,0 +1,155 @@
+package module
+
+import (
+	"fmt"
+)
+
+// ModuleConfig defines whether a module is part of the application.
+type ModuleConfig struct {
+	Name    string `json:"name" yaml:"name" mapstructure:"name"`
+	Enabled bool   `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
+}
+
+// Config defines the ordered list of the modules an application binary is
+// built with, along with whether each of them is enabled. It allows a single
+// binary to toggle optional modules per network without code changes.
+type Config []ModuleConfig
+
+// Validate checks that every module in the config has a name and appears only
+// once.
+func (c Config) Validate() error {
+	seen := make(map[string]bool, len(c))
+	for _, mc := range c {
+		if mc.Name == "" {
+			return fmt.Errorf("module config with an empty name")
+		}
+
+		if seen[mc.Name] {
+			return fmt.Errorf("duplicate module config for %s", mc.Name)
+		}
+		seen[mc.Name] = true
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the module with the given name is listed in the
+// config and enabled.
+func (c Config) IsEnabled(name string) bool {
+	for _, mc := range c {
+		if mc.Name == name {
+			return mc.Enabled
+		}
+	}
+
+	return false
+}
+
+// EnabledModules returns the names of the enabled modules in config order.
+func (c Config) EnabledModules() []string {
+	names := make([]string, 0, len(c))
+	for _, mc := range c {
+		if mc.Enabled {
+			names = append(names, mc.Name)
+		}
+	}
+
+	return names
+}
+
+// FilterEnabled returns the given module names, in the given order, without
+// the ones that are not enabled. It is meant to be used with the Manager's
+// SetOrder* setters so that a single ordering can be shared by all networks.
+func (c Config) FilterEnabled(moduleNames ...string) []string {
+	names := make([]string, 0, len(moduleNames))
+	for _, name := range moduleNames {
+		if c.IsEnabled(name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// checkModules ensures that the config and the provided modules list exactly
+// the same set of module names.
+func (c Config) checkModules(moduleNames []string) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	provided := make(map[string]bool, len(moduleNames))
+	for _, name := range moduleNames {
+		provided[name] = true
+	}
+
+	for _, mc := range c {
+		if !provided[mc.Name] {
+			return fmt.Errorf("module %s is configured but not provided", mc.Name)
+		}
+	}
+
+	for _, name := range moduleNames {
+		if !c.has(name) {
+			return fmt.Errorf("module %s is provided but not configured", name)
+		}
+	}
+
+	return nil
+}
+
+func (c Config) has(name string) bool {
+	for _, mc := range c {
+		if mc.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewBasicManagerFromConfig creates a new BasicManager object holding only the
+// modules enabled in the config. Every provided module must be listed in the
+// config and vice versa.
+func NewBasicManagerFromConfig(config Config, modules ...AppModuleBasic) (BasicManager, error) {
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, module.Name())
+	}
+
+	if err := config.checkModules(names); err != nil {
+		return nil, err
+	}
+
+	enabled := make([]AppModuleBasic, 0, len(modules))
+	for _, module := range modules {
+		if config.IsEnabled(module.Name()) {
+			enabled = append(enabled, module)
+		}
+	}
+
+	return NewBasicManager(enabled...), nil
+}
+
+// NewManagerFromConfig creates a new Manager object holding only the modules
+// enabled in the config. Every provided module must be listed in the config
+// and vice versa. All the orders of the Manager default to the config order.
+func NewManagerFromConfig(config Config, modules ...AppModule) (*Manager, error) {
+	names := make([]string, 0, len(modules))
+	byName := make(map[string]AppModule, len(modules))
+	for _, module := range modules {
+		names = append(names, module.Name())
+		byName[module.Name()] = module
+	}
+
+	if err := config.checkModules(names); err != nil {
+		return nil, err
+	}
+
+	enabled := make([]AppModule, 0, len(modules))
+	for _, name := range config.EnabledModules() {
+		enabled = append(enabled, byName[name])
+	}
+
+	return NewManager(enabled...), nil
+}
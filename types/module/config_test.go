@@ -0,0 +1,77 @@
+package module_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/tests/mocks"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func TestConfig(t *testing.T) {
+	config := module.Config{
+		{Name: "module1", Enabled: true},
+		{Name: "module2", Enabled: false},
+		{Name: "module3", Enabled: true},
+	}
+	require.NoError(t, config.Validate())
+
+	require.True(t, config.IsEnabled("module1"))
+	require.False(t, config.IsEnabled("module2"))
+	require.False(t, config.IsEnabled("module4"))
+	require.Equal(t, []string{"module1", "module3"}, config.EnabledModules())
+	require.Equal(t, []string{"module3", "module1"}, config.FilterEnabled("module3", "module2", "module1"))
+
+	require.Error(t, module.Config{{Name: ""}}.Validate())
+	require.Error(t, module.Config{{Name: "module1"}, {Name: "module1", Enabled: true}}.Validate())
+}
+
+func TestNewBasicManagerFromConfig(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModuleBasic1 := mocks.NewMockAppModuleBasic(mockCtrl)
+	mockAppModuleBasic2 := mocks.NewMockAppModuleBasic(mockCtrl)
+	mockAppModuleBasic1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModuleBasic2.EXPECT().Name().AnyTimes().Return("module2")
+
+	config := module.Config{{Name: "module1", Enabled: true}, {Name: "module2", Enabled: false}}
+	bm, err := module.NewBasicManagerFromConfig(config, mockAppModuleBasic1, mockAppModuleBasic2)
+	require.NoError(t, err)
+	require.Len(t, bm, 1)
+	require.Equal(t, mockAppModuleBasic1, bm["module1"])
+
+	// every provided module must be configured and vice versa
+	_, err = module.NewBasicManagerFromConfig(config[:1], mockAppModuleBasic1, mockAppModuleBasic2)
+	require.Error(t, err)
+	_, err = module.NewBasicManagerFromConfig(config, mockAppModuleBasic1)
+	require.Error(t, err)
+}
+
+func TestNewManagerFromConfig(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule3.EXPECT().Name().AnyTimes().Return("module3")
+
+	config := module.Config{
+		{Name: "module3", Enabled: true},
+		{Name: "module2", Enabled: false},
+		{Name: "module1", Enabled: true},
+	}
+	mm, err := module.NewManagerFromConfig(config, mockAppModule1, mockAppModule2, mockAppModule3)
+	require.NoError(t, err)
+	require.Len(t, mm.Modules, 2)
+	require.Equal(t, []string{"module3", "module1"}, mm.OrderInitGenesis)
+	require.Equal(t, []string{"module3", "module1"}, mm.OrderBeginBlockers)
+
+	_, err = module.NewManagerFromConfig(config, mockAppModule1, mockAppModule2)
+	require.Error(t, err)
+}
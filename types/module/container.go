@@ -0,0 +1,223 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProviderInputs holds the values a Provider requires, indexed by key.
+type ProviderInputs map[string]interface{}
+
+// ProviderOutputs holds the values a Provider provides, indexed by key.
+type ProviderOutputs map[string]interface{}
+
+// Provider declares how a module builds its outputs (e.g. its keeper) from the
+// values it requires (e.g. store keys and the keepers of other modules).
+type Provider struct {
+	// Name identifies the provider in errors, usually the module name.
+	Name string
+	// Requires lists the keys of the values passed to Provide.
+	Requires []string
+	// Provides lists the keys of the values Provide must return.
+	Provides []string
+	// Provide builds the provided values.
+	Provide func(in ProviderInputs) (ProviderOutputs, error)
+}
+
+// Container is a lightweight dependency-injection container used to wire an
+// application. Values are supplied directly or built by providers, which are
+// run in dependency order. Invokers run once all the values are built and are
+// meant for wiring that would otherwise be circular, such as setting hooks on
+// a keeper that other keepers depend on.
+type Container struct {
+	values    map[string]interface{}
+	providers []Provider
+	invokers  []invoker
+	built     bool
+}
+
+type invoker struct {
+	requires []string
+	invoke   func(in ProviderInputs) error
+}
+
+// NewContainer creates a new, empty Container.
+func NewContainer() *Container {
+	return &Container{values: make(map[string]interface{})}
+}
+
+// Supply adds a value built outside of the container, such as a store key or
+// the codec.
+func (c *Container) Supply(key string, value interface{}) error {
+	if _, ok := c.values[key]; ok {
+		return fmt.Errorf("value %s is already supplied", key)
+	}
+
+	c.values[key] = value
+	return nil
+}
+
+// Provide registers a provider. It fails if a value it provides is already
+// supplied or provided.
+func (c *Container) Provide(p Provider) error {
+	if p.Provide == nil {
+		return fmt.Errorf("provider %s has no Provide function", p.Name)
+	}
+
+	for _, key := range p.Provides {
+		if _, ok := c.values[key]; ok {
+			return fmt.Errorf("provider %s: value %s is already supplied", p.Name, key)
+		}
+
+		for _, other := range c.providers {
+			for _, otherKey := range other.Provides {
+				if key == otherKey {
+					return fmt.Errorf("provider %s: value %s is already provided by %s", p.Name, key, other.Name)
+				}
+			}
+		}
+	}
+
+	c.providers = append(c.providers, p)
+	return nil
+}
+
+// Invoke registers a function that is run with the required values once all
+// the providers have been run, in registration order.
+func (c *Container) Invoke(requires []string, fn func(in ProviderInputs) error) {
+	c.invokers = append(c.invokers, invoker{requires: requires, invoke: fn})
+}
+
+// Build runs all the providers in dependency order, providers registered
+// first being run first when possible, and then all the invokers. It fails if
+// a required value is never provided or if providers depend on each other
+// circularly.
+func (c *Container) Build() error {
+	if c.built {
+		return fmt.Errorf("container is already built")
+	}
+
+	providedBy := make(map[string]string)
+	for _, p := range c.providers {
+		for _, key := range p.Provides {
+			providedBy[key] = p.Name
+		}
+	}
+
+	for _, p := range c.providers {
+		for _, key := range p.Requires {
+			if _, ok := c.values[key]; !ok && providedBy[key] == "" {
+				return fmt.Errorf("provider %s requires %s which is neither supplied nor provided", p.Name, key)
+			}
+		}
+	}
+
+	pending := append([]Provider(nil), c.providers...)
+	for len(pending) > 0 {
+		ready := -1
+		for i, p := range pending {
+			if c.hasAll(p.Requires) {
+				ready = i
+				break
+			}
+		}
+
+		if ready < 0 {
+			names := make([]string, len(pending))
+			for i, p := range pending {
+				names[i] = p.Name
+			}
+
+			return fmt.Errorf("circular dependency between providers: %s", strings.Join(names, ", "))
+		}
+
+		p := pending[ready]
+		if err := c.runProvider(p); err != nil {
+			return err
+		}
+
+		pending = append(pending[:ready], pending[ready+1:]...)
+	}
+
+	for i, inv := range c.invokers {
+		in, err := c.inputs(inv.requires)
+		if err != nil {
+			return fmt.Errorf("invoker %d: %w", i, err)
+		}
+
+		if err := inv.invoke(in); err != nil {
+			return fmt.Errorf("invoker %d: %w", i, err)
+		}
+	}
+
+	c.built = true
+	return nil
+}
+
+// Get returns the value with the given key, if it has been supplied or built.
+func (c *Container) Get(key string) (interface{}, bool) {
+	value, ok := c.values[key]
+	return value, ok
+}
+
+// MustGet returns the value with the given key and panics if it has not been
+// supplied or built.
+func (c *Container) MustGet(key string) interface{} {
+	value, ok := c.values[key]
+	if !ok {
+		panic(fmt.Sprintf("value %s not found in container", key))
+	}
+
+	return value
+}
+
+func (c *Container) runProvider(p Provider) error {
+	in, err := c.inputs(p.Requires)
+	if err != nil {
+		return fmt.Errorf("provider %s: %w", p.Name, err)
+	}
+
+	out, err := p.Provide(in)
+	if err != nil {
+		return fmt.Errorf("provider %s: %w", p.Name, err)
+	}
+
+	if len(out) != len(p.Provides) {
+		return fmt.Errorf("provider %s: expected %d values, got %d", p.Name, len(p.Provides), len(out))
+	}
+
+	for _, key := range p.Provides {
+		value, ok := out[key]
+		if !ok {
+			return fmt.Errorf("provider %s: value %s not provided", p.Name, key)
+		}
+
+		c.values[key] = value
+	}
+
+	return nil
+}
+
+func (c *Container) inputs(keys []string) (ProviderInputs, error) {
+	in := make(ProviderInputs, len(keys))
+	for _, key := range keys {
+		value, ok := c.values[key]
+		if !ok {
+			return nil, fmt.Errorf("value %s not found in container", key)
+		}
+
+		in[key] = value
+	}
+
+	return in, nil
+}
+
+func (c *Container) hasAll(keys []string) bool {
+	for _, key := range keys {
+		if _, ok := c.values[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,105 @@
+package module_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type mockKeeper struct {
+	name  string
+	deps  []*mockKeeper
+	hooks []string
+}
+
+func mockProvider(name string, requires ...string) module.Provider {
+	return module.Provider{
+		Name:     name,
+		Requires: requires,
+		Provides: []string{name},
+		Provide: func(in module.ProviderInputs) (module.ProviderOutputs, error) {
+			k := &mockKeeper{name: name}
+			for _, key := range requires {
+				if dep, ok := in[key].(*mockKeeper); ok {
+					k.deps = append(k.deps, dep)
+				}
+			}
+
+			return module.ProviderOutputs{name: k}, nil
+		},
+	}
+}
+
+func TestContainer(t *testing.T) {
+	c := module.NewContainer()
+	require.NoError(t, c.Supply("store", "store key"))
+	require.Error(t, c.Supply("store", "store key"))
+
+	// providers are registered out of dependency order
+	require.NoError(t, c.Provide(mockProvider("distribution", "staking", "bank")))
+	require.NoError(t, c.Provide(mockProvider("staking", "bank")))
+	require.NoError(t, c.Provide(mockProvider("bank", "store")))
+	require.Error(t, c.Provide(mockProvider("bank")))
+	require.Error(t, c.Provide(mockProvider("store")))
+
+	// hooks reference a keeper depending on the hooked keeper
+	c.Invoke([]string{"staking", "distribution"}, func(in module.ProviderInputs) error {
+		staking := in["staking"].(*mockKeeper)
+		staking.hooks = append(staking.hooks, in["distribution"].(*mockKeeper).name)
+		return nil
+	})
+
+	require.NoError(t, c.Build())
+	require.Error(t, c.Build())
+
+	staking := c.MustGet("staking").(*mockKeeper)
+	require.Equal(t, []string{"distribution"}, staking.hooks)
+	require.Equal(t, c.MustGet("bank"), staking.deps[0])
+
+	distr, ok := c.Get("distribution")
+	require.True(t, ok)
+	require.Len(t, distr.(*mockKeeper).deps, 2)
+
+	_, ok = c.Get("gov")
+	require.False(t, ok)
+	require.Panics(t, func() { c.MustGet("gov") })
+}
+
+func TestContainerErrors(t *testing.T) {
+	// missing dependency
+	c := module.NewContainer()
+	require.NoError(t, c.Provide(mockProvider("staking", "bank")))
+	require.Error(t, c.Build())
+
+	// circular dependency
+	c = module.NewContainer()
+	require.NoError(t, c.Provide(mockProvider("staking", "distribution")))
+	require.NoError(t, c.Provide(mockProvider("distribution", "staking")))
+	require.Error(t, c.Build())
+
+	// provider failure
+	errFail := errors.New("fail")
+	c = module.NewContainer()
+	require.NoError(t, c.Provide(module.Provider{
+		Name:     "bank",
+		Provides: []string{"bank"},
+		Provide: func(module.ProviderInputs) (module.ProviderOutputs, error) {
+			return nil, errFail
+		},
+	}))
+	require.True(t, errors.Is(c.Build(), errFail))
+
+	// undeclared outputs
+	c = module.NewContainer()
+	require.NoError(t, c.Provide(module.Provider{
+		Name:     "bank",
+		Provides: []string{"bank"},
+		Provide: func(module.ProviderInputs) (module.ProviderOutputs, error) {
+			return module.ProviderOutputs{"staking": nil}, nil
+		},
+	}))
+	require.Error(t, c.Build())
+}
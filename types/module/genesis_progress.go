@@ -0,0 +1,103 @@
+package module
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesisProgress reports the progress of InitGenesis for one module. It
+// is reported when the module starts initializing its genesis state and once
+// it is done.
+type InitGenesisProgress struct {
+	Module string
+	// Index is the position of the module among the modules with a genesis
+	// state, starting at 1, and Total is the number of these modules.
+	Index int
+	Total int
+	Done  bool
+	// StateSize is the size in bytes of the genesis state of the module.
+	StateSize int
+	// Writes is the number of store writes made by the module so far.
+	Writes      uint64
+	GasConsumed sdk.Gas
+	Elapsed     time.Duration
+}
+
+// InitGenesisProgressFunc is called with the progress of InitGenesis.
+type InitGenesisProgressFunc func(InitGenesisProgress)
+
+// SetInitGenesisProgress sets a function called when each module starts and
+// finishes initializing its genesis state, e.g. to report the progress of a
+// large import.
+func (m *Manager) SetInitGenesisProgress(fn InitGenesisProgressFunc) {
+	m.initGenesisProgress = fn
+}
+
+// SetInitGenesisGasReport sets the amount of gas after which the progress of a
+// module initializing its genesis state is logged, and then again every time
+// it consumes that amount of gas. Genesis state is not subject to gas limits,
+// so this reveals modules that keep importing for a long time. Zero disables
+// the reports.
+func (m *Manager) SetInitGenesisGasReport(gas sdk.Gas) {
+	m.initGenesisGasReport = gas
+}
+
+func (m *Manager) reportInitGenesisProgress(progress InitGenesisProgress) {
+	if m.initGenesisProgress != nil {
+		m.initGenesisProgress(progress)
+	}
+}
+
+// initGenesisGasMeter is the infinite gas meter of a module initializing its
+// genesis state. It counts the store writes and logs the progress of the module
+// every time it consumes the report amount of gas.
+type initGenesisGasMeter struct {
+	sdk.GasMeter
+
+	logger log.Logger
+	module string
+	start  time.Time
+	writes uint64
+	report sdk.Gas
+	next   sdk.Gas
+}
+
+func newInitGenesisGasMeter(logger log.Logger, module string, report sdk.Gas) *initGenesisGasMeter {
+	return &initGenesisGasMeter{
+		GasMeter: sdk.NewInfiniteGasMeter(),
+		logger:   logger,
+		module:   module,
+		start:    sdk.WallClockNow(),
+		report:   report,
+		next:     report,
+	}
+}
+
+func (gm *initGenesisGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	gm.GasMeter.ConsumeGas(amount, descriptor)
+
+	if descriptor == storetypes.GasWriteCostFlatDesc {
+		gm.writes++
+	}
+
+	if gm.report == 0 || gm.GasConsumed() < gm.next {
+		return
+	}
+
+	for gm.next <= gm.GasConsumed() {
+		gm.next += gm.report
+	}
+
+	gm.logger.Info(
+		"module is still initializing its genesis state",
+		"module", gm.module, "gas", gm.GasConsumed(), "writes", gm.writes, "elapsed", gm.elapsed(),
+	)
+}
+
+func (gm *initGenesisGasMeter) elapsed() time.Duration {
+	return sdk.WallClockNow().Sub(gm.start)
+}
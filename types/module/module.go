@@ -30,6 +30,8 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -37,6 +39,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -96,6 +99,26 @@ func (bm BasicManager) ValidateGenesis(cdc codec.JSONMarshaler, genesis map[stri
 	return nil
 }
 
+// ValidateGenesisAll performs genesis state validation for all modules in
+// alphabetical order and returns every error found, each prefixed with the
+// name of the module that reported it.
+func (bm BasicManager) ValidateGenesisAll(cdc codec.JSONMarshaler, genesis map[string]json.RawMessage) []error {
+	names := make([]string, 0, len(bm))
+	for name := range bm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if err := bm[name].ValidateGenesis(cdc, genesis[name]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return errs
+}
+
 // RegisterRESTRoutes registers all module rest routes
 func (bm BasicManager) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
 	for _, b := range bm {
@@ -194,8 +217,16 @@ type Manager struct {
 	Modules            map[string]AppModule
 	OrderInitGenesis   []string
 	OrderExportGenesis []string
+	OrderPreBlockers   []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+
+	initGenesisProgress  InitGenesisProgressFunc
+	initGenesisGasReport sdk.Gas
+
+	// set by PreBlock and reset by BeginBlock, so that a block whose
+	// pre-blockers were not run is detected
+	preBlockRun bool
 }
 
 // NewManager creates a new Manager object
@@ -212,6 +243,7 @@ func NewManager(modules ...AppModule) *Manager {
 		Modules:            moduleMap,
 		OrderInitGenesis:   modulesStr,
 		OrderExportGenesis: modulesStr,
+		OrderPreBlockers:   modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
 	}
@@ -227,6 +259,24 @@ func (m *Manager) SetOrderExportGenesis(moduleNames ...string) {
 	m.OrderExportGenesis = moduleNames
 }
 
+// SetOrderPreBlockers sets the order of set pre-blocker calls. It panics if a
+// module implementing PreBlockModule is missing, as skipping e.g. the upgrade
+// module would silently stop applying the upgrades.
+func (m *Manager) SetOrderPreBlockers(moduleNames ...string) {
+	ordered := make(map[string]bool, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		ordered[moduleName] = true
+	}
+
+	for moduleName, module := range m.Modules {
+		if _, ok := module.(PreBlockModule); ok && !ordered[moduleName] {
+			panic(fmt.Sprintf("pre-blocker module %s is missing from the pre-blockers order", moduleName))
+		}
+	}
+
+	m.OrderPreBlockers = moduleNames
+}
+
 // SetOrderBeginBlockers sets the order of set begin-blocker calls
 func (m *Manager) SetOrderBeginBlockers(moduleNames ...string) {
 	m.OrderBeginBlockers = moduleNames
@@ -244,11 +294,12 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
-// RegisterRoutes registers all module routes and module querier routes
+// RegisterRoutes registers all module routes and module querier routes. The
+// execution time of every message handler is measured per module.
 func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter) {
-	for _, module := range m.Modules {
+	for moduleName, module := range m.Modules {
 		if module.Route() != "" {
-			router.AddRoute(module.Route(), module.NewHandler())
+			router.AddRoute(module.Route(), measureHandler(moduleName, module.NewHandler()))
 		}
 		if module.QuerierRoute() != "" {
 			queryRouter.AddRoute(module.QuerierRoute(), module.NewQuerierHandler())
@@ -256,15 +307,65 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
-// InitGenesis performs init genesis functionality for modules
+// assertDeliverMode panics if the context is not in deliver mode, as block
+// execution must never depend on the state of CheckTx or of a simulation.
+func assertDeliverMode(ctx sdk.Context, method string) {
+	if mode := ctx.ExecMode(); mode != sdk.ExecModeDeliver {
+		panic(fmt.Sprintf("%s must be run in %s mode; got %s", method, sdk.ExecModeDeliver, mode))
+	}
+}
+
+// measureHandler wraps a module message handler to measure its execution time.
+func measureHandler(moduleName string, handler sdk.Handler) sdk.Handler {
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		defer telemetry.ModuleMeasureSince(moduleName, sdk.WallClockNow(), telemetry.MetricKeyHandler, msg.Type())
+		return handler(ctx, msg)
+	}
+}
+
+// InitGenesis performs init genesis functionality for modules. The start and
+// the end of each module are logged and reported to the progress function.
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
+	total := 0
+	for _, moduleName := range m.OrderInitGenesis {
+		if genesisData[moduleName] != nil {
+			total++
+		}
+	}
+
 	var validatorUpdates []abci.ValidatorUpdate
+	index := 0
 	for _, moduleName := range m.OrderInitGenesis {
 		if genesisData[moduleName] == nil {
 			continue
 		}
+		index++
+
+		// genesis state is not subject to gas limits
+		gasMeter := newInitGenesisGasMeter(ctx.Logger(), moduleName, m.initGenesisGasReport)
+		progress := InitGenesisProgress{
+			Module: moduleName, Index: index, Total: total, StateSize: len(genesisData[moduleName]),
+		}
+
+		ctx.Logger().Info("initializing genesis state", "module", moduleName, "index", index, "total", total, "size", progress.StateSize)
+		m.reportInitGenesisProgress(progress)
+
+		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx.WithGasMeter(gasMeter), cdc, genesisData[moduleName])
+
+		progress.Done = true
+		progress.Writes = gasMeter.writes
+		progress.GasConsumed = gasMeter.GasConsumed()
+		progress.Elapsed = gasMeter.elapsed()
 
-		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, genesisData[moduleName])
+		ctx.Logger().Info(
+			"initialized genesis state", "module", moduleName, "writes", progress.Writes,
+			"gas", progress.GasConsumed, "elapsed", progress.Elapsed,
+		)
+		m.reportInitGenesisProgress(progress)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -291,14 +392,64 @@ func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) map[st
 	return genesisData
 }
 
+// ExportGenesisForModules performs export genesis functionality for the given
+// modules only, or for all modules if none is provided. It returns an error if
+// one of the modules is not registered.
+func (m *Manager) ExportGenesisForModules(
+	ctx sdk.Context, cdc codec.JSONMarshaler, modulesToExport []string,
+) (map[string]json.RawMessage, error) {
+	if len(modulesToExport) == 0 {
+		return m.ExportGenesis(ctx, cdc), nil
+	}
+
+	toExport := make(map[string]bool, len(modulesToExport))
+	for _, moduleName := range modulesToExport {
+		if _, ok := m.Modules[moduleName]; !ok {
+			return nil, fmt.Errorf("unknown module: %s", moduleName)
+		}
+
+		toExport[moduleName] = true
+	}
+
+	genesisData := make(map[string]json.RawMessage)
+	for _, moduleName := range m.OrderExportGenesis {
+		if toExport[moduleName] {
+			genesisData[moduleName] = m.Modules[moduleName].ExportGenesis(ctx, cdc)
+		}
+	}
+
+	return genesisData, nil
+}
+
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. It panics if the context is not in deliver mode, or if some modules
+// implement PreBlockModule and PreBlock was not run for the block, e.g. because
+// the app did not set it as its pre-blocker.
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	assertDeliverMode(ctx, "BeginBlock")
+	m.assertPreBlockRun()
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+	// the ABCI request is parsed once for the modules handling its votes and
+	// evidence
+	votes := sdk.VoteInfosFromABCI(req.LastCommitInfo)
+	misbehaviors := sdk.MisbehaviorsFromABCI(req.ByzantineValidators)
+
 	for _, moduleName := range m.OrderBeginBlockers {
-		m.Modules[moduleName].BeginBlock(ctx, req)
+		start := sdk.WallClockNow()
+		mod := m.Modules[moduleName]
+
+		if handler, ok := mod.(BeginBlockVotesHandler); ok {
+			handler.HandleVotes(ctx, votes)
+		}
+
+		if handler, ok := mod.(BeginBlockMisbehaviorHandler); ok {
+			handler.HandleMisbehaviors(ctx, misbehaviors)
+		}
+
+		mod.BeginBlock(ctx, req)
+		telemetry.ModuleMeasureSince(moduleName, start, telemetry.MetricKeyBeginBlocker)
 	}
 
 	return abci.ResponseBeginBlock{
@@ -308,13 +459,16 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 
 // EndBlock performs end block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. It panics if the context is not in deliver mode.
 func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	assertDeliverMode(ctx, "EndBlock")
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
+		start := sdk.WallClockNow()
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
+		telemetry.ModuleMeasureSince(moduleName, start, telemetry.MetricKeyEndBlocker)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -1,18 +1,22 @@
 package module_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -32,7 +36,7 @@ func TestBasicManager(t *testing.T) {
 
 	mockAppModuleBasic1.EXPECT().Name().AnyTimes().Return("mockAppModuleBasic1")
 	mockAppModuleBasic1.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(``))
-	mockAppModuleBasic1.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Eq(wantDefaultGenesis["mockAppModuleBasic1"])).Times(1).Return(errFoo)
+	mockAppModuleBasic1.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Eq(wantDefaultGenesis["mockAppModuleBasic1"])).Times(2).Return(errFoo)
 	mockAppModuleBasic1.EXPECT().RegisterRESTRoutes(gomock.Eq(context.CLIContext{}), gomock.Eq(&mux.Router{})).Times(1)
 	mockAppModuleBasic1.EXPECT().RegisterCodec(gomock.Eq(cdc)).Times(1)
 	mockAppModuleBasic1.EXPECT().GetTxCmd(ctx).Times(1).Return(nil)
@@ -50,6 +54,10 @@ func TestBasicManager(t *testing.T) {
 
 	require.True(t, errors.Is(errFoo, mm.ValidateGenesis(cdc, wantDefaultGenesis)))
 
+	errs := mm.ValidateGenesisAll(cdc, wantDefaultGenesis)
+	require.Len(t, errs, 1)
+	require.True(t, errors.Is(errs[0], errFoo))
+
 	mm.RegisterRESTRoutes(context.CLIContext{}, &mux.Router{})
 
 	mockCmd := &cobra.Command{Use: "root"}
@@ -59,6 +67,7 @@ func TestBasicManager(t *testing.T) {
 
 	// validate genesis returns nil
 	require.Nil(t, module.NewBasicManager().ValidateGenesis(cdc, wantDefaultGenesis))
+	require.Empty(t, module.NewBasicManager().ValidateGenesisAll(cdc, wantDefaultGenesis))
 }
 
 func TestGenesisOnlyAppModule(t *testing.T) {
@@ -171,21 +180,63 @@ func TestManager_InitGenesis(t *testing.T) {
 	require.NotNil(t, mm)
 	require.Equal(t, 2, len(mm.Modules))
 
-	cdc, ctx := codec.New(), sdk.Context{}
+	// each module is given its own gas meter
+	cdc, ctx := codec.New(), sdk.Context{}.WithLogger(log.NewNopLogger())
 	genesisData := map[string]json.RawMessage{"module1": json.RawMessage(`{"key": "value"}`)}
 
-	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return(nil)
+	var progress []module.InitGenesisProgress
+	mm.SetInitGenesisProgress(func(p module.InitGenesisProgress) {
+		p.Elapsed = 0
+		progress = append(progress, p)
+	})
+
+	mockAppModule1.EXPECT().InitGenesis(gomock.Any(), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return(nil)
 	require.Equal(t, abci.ResponseInitChain{Validators: []abci.ValidatorUpdate(nil)}, mm.InitGenesis(ctx, cdc, genesisData))
+	require.Equal(t, []module.InitGenesisProgress{
+		{Module: "module1", Index: 1, Total: 1, StateSize: 16},
+		{Module: "module1", Index: 1, Total: 1, StateSize: 16, Done: true},
+	}, progress)
 
 	// test panic
 	genesisData = map[string]json.RawMessage{
 		"module1": json.RawMessage(`{"key": "value"}`),
 		"module2": json.RawMessage(`{"key": "value"}`)}
-	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return([]abci.ValidatorUpdate{{}})
-	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module2"])).Times(1).Return([]abci.ValidatorUpdate{{}})
+	mockAppModule1.EXPECT().InitGenesis(gomock.Any(), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return([]abci.ValidatorUpdate{{}})
+	mockAppModule2.EXPECT().InitGenesis(gomock.Any(), gomock.Eq(cdc), gomock.Eq(genesisData["module2"])).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.InitGenesis(ctx, cdc, genesisData) })
 }
 
+func TestManager_InitGenesisGasReport(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mm := module.NewManager(mockAppModule1)
+	mm.SetInitGenesisGasReport(100)
+
+	var progress module.InitGenesisProgress
+	mm.SetInitGenesisProgress(func(p module.InitGenesisProgress) { progress = p })
+
+	cdc, ctx := codec.New(), sdk.Context{}.WithLogger(log.NewNopLogger())
+	genesisData := map[string]json.RawMessage{"module1": json.RawMessage(`{}`)}
+
+	// genesis state is not subject to gas limits, but the writes are counted
+	mockAppModule1.EXPECT().InitGenesis(gomock.Any(), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).DoAndReturn(
+		func(ctx sdk.Context, _ codec.JSONMarshaler, _ json.RawMessage) []abci.ValidatorUpdate {
+			for i := 0; i < 3; i++ {
+				ctx.GasMeter().ConsumeGas(150, storetypes.GasWriteCostFlatDesc)
+			}
+			return nil
+		},
+	)
+	mm.InitGenesis(ctx, cdc, genesisData)
+
+	require.True(t, progress.Done)
+	require.Equal(t, uint64(3), progress.Writes)
+	require.Equal(t, sdk.Gas(450), progress.GasConsumed)
+}
+
 func TestManager_ExportGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -208,6 +259,54 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_ExportGenesisForModules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cdc, ctx := codec.New(), sdk.Context{}
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2": "value2"}`))
+
+	res, err := mm.ExportGenesisForModules(ctx, cdc, []string{"module2"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]json.RawMessage{"module2": json.RawMessage(`{"key2": "value2"}`)}, res)
+
+	_, err = mm.ExportGenesisForModules(ctx, cdc, []string{"module3"})
+	require.Error(t, err)
+}
+
+func TestManager_ExportGenesisTo(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cdc, ctx := codec.New(), sdk.Context{}
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1":"value1"}`))
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(2).Return(json.RawMessage(`{"key2":"value2"}`))
+
+	var buf bytes.Buffer
+	require.NoError(t, mm.ExportGenesisTo(ctx, cdc, &buf, nil))
+	require.Equal(t, `{"module1":{"key1":"value1"},"module2":{"key2":"value2"}}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, mm.ExportGenesisTo(ctx, cdc, &buf, []string{"module2"}))
+	require.Equal(t, `{"module2":{"key2":"value2"}}`, buf.String())
+
+	require.Error(t, mm.ExportGenesisTo(ctx, cdc, &buf, []string{"module3"}))
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -225,6 +324,129 @@ func TestManager_BeginBlock(t *testing.T) {
 	mockAppModule1.EXPECT().BeginBlock(gomock.Any(), gomock.Eq(req)).Times(1)
 	mockAppModule2.EXPECT().BeginBlock(gomock.Any(), gomock.Eq(req)).Times(1)
 	mm.BeginBlock(sdk.Context{}, req)
+
+	// blocks are only executed in deliver mode
+	require.Panics(t, func() { mm.BeginBlock(sdk.Context{}.WithExecMode(sdk.ExecModeCheck), req) })
+}
+
+type preBlockModule struct {
+	*mocks.MockAppModule
+
+	res sdk.ResponsePreBlock
+	err error
+}
+
+func (m preBlockModule) PreBlock(ctx sdk.Context) (sdk.ResponsePreBlock, error) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent("pre_block"))
+	return m.res, m.err
+}
+
+func TestManager_PreBlock(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	module1 := preBlockModule{MockAppModule: mocks.NewMockAppModule(mockCtrl)}
+	module2 := preBlockModule{
+		MockAppModule: mocks.NewMockAppModule(mockCtrl),
+		res:           sdk.ResponsePreBlock{ConsensusParamsChanged: true},
+	}
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	module1.EXPECT().Name().Times(2).Return("module1")
+	module2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule3.EXPECT().Name().Times(2).Return("module3")
+	mm := module.NewManager(module1, module2, mockAppModule3)
+
+	// modules which don't implement PreBlockModule are skipped
+	res, err := mm.PreBlock(sdk.Context{}, abci.RequestBeginBlock{})
+	require.NoError(t, err)
+	require.True(t, res.ConsensusParamsChanged)
+	require.Len(t, res.Events, 2)
+
+	mm.SetOrderPreBlockers("module2", "module1", "module3")
+	res, err = mm.PreBlock(sdk.Context{}, abci.RequestBeginBlock{})
+	require.NoError(t, err)
+	require.True(t, res.ConsensusParamsChanged)
+	require.Len(t, res.Events, 2)
+
+	// the pre-blocker modules cannot be left out of the order
+	require.Panics(t, func() { mm.SetOrderPreBlockers("module1", "module3") })
+	require.Equal(t, []string{"module2", "module1", "module3"}, mm.OrderPreBlockers)
+
+	module1.err = errors.New("upgrade needed")
+	mm = module.NewManager(module1, module2)
+	_, err = mm.PreBlock(sdk.Context{}, abci.RequestBeginBlock{})
+	require.Error(t, err)
+
+	// blocks are only executed in deliver mode
+	require.Panics(t, func() { mm.PreBlock(sdk.Context{}.WithExecMode(sdk.ExecModeCheck), abci.RequestBeginBlock{}) })
+}
+
+func TestManager_BeginBlockWithoutPreBlock(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	module1 := preBlockModule{MockAppModule: mocks.NewMockAppModule(mockCtrl)}
+	module1.EXPECT().Name().Times(2).Return("module1")
+	mm := module.NewManager(module1)
+
+	req := abci.RequestBeginBlock{Hash: []byte("test")}
+	module1.EXPECT().BeginBlock(gomock.Any(), gomock.Eq(req)).Times(1)
+
+	_, err := mm.PreBlock(sdk.Context{}, req)
+	require.NoError(t, err)
+	mm.BeginBlock(sdk.Context{}, req)
+
+	// the next block must run the pre-blockers again
+	require.Panics(t, func() { mm.BeginBlock(sdk.Context{}, req) })
+}
+
+type beginBlockHandlerModule struct {
+	*mocks.MockAppModule
+
+	votes        []sdk.VoteInfo
+	misbehaviors []sdk.Misbehavior
+}
+
+func (m *beginBlockHandlerModule) HandleVotes(_ sdk.Context, votes []sdk.VoteInfo) {
+	m.votes = votes
+}
+
+func (m *beginBlockHandlerModule) HandleMisbehaviors(_ sdk.Context, misbehaviors []sdk.Misbehavior) {
+	m.misbehaviors = misbehaviors
+}
+
+func TestManager_BeginBlockHandlers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	handlerModule := &beginBlockHandlerModule{MockAppModule: mocks.NewMockAppModule(mockCtrl)}
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	handlerModule.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(handlerModule, mockAppModule2)
+
+	val := abci.Validator{Address: []byte("validator"), Power: 10}
+	evTime := time.Unix(1600000000, 0).UTC()
+	req := abci.RequestBeginBlock{
+		LastCommitInfo: abci.LastCommitInfo{
+			Votes: []abci.VoteInfo{{Validator: val, SignedLastBlock: true}},
+		},
+		ByzantineValidators: []abci.Evidence{{
+			Type: "duplicate/vote", Validator: val, Height: 3, Time: evTime, TotalVotingPower: 30,
+		}},
+	}
+
+	handlerModule.EXPECT().BeginBlock(gomock.Any(), gomock.Eq(req)).Times(1)
+	mockAppModule2.EXPECT().BeginBlock(gomock.Any(), gomock.Eq(req)).Times(1)
+	mm.BeginBlock(sdk.Context{}, req)
+
+	require.Equal(t, []sdk.VoteInfo{{
+		ConsAddress: sdk.ConsAddress(val.Address), Power: 10, SignedLastBlock: true,
+	}}, handlerModule.votes)
+	require.Equal(t, []sdk.Misbehavior{{
+		Type: "duplicate/vote", ConsAddress: sdk.ConsAddress(val.Address), Power: 10,
+		Height: 3, Time: evTime, TotalVotingPower: 30,
+	}}, handlerModule.misbehaviors)
 }
 
 func TestManager_EndBlock(t *testing.T) {
@@ -0,0 +1,70 @@
+package module
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PreBlockModule is implemented by the modules which must run logic before the
+// BeginBlock of any module, e.g. the upgrade module, which applies upgrades
+// before any other module touches state in a new format.
+type PreBlockModule interface {
+	PreBlock(ctx sdk.Context) (sdk.ResponsePreBlock, error)
+}
+
+// PreBlock runs the PreBlock method of the modules implementing PreBlockModule,
+// in the order set with SetOrderPreBlockers. It creates a child context with
+// an event manager to aggregate events emitted from all modules, and signals a
+// change of the consensus params if any module did. It panics if the context
+// is not in deliver mode.
+func (m *Manager) PreBlock(ctx sdk.Context, _ abci.RequestBeginBlock) (sdk.ResponsePreBlock, error) {
+	assertDeliverMode(ctx, "PreBlock")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	m.preBlockRun = true
+
+	var paramsChanged bool
+	for _, moduleName := range m.OrderPreBlockers {
+		mod, ok := m.Modules[moduleName].(PreBlockModule)
+		if !ok {
+			continue
+		}
+
+		start := sdk.WallClockNow()
+		res, err := mod.PreBlock(ctx)
+		telemetry.ModuleMeasureSince(moduleName, start, telemetry.MetricKeyPreBlocker)
+
+		if err != nil {
+			return sdk.ResponsePreBlock{}, fmt.Errorf("%s pre-blocker: %w", moduleName, err)
+		}
+
+		paramsChanged = paramsChanged || res.ConsensusParamsChanged
+	}
+
+	return sdk.ResponsePreBlock{
+		ConsensusParamsChanged: paramsChanged,
+		Events:                 ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// assertPreBlockRun panics if a module implements PreBlockModule and PreBlock
+// was not run since the last block, and resets the check for the next block.
+func (m *Manager) assertPreBlockRun() {
+	preBlockRun := m.preBlockRun
+	m.preBlockRun = false
+
+	if preBlockRun {
+		return
+	}
+
+	for moduleName, module := range m.Modules {
+		if _, ok := module.(PreBlockModule); ok {
+			panic(fmt.Sprintf(
+				"pre-blocker of module %s was not run; the app must set Manager.PreBlock as its pre-blocker", moduleName,
+			))
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package module
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreModule is an interface that modules can implement in order to declare
+// the KV, transient and memory store keys they use, so that the application
+// mounts exactly the stores its modules need.
+type StoreModule interface {
+	StoreKeys() []sdk.StoreKey
+}
+
+// StoreKeys holds the store keys declared by the modules of an application,
+// indexed by store name.
+type StoreKeys map[string]sdk.StoreKey
+
+// StoreKeys returns the store keys declared by all the modules which implement
+// StoreModule in the manager. It panics if two keys share a store name, as
+// they could not be mounted on the same multistore.
+func (bm BasicManager) StoreKeys() StoreKeys {
+	keys := make(StoreKeys)
+	for _, m := range bm {
+		sm, ok := m.(StoreModule)
+		if !ok {
+			continue
+		}
+
+		for _, key := range sm.StoreKeys() {
+			if _, ok := keys[key.Name()]; ok {
+				panic(fmt.Sprintf("store key %s is declared more than once", key.Name()))
+			}
+			keys[key.Name()] = key
+		}
+	}
+
+	return keys
+}
+
+// KVStoreKeys returns the KV store keys indexed by store name.
+func (sk StoreKeys) KVStoreKeys() map[string]*sdk.KVStoreKey {
+	keys := make(map[string]*sdk.KVStoreKey)
+	for name, key := range sk {
+		if kvKey, ok := key.(*sdk.KVStoreKey); ok {
+			keys[name] = kvKey
+		}
+	}
+
+	return keys
+}
+
+// TransientStoreKeys returns the transient store keys indexed by store name.
+func (sk StoreKeys) TransientStoreKeys() map[string]*sdk.TransientStoreKey {
+	keys := make(map[string]*sdk.TransientStoreKey)
+	for name, key := range sk {
+		if tKey, ok := key.(*sdk.TransientStoreKey); ok {
+			keys[name] = tKey
+		}
+	}
+
+	return keys
+}
+
+// MemoryStoreKeys returns the memory store keys indexed by store name.
+func (sk StoreKeys) MemoryStoreKeys() map[string]*sdk.MemoryStoreKey {
+	keys := make(map[string]*sdk.MemoryStoreKey)
+	for name, key := range sk {
+		if memKey, ok := key.(*sdk.MemoryStoreKey); ok {
+			keys[name] = memKey
+		}
+	}
+
+	return keys
+}
+
+// Keys returns all the store keys sorted by store name, e.g. to mount them with
+// BaseApp.MountStores.
+func (sk StoreKeys) Keys() []sdk.StoreKey {
+	names := make([]string, 0, len(sk))
+	for name := range sk {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]sdk.StoreKey, len(names))
+	for i, name := range names {
+		keys[i] = sk[name]
+	}
+
+	return keys
+}
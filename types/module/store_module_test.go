@@ -0,0 +1,59 @@
+package module_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/tests/mocks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type storeModule struct {
+	*mocks.MockAppModuleBasic
+	keys []sdk.StoreKey
+}
+
+func (sm storeModule) StoreKeys() []sdk.StoreKey { return sm.keys }
+
+func newStoreModule(ctrl *gomock.Controller, name string, keys ...sdk.StoreKey) storeModule {
+	mockAppModuleBasic := mocks.NewMockAppModuleBasic(ctrl)
+	mockAppModuleBasic.EXPECT().Name().AnyTimes().Return(name)
+
+	return storeModule{mockAppModuleBasic, keys}
+}
+
+func TestBasicManagerStoreKeys(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	kvKey := sdk.NewKVStoreKey("kv")
+	tKey := sdk.NewTransientStoreKey("transient_kv")
+	memKey := sdk.NewMemoryStoreKey("mem_kv")
+	otherKey := sdk.NewKVStoreKey("other")
+
+	// modules without stores are skipped
+	noStoreModule := mocks.NewMockAppModuleBasic(mockCtrl)
+	noStoreModule.EXPECT().Name().AnyTimes().Return("nostore")
+
+	bm := module.NewBasicManager(
+		newStoreModule(mockCtrl, "kv", kvKey, tKey, memKey),
+		newStoreModule(mockCtrl, "other", otherKey),
+		noStoreModule,
+	)
+
+	storeKeys := bm.StoreKeys()
+	require.Equal(t, map[string]*sdk.KVStoreKey{"kv": kvKey, "other": otherKey}, storeKeys.KVStoreKeys())
+	require.Equal(t, map[string]*sdk.TransientStoreKey{"transient_kv": tKey}, storeKeys.TransientStoreKeys())
+	require.Equal(t, map[string]*sdk.MemoryStoreKey{"mem_kv": memKey}, storeKeys.MemoryStoreKeys())
+	require.Equal(t, []sdk.StoreKey{kvKey, memKey, otherKey, tKey}, storeKeys.Keys())
+
+	// two modules can't declare the same store
+	bm = module.NewBasicManager(
+		newStoreModule(mockCtrl, "kv", kvKey),
+		newStoreModule(mockCtrl, "other", sdk.NewKVStoreKey("kv")),
+	)
+	require.Panics(t, func() { bm.StoreKeys() })
+}
@@ -0,0 +1,74 @@
+package module
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StreamingGenesisModule is an interface that modules with a large genesis
+// state, e.g. one entry per account, can implement in order to write their
+// exported genesis state as JSON to a writer instead of building it in memory.
+type StreamingGenesisModule interface {
+	ExportGenesisTo(sdk.Context, codec.JSONMarshaler, io.Writer) error
+}
+
+// ExportGenesisTo writes the exported genesis state of the given modules, or of
+// all modules if none is provided, as a JSON object indexed by module name. The
+// modules which implement StreamingGenesisModule stream their state to w, so
+// that at most the state of one non-streaming module is held in memory.
+func (m *Manager) ExportGenesisTo(
+	ctx sdk.Context, cdc codec.JSONMarshaler, w io.Writer, modulesToExport []string,
+) error {
+	toExport := make(map[string]bool, len(modulesToExport))
+	for _, moduleName := range modulesToExport {
+		if _, ok := m.Modules[moduleName]; !ok {
+			return fmt.Errorf("unknown module: %s", moduleName)
+		}
+
+		toExport[moduleName] = true
+	}
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+
+	first := true
+	for _, moduleName := range m.OrderExportGenesis {
+		if len(toExport) > 0 && !toExport[moduleName] {
+			continue
+		}
+
+		sep := ","
+		if first {
+			sep = ""
+		}
+		first = false
+
+		if _, err := fmt.Fprintf(w, "%s%q:", sep, moduleName); err != nil {
+			return err
+		}
+
+		if sm, ok := m.Modules[moduleName].(StreamingGenesisModule); ok {
+			if err := sm.ExportGenesisTo(ctx, cdc, w); err != nil {
+				return fmt.Errorf("failed to export %s genesis state: %w", moduleName, err)
+			}
+
+			continue
+		}
+
+		bz := m.Modules[moduleName].ExportGenesis(ctx, cdc)
+		if len(bz) == 0 {
+			bz = []byte("null")
+		}
+
+		if _, err := w.Write(bz); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "}")
+	return err
+}
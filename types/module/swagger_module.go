@@ -0,0 +1,23 @@
+package module
+
+// SwaggerModule is an interface that modules can implement in order to
+// contribute the Swagger (OpenAPI 2.0) specification of the REST routes they
+// register. The specification is expected to be YAML or JSON encoded.
+type SwaggerModule interface {
+	SwaggerSpec() []byte
+}
+
+// SwaggerSpecs returns the Swagger specifications, keyed by module name, of all
+// the modules which implement SwaggerModule in the manager.
+func (bm BasicManager) SwaggerSpecs() map[string][]byte {
+	specs := make(map[string][]byte)
+	for name, m := range bm {
+		sm, ok := m.(SwaggerModule)
+		if !ok {
+			continue
+		}
+		specs[name] = sm.SwaggerSpec()
+	}
+
+	return specs
+}
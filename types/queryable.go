@@ -7,3 +7,8 @@ import (
 // Querier defines a function type that a module querier must implement to handle
 // custom client queries.
 type Querier = func(ctx Context, path []string, req abci.RequestQuery) ([]byte, error)
+
+// QuerierMiddleware wraps the Querier registered under a query route, e.g. to
+// log, measure, rate limit or cache the queries made to that route. It returns
+// the Querier to execute in place of next.
+type QuerierMiddleware = func(route string, next Querier) Querier
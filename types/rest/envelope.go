@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ResponseEnvelope defines the response object of the versioned REST API. It
+// wraps the original response with the chain ID and the height at which it was
+// queried, so that clients can detect responses of another chain or stale ones.
+type ResponseEnvelope struct {
+	ChainID string          `json:"chain_id"`
+	Height  int64           `json:"height"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// NewResponseEnvelope creates a new ResponseEnvelope instance
+func NewResponseEnvelope(chainID string, height int64, result json.RawMessage) ResponseEnvelope {
+	return ResponseEnvelope{
+		ChainID: chainID,
+		Height:  height,
+		Result:  result,
+	}
+}
+
+// envelopeWriter marks the responses of the versioned REST API, which
+// PostProcessResponse and PostProcessResponseBare wrap in a ResponseEnvelope.
+type envelopeWriter struct {
+	http.ResponseWriter
+
+	chainID func() string
+}
+
+// Flush implements http.Flusher if the wrapped writer does.
+func (ew *envelopeWriter) Flush() {
+	if f, ok := ew.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker so that WebSocket connections, such as the
+// ones of the subscribe route, can be upgraded under the versioned REST API.
+func (ew *envelopeWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := ew.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+
+	return h.Hijack()
+}
+
+// EnvelopeMiddleware returns a middleware wrapping the responses written with
+// PostProcessResponse and PostProcessResponseBare in a ResponseEnvelope holding
+// the chain ID returned by chainID. Since chainID may have to query the node, it
+// is only called when an envelope is written. Error responses are left unchanged.
+func EnvelopeMiddleware(chainID func() string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(&envelopeWriter{ResponseWriter: w, chainID: chainID}, r)
+		})
+	}
+}
+
+// writeEnvelope writes the result in a ResponseEnvelope if w serves the
+// versioned REST API and returns false otherwise.
+func writeEnvelope(w http.ResponseWriter, ctx context.CLIContext, result []byte) bool {
+	ew, ok := w.(*envelopeWriter)
+	if !ok {
+		return false
+	}
+
+	// the result of bare responses can be plain text
+	if !json.Valid(result) {
+		bz, err := json.Marshal(string(result))
+		if CheckInternalServerError(w, err) {
+			return true
+		}
+
+		result = bz
+	}
+
+	output, err := json.Marshal(NewResponseEnvelope(ew.chainID(), ctx.Height, result))
+	if ctx.Indent && err == nil {
+		output, err = codec.MarshalIndentFromJSON(output)
+	}
+
+	if CheckInternalServerError(w, err) {
+		return true
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(output)
+
+	return true
+}
@@ -24,6 +24,10 @@ const (
 	DefaultLimit   = 30             // should be consistent with tendermint/tendermint/rpc/core/pipe.go:19
 	TxMinHeightKey = "tx.minheight" // Inclusive minimum height filter
 	TxMaxHeightKey = "tx.maxheight" // Inclusive maximum height filter
+	OrderByKey     = "order_by"     // Ordering of returned results by height
+
+	OrderByAsc  = "asc"
+	OrderByDesc = "desc"
 )
 
 // ResponseWithHeight defines a response object type that wraps an original
@@ -286,6 +290,10 @@ func PostProcessResponseBare(w http.ResponseWriter, ctx context.CLIContext, body
 		}
 	}
 
+	if writeEnvelope(w, ctx, resp) {
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	_, _ = w.Write(resp)
 }
@@ -330,6 +338,10 @@ func PostProcessResponse(w http.ResponseWriter, ctx context.CLIContext, resp int
 		}
 	}
 
+	if writeEnvelope(w, ctx, result) {
+		return
+	}
+
 	wrappedResp := NewResponseWithHeight(ctx.Height, result)
 
 	output, err := marshaler.MarshalJSON(wrappedResp)
@@ -352,7 +364,7 @@ func ParseHTTPArgsWithLimit(r *http.Request, defaultLimit int) (tags []string, p
 	tags = make([]string, 0, len(r.Form))
 
 	for key, values := range r.Form {
-		if key == "page" || key == "limit" {
+		if key == "page" || key == "limit" || key == OrderByKey {
 			continue
 		}
 
@@ -415,6 +427,21 @@ func ParseHTTPArgs(r *http.Request) (tags []string, page, limit int, err error)
 	return ParseHTTPArgsWithLimit(r, DefaultLimit)
 }
 
+// ParseOrderBy parses the order_by query parameter of a search request. It
+// returns an empty string when the parameter is absent, leaving the ordering
+// to the node's default, and an error for anything other than asc or desc.
+func ParseOrderBy(r *http.Request) (string, error) {
+	orderBy := strings.ToLower(r.FormValue(OrderByKey))
+
+	switch orderBy {
+	case "", OrderByAsc, OrderByDesc:
+		return orderBy, nil
+
+	default:
+		return "", fmt.Errorf("invalid %s value %q; must be either %s or %s", OrderByKey, orderBy, OrderByAsc, OrderByDesc)
+	}
+}
+
 // ParseQueryParamBool parses the given param to a boolean. It returns false by
 // default if the string is not parseable to bool.
 func ParseQueryParamBool(r *http.Request, param string) bool {
@@ -1,9 +1,11 @@
 package rest_test
 
 import (
+	"bufio"
 	"errors"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"sort"
@@ -93,6 +95,7 @@ func TestParseHTTPArgs(t *testing.T) {
 	req4 := mustNewRequest(t, "", "/?foo=faa", nil)
 
 	reqTxH := mustNewRequest(t, "", "/?tx.minheight=12&tx.maxheight=14", nil)
+	reqOrder := mustNewRequest(t, "", "/?foo=faa&order_by=desc", nil)
 
 	tests := []struct {
 		name  string
@@ -113,6 +116,7 @@ func TestParseHTTPArgs(t *testing.T) {
 
 		{"tags", req4, httptest.NewRecorder(), []string{"foo='faa'"}, rest.DefaultPage, rest.DefaultLimit, false},
 		{"tags", reqTxH, httptest.NewRecorder(), []string{"tx.height<=14", "tx.height>=12"}, rest.DefaultPage, rest.DefaultLimit, false},
+		{"order by is not a tag", reqOrder, httptest.NewRecorder(), []string{"foo='faa'"}, rest.DefaultPage, rest.DefaultLimit, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -133,6 +137,33 @@ func TestParseHTTPArgs(t *testing.T) {
 	}
 }
 
+func TestParseOrderBy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		req     *http.Request
+		orderBy string
+		err     bool
+	}{
+		{"no order", mustNewRequest(t, "", "/", nil), "", false},
+		{"asc", mustNewRequest(t, "", "/?order_by=asc", nil), rest.OrderByAsc, false},
+		{"desc upper case", mustNewRequest(t, "", "/?order_by=DESC", nil), rest.OrderByDesc, false},
+		{"invalid", mustNewRequest(t, "", "/?order_by=height", nil), "", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			orderBy, err := rest.ParseOrderBy(tt.req)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.orderBy, orderBy)
+			}
+		})
+	}
+}
+
 func TestParseQueryHeight(t *testing.T) {
 	t.Parallel()
 	var emptyHeight int64
@@ -228,6 +259,74 @@ func TestProcessPostResponse(t *testing.T) {
 	runPostProcessResponse(t, ctx, acc, expectedWithIndent, true)
 }
 
+func TestEnvelopeMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.CLIContext{}.WithCodec(codec.New()).WithHeight(42)
+	var chainIDCalls int
+	handler := rest.EnvelopeMiddleware(func() string { chainIDCalls++; return "testchain" })(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/bare":
+				rest.PostProcessResponseBare(w, ctx, []byte("text string"))
+			case "/error":
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid request")
+			case "/stream":
+				w.(http.Flusher).Flush()
+			case "/subscribe":
+				_, _, err := w.(http.Hijacker).Hijack()
+				require.NoError(t, err)
+			default:
+				rest.PostProcessResponse(w, ctx, struct {
+					X int `json:"x"`
+				}{X: 10})
+			}
+		}),
+	)
+
+	tests := []struct {
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{"/", http.StatusOK, `{"chain_id":"testchain","height":42,"result":{"x":"10"}}`},
+		{"/bare", http.StatusOK, `{"chain_id":"testchain","height":42,"result":"text string"}`},
+		{"/error", http.StatusBadRequest, `{"error":"invalid request"}`},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+		require.Equal(t, tt.wantCode, w.Code, tt.path)
+		require.Equal(t, tt.wantBody, w.Body.String(), tt.path)
+	}
+
+	// the chain ID is only resolved for the envelopes
+	require.Equal(t, 2, chainIDCalls)
+
+	// flushing and hijacking pass through to the wrapped writer
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stream", nil))
+	require.True(t, w.Flushed)
+
+	hw := &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}
+	handler.ServeHTTP(hw, httptest.NewRequest(http.MethodGet, "/subscribe", nil))
+	require.True(t, hw.hijacked)
+	require.Equal(t, 2, chainIDCalls)
+}
+
+type hijackRecorder struct {
+	*httptest.ResponseRecorder
+
+	hijacked bool
+}
+
+func (hr *hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hr.hijacked = true
+	return nil, nil, nil
+}
+
 func TestReadRESTReq(t *testing.T) {
 	t.Parallel()
 	reqBody := ioutil.NopCloser(strings.NewReader(`{"chain_id":"alessio","memo":"text"}`))
@@ -7,6 +7,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,6 +26,32 @@ func (r Result) String() string {
 	return string(bz)
 }
 
+// WrapServiceResult wraps the response of a service method handler into a
+// Result, along with the events emitted on the Context.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = proto.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events Events
+	if evtMgr := ctx.EventManager(); evtMgr != nil {
+		events = evtMgr.Events()
+	}
+
+	return &Result{
+		Data:   data,
+		Events: events.ToABCIEvents(),
+	}, nil
+}
+
 func (r Result) GetEvents() Events {
 	events := make(Events, len(r.Events))
 	for i, e := range r.Events {
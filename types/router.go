@@ -35,3 +35,10 @@ type QueryRouter interface {
 	AddRoute(r string, h Querier) QueryRouter
 	Route(path string) Querier
 }
+
+// MsgRouterService dispatches messages to their handlers on behalf of an
+// account, so that keepers execute the messages of other modules through the
+// same handlers as transactions instead of calling their keepers directly.
+type MsgRouterService interface {
+	DispatchMsg(ctx Context, signer AccAddress, msg Msg) (*Result, error)
+}
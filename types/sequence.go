@@ -0,0 +1,26 @@
+package types
+
+// GetSequence returns the next value of the sequence stored under the given
+// key, e.g. the next ID of a module object. Sequences start at 1.
+func GetSequence(store KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 1
+	}
+
+	return BigEndianToUint64(bz)
+}
+
+// SetSequence sets the next value of the sequence stored under the given key.
+func SetSequence(store KVStore, key []byte, seq uint64) {
+	store.Set(key, Uint64ToBigEndian(seq))
+}
+
+// NextSequence returns the next value of the sequence stored under the given
+// key and increments it.
+func NextSequence(store KVStore, key []byte) uint64 {
+	seq := GetSequence(store, key)
+	SetSequence(store, key, seq+1)
+
+	return seq
+}
@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSequence(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	key := []byte("seq")
+
+	require.Equal(t, uint64(1), sdk.GetSequence(store, key))
+	require.Equal(t, uint64(1), sdk.NextSequence(store, key))
+	require.Equal(t, uint64(2), sdk.NextSequence(store, key))
+	require.Equal(t, uint64(3), sdk.GetSequence(store, key))
+
+	sdk.SetSequence(store, key, 10)
+	require.Equal(t, uint64(10), sdk.NextSequence(store, key))
+	require.Equal(t, uint64(11), sdk.GetSequence(store, key))
+
+	// sequences are independent
+	require.Equal(t, uint64(1), sdk.GetSequence(store, []byte("other")))
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// ErrEmptyServiceMsgRequest is returned when validating a ServiceMsg without
+// a request.
+var ErrEmptyServiceMsgRequest = errors.New("service msg request cannot be empty")
+
+// MsgRequest is the interface a transaction message, defined as a proto
+// service method, must fulfill.
+type MsgRequest interface {
+	proto.Message
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+
+	// Signers returns the addrs of signers that must sign.
+	// CONTRACT: All signatures must be present to be valid.
+	// CONTRACT: Returns addrs in some deterministic order.
+	GetSigners() []AccAddress
+}
+
+// ServiceMsg is a Msg routed by the fully-qualified name of the proto service
+// method handling its request (ex. `/cosmos.bank.Msg/Send`), as opposed to
+// the free-form route string of legacy messages.
+type ServiceMsg struct {
+	// MethodName is the fully-qualified service method name.
+	MethodName string
+
+	// Request is the request type of the service method.
+	Request MsgRequest
+}
+
+var _ Msg = ServiceMsg{}
+
+// Route implements Msg. The method name is used as the route of the message.
+func (msg ServiceMsg) Route() string {
+	return msg.MethodName
+}
+
+// Type implements Msg. The method name is used as the type of the message.
+func (msg ServiceMsg) Type() string {
+	return msg.MethodName
+}
+
+// ValidateBasic implements Msg by validating the request.
+func (msg ServiceMsg) ValidateBasic() error {
+	if msg.Request == nil {
+		return ErrEmptyServiceMsgRequest
+	}
+
+	return msg.Request.ValidateBasic()
+}
+
+// GetSignBytes implements Msg. Sign bytes of service messages are derived
+// from the transaction encoding, thus this method must not be called.
+func (msg ServiceMsg) GetSignBytes() []byte {
+	panic("ServiceMsg does not have a GetSignBytes method")
+}
+
+// GetSigners implements Msg by returning the signers of the request.
+func (msg ServiceMsg) GetSigners() []AccAddress {
+	if msg.Request == nil {
+		return nil
+	}
+
+	return msg.Request.GetSigners()
+}
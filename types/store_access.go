@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+)
+
+// StoreAccess defines a range of keys of a store accessed by a message, as the
+// keys starting with Prefix in the store named StoreKey. An empty prefix spans
+// the whole store.
+type StoreAccess struct {
+	StoreKey string
+	Prefix   []byte
+}
+
+// NewStoreAccess returns a new StoreAccess of the keys starting with prefix in
+// the given store.
+func NewStoreAccess(storeKey string, prefix []byte) StoreAccess {
+	return StoreAccess{StoreKey: storeKey, Prefix: prefix}
+}
+
+// Overlaps returns true if both accesses may read or write a same key.
+func (sa StoreAccess) Overlaps(other StoreAccess) bool {
+	if sa.StoreKey != other.StoreKey {
+		return false
+	}
+
+	return bytes.HasPrefix(sa.Prefix, other.Prefix) || bytes.HasPrefix(other.Prefix, sa.Prefix)
+}
+
+// StoreAccessMsg defines the interface of a message declaring the store keys it
+// reads and writes. The messages of a transaction which all declare disjoint
+// store accesses may be executed in parallel.
+//
+// CONTRACT: the message handler must only access the declared keys. The keys
+// accessed by the messages executed in parallel are checked, and if a message
+// accesses an undeclared key, the messages are executed sequentially instead.
+type StoreAccessMsg interface {
+	Msg
+
+	// GetStoreAccess returns the store keys read and written by the message.
+	GetStoreAccess() []StoreAccess
+}
+
+// StoreAccessDisjoint returns true if all the given messages declare their
+// store accesses and no two messages access a same key.
+func StoreAccessDisjoint(msgs []Msg) bool {
+	accesses := make([][]StoreAccess, len(msgs))
+	for i, msg := range msgs {
+		saMsg, ok := msg.(StoreAccessMsg)
+		if !ok {
+			return false
+		}
+
+		accesses[i] = saMsg.GetStoreAccess()
+	}
+
+	for i := range accesses {
+		for j := i + 1; j < len(accesses); j++ {
+			for _, a := range accesses[i] {
+				for _, b := range accesses[j] {
+					if a.Overlaps(b) {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
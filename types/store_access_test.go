@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type storeAccessMsg struct {
+	*sdk.TestMsg
+	access []sdk.StoreAccess
+}
+
+func (msg storeAccessMsg) GetStoreAccess() []sdk.StoreAccess { return msg.access }
+
+func newStoreAccessMsg(access ...sdk.StoreAccess) sdk.Msg {
+	return storeAccessMsg{TestMsg: sdk.NewTestMsg(), access: access}
+}
+
+func TestStoreAccessOverlaps(t *testing.T) {
+	testCases := []struct {
+		a, b     sdk.StoreAccess
+		overlaps bool
+	}{
+		{sdk.NewStoreAccess("bank", []byte("ab")), sdk.NewStoreAccess("bank", []byte("ab")), true},
+		{sdk.NewStoreAccess("bank", []byte("ab")), sdk.NewStoreAccess("bank", []byte("abc")), true},
+		{sdk.NewStoreAccess("bank", []byte("abc")), sdk.NewStoreAccess("bank", []byte("ab")), true},
+		{sdk.NewStoreAccess("bank", nil), sdk.NewStoreAccess("bank", []byte("ab")), true},
+		{sdk.NewStoreAccess("bank", []byte("ab")), sdk.NewStoreAccess("bank", []byte("ac")), false},
+		{sdk.NewStoreAccess("bank", []byte("ab")), sdk.NewStoreAccess("acc", []byte("ab")), false},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.overlaps, tc.a.Overlaps(tc.b), "test case #%d", i)
+	}
+}
+
+func TestStoreAccessDisjoint(t *testing.T) {
+	msgA := newStoreAccessMsg(sdk.NewStoreAccess("bank", []byte("a")), sdk.NewStoreAccess("acc", []byte("a")))
+	msgB := newStoreAccessMsg(sdk.NewStoreAccess("bank", []byte("b")), sdk.NewStoreAccess("acc", []byte("b")))
+	msgAB := newStoreAccessMsg(sdk.NewStoreAccess("bank", []byte("b")), sdk.NewStoreAccess("acc", []byte("a1")))
+
+	require.True(t, sdk.StoreAccessDisjoint(nil))
+	require.True(t, sdk.StoreAccessDisjoint([]sdk.Msg{msgA}))
+	require.True(t, sdk.StoreAccessDisjoint([]sdk.Msg{msgA, msgB}))
+	require.False(t, sdk.StoreAccessDisjoint([]sdk.Msg{msgA, msgB, msgAB}))
+
+	// messages which do not declare their accesses may access any key
+	require.False(t, sdk.StoreAccessDisjoint([]sdk.Msg{msgA, sdk.NewTestMsg()}))
+}
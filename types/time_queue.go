@@ -0,0 +1,45 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// Time queues hold the entries maturing at a block time, e.g. unbonding
+// delegations or proposals ending their voting period. Their keys are made of
+// the queue prefix, the sortable encoding of the time and an optional suffix
+// identifying the entry, so that the entries are ordered by time. Durations
+// computed against the block time keep the same real world length whatever the
+// block time of the chain, unlike durations counted in blocks.
+
+// TimeQueueKey returns the key prefix of the entries of a time queue maturing
+// at the given time.
+func TimeQueueKey(prefix []byte, t time.Time) []byte {
+	key := make([]byte, 0, len(prefix)+len(SortableTimeFormat))
+	key = append(key, prefix...)
+
+	return append(key, FormatTimeBytes(t)...)
+}
+
+// TimeQueueIterator returns an iterator over the entries of a time queue
+// maturing at or before endTime, ordered by time.
+func TimeQueueIterator(store KVStore, prefix []byte, endTime time.Time) Iterator {
+	return store.Iterator(prefix, PrefixEndBytes(TimeQueueKey(prefix, endTime)))
+}
+
+// ParseTimeQueueKey returns the time of a time queue key along with the suffix
+// following it.
+func ParseTimeQueueKey(prefix, key []byte) (time.Time, []byte, error) {
+	timeLen := len(FormatTimeBytes(time.Time{}))
+	if !bytes.HasPrefix(key, prefix) || len(key) < len(prefix)+timeLen {
+		return time.Time{}, nil, fmt.Errorf("invalid time queue key %X", key)
+	}
+
+	t, err := ParseTimeBytes(key[len(prefix) : len(prefix)+timeLen])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return t, key[len(prefix)+timeLen:], nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTimeQueue(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	prefix := []byte{0x01}
+	now := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	// entries are keyed by time followed by an ID
+	times := []time.Time{now.Add(time.Hour), now, now.Add(-time.Hour), now.Add(time.Nanosecond)}
+	for i, tm := range times {
+		store.Set(append(sdk.TimeQueueKey(prefix, tm), byte(i)), []byte{byte(i)})
+	}
+	store.Set([]byte{0x02}, []byte{0xff})
+
+	iterator := sdk.TimeQueueIterator(store, prefix, now)
+	defer iterator.Close()
+
+	var matured []byte
+	for ; iterator.Valid(); iterator.Next() {
+		matured = append(matured, iterator.Value()...)
+
+		tm, suffix, err := sdk.ParseTimeQueueKey(prefix, iterator.Key())
+		require.NoError(t, err)
+		require.Equal(t, times[iterator.Value()[0]], tm)
+		require.Equal(t, iterator.Value(), suffix)
+	}
+	require.Equal(t, []byte{2, 1}, matured)
+
+	// the key prefix is not modified
+	require.Equal(t, []byte{0x01}, prefix)
+
+	_, _, err := sdk.ParseTimeQueueKey(prefix, []byte{0x02})
+	require.Error(t, err)
+	_, _, err = sdk.ParseTimeQueueKey(prefix, append(prefix, "not a time"...))
+	require.Error(t, err)
+}
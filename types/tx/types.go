@@ -0,0 +1,18 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// GetExtensionOptions returns the extension options of the body of the
+// transaction, which must be accepted by the application for the transaction
+// to be processed.
+func (m *Tx) GetExtensionOptions() []*codectypes.Any {
+	return m.GetBody().GetExtensionOptions()
+}
+
+// GetNonCriticalExtensionOptions returns the non-critical extension options of
+// the body of the transaction, which the application may ignore.
+func (m *Tx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return m.GetBody().GetNonCriticalExtensionOptions()
+}
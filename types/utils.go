@@ -2,7 +2,6 @@ package types
 
 import (
 	"encoding/binary"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -26,17 +25,9 @@ func init() {
 // This method can be used to canonicalize JSON to be returned by GetSignBytes,
 // e.g. for the ledger integration.
 // If the passed JSON isn't valid it will return an error.
+// The JSON is encoded in its canonical form, see CanonicalJSON.
 func SortJSON(toSortJSON []byte) ([]byte, error) {
-	var c interface{}
-	err := json.Unmarshal(toSortJSON, &c)
-	if err != nil {
-		return nil, err
-	}
-	js, err := json.Marshal(c)
-	if err != nil {
-		return nil, err
-	}
-	return js, nil
+	return CanonicalJSON(toSortJSON)
 }
 
 // MustSortJSON is like SortJSON but panic if an error occurs, e.g., if
@@ -86,12 +77,24 @@ func ParseTimeBytes(bz []byte) (time.Time, error) {
 
 // NewLevelDB instantiate a new LevelDB instance according to DBBackend.
 func NewLevelDB(name, dir string) (db dbm.DB, err error) {
+	return NewDB(name, backend, dir)
+}
+
+// NewDB instantiates a new DB instance of the given backend type. An error is
+// returned if the backend is unknown or was not compiled in.
+func NewDB(name string, backendType dbm.BackendType, dir string) (db dbm.DB, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("couldn't create db: %v", r)
 		}
 	}()
-	return dbm.NewDB(name, backend, dir), err
+	return dbm.NewDB(name, backendType, dir), err
+}
+
+// DefaultDBBackend returns the DB backend set at compile time with DBBackend,
+// goleveldb by default.
+func DefaultDBBackend() dbm.BackendType {
+	return backend
 }
 
 // copy bytes
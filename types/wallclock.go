@@ -0,0 +1,16 @@
+package types
+
+import (
+	"time"
+)
+
+// WallClockNow returns the local time of the node.
+//
+// The wall clock differs between the nodes of a network, so it must never
+// affect the state, the events or the results of a block or a transaction:
+// use Context.BlockTime instead. WallClockNow is meant for telemetry and
+// logging only, and is the only way the baseapp, types and x packages may read
+// the wall clock, which `make lint-wallclock` enforces.
+func WallClockNow() time.Time {
+	return time.Now()
+}
@@ -20,8 +20,11 @@ package version
 import (
 	"fmt"
 	"runtime"
+	"runtime/debug"
 )
 
+const sdkModulePath = "github.com/cosmos/cosmos-sdk"
+
 var (
 	// application's name
 	Name = ""
@@ -46,6 +49,9 @@ type Info struct {
 	GitCommit  string `json:"commit" yaml:"commit"`
 	BuildTags  string `json:"build_tags" yaml:"build_tags"`
 	GoVersion  string `json:"go" yaml:"go"`
+	// CosmosSdkVersion is the version of the SDK module the binary was built
+	// with, if the binary embeds module information.
+	CosmosSdkVersion string `json:"cosmos_sdk_version" yaml:"cosmos_sdk_version"`
 }
 
 func NewInfo() Info {
@@ -57,7 +63,36 @@ func NewInfo() Info {
 		GitCommit:  Commit,
 		BuildTags:  BuildTags,
 		GoVersion:  fmt.Sprintf("go version %s %s/%s", runtime.Version(), runtime.GOOS, runtime.GOARCH),
+
+		CosmosSdkVersion: sdkVersion(),
+	}
+}
+
+// sdkVersion returns the version of the SDK module found in the build
+// information of the binary, or an empty string if there is none.
+func sdkVersion() string {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	if buildInfo.Main.Path == sdkModulePath {
+		return buildInfo.Main.Version
+	}
+
+	for _, dep := range buildInfo.Deps {
+		if dep.Path != sdkModulePath {
+			continue
+		}
+
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+
+		return dep.Version
 	}
+
+	return ""
 }
 
 func (vi Info) String() string {
@@ -13,6 +13,7 @@ const (
 	ModuleName                    = types.ModuleName
 	StoreKey                      = types.StoreKey
 	FeeCollectorName              = types.FeeCollectorName
+	TipCollectorName              = types.TipCollectorName
 	QuerierRoute                  = types.QuerierRoute
 	DefaultParamspace             = types.DefaultParamspace
 	DefaultMaxMemoCharacters      = types.DefaultMaxMemoCharacters
@@ -20,6 +21,9 @@ const (
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultMaxTxBytes             = types.DefaultMaxTxBytes
+	DefaultSigVerifyCostSecp256r1 = types.DefaultSigVerifyCostSecp256r1
+	DefaultSigVerifyCostSr25519   = types.DefaultSigVerifyCostSr25519
 	QueryAccount                  = types.QueryAccount
 	QueryParams                   = types.QueryParams
 	MaxGasWanted                  = types.MaxGasWanted
@@ -35,6 +39,7 @@ var (
 	DefaultSigVerificationGasConsumer = ante.DefaultSigVerificationGasConsumer
 	DeductFees                        = ante.DeductFees
 	SetGasMeter                       = ante.SetGasMeter
+	SenderNonce                       = ante.SenderNonce
 	NewAccountKeeper                  = keeper.NewAccountKeeper
 	NewQuerier                        = keeper.NewQuerier
 	NewBaseAccount                    = types.NewBaseAccount
@@ -60,12 +65,17 @@ var (
 	NewTxBuilder                      = types.NewTxBuilder
 	NewTxBuilderFromCLI               = types.NewTxBuilderFromCLI
 	MakeSignature                     = types.MakeSignature
+	MakeSignatureWithSignMode         = types.MakeSignatureWithSignMode
+	ParseSignMode                     = types.ParseSignMode
+	StdSignText                       = types.StdSignText
 	ValidateGenAccounts               = types.ValidateGenAccounts
 	GetGenesisStateFromAppState       = types.GetGenesisStateFromAppState
 	NewStdSignature                   = types.NewStdSignature
 	NewModuleAddress                  = types.NewModuleAddress
 	NewEmptyModuleAccount             = types.NewEmptyModuleAccount
 	NewModuleAccount                  = types.NewModuleAccount
+	DefaultSignModeHandler            = types.DefaultSignModeHandler
+	NewSignModeHandlerMap             = types.NewSignModeHandlerMap
 
 	// variable aliases
 	AddressStoreKeyPrefix     = types.AddressStoreKeyPrefix
@@ -75,6 +85,9 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeyMaxTxBytes             = types.KeyMaxTxBytes
+	KeySigVerifyCostSecp256r1 = types.KeySigVerifyCostSecp256r1
+	KeySigVerifyCostSr25519   = types.KeySigVerifyCostSr25519
 )
 
 type (
@@ -96,4 +109,6 @@ type (
 	ModuleAccount                    = types.ModuleAccount
 	GenesisAccounts                  = types.GenesisAccounts
 	GenesisAccount                   = types.GenesisAccount
+	SignMode                         = types.SignMode
+	SignModeHandler                  = types.SignModeHandler
 )
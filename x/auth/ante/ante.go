@@ -9,22 +9,38 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer. Signatures are verified against the sign bytes derived by the
+// signModeHandler for the sign mode of each signature.
 func NewAnteHandler(
 	ak AccountKeeper, bankKeeper types.BankKeeper, ibcKeeper ibckeeper.Keeper,
-	sigGasConsumer SignatureVerificationGasConsumer,
+	sigGasConsumer SignatureVerificationGasConsumer, signModeHandler types.SignModeHandler,
+) sdk.AnteHandler {
+	return NewAnteHandlerWithFeeConverter(ak, bankKeeper, ibcKeeper, sigGasConsumer, signModeHandler, nil)
+}
+
+// NewAnteHandlerWithFeeConverter returns the AnteHandler of NewAnteHandler,
+// which also accepts the fees paid in the denominations converted by the given
+// FeeConverter, if not nil.
+func NewAnteHandlerWithFeeConverter(
+	ak AccountKeeper, bankKeeper types.BankKeeper, ibcKeeper ibckeeper.Keeper,
+	sigGasConsumer SignatureVerificationGasConsumer, signModeHandler types.SignModeHandler,
+	feeConverter FeeConverter,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewMempoolFeeDecorator(),
+		NewExtensionOptionsDecorator(nil),
+		NewMempoolFeeDecorator().WithFeeConverter(feeConverter),
+		NewTxPriorityDecorator(nil),
 		NewValidateBasicDecorator(),
+		NewUnorderedTxDecorator(ak, DefaultMaxUnorderedTxTimeout),
+		NewValidateTxSizeDecorator(ak),
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		NewDeductFeeDecorator(ak, bankKeeper).WithFeeConverter(feeConverter),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
-		NewSigVerificationDecorator(ak),
+		NewSigVerificationDecorator(ak, signModeHandler),
 		NewIncrementSequenceDecorator(ak),
 		ibcante.NewProofVerificationDecorator(ibcKeeper.ClientKeeper, ibcKeeper.ChannelKeeper), // innermost AnteDecorator
 	)
@@ -38,6 +38,33 @@ func (vbd ValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 	return next(ctx, tx, simulate)
 }
 
+// ValidateTxSizeDecorator will validate the size of the tx bytes given the
+// MaxTxBytes parameter. If the tx is too large decorator returns with error,
+// otherwise call next AnteHandler. A zero MaxTxBytes does not limit the size.
+type ValidateTxSizeDecorator struct {
+	ak AccountKeeper
+}
+
+func NewValidateTxSizeDecorator(ak AccountKeeper) ValidateTxSizeDecorator {
+	return ValidateTxSizeDecorator{
+		ak: ak,
+	}
+}
+
+func (vtsd ValidateTxSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := vtsd.ak.GetParams(ctx)
+
+	txSize := uint64(len(ctx.TxBytes()))
+	if params.MaxTxBytes != 0 && txSize > params.MaxTxBytes {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxTooLarge,
+			"maximum number of bytes is %d but received %d bytes",
+			params.MaxTxBytes, txSize,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // Tx must have GetMemo() method to use ValidateMemoDecorator
 type TxWithMemo interface {
 	sdk.Tx
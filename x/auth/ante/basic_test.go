@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -50,6 +51,32 @@ func TestValidateBasic(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator ran on ReCheck")
 }
 
+func TestValidateTxSize(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	params := app.AccountKeeper.GetParams(ctx)
+	params.MaxTxBytes = 100
+	app.AccountKeeper.SetParams(ctx, params)
+
+	vtsd := ante.NewValidateTxSizeDecorator(app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(vtsd)
+
+	// require that large txs get rejected
+	_, err := antehandler(ctx.WithTxBytes(make([]byte, 101)), types.StdTx{}, false)
+	require.True(t, sdkerrors.ErrTxTooLarge.Is(err), "Did not error on large tx")
+
+	_, err = antehandler(ctx.WithTxBytes(make([]byte, 100)), types.StdTx{}, false)
+	require.Nil(t, err, "ValidateTxSizeDecorator returned error on valid tx. err: %v", err)
+
+	// require that a zero limit does not limit the tx size
+	params.MaxTxBytes = 0
+	app.AccountKeeper.SetParams(ctx, params)
+
+	_, err = antehandler(ctx.WithTxBytes(make([]byte, 10000)), types.StdTx{}, false)
+	require.Nil(t, err, "ValidateTxSizeDecorator returned error on unlimited tx size. err: %v", err)
+}
+
 func TestValidateMemo(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -0,0 +1,57 @@
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasExtensionOptionsTx defines the interface of a transaction carrying
+// extension options, such as the protobuf Tx through the
+// extension_options/non_critical_extension_options fields of its body.
+type HasExtensionOptionsTx interface {
+	sdk.Tx
+
+	GetExtensionOptions() []*codectypes.Any
+	GetNonCriticalExtensionOptions() []*codectypes.Any
+}
+
+// ExtensionOptionChecker is a function registered by the application which
+// returns true if the given extension option is accepted.
+type ExtensionOptionChecker func(*codectypes.Any) bool
+
+// RejectAllExtensionOptions is an ExtensionOptionChecker rejecting all the
+// extension options.
+func RejectAllExtensionOptions(*codectypes.Any) bool {
+	return false
+}
+
+// ExtensionOptionsDecorator rejects the transactions with an extension option
+// which is not accepted by the checker, before calling next AnteHandler. The
+// non-critical extension options are not checked, and are left to the
+// application to interpret. A nil checker rejects all the extension options.
+type ExtensionOptionsDecorator struct {
+	checker ExtensionOptionChecker
+}
+
+func NewExtensionOptionsDecorator(checker ExtensionOptionChecker) ExtensionOptionsDecorator {
+	if checker == nil {
+		checker = RejectAllExtensionOptions
+	}
+
+	return ExtensionOptionsDecorator{
+		checker: checker,
+	}
+}
+
+func (eod ExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if extTx, ok := tx.(HasExtensionOptionsTx); ok {
+		for _, opt := range extTx.GetExtensionOptions() {
+			if !eod.checker(opt) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownExtensionOptions, "extension option %s", opt.GetTypeUrl())
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type extOptionsTx struct {
+	opts, nonCriticalOpts []*codectypes.Any
+}
+
+var _ ante.HasExtensionOptionsTx = extOptionsTx{}
+
+func (tx extOptionsTx) GetMsgs() []sdk.Msg                                { return nil }
+func (tx extOptionsTx) ValidateBasic() error                              { return nil }
+func (tx extOptionsTx) GetExtensionOptions() []*codectypes.Any            { return tx.opts }
+func (tx extOptionsTx) GetNonCriticalExtensionOptions() []*codectypes.Any { return tx.nonCriticalOpts }
+
+func TestExtensionOptionsDecorator(t *testing.T) {
+	tipOpt := &codectypes.Any{TypeUrl: "/tip"}
+	ethOpt := &codectypes.Any{TypeUrl: "/eth"}
+
+	acceptTips := func(opt *codectypes.Any) bool { return opt.GetTypeUrl() == "/tip" }
+
+	testCases := []struct {
+		name    string
+		checker ante.ExtensionOptionChecker
+		tx      sdk.Tx
+		expErr  bool
+	}{
+		{"no options", nil, extOptionsTx{}, false},
+		{"tx without options", nil, types.StdTx{}, false},
+		{"rejected by default", nil, extOptionsTx{opts: []*codectypes.Any{tipOpt}}, true},
+		{"accepted option", acceptTips, extOptionsTx{opts: []*codectypes.Any{tipOpt}}, false},
+		{"rejected option", acceptTips, extOptionsTx{opts: []*codectypes.Any{tipOpt, ethOpt}}, true},
+		{"non-critical option", nil, extOptionsTx{nonCriticalOpts: []*codectypes.Any{ethOpt}}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			antehandler := sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecorator(tc.checker))
+
+			_, err := antehandler(sdk.Context{}, tc.tx, false)
+			if tc.expErr {
+				require.True(t, sdkerrors.ErrUnknownExtensionOptions.Is(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -20,18 +20,34 @@ type FeeTx interface {
 	FeePayer() sdk.AccAddress
 }
 
+// TipTx defines the interface to be implemented by Tx paying a tip to the
+// block proposer on top of its fee
+type TipTx interface {
+	FeeTx
+	GetTip() sdk.Coins
+}
+
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
-type MempoolFeeDecorator struct{}
+type MempoolFeeDecorator struct {
+	feeConverter FeeConverter
+}
 
 func NewMempoolFeeDecorator() MempoolFeeDecorator {
 	return MempoolFeeDecorator{}
 }
 
+// WithFeeConverter returns a copy of the decorator checking the native
+// equivalent of the fees paid in a convertible denomination.
+func (mfd MempoolFeeDecorator) WithFeeConverter(converter FeeConverter) MempoolFeeDecorator {
+	mfd.feeConverter = converter
+	return mfd
+}
+
 func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(FeeTx)
 	if !ok {
@@ -56,7 +72,7 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 			}
 
-			if !feeCoins.IsAnyGTE(requiredFees) {
+			if !ConvertFees(ctx, mfd.feeConverter, feeCoins).IsAnyGTE(requiredFees) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
@@ -65,13 +81,14 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-// DeductFeeDecorator deducts fees from the first signer of the tx
+// DeductFeeDecorator deducts fees, and the tip of a TipTx, from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak         AccountKeeper
-	bankKeeper types.BankKeeper
+	ak           AccountKeeper
+	bankKeeper   types.BankKeeper
+	feeConverter FeeConverter
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecorator {
@@ -81,6 +98,13 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecor
 	}
 }
 
+// WithFeeConverter returns a copy of the decorator converting the fees paid in
+// a convertible denomination with the given converter.
+func (dfd DeductFeeDecorator) WithFeeConverter(converter FeeConverter) DeductFeeDecorator {
+	dfd.feeConverter = converter
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(FeeTx)
 	if !ok {
@@ -99,14 +123,39 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	// deduct the fees
+	if simulate {
+		// When simulating, the fees are deducted in a branch of the state so that
+		// the same gas is charged as during execution, but a fee payer that can't
+		// pay the fees yet doesn't prevent the gas estimation.
+		cacheCtx, write := ctx.CacheContext()
+		if err := dfd.deductFees(cacheCtx, feePayerAcc, feeTx); err == nil {
+			write()
+		}
+
+		return next(ctx, tx, simulate)
+	}
+
+	if err := dfd.deductFees(ctx, feePayerAcc, feeTx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// deductFees deducts the fees of the tx, and its tip if any, from the fee payer.
+func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, feePayerAcc types.AccountI, feeTx FeeTx) error {
 	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
+		err := DeductFeesWithConverter(dfd.bankKeeper, dfd.feeConverter, ctx, feePayerAcc, feeTx.GetFee())
 		if err != nil {
-			return ctx, err
+			return err
 		}
 	}
 
-	return next(ctx, tx, simulate)
+	if tipTx, ok := feeTx.(TipTx); ok && !tipTx.GetTip().IsZero() {
+		return DeductTip(dfd.ak, dfd.bankKeeper, ctx, feePayerAcc, tipTx.GetTip())
+	}
+
+	return nil
 }
 
 // DeductFees deducts fees from the given account.
@@ -122,3 +171,24 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 
 	return nil
 }
+
+// DeductTip sends the tip of a tx from the given account to the tip collector,
+// from which the distribution module splits it between the fee collector and
+// the block proposer. Tips are rejected if the tip collector module account is
+// not set.
+func DeductTip(ak AccountKeeper, bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, tip sdk.Coins) error {
+	if !tip.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid tip amount: %s", tip)
+	}
+
+	if addr := ak.GetModuleAddress(types.TipCollectorName); addr == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tips are not supported: %s module account has not been set", types.TipCollectorName)
+	}
+
+	err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.TipCollectorName, tip)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FeeConverter lets transactions pay their fees in denominations other than
+// the native fee denominations, e.g. bridged assets, at a rate supplied by the
+// module implementing it. The fees paid in a convertible denomination are sent
+// to the reserve module account of the converter, which deposits their native
+// equivalent to the fee collector.
+type FeeConverter interface {
+	// ConvertFee returns the native coin the given fee coin is worth at the
+	// current rate, or false if its denomination is not converted.
+	ConvertFee(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, bool)
+	// ReserveModule returns the name of the module account receiving the
+	// converted fees and paying their native equivalent.
+	ReserveModule() string
+}
+
+// ConvertFees returns the native equivalent of the given fees, the coins which
+// are not converted being kept as is. It returns the fees unchanged if the
+// converter is nil.
+func ConvertFees(ctx sdk.Context, converter FeeConverter, fees sdk.Coins) sdk.Coins {
+	if converter == nil {
+		return fees
+	}
+
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		if native, ok := converter.ConvertFee(ctx, fee); ok {
+			fee = native
+		}
+
+		converted = converted.Add(fee)
+	}
+
+	return converted
+}
+
+// DeductFeesWithConverter deducts fees from the given account, converting the
+// fees paid in a convertible denomination with the converter, if any.
+func DeductFeesWithConverter(
+	bankKeeper types.BankKeeper, converter FeeConverter, ctx sdk.Context, acc types.AccountI, fees sdk.Coins,
+) error {
+	if converter == nil {
+		return DeductFees(bankKeeper, ctx, acc, fees)
+	}
+
+	if !fees.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+	}
+
+	direct := sdk.NewCoins()
+	for _, fee := range fees {
+		native, ok := converter.ConvertFee(ctx, fee)
+		if !ok {
+			direct = direct.Add(fee)
+			continue
+		}
+
+		reserve := converter.ReserveModule()
+		if err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), reserve, sdk.NewCoins(fee)); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+
+		if err := bankKeeper.SendCoinsFromModuleToModule(ctx, reserve, types.FeeCollectorName, sdk.NewCoins(native)); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "failed to convert fee %s: %s", fee, err)
+		}
+	}
+
+	if direct.IsZero() {
+		return nil
+	}
+
+	return DeductFees(bankKeeper, ctx, acc, direct)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -96,3 +97,144 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesSimulate(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// gas is estimated even if the fee payer can't pay the fees
+	balance := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10)))
+	app.BankKeeper.SetBalances(ctx, addr1, balance)
+
+	_, err := antehandler(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, true)
+	require.NoError(t, err)
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// simulation consumes the same gas as execution
+	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	simCtx, _ := ctx.CacheContext()
+	simCtx = simCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = antehandler(simCtx, tx, true)
+	require.NoError(t, err)
+
+	execCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = antehandler(execCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, execCtx.GasMeter().GasConsumed(), simCtx.GasMeter().GasConsumed())
+}
+
+// testFeeConverter converts usdc fees to twice their amount in atom.
+type testFeeConverter struct{}
+
+func (testFeeConverter) ConvertFee(_ sdk.Context, fee sdk.Coin) (sdk.Coin, bool) {
+	if fee.Denom != "usdc" {
+		return sdk.Coin{}, false
+	}
+
+	return sdk.NewCoin("atom", fee.Amount.MulRaw(2)), true
+}
+
+func (testFeeConverter) ReserveModule() string { return "distribution" }
+
+func TestDeductFeesWithConverter(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("usdc", 75)))
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("usdc", 100)))
+
+	// the converted fees meet the minimum gas prices
+	minGasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 4))}
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+
+	_, err := sdk.ChainAnteDecorators(ante.NewMempoolFeeDecorator())(checkCtx, tx, false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	mfd := ante.NewMempoolFeeDecorator().WithFeeConverter(testFeeConverter{})
+	_, err = sdk.ChainAnteDecorators(mfd)(checkCtx, tx, false)
+	require.NoError(t, err)
+
+	// the reserve must hold the native equivalent of the fees
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper).WithFeeConverter(testFeeConverter{})
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	reserve := app.AccountKeeper.GetModuleAddress("distribution")
+	app.BankKeeper.SetBalances(ctx, reserve, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdc", 25)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	require.Equal(
+		t, sdk.NewCoins(sdk.NewInt64Coin("atom", 850), sdk.NewInt64Coin("usdc", 75)),
+		app.BankKeeper.GetAllBalances(ctx, reserve),
+	)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+}
+
+func TestDeductFeesWithTip(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	fee := types.NewTestStdFee()
+	fee.Tip = sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, []sdk.Msg{types.NewTestMsg(addr1)}, privs, accNums, seqs, fee)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 180)))
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper))
+
+	// the fee payer must afford both the fee and the tip
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := antehandler(cacheCtx, tx, false)
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err), err)
+
+	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)))
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	tipCollector := app.AccountKeeper.GetModuleAddress(types.TipCollectorName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), app.BankKeeper.GetAllBalances(ctx, tipCollector))
+}
@@ -0,0 +1,53 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// SenderNonce returns a mempool.SenderNonceFunc using the first signer of a
+// transaction as its sender, and the sequence of the signer account as its
+// nonce. The sequence is read from the CheckTx context the transaction is
+// inserted with, before the ante handler increments it.
+//
+// CONTRACT: Tx must implement SigVerifiableTx interface
+func SenderNonce(ak AccountKeeper) mempool.SenderNonceFunc {
+	return func(ctx sdk.Context, tx sdk.Tx) (string, uint64, error) {
+		sigTx, ok := tx.(SigVerifiableTx)
+		if !ok {
+			return "", 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+		}
+
+		signers := sigTx.GetSigners()
+		if len(signers) == 0 {
+			return "", 0, sdkerrors.ErrNoSignatures
+		}
+
+		var nonce uint64
+		if acc := ak.GetAccount(ctx, signers[0]); acc != nil {
+			nonce = acc.GetSequence()
+		}
+
+		return signers[0].String(), nonce, nil
+	}
+}
+
+// FeePriority returns a mempool.PriorityFunc prioritizing transactions by the
+// priority computed by GetTxPriority from their fee and tip with the given
+// denom weights, i.e. the priority set by a TxPriorityDecorator with the same
+// weights. The priority only depends on the transaction, so that it is known
+// when the transaction is inserted in the mempool, before the ante handler
+// runs.
+//
+// CONTRACT: Tx must implement FeeTx interface
+func FeePriority(weights sdk.DecCoins) mempool.PriorityFunc {
+	return func(_ sdk.Context, tx sdk.Tx) (int64, error) {
+		feeTx, ok := tx.(FeeTx)
+		if !ok {
+			return 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		return GetTxPriority(feeWithTip(feeTx), feeTx.GetGas(), weights), nil
+	}
+}
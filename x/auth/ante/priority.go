@@ -0,0 +1,77 @@
+package ante
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetTxPriority returns the priority of a transaction paying the given fee for
+// the given gas limit: the weighted sum of the fee amounts per unit of gas,
+// truncated to an integer. Each fee denomination is weighted by its amount in
+// weights, denominations missing from weights having a zero weight. Empty
+// weights weigh all the denominations equally.
+//
+// The priority only depends on the transaction, so that it is the same on all
+// the nodes.
+func GetTxPriority(fee sdk.Coins, gas uint64, weights sdk.DecCoins) int64 {
+	sum := sdk.ZeroDec()
+	for _, coin := range fee {
+		weight := sdk.OneDec()
+		if !weights.Empty() {
+			weight = weights.AmountOf(coin.Denom)
+		}
+
+		sum = sum.Add(weight.MulInt(coin.Amount))
+	}
+
+	if gas > 0 {
+		sum = sum.QuoInt(sdk.NewIntFromUint64(gas))
+	}
+
+	priority := sum.TruncateInt()
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return priority.Int64()
+}
+
+// TxPriorityDecorator sets the priority of the transaction on the context,
+// computed by GetTxPriority from its fee, including the tip of a TipTx, and gas
+// limit with the given denom weights, before calling next AnteHandler. The priority orders the
+// transactions of a PriorityMempool using FeePriority with the same weights.
+//
+// NOTE: The ResponseCheckTx of Tendermint v0.33 does not carry a priority, so
+// that the transactions are only ordered by priority in the app side mempool.
+// CONTRACT: Tx must implement FeeTx interface to use TxPriorityDecorator
+type TxPriorityDecorator struct {
+	weights sdk.DecCoins
+}
+
+func NewTxPriorityDecorator(weights sdk.DecCoins) TxPriorityDecorator {
+	return TxPriorityDecorator{
+		weights: weights,
+	}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	priority := GetTxPriority(feeWithTip(feeTx), feeTx.GetGas(), tpd.weights)
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// feeWithTip returns the fee of the tx, plus its tip if it is a TipTx.
+func feeWithTip(feeTx FeeTx) sdk.Coins {
+	tipTx, ok := feeTx.(TipTx)
+	if !ok {
+		return feeTx.GetFee()
+	}
+
+	return feeTx.GetFee().Add(tipTx.GetTip()...)
+}
@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestGetTxPriority(t *testing.T) {
+	weights := sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 2), sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(5, 1)))
+
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		weights  sdk.DecCoins
+		priority int64
+	}{
+		{"no fee", sdk.NewCoins(), 1000, nil, 0},
+		{"fee per gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 5000)), 1000, nil, 5},
+		{"truncated fee per gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 5999)), 1000, nil, 5},
+		{"no gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), 0, nil, 50},
+		{"equal weights", sdk.NewCoins(sdk.NewInt64Coin("atom", 3000), sdk.NewInt64Coin("photon", 2000)), 1000, nil, 5},
+		{"weighted denoms", sdk.NewCoins(sdk.NewInt64Coin("atom", 3000), sdk.NewInt64Coin("photon", 2000)), 1000, weights, 7},
+		{"unweighted denom", sdk.NewCoins(sdk.NewInt64Coin("stake", 3000)), 1000, weights, 0},
+		{"overflow", sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(math.MaxInt64).MulRaw(10))), 1, nil, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.priority, ante.GetTxPriority(tc.fee, tc.gas, tc.weights))
+		})
+	}
+}
+
+func TestTxPriorityDecorator(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewStdFee(1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 7000)))
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	var priority int64
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxPriorityDecorator(nil), readPriorityDecorator{&priority})
+
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), priority)
+
+	// the mempool priority of the tx is the same as in the ante handler
+	mempoolPriority, err := ante.FeePriority(nil)(ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, priority, mempoolPriority)
+}
+
+// readPriorityDecorator reads the tx priority set on the context.
+type readPriorityDecorator struct {
+	priority *int64
+}
+
+func (rpd readPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*rpd.priority = ctx.Priority()
+	return next(ctx, tx, simulate)
+}
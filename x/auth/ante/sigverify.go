@@ -8,8 +8,10 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -40,7 +42,7 @@ type SigVerifiableTx interface {
 	GetSignatures() [][]byte
 	GetSigners() []sdk.AccAddress
 	GetPubKeys() []crypto.PubKey // If signer already has pubkey in context, this list will have nil in its place
-	GetSignBytes(ctx sdk.Context, acc types.AccountI) []byte
+	GetSignModes() []types.SignMode
 }
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
@@ -142,6 +144,15 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				pubKey = simSecp256k1Pubkey
 			}
 		}
+
+		if simulate && len(sig) == 0 {
+			// Multisig accounts are charged for every signature they verify, so an
+			// unsigned simulated transaction is charged as if all the keys signed.
+			if multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold); ok {
+				sig = simMultisignature(multisigPubKey)
+			}
+		}
+
 		err = sgcd.sigGasConsumer(ctx.GasMeter(), sig, pubKey, params)
 		if err != nil {
 			return ctx, err
@@ -157,12 +168,14 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
-	ak AccountKeeper
+	ak              AccountKeeper
+	signModeHandler types.SignModeHandler
 }
 
-func NewSigVerificationDecorator(ak AccountKeeper) SigVerificationDecorator {
+func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler types.SignModeHandler) SigVerificationDecorator {
 	return SigVerificationDecorator{
-		ak: ak,
+		ak:              ak,
+		signModeHandler: signModeHandler,
 	}
 }
 
@@ -190,14 +203,21 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	signModes := sigTx.GetSignModes()
+
 	for i, sig := range sigs {
 		signerAccs[i], err = GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
 			return ctx, err
 		}
 
-		// retrieve signBytes of tx
-		signBytes := sigTx.GetSignBytes(ctx, signerAccs[i])
+		// retrieve signBytes of tx; each signer signs with its own account
+		// number and sequence
+		signerData := types.NewSignerData(ctx, signerAccs[i])
+		signBytes, err := svd.signModeHandler.GetSignBytes(signModes[i], signerData, tx)
+		if err != nil {
+			return ctx, err
+		}
 
 		// retrieve pubkey
 		pubKey := signerAccs[i].GetPubKey()
@@ -207,7 +227,11 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// verify signature
 		if !simulate && !pubKey.VerifyBytes(signBytes, sig) {
-			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; verify correct account sequence and chain-id")
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"signature verification failed for signer %s; verify correct account number (%d), account sequence (%d) and chain-id (%s)",
+				signerAddrs[i], signerData.AccountNumber, signerData.Sequence, signerData.ChainID,
+			)
 		}
 	}
 
@@ -215,7 +239,9 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 }
 
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
-// Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
+// Use the IncrementSequenceDecorator decorator to prevent replay attacks. The
+// sequences are not incremented for unordered txs, whose replay is prevented
+// by the UnorderedTxDecorator instead. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
 // CheckTx would already bump the sequence number.
 //
@@ -239,6 +265,10 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	if isUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -303,6 +333,14 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case secp256r1.PubKeySecp256r1:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
+		return nil
+
+	case sr25519.PubKeySr25519:
+		meter.ConsumeGas(params.SigVerifyCostSr25519, "ante verify: sr25519")
+		return nil
+
 	case multisig.PubKeyMultisigThreshold:
 		var multisignature multisig.Multisignature
 		codec.Cdc.MustUnmarshalBinaryBare(sig, &multisignature)
@@ -331,6 +369,17 @@ func ConsumeMultisignatureVerificationGas(
 	}
 }
 
+// simMultisignature returns a placeholder multisignature of the given multisig
+// public key where every key signed, i.e. the most expensive one to verify.
+func simMultisignature(pubKey multisig.PubKeyMultisigThreshold) []byte {
+	multisignature := multisig.NewMultisig(len(pubKey.PubKeys))
+	for i := range pubKey.PubKeys {
+		multisignature.AddSignature(simSecp256k1Sig[:], i)
+	}
+
+	return multisignature.Marshal()
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types.AccountI, error) {
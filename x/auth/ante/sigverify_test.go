@@ -9,8 +9,11 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -82,6 +85,8 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
+		{"PubKeySr25519", args{sdk.NewInfiniteGasMeter(), nil, sr25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSr25519, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -100,6 +105,33 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
+func TestSimulateMultisigGas(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	pkSet, _ := generatePubKeysAndSignatures(5, []byte{1, 2, 3, 4}, false)
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pkSet)
+	addr := sdk.AccAddress(multisigKey.Address())
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(multisigKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// simulated transactions are not signed
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	tx := types.NewStdTx(msgs, types.NewTestStdFee(), []types.StdSignature{{}}, "")
+
+	sgcd := ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
+	antehandler := sdk.ChainAnteDecorators(sgcd)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	ctx, err := antehandler(ctx, tx, true)
+	require.NoError(t, err)
+
+	// every key of the multisig is charged for
+	require.Equal(t, expectedGasCostByKeys(pkSet), ctx.GasMeter().GasConsumed())
+}
+
 func TestSigVerification(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -125,7 +157,7 @@ func TestSigVerification(t *testing.T) {
 	fee := types.NewTestStdFee()
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, types.DefaultSignModeHandler())
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 
 	type testCase struct {
@@ -157,6 +189,38 @@ func TestSigVerification(t *testing.T) {
 			require.Nil(t, err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
 		}
 	}
+
+	// the error of a wrong sequence reports the expected account number and sequence
+	ctx = ctx.WithIsReCheckTx(false)
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{0, 4, 0}, fee)
+	_, err := antehandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	require.Contains(t, err.Error(), "account number (1), account sequence (0)")
+
+	// signatures over the textual representation are verified alongside the
+	// default sign mode
+	privs := []crypto.PrivKey{priv1, priv2, priv3}
+	sigs := make([]types.StdSignature, len(privs))
+	for i, priv := range privs {
+		signMode := types.SignModeTextual
+		signBytes := types.StdSignText(ctx.ChainID(), uint64(i), 0, fee, msgs, "")
+		if i == 1 {
+			signMode = types.SignModeLegacyAminoJSON
+			signBytes = types.StdSignBytes(ctx.ChainID(), uint64(i), 0, fee, msgs, "")
+		}
+
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		sigs[i] = types.StdSignature{PubKey: priv.PubKey().Bytes(), Signature: sig, SignMode: signMode}
+	}
+
+	_, err = antehandler(ctx, types.NewStdTx(msgs, fee, sigs, ""), false)
+	require.NoError(t, err)
+
+	// a textual signature does not verify under the default sign mode
+	sigs[0].SignMode = types.SignModeUnspecified
+	_, err = antehandler(ctx, types.NewStdTx(msgs, fee, sigs, ""), false)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
 }
 
 func TestSigIntegration(t *testing.T) {
@@ -202,7 +266,7 @@ func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...crypto
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
 	svgc := ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, types.DefaultSignModeHandler())
 	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
 
 	// Determine gas consumption of antehandler with default params
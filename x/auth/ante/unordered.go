@@ -0,0 +1,87 @@
+package ante
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultMaxUnorderedTxTimeout is the default maximum duration between the
+// block time and the timeout timestamp of an unordered transaction, which
+// bounds the number of tx hashes kept to prevent their replay.
+const DefaultMaxUnorderedTxTimeout = 10 * time.Minute
+
+var (
+	_ UnorderedTx = (*types.StdTx)(nil) // assert StdTx implements UnorderedTx
+)
+
+// UnorderedTx defines the interface of a transaction which may be unordered,
+// i.e. not ordered by the sequences of its signers.
+type UnorderedTx interface {
+	sdk.Tx
+	GetUnordered() bool
+	GetTimeoutTimestamp() int64
+	GetUnorderedTxHash(chainID string) []byte
+}
+
+// isUnorderedTx returns true if the transaction is unordered.
+func isUnorderedTx(tx sdk.Tx) bool {
+	utx, ok := tx.(UnorderedTx)
+	return ok && utx.GetUnordered()
+}
+
+// UnorderedTxDecorator prevents the replay of unordered transactions, which
+// are signed without sequences so that a signer can send them concurrently.
+// It rejects the unordered txs timed out at the block time, or timing out more
+// than maxTimeout after it, as well as the unordered txs with the hash of a tx
+// already executed. The hashes do not depend on the signatures nor on the
+// encoding of the txs, so that a tx cannot be replayed by re-encoding it. They
+// are kept until the timeout of the txs, the auth module removing the timed
+// out hashes at the beginning of each block.
+// Ordered txs are passed to next AnteHandler unchecked.
+type UnorderedTxDecorator struct {
+	ak         AccountKeeper
+	maxTimeout time.Duration
+}
+
+func NewUnorderedTxDecorator(ak AccountKeeper, maxTimeout time.Duration) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak:         ak,
+		maxTimeout: maxTimeout,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !isUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	utx := tx.(UnorderedTx)
+	timeoutTimestamp := utx.GetTimeoutTimestamp()
+	timeout := time.Unix(timeoutTimestamp, 0)
+	blockTime := ctx.BlockTime()
+
+	if !timeout.After(blockTime) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timed out at %s; block time: %s", timeout.UTC(), blockTime.UTC(),
+		)
+	}
+	if timeout.After(blockTime.Add(utd.maxTimeout)) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout %s exceeds the max timeout %s", timeout.UTC(), utd.maxTimeout,
+		)
+	}
+
+	txHash := utx.GetUnorderedTxHash(ctx.ChainID())
+	if utd.ak.ContainsUnorderedTx(ctx, txHash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X already executed", txHash)
+	}
+
+	if !simulate {
+		utd.ak.SetUnorderedTx(ctx, txHash, timeoutTimestamp)
+	}
+
+	return next(ctx, tx, simulate)
+}
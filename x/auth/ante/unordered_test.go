@@ -0,0 +1,88 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestUnorderedTxDecorator(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	blockTime := time.Unix(1000000, 0)
+	ctx = ctx.WithBlockTime(blockTime).WithTxBytes([]byte("unordered tx"))
+
+	utd := ante.NewUnorderedTxDecorator(app.AccountKeeper, time.Minute)
+	antehandler := sdk.ChainAnteDecorators(utd)
+
+	_, _, addr := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	newTx := func(timeout time.Time) types.StdTx {
+		return types.NewUnorderedStdTx(msgs, types.NewTestStdFee(), nil, "", timeout.Unix())
+	}
+
+	// ordered txs are not checked
+	_, err := antehandler(ctx, types.NewStdTx(msgs, types.NewTestStdFee(), nil, ""), false)
+	require.NoError(t, err)
+
+	// the timeout must be after the block time and within the max timeout
+	_, err = antehandler(ctx, newTx(blockTime), false)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	_, err = antehandler(ctx, newTx(blockTime.Add(time.Minute+time.Second)), false)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	// simulated txs are not recorded
+	tx := newTx(blockTime.Add(time.Minute))
+	_, err = antehandler(ctx, tx, true)
+	require.NoError(t, err)
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, tx.GetUnorderedTxHash(ctx.ChainID())))
+
+	// a tx cannot be replayed until its timeout
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, tx.GetUnorderedTxHash(ctx.ChainID())))
+
+	_, err = antehandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	// nor by re-encoding it, e.g. omitting the public keys of its signers
+	reencoded := tx
+	reencoded.Signatures = []types.StdSignature{{Signature: []byte("signature")}}
+	_, err = antehandler(ctx.WithTxBytes([]byte("re-encoded unordered tx")), reencoded, false)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	// the hash of a tx is removed once it timed out
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Minute - time.Second))
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, tx.GetUnorderedTxHash(ctx.ChainID())))
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Minute))
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx)
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, tx.GetUnorderedTxHash(ctx.ChainID())))
+}
+
+func TestIncrementSequenceDecoratorUnordered(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	_, _, addr := types.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(uint64(50)))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	tx := types.NewUnorderedStdTx(msgs, types.NewTestStdFee(), nil, "", 1)
+
+	isd := ante.NewIncrementSequenceDecorator(app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(isd)
+
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), app.AccountKeeper.GetAccount(ctx, addr).GetSequence())
+}
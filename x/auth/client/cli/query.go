@@ -20,7 +20,8 @@ import (
 )
 
 const (
-	flagEvents = "events"
+	flagEvents  = "events"
+	flagOrderBy = "order-by"
 
 	eventFormat = "{eventType}.{eventAttribute}={value}"
 )
@@ -37,6 +38,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(cdc),
+		GetPubKeyCmd(cdc),
 		QueryParamsCmd(cdc),
 	)
 
@@ -102,6 +104,49 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetPubKeyCmd returns a query command that displays the public key of the
+// account at a given address, e.g. to build a multisig key with it.
+func GetPubKeyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubkey [address]",
+		Short: "Query for the public key of an account by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the Bech32 public key of an account, along with its account number and
+sequence. The public key of an account is stored when the account signs its first transaction.
+
+Example:
+$ %s query auth pubkey cosmos1...
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			accGetter := types.NewAccountRetriever(authclient.Codec)
+
+			key, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			acc, err := accGetter.GetAccount(cliCtx, key)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := types.NewAccountPubKey(acc)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(pubKey)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -112,11 +157,15 @@ func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 Search for transactions that match the exact given events where results are paginated.
 Each event takes the form of '%s'. Please refer
 to each module's documentation for the full set of events to query for. Each module
-documents its respective events under 'xx_events.md'.
+documents its respective events under 'xx_events.md'. Results can be restricted to an
+inclusive height range with the '%s' and '%s' events.
 
 Example:
 $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator_reward' --page 1 --limit 30
-`, eventFormat, version.ClientName, flagEvents),
+$ %s query txs --%s 'message.sender=cosmos1...&%s=100&%s=200' --%s desc
+`, eventFormat, rest.TxMinHeightKey, rest.TxMaxHeightKey,
+				version.ClientName, flagEvents,
+				version.ClientName, flagEvents, rest.TxMinHeightKey, rest.TxMaxHeightKey, flagOrderBy),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			eventsStr := strings.Trim(viper.GetString(flagEvents), "'")
@@ -138,9 +187,14 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 				}
 
 				tokens := strings.Split(event, "=")
-				if tokens[0] == tmtypes.TxHeightKey {
+				switch tokens[0] {
+				case tmtypes.TxHeightKey:
 					event = fmt.Sprintf("%s=%s", tokens[0], tokens[1])
-				} else {
+				case rest.TxMinHeightKey:
+					event = fmt.Sprintf("%s>=%s", tmtypes.TxHeightKey, tokens[1])
+				case rest.TxMaxHeightKey:
+					event = fmt.Sprintf("%s<=%s", tmtypes.TxHeightKey, tokens[1])
+				default:
 					event = fmt.Sprintf("%s='%s'", tokens[0], tokens[1])
 				}
 
@@ -150,25 +204,18 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 			page := viper.GetInt(flags.FlagPage)
 			limit := viper.GetInt(flags.FlagLimit)
 
-			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			txs, err := authclient.QueryTxsByEvents(cliCtx, tmEvents, page, limit, "")
-			if err != nil {
-				return err
-			}
-
-			var output []byte
-			if cliCtx.Indent {
-				output, err = cdc.MarshalJSONIndent(txs, "", "  ")
-			} else {
-				output, err = cdc.MarshalJSON(txs)
+			orderBy := strings.ToLower(viper.GetString(flagOrderBy))
+			if orderBy != "" && orderBy != rest.OrderByAsc && orderBy != rest.OrderByDesc {
+				return fmt.Errorf("invalid --%s value %q; must be either %s or %s", flagOrderBy, orderBy, rest.OrderByAsc, rest.OrderByDesc)
 			}
 
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txs, err := authclient.QueryTxsByEvents(cliCtx, tmEvents, page, limit, orderBy)
 			if err != nil {
 				return err
 			}
 
-			fmt.Println(string(output))
-			return nil
+			return cliCtx.PrintOutput(txs)
 		},
 	}
 
@@ -184,6 +231,7 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
 	cmd.Flags().Uint32(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Uint32(flags.FlagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
+	cmd.Flags().String(flagOrderBy, "", fmt.Sprintf("Order results by height (%s|%s); defaults to the node's ordering", rest.OrderByAsc, rest.OrderByDesc))
 	cmd.MarkFlagRequired(flagEvents)
 
 	return cmd
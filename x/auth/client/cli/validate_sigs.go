@@ -143,7 +143,10 @@ func readStdTxAndInitContexts(cdc *codec.Codec, cmd *cobra.Command, filename str
 
 	inBuf := bufio.NewReader(cmd.InOrStdin())
 	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
-	txBldr := types.NewTxBuilderFromCLI(inBuf)
+	txBldr, err := types.NewTxBuilderFromCLI(inBuf)
+	if err != nil {
+		return context.CLIContext{}, types.TxBuilder{}, types.StdTx{}, err
+	}
 
 	return cliCtx, txBldr, stdTx, nil
 }
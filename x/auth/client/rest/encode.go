@@ -2,6 +2,7 @@ package rest
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 
@@ -10,14 +11,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// EncodeResp defines a tx encoding response.
-type EncodeResp struct {
-	Tx string `json:"tx" yaml:"tx"`
-}
+type (
+	// EncodeReq defines a tx encoding request.
+	EncodeReq struct {
+		Tx types.StdTx `json:"tx" yaml:"tx"`
+	}
+
+	// EncodeResp defines a tx encoding response.
+	EncodeResp struct {
+		Tx string `json:"tx" yaml:"tx"`
+	}
+)
 
 // EncodeTxRequestHandlerFn returns the encode tx REST handler. In particular,
 // it takes a json-formatted transaction, encodes it to the Amino wire protocol,
-// and responds with base64-encoded bytes.
+// and responds with base64-encoded bytes. The transaction is either wrapped in
+// an EncodeReq or sent as is.
 func EncodeTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req types.StdTx
@@ -27,6 +36,14 @@ func EncodeTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// unwrap the tx of an EncodeReq, a StdTx having no tx field
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(body, &fields) == nil {
+			if tx, ok := fields["tx"]; ok {
+				body = tx
+			}
+		}
+
 		err = cliCtx.Codec.UnmarshalJSON(body, &req)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -0,0 +1,77 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func makeCodec() *codec.Codec {
+	var cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+	return cdc
+}
+
+func postJSON(t *testing.T, handler http.HandlerFunc, body []byte) *httptest.ResponseRecorder {
+	req, err := http.NewRequest("POST", "/", bytes.NewReader(body))
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	return rec
+}
+
+func TestEncodeDecodeTx(t *testing.T) {
+	cdc := makeCodec()
+	cliCtx := context.CLIContext{}.WithCodec(cdc)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	fee := authtypes.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	stdTx := authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, fee, nil, "memo")
+
+	txBytes, err := cdc.MarshalBinaryBare(stdTx)
+	require.NoError(t, err)
+	txBase64 := base64.StdEncoding.EncodeToString(txBytes)
+
+	// the tx may be sent as is or wrapped in an encode request
+	for _, body := range [][]byte{cdc.MustMarshalJSON(stdTx), cdc.MustMarshalJSON(authrest.EncodeReq{Tx: stdTx})} {
+		rec := postJSON(t, authrest.EncodeTxRequestHandlerFn(cliCtx), body)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var encodeResp authrest.EncodeResp
+		require.NoError(t, cdc.UnmarshalJSON(rec.Body.Bytes(), &encodeResp))
+		require.Equal(t, txBase64, encodeResp.Tx)
+	}
+
+	rec := postJSON(t, authrest.EncodeTxRequestHandlerFn(cliCtx), []byte("not a tx"))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// the encoded tx decodes back to the original tx
+	rec = postJSON(t, authrest.DecodeTxRequestHandlerFn(cliCtx), cdc.MustMarshalJSON(authrest.DecodeReq{Tx: txBase64}))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp rest.ResponseWithHeight
+	require.NoError(t, cdc.UnmarshalJSON(rec.Body.Bytes(), &resp))
+
+	var decodeResp authrest.DecodeResp
+	require.NoError(t, cdc.UnmarshalJSON(resp.Result, &decodeResp))
+	require.Equal(t, stdTx.Memo, decodeResp.Memo)
+	require.Equal(t, txBytes, cdc.MustMarshalBinaryBare(authtypes.StdTx(decodeResp)))
+
+	rec = postJSON(t, authrest.DecodeTxRequestHandlerFn(cliCtx), cdc.MustMarshalJSON(authrest.DecodeReq{Tx: "invalid"}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -53,6 +53,61 @@ func QueryAccountRequestHandlerFn(storeName string, cliCtx context.CLIContext) h
 	}
 }
 
+// QueryPubKeyRequestHandlerFn implements a REST handler that returns the public
+// key of an account, along with its account number and sequence.
+func QueryPubKeyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		account, height, err := types.NewAccountRetriever(client.Codec).GetAccountWithHeight(cliCtx, addr)
+		if rest.CheckNotFoundError(w, err) {
+			return
+		}
+
+		pubKey, err := types.NewAccountPubKey(account)
+		if rest.CheckNotFoundError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, pubKey)
+	}
+}
+
+// QueryAccountProofRequestHandlerFn implements a REST handler that returns the
+// stored account of an address along with the merkle proof of its existence or
+// non-existence. Only the key of the given address can be proven, so that a
+// request cannot make the node build proofs of arbitrary keys or ranges.
+func QueryAccountProofRequestHandlerFn(storeName string, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		proof, err := cliCtx.QueryStoreProof(types.AddressStoreKey(addr), storeName)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(proof.Height)
+		rest.PostProcessResponse(w, cliCtx, proof)
+	}
+}
+
 // QueryTxsHandlerFn implements a REST handler that searches for transactions.
 // Genesis transactions are returned if the height parameter is set to zero,
 // otherwise the transactions are searched for by events.
@@ -80,6 +135,7 @@ func QueryTxsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			events      []string
 			txs         []sdk.TxResponse
 			page, limit int
+			orderBy     string
 		)
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -97,7 +153,12 @@ func QueryTxsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		searchResult, err := client.QueryTxsByEvents(cliCtx, events, page, limit, "")
+		orderBy, err = rest.ParseOrderBy(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		searchResult, err := client.QueryTxsByEvents(cliCtx, events, page, limit, orderBy)
 		if rest.CheckInternalServerError(w, err) {
 			return
 		}
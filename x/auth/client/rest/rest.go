@@ -17,6 +17,14 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		"/auth/accounts/{address}", QueryAccountRequestHandlerFn(storeName, cliCtx),
 	).Methods(MethodGet)
 
+	r.HandleFunc(
+		"/auth/accounts/{address}/pubkey", QueryPubKeyRequestHandlerFn(cliCtx),
+	).Methods(MethodGet)
+
+	r.HandleFunc(
+		"/auth/accounts/{address}/proof", QueryAccountProofRequestHandlerFn(storeName, cliCtx),
+	).Methods(MethodGet)
+
 	r.HandleFunc(
 		"/auth/params",
 		queryParamsHandler(cliCtx),
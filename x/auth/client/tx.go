@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -41,9 +42,14 @@ func (gr GasEstimateResponse) String() string {
 
 // GenerateOrBroadcastMsgs creates a StdTx given a series of messages. If
 // the provided context has generate-only enabled, the tx will only be printed
-// to STDOUT in a fully offline manner. Otherwise, the tx will be signed and
+// to STDOUT in a fully offline manner. If a dry run was requested, the tx is
+// printed with its gas simulated. Otherwise, the tx will be signed and
 // broadcasted.
 func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr authtypes.TxBuilder, msgs []sdk.Msg) error {
+	if cliCtx.Simulate {
+		return DryRunMsgs(txBldr, cliCtx, msgs)
+	}
+
 	if cliCtx.GenerateOnly {
 		return PrintUnsignedStdTx(txBldr, cliCtx, msgs)
 	}
@@ -57,6 +63,10 @@ func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr authtypes.TxBuild
 // sequence set. In addition, it builds and signs a transaction with the
 // supplied messages. Finally, it broadcasts the signed transaction to a node.
 func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if cliCtx.Simulate {
+		return DryRunMsgs(txBldr, cliCtx, msgs)
+	}
+
 	txBldr, err := PrepareTxBuilder(txBldr, cliCtx)
 	if err != nil {
 		return err
@@ -64,7 +74,7 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 
 	fromName := cliCtx.GetFromName()
 
-	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+	if txBldr.SimulateAndExecute() {
 		txBldr, err = EnrichWithGas(txBldr, cliCtx, msgs)
 		if err != nil {
 			return err
@@ -74,10 +84,6 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 
-	if cliCtx.Simulate {
-		return nil
-	}
-
 	if !cliCtx.SkipConfirm {
 		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
 		if err != nil {
@@ -119,6 +125,36 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 	return cliCtx.PrintOutput(res)
 }
 
+// DryRunMsgs builds the StdTx that CompleteAndBroadcastTxCLI would sign and
+// broadcast, with its gas simulated, and prints it unsigned, so that its
+// messages and fees can be reviewed. Its gas estimate and signers are printed
+// to os.Stderr. Nothing is signed nor broadcast.
+func DryRunMsgs(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if cliCtx.Offline {
+		return errors.New("cannot perform a dry run in offline mode")
+	}
+
+	txBldr, err := PrepareTxBuilder(txBldr, cliCtx)
+	if err != nil {
+		return err
+	}
+
+	txBldr, err = EnrichWithGas(txBldr, cliCtx, msgs)
+	if err != nil {
+		return err
+	}
+
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return err
+	}
+
+	stdTx := stdSignMsg.StdTx(nil)
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", tx.DryRunResponse{GasEstimate: txBldr.Gas(), Signers: stdTx.GetSigners()})
+
+	return cliCtx.PrintOutput(stdTx)
+}
+
 // EnrichWithGas calculates the gas estimate that would be consumed by the
 // transaction and set the transaction's respective value accordingly.
 func EnrichWithGas(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (authtypes.TxBuilder, error) {
@@ -1,6 +1,10 @@
 package auth
 
 import (
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -34,3 +38,35 @@ func ExportGenesis(ctx sdk.Context, ak AccountKeeper) GenesisState {
 
 	return NewGenesisState(params, genAccounts)
 }
+
+// ExportGenesisTo writes the GenesisState of the given keeper as JSON to w,
+// one account at a time, so that the accounts are never all held in memory.
+func ExportGenesisTo(ctx sdk.Context, ak AccountKeeper, cdc codec.JSONMarshaler, w io.Writer) error {
+	params, err := cdc.MarshalJSON(ak.GetParams(ctx))
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, `{"params":%s,"accounts":[`, params); err != nil {
+		return err
+	}
+
+	sep := ""
+	ak.IterateAccounts(ctx, func(account types.AccountI) bool {
+		var bz []byte
+		bz, err = cdc.MarshalJSON(account.(types.GenesisAccount))
+		if err != nil {
+			return true
+		}
+
+		_, err = fmt.Fprintf(w, "%s%s", sep, bz)
+		sep = ","
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
@@ -184,15 +184,19 @@ func (ak AccountKeeper) decodeAccount(bz []byte) types.AccountI {
 	return acc
 }
 
-// MarshalEvidence marshals an Evidence interface. If the given type implements
+// MarshalAccount marshals an AccountI interface. If the given type implements
 // the Marshaler interface, it is treated as a Proto-defined message and
 // serialized that way. Otherwise, it falls back on the internal Amino codec.
+//
+// The concrete account type must be registered as an implementation of AccountI
+// on the interface registry of the codec, which allows applications to store
+// custom account types.
 func (ak AccountKeeper) MarshalAccount(accountI types.AccountI) ([]byte, error) {
 	return codec.MarshalAny(ak.cdc, accountI)
 }
 
-// UnmarshalEvidence returns an Evidence interface from raw encoded evidence
-// bytes of a Proto-based Evidence type. An error is returned upon decoding
+// UnmarshalAccount returns an AccountI interface from raw encoded account
+// bytes of a Proto-based account type. An error is returned upon decoding
 // failure.
 func (ak AccountKeeper) UnmarshalAccount(bz []byte) (types.AccountI, error) {
 	var acc types.AccountI
@@ -31,6 +31,7 @@ func TestAccountMapperGetSet(t *testing.T) {
 	// no account before its created
 	acc := app.AccountKeeper.GetAccount(ctx, addr)
 	require.Nil(t, acc)
+	require.False(t, app.AccountKeeper.HasAccount(ctx, addr))
 
 	// create account and check default values
 	acc = app.AccountKeeper.NewAccountWithAddress(ctx, addr)
@@ -47,6 +48,7 @@ func TestAccountMapperGetSet(t *testing.T) {
 	err := acc.SetSequence(newSequence)
 	require.NoError(t, err)
 	app.AccountKeeper.SetAccount(ctx, acc)
+	require.True(t, app.AccountKeeper.HasAccount(ctx, addr))
 
 	// check the new values
 	acc = app.AccountKeeper.GetAccount(ctx, addr)
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if an unordered tx with the given hash was
+// executed and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	return ctx.KVStore(ak.key).Has(types.UnorderedTxKey(txHash))
+}
+
+// SetUnorderedTx records an executed unordered tx until its timeout timestamp,
+// preventing its replay.
+func (ak AccountKeeper) SetUnorderedTx(ctx sdk.Context, txHash []byte, timeoutTimestamp int64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxKey(txHash), sdk.Uint64ToBigEndian(uint64(timeoutTimestamp)))
+	store.Set(types.UnorderedTxByTimeoutKey(timeoutTimestamp, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs timed out at the block
+// time, which cannot be included in a block anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	end := types.UnorderedTxByTimeoutPrefix(ctx.BlockTime().Unix() + 1)
+
+	iterator := store.Iterator(types.UnorderedTxByTimeoutKeyPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		txHash := key[len(types.UnorderedTxByTimeoutKeyPrefix)+8:]
+		store.Delete(types.UnorderedTxKey(txHash))
+		store.Delete(key)
+	}
+}
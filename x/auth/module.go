@@ -3,6 +3,7 @@ package auth
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 
 	"github.com/gorilla/mux"
@@ -26,6 +27,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.InterfaceModule     = AppModuleBasic{}
+
+	_ module.StreamingGenesisModule = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -36,6 +39,11 @@ func (AppModuleBasic) Name() string {
 	return authtypes.ModuleName
 }
 
+// StoreKeys returns the keys of the auth module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the auth module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	authtypes.RegisterCodec(cdc)
@@ -134,8 +142,17 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// ExportGenesisTo writes the exported genesis state of the auth module as JSON
+// to w, without holding all the accounts in memory.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONMarshaler, w io.Writer) error {
+	return ExportGenesisTo(ctx, am.accountKeeper, cdc, w)
+}
+
+// BeginBlock returns the begin blocker for the auth module. It removes the
+// timed out unordered txs.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
@@ -21,6 +21,9 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	MaxTxBytes             = "max_tx_bytes"
+	SigVerifyCostSECP256R1 = "sig_verify_cost_secp256r1"
+	SigVerifyCostSR25519   = "sig_verify_cost_sr25519"
 )
 
 // GenMaxMemoChars randomized MaxMemoChars
@@ -51,6 +54,21 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenMaxTxBytes randomized MaxTxBytes
+func GenMaxTxBytes(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 64*1024, 1024*1024))
+}
+
+// GenSigVerifyCostSECP256R1 randomized SigVerifyCostSECP256R1
+func GenSigVerifyCostSECP256R1(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1000, 2000))
+}
+
+// GenSigVerifyCostSR25519 randomized SigVerifyCostSR25519
+func GenSigVerifyCostSR25519(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 500, 1500))
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxMemoChars uint64
@@ -83,8 +101,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
 	)
 
+	var maxTxBytes uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxTxBytes, &maxTxBytes, simState.Rand,
+		func(r *rand.Rand) { maxTxBytes = GenMaxTxBytes(r) },
+	)
+
+	var sigVerifyCostSECP256R1 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSECP256R1, &sigVerifyCostSECP256R1, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSECP256R1 = GenSigVerifyCostSECP256R1(r) },
+	)
+
+	var sigVerifyCostSR25519 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSR25519, &sigVerifyCostSR25519, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSR25519 = GenSigVerifyCostSR25519(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, maxTxBytes, sigVerifyCostSECP256R1, sigVerifyCostSR25519)
 	genesisAccs := RandomGenesisAccounts(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
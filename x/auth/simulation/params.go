@@ -16,6 +16,7 @@ const (
 	keyMaxMemoCharacters = "MaxMemoCharacters"
 	keyTxSigLimit        = "TxSigLimit"
 	keyTxSizeCostPerByte = "TxSizeCostPerByte"
+	keyMaxTxBytes        = "MaxTxBytes"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +38,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenTxSizeCostPerByte(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxTxBytes,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxTxBytes(r))
+			},
+		),
 	}
 }
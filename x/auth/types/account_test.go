@@ -206,3 +206,27 @@ func TestGenesisAccountsContains(t *testing.T) {
 	genAccounts = append(genAccounts, acc)
 	require.True(t, genAccounts.Contains(acc.GetAddress()))
 }
+
+func TestNewAccountPubKey(t *testing.T) {
+	_, pub, addr := types.KeyTestPubAddr()
+	acc := types.NewBaseAccount(addr, nil, 3, 7)
+
+	// the pubkey is only known once the account signed a transaction
+	_, err := types.NewAccountPubKey(acc)
+	require.Error(t, err)
+
+	require.NoError(t, acc.SetPubKey(pub))
+	accPubKey, err := types.NewAccountPubKey(acc)
+	require.NoError(t, err)
+
+	pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pub)
+	require.NoError(t, err)
+	require.Equal(t, addr, accPubKey.Address)
+	require.Equal(t, pks, accPubKey.PubKey)
+	require.Equal(t, uint64(3), accPubKey.AccountNumber)
+	require.Equal(t, uint64(7), accPubKey.Sequence)
+
+	decoded, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, accPubKey.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, pub, decoded)
+}
@@ -48,6 +48,9 @@ func (s *StdTxBuilder) SetSignatures(signatures ...context.ClientSignature) erro
 			PubKey:    pubKeyBz,
 			Signature: sig.GetSignature(),
 		}
+		if stdSig, ok := sig.(*StdSignature); ok {
+			sigs[i].SignMode = stdSig.SignMode
+		}
 	}
 	s.Signatures = sigs
 	return nil
@@ -14,6 +14,10 @@ const (
 	// FeeCollectorName the root string for the fee collector account address
 	FeeCollectorName = "fee_collector"
 
+	// TipCollectorName the root string for the account collecting the tips paid
+	// to the block proposers until they are distributed
+	TipCollectorName = "tip_collector"
+
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
 )
@@ -22,6 +26,14 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxKeyPrefix prefix for the timeout timestamps of the unordered
+	// txs executed and not timed out yet, by tx hash
+	UnorderedTxKeyPrefix = []byte{0x02}
+
+	// UnorderedTxByTimeoutKeyPrefix prefix for the index of the unordered txs
+	// by timeout timestamp
+	UnorderedTxByTimeoutKeyPrefix = []byte{0x03}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +42,21 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedTxKey returns the key of the timeout timestamp of an unordered tx,
+// by its hash.
+func UnorderedTxKey(txHash []byte) []byte {
+	return append(UnorderedTxKeyPrefix, txHash...)
+}
+
+// UnorderedTxByTimeoutKey returns the key of an unordered tx in the index of
+// the unordered txs by timeout timestamp.
+func UnorderedTxByTimeoutKey(timeoutTimestamp int64, txHash []byte) []byte {
+	return append(UnorderedTxByTimeoutPrefix(timeoutTimestamp), txHash...)
+}
+
+// UnorderedTxByTimeoutPrefix returns the prefix of the keys of the unordered
+// txs timing out at the given timestamp.
+func UnorderedTxByTimeoutPrefix(timeoutTimestamp int64) []byte {
+	return append(UnorderedTxByTimeoutKeyPrefix, sdk.Uint64ToBigEndian(uint64(timeoutTimestamp))...)
+}
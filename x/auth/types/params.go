@@ -18,6 +18,9 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultMaxTxBytes             uint64 = 1024 * 1024
+	DefaultSigVerifyCostSecp256r1 uint64 = 1500
+	DefaultSigVerifyCostSr25519   uint64 = 1200
 )
 
 // Parameter keys
@@ -27,13 +30,18 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMaxTxBytes             = []byte("MaxTxBytes")
+	KeySigVerifyCostSecp256r1 = []byte("SigVerifyCostSecp256r1")
+	KeySigVerifyCostSr25519   = []byte("SigVerifyCostSr25519")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object. A zero maxTxBytes does not limit the
+// size of the transactions.
 func NewParams(
-	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1,
+	maxTxBytes, sigVerifyCostSecp256r1, sigVerifyCostSr25519 uint64,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -41,6 +49,9 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MaxTxBytes:             maxTxBytes,
+		SigVerifyCostSecp256r1: sigVerifyCostSecp256r1,
+		SigVerifyCostSr25519:   sigVerifyCostSr25519,
 	}
 }
 
@@ -59,6 +70,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyMaxTxBytes, &p.MaxTxBytes, validateMaxTxBytes),
+		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256r1, &p.SigVerifyCostSecp256r1, validateSigVerifyCostSecp256r1),
+		paramtypes.NewParamSetPair(KeySigVerifyCostSr25519, &p.SigVerifyCostSr25519, validateSigVerifyCostSr25519),
 	}
 }
 
@@ -70,6 +84,9 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxTxBytes:             DefaultMaxTxBytes,
+		SigVerifyCostSecp256r1: DefaultSigVerifyCostSecp256r1,
+		SigVerifyCostSr25519:   DefaultSigVerifyCostSr25519,
 	}
 }
 
@@ -118,6 +135,32 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 	return nil
 }
 
+func validateSigVerifyCostSecp256r1(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid SECP256r1 signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
+func validateSigVerifyCostSr25519(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid SR25519 signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -144,6 +187,16 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+// validateMaxTxBytes accepts any value, a zero value not limiting the size of
+// the transactions.
+func validateMaxTxBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -161,6 +214,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateMaxTxBytes(p.MaxTxBytes); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSecp256r1(p.SigVerifyCostSecp256r1); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSr25519(p.SigVerifyCostSr25519); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // query endpoints supported by the auth Querier
@@ -19,3 +20,36 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// AccountPubKey defines the public key of an account, Bech32 encoded, along
+// with the account number and sequence needed to verify its signatures.
+type AccountPubKey struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey        string         `json:"public_key" yaml:"public_key"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+}
+
+// NewAccountPubKey returns the public key of the given account. An error is
+// returned if the account has no public key yet, which is set when the account
+// signs its first transaction.
+func NewAccountPubKey(acc AccountI) (AccountPubKey, error) {
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return AccountPubKey{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidPubKey, "account %s has no public key, it has not signed any transaction yet", acc.GetAddress(),
+		)
+	}
+
+	pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return AccountPubKey{}, err
+	}
+
+	return AccountPubKey{
+		Address:       acc.GetAddress(),
+		PubKey:        pks,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}, nil
+}
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SignMode defines how the sign bytes of a transaction are derived by a signer.
+// It allows signers, e.g. hardware wallets, to sign a representation of a
+// transaction they are able to render, while the transaction itself keeps a
+// single encoding.
+type SignMode int32
+
+const (
+	// SignModeUnspecified is used when no sign mode is set on a signature, in
+	// which case the default sign mode of the SignModeHandler applies.
+	SignModeUnspecified SignMode = 0
+
+	// SignModeDirect signs the Amino binary encoding of a StdSignDoc, which is
+	// more compact than its JSON encoding.
+	SignModeDirect SignMode = 1
+
+	// SignModeTextual signs a human-readable textual representation of the
+	// transaction.
+	SignModeTextual SignMode = 2
+
+	// SignModeLegacyAminoJSON signs the sorted Amino JSON encoding of a
+	// StdSignDoc.
+	SignModeLegacyAminoJSON SignMode = 127
+)
+
+// String implements fmt.Stringer.
+func (m SignMode) String() string {
+	switch m {
+	case SignModeUnspecified:
+		return "unspecified"
+	case SignModeDirect:
+		return "direct"
+	case SignModeTextual:
+		return "textual"
+	case SignModeLegacyAminoJSON:
+		return "amino-json"
+	default:
+		return fmt.Sprintf("%d", int32(m))
+	}
+}
+
+// ParseSignMode returns the SignMode matching the given string, as returned by
+// SignMode.String. An empty string yields SignModeUnspecified.
+func ParseSignMode(s string) (SignMode, error) {
+	switch s {
+	case "", "unspecified":
+		return SignModeUnspecified, nil
+	case "direct":
+		return SignModeDirect, nil
+	case "textual":
+		return SignModeTextual, nil
+	case "amino-json":
+		return SignModeLegacyAminoJSON, nil
+	default:
+		return SignModeUnspecified, fmt.Errorf("invalid sign mode %q", s)
+	}
+}
+
+// SignerData is the specific information needed to sign a transaction that
+// generally isn't included in the transaction body itself.
+type SignerData struct {
+	// ChainID is the chain that this transaction is targeted for.
+	ChainID string
+
+	// AccountNumber is the account number of the signer.
+	AccountNumber uint64
+
+	// Sequence is the account sequence of the signer, which is used for
+	// replay protection.
+	Sequence uint64
+}
+
+// NewSignerData returns the SignerData of an account signing a transaction
+// executed with the given context. Transactions included in the genesis state
+// are signed with an account number of 0, since accounts are not yet numbered.
+func NewSignerData(ctx sdk.Context, acc AccountI) SignerData {
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	return SignerData{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+}
+
+// SignModeHandler defines an interface to be implemented by types which handle
+// sign modes by generating sign bytes from a transaction and SignerData.
+type SignModeHandler interface {
+	// DefaultMode is the default mode that is to be used with this handler if
+	// no other mode is specified.
+	DefaultMode() SignMode
+
+	// Modes is the list of modes supported by this handler.
+	Modes() []SignMode
+
+	// GetSignBytes returns the sign bytes for the provided SignMode,
+	// SignerData and transaction, or an error.
+	GetSignBytes(mode SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// LegacyAminoJSONHandler is a SignModeHandler that handles
+// SignModeLegacyAminoJSON for StdTx transactions.
+type LegacyAminoJSONHandler struct{}
+
+var _ SignModeHandler = LegacyAminoJSONHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode.
+func (LegacyAminoJSONHandler) DefaultMode() SignMode {
+	return SignModeLegacyAminoJSON
+}
+
+// Modes implements SignModeHandler.Modes.
+func (LegacyAminoJSONHandler) Modes() []SignMode {
+	return []SignMode{SignModeLegacyAminoJSON}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes.
+func (LegacyAminoJSONHandler) GetSignBytes(mode SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != SignModeLegacyAminoJSON {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected %s, got %s", SignModeLegacyAminoJSON, mode)
+	}
+
+	var stdTx StdTx
+	switch t := tx.(type) {
+	case StdTx:
+		stdTx = t
+	case *StdTx:
+		stdTx = *t
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", StdTx{}, tx)
+	}
+
+	return stdTx.signBytes(data), nil
+}
+
+// DirectHandler is a SignModeHandler that handles SignModeDirect for StdTx
+// transactions.
+type DirectHandler struct{}
+
+var _ SignModeHandler = DirectHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode.
+func (DirectHandler) DefaultMode() SignMode {
+	return SignModeDirect
+}
+
+// Modes implements SignModeHandler.Modes.
+func (DirectHandler) Modes() []SignMode {
+	return []SignMode{SignModeDirect}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes.
+func (DirectHandler) GetSignBytes(mode SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != SignModeDirect {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected %s, got %s", SignModeDirect, mode)
+	}
+
+	var stdTx StdTx
+	switch t := tx.(type) {
+	case StdTx:
+		stdTx = t
+	case *StdTx:
+		stdTx = *t
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", StdTx{}, tx)
+	}
+
+	return stdTx.directSignBytes(data), nil
+}
+
+// SignModeHandlerMap is a SignModeHandler which dispatches to the handler
+// registered for each supported sign mode.
+type SignModeHandlerMap struct {
+	defaultMode      SignMode
+	modes            []SignMode
+	signModeHandlers map[SignMode]SignModeHandler
+}
+
+var _ SignModeHandler = SignModeHandlerMap{}
+
+// NewSignModeHandlerMap returns a new SignModeHandlerMap with the provided
+// default mode and handlers. It panics if the default mode is not supported
+// by any of the handlers or if two handlers support the same mode.
+func NewSignModeHandlerMap(defaultMode SignMode, handlers []SignModeHandler) SignModeHandlerMap {
+	handlerMap := make(map[SignMode]SignModeHandler)
+	var modes []SignMode
+
+	for _, h := range handlers {
+		for _, m := range h.Modes() {
+			if _, have := handlerMap[m]; have {
+				panic(fmt.Sprintf("duplicate sign mode handler for mode %s", m))
+			}
+
+			handlerMap[m] = h
+			modes = append(modes, m)
+		}
+	}
+
+	if _, have := handlerMap[defaultMode]; !have {
+		panic(fmt.Sprintf("no sign mode handler for default mode %s", defaultMode))
+	}
+
+	return SignModeHandlerMap{
+		defaultMode:      defaultMode,
+		modes:            modes,
+		signModeHandlers: handlerMap,
+	}
+}
+
+// DefaultSignModeHandler returns the SignModeHandler supporting the sign modes
+// of StdTx, with SignModeLegacyAminoJSON as default mode.
+func DefaultSignModeHandler() SignModeHandler {
+	return NewSignModeHandlerMap(
+		SignModeLegacyAminoJSON,
+		[]SignModeHandler{LegacyAminoJSONHandler{}, DirectHandler{}, TextualHandler{}},
+	)
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode.
+func (h SignModeHandlerMap) DefaultMode() SignMode {
+	return h.defaultMode
+}
+
+// Modes implements SignModeHandler.Modes.
+func (h SignModeHandlerMap) Modes() []SignMode {
+	return h.modes
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes.
+func (h SignModeHandlerMap) GetSignBytes(mode SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode == SignModeUnspecified {
+		mode = h.defaultMode
+	}
+
+	handler, found := h.signModeHandlers[mode]
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported sign mode %s", mode)
+	}
+
+	return handler.GetSignBytes(mode, data, tx)
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestLegacyAminoJSONHandler(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	msgs := []sdk.Msg{NewTestMsg(addr)}
+	fee := NewTestStdFee()
+	tx := NewStdTx(msgs, fee, nil, "memo")
+	data := SignerData{ChainID: "test-chain", AccountNumber: 3, Sequence: 7}
+
+	handler := LegacyAminoJSONHandler{}
+	require.Equal(t, SignModeLegacyAminoJSON, handler.DefaultMode())
+
+	signBytes, err := handler.GetSignBytes(SignModeLegacyAminoJSON, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, StdSignBytes("test-chain", 3, 7, fee, msgs, "memo"), signBytes)
+
+	_, err = handler.GetSignBytes(SignModeTextual, data, tx)
+	require.Error(t, err)
+}
+
+func TestDirectHandler(t *testing.T) {
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	fee := NewTestStdFee()
+	tx := NewStdTx(msgs, fee, nil, "memo")
+	data := SignerData{ChainID: "test-chain", AccountNumber: 3, Sequence: 7}
+
+	handler := DirectHandler{}
+	require.Equal(t, SignModeDirect, handler.DefaultMode())
+
+	// the Amino binary encoding of the StdSignDoc is signed
+	signBytes, err := handler.GetSignBytes(SignModeDirect, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, StdSignBytesDirect("test-chain", 3, 7, fee, msgs, "memo"), signBytes)
+	require.NotEqual(t, StdSignBytes("test-chain", 3, 7, fee, msgs, "memo"), signBytes)
+
+	var doc StdSignDoc
+	require.NoError(t, codec.Cdc.UnmarshalBinaryBare(signBytes, &doc))
+	require.Equal(t, "test-chain", doc.ChainID)
+	require.Equal(t, uint64(3), doc.AccountNumber)
+	require.Equal(t, uint64(7), doc.Sequence)
+	require.Equal(t, "memo", doc.Memo)
+	require.Equal(t, fee.Bytes(), []byte(doc.Fee))
+	require.Equal(t, msgs[0].GetSignBytes(), []byte(doc.Msgs[0]))
+
+	msg := StdSignMsg{ChainID: "test-chain", AccountNumber: 3, Sequence: 7, Fee: fee, Msgs: msgs, Memo: "memo"}
+	require.Equal(t, signBytes, msg.DirectBytes())
+
+	// a direct signature only verifies under the direct sign mode
+	sig, err := priv.Sign(msg.DirectBytes())
+	require.NoError(t, err)
+	signBytes, err = DefaultSignModeHandler().GetSignBytes(SignModeDirect, data, &tx)
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().VerifyBytes(signBytes, sig))
+	signBytes, err = DefaultSignModeHandler().GetSignBytes(SignModeLegacyAminoJSON, data, &tx)
+	require.NoError(t, err)
+	require.False(t, priv.PubKey().VerifyBytes(signBytes, sig))
+
+	// unordered txs are signed without sequences
+	unordered := NewUnorderedStdTx(msgs, fee, nil, "memo", 100)
+	signBytes, err = handler.GetSignBytes(SignModeDirect, data, unordered)
+	require.NoError(t, err)
+	require.Equal(t, StdSignBytesDirectUnordered("test-chain", 3, 100, fee, msgs, "memo"), signBytes)
+	data.Sequence = 8
+	signBytes2, err := handler.GetSignBytes(SignModeDirect, data, unordered)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, signBytes2)
+
+	_, err = handler.GetSignBytes(SignModeLegacyAminoJSON, data, tx)
+	require.Error(t, err)
+}
+
+func TestSignModeHandlerMap(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	tx := NewStdTx([]sdk.Msg{NewTestMsg(addr)}, NewTestStdFee(), nil, "")
+	data := SignerData{ChainID: "test-chain"}
+
+	handler := DefaultSignModeHandler()
+	require.Equal(t, SignModeLegacyAminoJSON, handler.DefaultMode())
+	require.Equal(t, []SignMode{SignModeLegacyAminoJSON, SignModeDirect, SignModeTextual}, handler.Modes())
+
+	// an unspecified sign mode resolves to the default sign mode
+	expected, err := handler.GetSignBytes(SignModeLegacyAminoJSON, data, tx)
+	require.NoError(t, err)
+	signBytes, err := handler.GetSignBytes(SignModeUnspecified, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, expected, signBytes)
+
+	_, err = handler.GetSignBytes(SignMode(3), data, tx)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	require.Panics(t, func() {
+		NewSignModeHandlerMap(SignModeLegacyAminoJSON, []SignModeHandler{LegacyAminoJSONHandler{}, LegacyAminoJSONHandler{}})
+	})
+	require.Panics(t, func() {
+		NewSignModeHandlerMap(SignModeTextual, []SignModeHandler{LegacyAminoJSONHandler{}})
+	})
+}
+
+func TestParseSignMode(t *testing.T) {
+	for _, mode := range []SignMode{SignModeUnspecified, SignModeDirect, SignModeTextual, SignModeLegacyAminoJSON} {
+		parsed, err := ParseSignMode(mode.String())
+		require.NoError(t, err)
+		require.Equal(t, mode, parsed)
+	}
+
+	parsed, err := ParseSignMode("")
+	require.NoError(t, err)
+	require.Equal(t, SignModeUnspecified, parsed)
+
+	_, err = ParseSignMode("binary")
+	require.Error(t, err)
+}
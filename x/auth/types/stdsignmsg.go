@@ -15,13 +15,45 @@ type StdSignMsg struct {
 	Fee           StdFee    `json:"fee" yaml:"fee"`
 	Msgs          []sdk.Msg `json:"msgs" yaml:"msgs"`
 	Memo          string    `json:"memo" yaml:"memo"`
+
+	Unordered        bool  `json:"unordered,omitempty" yaml:"unordered,omitempty"`
+	TimeoutTimestamp int64 `json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp,omitempty"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
+	if msg.Unordered {
+		return StdSignBytesUnordered(msg.ChainID, msg.AccountNumber, msg.TimeoutTimestamp, msg.Fee, msg.Msgs, msg.Memo)
+	}
+
 	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
 }
 
+// DirectBytes returns the bytes of the message which are signed under
+// SignModeDirect.
+func (msg StdSignMsg) DirectBytes() []byte {
+	if msg.Unordered {
+		return StdSignBytesDirectUnordered(msg.ChainID, msg.AccountNumber, msg.TimeoutTimestamp, msg.Fee, msg.Msgs, msg.Memo)
+	}
+
+	return StdSignBytesDirect(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+}
+
+// Text returns the human-readable representation of the message which is
+// signed under SignModeTextual.
+func (msg StdSignMsg) Text() []byte {
+	return StdSignText(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+}
+
+// StdTx returns the StdTx of the message with the given signatures.
+func (msg StdSignMsg) StdTx(sigs []StdSignature) StdTx {
+	if msg.Unordered {
+		return NewUnorderedStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo, msg.TimeoutTimestamp)
+	}
+
+	return NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo)
+}
+
 var _ types.UnpackInterfacesMessage = StdSignMsg{}
 
 func (msg StdSignMsg) UnpackInterfaces(unpacker types.AnyUnpacker) error {
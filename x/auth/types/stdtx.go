@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,9 +21,13 @@ const MaxGasWanted = uint64((1 << 63) - 1)
 // Deprecated: StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// The optional tip is paid on top of the fee and split between the fee
+// collector and the proposer of the block including the transaction.
 type StdFee struct {
 	Amount sdk.Coins `json:"amount" yaml:"amount"`
 	Gas    uint64    `json:"gas" yaml:"gas"`
+	Tip    sdk.Coins `json:"tip,omitempty" yaml:"tip,omitempty"`
 }
 
 // Deprecated: NewStdFee returns a new instance of StdFee
@@ -43,6 +48,11 @@ func (fee StdFee) GetAmount() sdk.Coins {
 	return fee.Amount
 }
 
+// GetTip returns the fee's tip.
+func (fee StdFee) GetTip() sdk.Coins {
+	return fee.Tip
+}
+
 // Bytes returns the encoded bytes of a StdFee.
 func (fee StdFee) Bytes() []byte {
 	if len(fee.Amount) == 0 {
@@ -149,6 +159,15 @@ type StdTx struct {
 	Fee        StdFee         `json:"fee" yaml:"fee"`
 	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
 	Memo       string         `json:"memo" yaml:"memo"`
+
+	// Unordered marks a transaction which is not ordered by the sequences of
+	// its signers. It is signed without sequences and its replay is prevented
+	// by its hash until TimeoutTimestamp.
+	Unordered bool `json:"unordered,omitempty" yaml:"unordered,omitempty"`
+
+	// TimeoutTimestamp is the Unix time, in seconds, from which an unordered
+	// transaction cannot be included in a block anymore.
+	TimeoutTimestamp int64 `json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -160,6 +179,16 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
+// NewUnorderedStdTx returns a new unordered StdTx, which cannot be included in
+// a block from the given Unix time in seconds.
+func NewUnorderedStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string, timeoutTimestamp int64) StdTx {
+	tx := NewStdTx(msgs, fee, sigs, memo)
+	tx.Unordered = true
+	tx.TimeoutTimestamp = timeoutTimestamp
+
+	return tx
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
 
@@ -180,6 +209,18 @@ func (tx StdTx) ValidateBasic() error {
 			"invalid fee provided: %s", tx.Fee.Amount,
 		)
 	}
+	if tx.Fee.Tip.IsAnyNegative() {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"invalid tip provided: %s", tx.Fee.Tip,
+		)
+	}
+	if tx.Unordered && tx.TimeoutTimestamp <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must have a timeout timestamp")
+	}
+	if !tx.Unordered && tx.TimeoutTimestamp != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout timestamp is only allowed for unordered tx")
+	}
 	if len(stdSigs) == 0 {
 		return sdkerrors.ErrNoSignatures
 	}
@@ -217,6 +258,23 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// GetUnordered returns true if the transaction is not ordered by the sequences
+// of its signers.
+func (tx StdTx) GetUnordered() bool { return tx.Unordered }
+
+// GetTimeoutTimestamp returns the Unix time, in seconds, from which an
+// unordered transaction cannot be included in a block anymore.
+func (tx StdTx) GetTimeoutTimestamp() int64 { return tx.TimeoutTimestamp }
+
+// GetUnorderedTxHash returns the hash identifying an unordered transaction on
+// the given chain. It is computed on the sign bytes of the transaction without
+// account number, and thus depends neither on its signatures nor on its
+// encoding, so that the transaction cannot be replayed by re-encoding it, e.g.
+// by omitting the public keys of its signers.
+func (tx StdTx) GetUnorderedTxHash(chainID string) []byte {
+	return tmhash.Sum(StdSignBytesUnordered(chainID, 0, tx.TimeoutTimestamp, tx.Fee, tx.Msgs, tx.Memo))
+}
+
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
 // pubkeys returned from MsgKeySigners, and the order
@@ -232,6 +290,16 @@ func (tx StdTx) GetSignatures() [][]byte {
 	return sigs
 }
 
+// GetSignModes returns the sign mode of each signature, in the same order as
+// the signatures.
+func (tx StdTx) GetSignModes() []SignMode {
+	modes := make([]SignMode, len(tx.Signatures))
+	for i, stdSig := range tx.Signatures {
+		modes[i] = stdSig.SignMode
+	}
+	return modes
+}
+
 // GetPubkeys returns the pubkeys of signers if the pubkey is included in the signature
 // If pubkey is not included in the signature, then nil is in the slice instead
 func (tx StdTx) GetPubKeys() []crypto.PubKey {
@@ -246,16 +314,25 @@ func (tx StdTx) GetPubKeys() []crypto.PubKey {
 
 // GetSignBytes returns the signBytes of the tx for a given signer
 func (tx StdTx) GetSignBytes(ctx sdk.Context, acc AccountI) []byte {
-	genesis := ctx.BlockHeight() == 0
-	chainID := ctx.ChainID()
-	var accNum uint64
-	if !genesis {
-		accNum = acc.GetAccountNumber()
+	return tx.signBytes(NewSignerData(ctx, acc))
+}
+
+// signBytes returns the Amino JSON sign bytes of the tx for the given signer.
+// Unordered transactions are signed without the sequence of the signer.
+func (tx StdTx) signBytes(data SignerData) []byte {
+	if tx.Unordered {
+		return StdSignBytesUnordered(data.ChainID, data.AccountNumber, tx.TimeoutTimestamp, tx.Fee, tx.Msgs, tx.Memo)
 	}
 
-	return StdSignBytes(
-		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo,
-	)
+	return StdSignBytes(data.ChainID, data.AccountNumber, data.Sequence, tx.Fee, tx.Msgs, tx.Memo)
+}
+
+func (tx StdTx) directSignBytes(data SignerData) []byte {
+	if tx.Unordered {
+		return StdSignBytesDirectUnordered(data.ChainID, data.AccountNumber, tx.TimeoutTimestamp, tx.Fee, tx.Msgs, tx.Memo)
+	}
+
+	return StdSignBytesDirect(data.ChainID, data.AccountNumber, data.Sequence, tx.Fee, tx.Msgs, tx.Memo)
 }
 
 // GetGas returns the Gas in StdFee
@@ -264,6 +341,9 @@ func (tx StdTx) GetGas() uint64 { return tx.Fee.Gas }
 // GetFee returns the FeeAmount in StdFee
 func (tx StdTx) GetFee() sdk.Coins { return tx.Fee.Amount }
 
+// GetTip returns the tip paid to the block proposer in StdFee
+func (tx StdTx) GetTip() sdk.Coins { return tx.Fee.Tip }
+
 // FeePayer returns the address that is responsible for paying fee
 // StdTx returns the first signer as the fee payer
 // If no signers for tx, return empty address
@@ -286,24 +366,61 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+
+	// Unordered transactions are signed with a zero sequence, their timeout
+	// timestamp preventing their replay instead.
+	TimeoutTimestamp int64 `json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp,omitempty"`
+	Unordered        bool  `json:"unordered,omitempty" yaml:"unordered,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
-	msgsBytes := make([]json.RawMessage, 0, len(msgs))
-	for _, msg := range msgs {
-		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
-	}
+	return stdSignBytes(StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Memo:          memo,
+		Sequence:      sequence,
+	}, fee, msgs)
+}
 
-	bz, err := codec.Cdc.MarshalJSON(StdSignDoc{
+// StdSignBytesUnordered returns the bytes to sign for an unordered
+// transaction, which do not depend on the sequence of the signer.
+func StdSignBytesUnordered(chainID string, accnum uint64, timeoutTimestamp int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(StdSignDoc{
+		AccountNumber:    accnum,
+		ChainID:          chainID,
+		Memo:             memo,
+		TimeoutTimestamp: timeoutTimestamp,
+		Unordered:        true,
+	}, fee, msgs)
+}
+
+// StdSignBytesDirect returns the bytes to sign for a transaction under
+// SignModeDirect, which are the Amino binary encoding of the StdSignDoc whose
+// sorted JSON encoding StdSignBytes returns.
+func StdSignBytesDirect(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytesDirect(StdSignDoc{
 		AccountNumber: accnum,
 		ChainID:       chainID,
-		Fee:           json.RawMessage(fee.Bytes()),
 		Memo:          memo,
-		Msgs:          msgsBytes,
 		Sequence:      sequence,
-	})
+	}, fee, msgs)
+}
 
+// StdSignBytesDirectUnordered returns the bytes to sign for an unordered
+// transaction under SignModeDirect.
+func StdSignBytesDirectUnordered(chainID string, accnum uint64, timeoutTimestamp int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytesDirect(StdSignDoc{
+		AccountNumber:    accnum,
+		ChainID:          chainID,
+		Memo:             memo,
+		TimeoutTimestamp: timeoutTimestamp,
+		Unordered:        true,
+	}, fee, msgs)
+}
+
+func stdSignBytes(doc StdSignDoc, fee StdFee, msgs []sdk.Msg) []byte {
+	bz, err := codec.Cdc.MarshalJSON(withFeeAndMsgs(doc, fee, msgs))
 	if err != nil {
 		panic(err)
 	}
@@ -311,10 +428,30 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 	return sdk.MustSortJSON(bz)
 }
 
+func stdSignBytesDirect(doc StdSignDoc, fee StdFee, msgs []sdk.Msg) []byte {
+	return codec.Cdc.MustMarshalBinaryBare(withFeeAndMsgs(doc, fee, msgs))
+}
+
+func withFeeAndMsgs(doc StdSignDoc, fee StdFee, msgs []sdk.Msg) StdSignDoc {
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
+	}
+
+	doc.Fee = json.RawMessage(fee.Bytes())
+	doc.Msgs = msgsBytes
+
+	return doc
+}
+
 // Deprecated: StdSignature represents a sig
 type StdSignature struct {
 	PubKey    []byte `json:"pub_key" yaml:"pub_key"` // optional
 	Signature []byte `json:"signature" yaml:"signature"`
+
+	// SignMode is the mode used to derive the signed bytes. When unspecified,
+	// the default sign mode of the chain applies.
+	SignMode SignMode `json:"sign_mode,omitempty" yaml:"sign_mode,omitempty"`
 }
 
 // DefaultTxDecoder logic for standard transaction decoding
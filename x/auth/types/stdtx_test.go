@@ -59,6 +59,42 @@ func TestStdSignBytes(t *testing.T) {
 	}
 }
 
+func TestUnorderedStdTx(t *testing.T) {
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	fee := NewTestStdFee()
+	sigs := []StdSignature{{Signature: []byte("sig")}}
+
+	tx := NewUnorderedStdTx(msgs, fee, sigs, "memo", 100)
+	require.True(t, tx.GetUnordered())
+	require.Equal(t, int64(100), tx.GetTimeoutTimestamp())
+	require.NoError(t, tx.ValidateBasic())
+
+	// unordered txs are signed without sequences, with their timeout
+	data := SignerData{ChainID: "1234", AccountNumber: 3, Sequence: 6}
+	signBytes := tx.signBytes(data)
+	data.Sequence = 7
+	require.Equal(t, signBytes, tx.signBytes(data))
+	require.Equal(t, StdSignBytesUnordered("1234", 3, 100, fee, msgs, "memo"), signBytes)
+	require.Contains(t, string(signBytes), `"timeout_timestamp":"100","unordered":true`)
+
+	// the sign bytes of ordered txs are unchanged
+	tx = NewStdTx(msgs, fee, sigs, "memo")
+	require.Equal(t, StdSignBytes("1234", 3, 7, fee, msgs, "memo"), tx.signBytes(data))
+	require.NotContains(t, string(tx.signBytes(data)), "unordered")
+
+	// unordered txs require a timeout, which ordered txs cannot have
+	tx = NewUnorderedStdTx(msgs, fee, sigs, "memo", 0)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(tx.ValidateBasic()))
+
+	tx = NewStdTx(msgs, fee, sigs, "memo")
+	tx.TimeoutTimestamp = 100
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(tx.ValidateBasic()))
+
+	// unordered txs are not supported by the textual sign mode
+	_, err := TextualHandler{}.GetSignBytes(SignModeTextual, data, NewUnorderedStdTx(msgs, fee, sigs, "memo", 100))
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+}
+
 func TestTxValidateBasic(t *testing.T) {
 	ctx := sdk.NewContext(nil, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
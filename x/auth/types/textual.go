@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StdSignText returns the canonical human-readable textual representation of
+// a transaction which is signed under SignModeTextual. It is meant to be
+// displayed as-is by signers, e.g. hardware wallets and air-gapped signers,
+// before signing. Amounts are rendered with their denomination and addresses
+// with their bech32 prefix, as found in the JSON sign bytes of each message.
+// The tip of the fee is rendered if it is not empty, so that the text of the
+// transactions without tip does not change, while a tip cannot be added to a
+// signed transaction.
+func StdSignText(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Chain ID: %s\n", chainID)
+	fmt.Fprintf(&sb, "Account number: %d\n", accnum)
+	fmt.Fprintf(&sb, "Sequence: %d\n", sequence)
+	fmt.Fprintf(&sb, "Fee: %s\n", feeText(fee.Amount))
+	if !fee.Tip.Empty() {
+		fmt.Fprintf(&sb, "Tip: %s\n", fee.Tip)
+	}
+	fmt.Fprintf(&sb, "Gas: %d\n", fee.Gas)
+	fmt.Fprintf(&sb, "Memo: %s\n", strconv.Quote(memo))
+	fmt.Fprintf(&sb, "Messages: %d\n", len(msgs))
+
+	for i, msg := range msgs {
+		fmt.Fprintf(&sb, "Message %d/%d: %s/%s\n", i+1, len(msgs), msg.Route(), msg.Type())
+		fmt.Fprintf(&sb, "%s\n", sdk.MustSortJSON(msg.GetSignBytes()))
+	}
+
+	return []byte(sb.String())
+}
+
+func feeText(amount sdk.Coins) string {
+	if amount.Empty() {
+		return "none"
+	}
+
+	return amount.String()
+}
+
+// TextualHandler is a SignModeHandler that handles SignModeTextual for StdTx
+// transactions.
+type TextualHandler struct{}
+
+var _ SignModeHandler = TextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode.
+func (TextualHandler) DefaultMode() SignMode {
+	return SignModeTextual
+}
+
+// Modes implements SignModeHandler.Modes.
+func (TextualHandler) Modes() []SignMode {
+	return []SignMode{SignModeTextual}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes.
+func (TextualHandler) GetSignBytes(mode SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != SignModeTextual {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected %s, got %s", SignModeTextual, mode)
+	}
+
+	var stdTx StdTx
+	switch t := tx.(type) {
+	case StdTx:
+		stdTx = t
+	case *StdTx:
+		stdTx = *t
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", StdTx{}, tx)
+	}
+
+	if stdTx.Unordered {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered txs cannot be signed with %s", SignModeTextual)
+	}
+
+	return StdSignText(
+		data.ChainID, data.AccountNumber, data.Sequence, stdTx.Fee, stdTx.Msgs, stdTx.Memo,
+	), nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStdSignText(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	msg := NewTestMsg(addr)
+	fee := NewTestStdFee()
+
+	expected := fmt.Sprintf(`Chain ID: test-chain
+Account number: 3
+Sequence: 7
+Fee: 150atom
+Gas: 100000
+Memo: "say \"hi\""
+Messages: 1
+Message 1/1: TestMsg/Test message
+%s
+`, sdk.MustSortJSON(msg.GetSignBytes()))
+
+	text := StdSignText("test-chain", 3, 7, fee, []sdk.Msg{msg}, `say "hi"`)
+	require.Equal(t, expected, string(text))
+	require.Contains(t, string(text), addr.String())
+
+	text = StdSignText("test-chain", 3, 7, NewStdFee(0, nil), []sdk.Msg{msg}, "")
+	require.Contains(t, string(text), "Fee: none\n")
+	require.NotContains(t, string(text), "Tip:")
+
+	// the tip is signed along with the fee
+	tipFee := NewTestStdFee()
+	tipFee.Tip = sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	text = StdSignText("test-chain", 3, 7, tipFee, []sdk.Msg{msg}, "")
+	require.Contains(t, string(text), "Fee: 150atom\nTip: 10atom\nGas: 100000\n")
+	require.NotEqual(t, StdSignText("test-chain", 3, 7, fee, []sdk.Msg{msg}, ""), text)
+}
+
+func TestTextualHandler(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	msgs := []sdk.Msg{NewTestMsg(addr)}
+	fee := NewTestStdFee()
+	tx := NewStdTx(msgs, fee, nil, "memo")
+	data := SignerData{ChainID: "test-chain", AccountNumber: 3, Sequence: 7}
+
+	handler := TextualHandler{}
+	require.Equal(t, SignModeTextual, handler.DefaultMode())
+
+	signBytes, err := handler.GetSignBytes(SignModeTextual, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, StdSignText("test-chain", 3, 7, fee, msgs, "memo"), signBytes)
+
+	_, err = handler.GetSignBytes(SignModeLegacyAminoJSON, data, tx)
+	require.Error(t, err)
+
+	// adding a tip to a signed tx changes its sign bytes
+	tipped := tx
+	tipped.Fee.Tip = sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	tippedBytes, err := handler.GetSignBytes(SignModeTextual, data, tipped)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, tippedBytes)
+
+	// the default handler dispatches textual signatures to the textual handler
+	signBytes, err = DefaultSignModeHandler().GetSignBytes(SignModeTextual, data, &tx)
+	require.NoError(t, err)
+	require.Equal(t, StdSignText("test-chain", 3, 7, fee, msgs, "memo"), signBytes)
+}
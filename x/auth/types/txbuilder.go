@@ -26,7 +26,11 @@ type TxBuilder struct {
 	chainID            string
 	memo               string
 	fees               sdk.Coins
+	tip                sdk.Coins
 	gasPrices          sdk.DecCoins
+	signMode           SignMode
+	unordered          bool
+	timeoutTimestamp   int64
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -52,11 +56,17 @@ func NewTxBuilder(
 
 // NewTxBuilderFromCLI returns a new initialized TxBuilder with parameters from
 // the command line using Viper.
-func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
+func NewTxBuilderFromCLI(input io.Reader) (TxBuilder, error) {
 	kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), input)
 	if err != nil {
-		panic(err)
+		return TxBuilder{}, err
+	}
+
+	signMode, err := ParseSignMode(viper.GetString(flags.FlagSignMode))
+	if err != nil {
+		return TxBuilder{}, fmt.Errorf("invalid --%s flag: %w", flags.FlagSignMode, err)
 	}
+
 	txbldr := TxBuilder{
 		keybase:            kb,
 		accountNumber:      viper.GetUint64(flags.FlagAccountNumber),
@@ -69,9 +79,11 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 	}
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
+	txbldr = txbldr.WithTip(viper.GetString(flags.FlagTip))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
+	txbldr = txbldr.WithSignMode(signMode)
 
-	return txbldr
+	return txbldr, nil
 }
 
 // TxEncoder returns the transaction encoder
@@ -105,9 +117,15 @@ func (bldr TxBuilder) Memo() string { return bldr.memo }
 // Fees returns the fees for the transaction
 func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 
+// Tip returns the tip paid to the block proposer on top of the fees
+func (bldr TxBuilder) Tip() sdk.Coins { return bldr.tip }
+
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// SignMode returns the sign mode used to sign transactions.
+func (bldr TxBuilder) SignMode() SignMode { return bldr.signMode }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -137,6 +155,17 @@ func (bldr TxBuilder) WithFees(fees string) TxBuilder {
 	return bldr
 }
 
+// WithTip returns a copy of the context with an updated tip.
+func (bldr TxBuilder) WithTip(tip string) TxBuilder {
+	parsedTip, err := sdk.ParseCoins(tip)
+	if err != nil {
+		panic(err)
+	}
+
+	bldr.tip = parsedTip
+	return bldr
+}
+
 // WithGasPrices returns a copy of the context with updated gas prices.
 func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -172,6 +201,28 @@ func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	return bldr
 }
 
+// Unordered returns true if the built transactions are unordered.
+func (bldr TxBuilder) Unordered() bool { return bldr.unordered }
+
+// TimeoutTimestamp returns the timeout timestamp of the built unordered
+// transactions.
+func (bldr TxBuilder) TimeoutTimestamp() int64 { return bldr.timeoutTimestamp }
+
+// WithUnordered returns a copy of the context building unordered transactions,
+// which are not ordered by the sequence of the signer and cannot be included
+// in a block from the given Unix time in seconds.
+func (bldr TxBuilder) WithUnordered(timeoutTimestamp int64) TxBuilder {
+	bldr.unordered = true
+	bldr.timeoutTimestamp = timeoutTimestamp
+	return bldr
+}
+
+// WithSignMode returns a copy of the context with an updated sign mode.
+func (bldr TxBuilder) WithSignMode(signMode SignMode) TxBuilder {
+	bldr.signMode = signMode
+	return bldr
+}
+
 // BuildSignMsg builds a single message to be signed from a TxBuilder given a
 // set of messages. It returns an error if a fee is supplied but cannot be
 // parsed.
@@ -197,25 +248,30 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.Tip = bldr.tip
+
 	return StdSignMsg{
-		ChainID:       bldr.chainID,
-		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
-		Memo:          bldr.memo,
-		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		ChainID:          bldr.chainID,
+		AccountNumber:    bldr.accountNumber,
+		Sequence:         bldr.sequence,
+		Memo:             bldr.memo,
+		Msgs:             msgs,
+		Fee:              fee,
+		Unordered:        bldr.unordered,
+		TimeoutTimestamp: bldr.timeoutTimestamp,
 	}, nil
 }
 
 // Sign signs a transaction given a name and a single message to be signed.
 // Returns error if signing fails.
 func (bldr TxBuilder) Sign(name string, msg StdSignMsg) ([]byte, error) {
-	sig, err := MakeSignature(bldr.keybase, name, msg)
+	sig, err := MakeSignatureWithSignMode(bldr.keybase, name, msg, bldr.signMode)
 	if err != nil {
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo))
+	return bldr.txEncoder(msg.StdTx([]StdSignature{sig}))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -239,7 +295,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo))
+	return bldr.txEncoder(signMsg.StdTx(sigs))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
@@ -249,14 +305,16 @@ func (bldr TxBuilder) SignStdTx(name string, stdTx StdTx, appendSig bool) (signe
 		return StdTx{}, fmt.Errorf("chain ID required but not specified")
 	}
 
-	stdSignature, err := MakeSignature(bldr.keybase, name, StdSignMsg{
-		ChainID:       bldr.chainID,
-		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
-		Fee:           stdTx.Fee,
-		Msgs:          stdTx.GetMsgs(),
-		Memo:          stdTx.GetMemo(),
-	})
+	stdSignature, err := MakeSignatureWithSignMode(bldr.keybase, name, StdSignMsg{
+		ChainID:          bldr.chainID,
+		AccountNumber:    bldr.accountNumber,
+		Sequence:         bldr.sequence,
+		Fee:              stdTx.Fee,
+		Msgs:             stdTx.GetMsgs(),
+		Memo:             stdTx.GetMemo(),
+		Unordered:        stdTx.Unordered,
+		TimeoutTimestamp: stdTx.TimeoutTimestamp,
+	}, bldr.signMode)
 	if err != nil {
 		return
 	}
@@ -267,12 +325,34 @@ func (bldr TxBuilder) SignStdTx(name string, stdTx StdTx, appendSig bool) (signe
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = stdTx
+	signedStdTx.Signatures = sigs
 	return
 }
 
 // MakeSignature builds a StdSignature given a keyring, key name, and a StdSignMsg.
 func MakeSignature(kr keyring.Keyring, name string, msg StdSignMsg) (sig StdSignature, err error) {
+	return MakeSignatureWithSignMode(kr, name, msg, SignModeUnspecified)
+}
+
+// MakeSignatureWithSignMode builds a StdSignature given a keyring, key name, a
+// StdSignMsg and the sign mode used to derive the signed bytes.
+func MakeSignatureWithSignMode(kr keyring.Keyring, name string, msg StdSignMsg, signMode SignMode) (sig StdSignature, err error) {
+	var signBytes []byte
+	switch signMode {
+	case SignModeUnspecified, SignModeLegacyAminoJSON:
+		signBytes = msg.Bytes()
+	case SignModeDirect:
+		signBytes = msg.DirectBytes()
+	case SignModeTextual:
+		if msg.Unordered {
+			return sig, fmt.Errorf("unordered txs cannot be signed with %s", signMode)
+		}
+		signBytes = msg.Text()
+	default:
+		return sig, fmt.Errorf("unsupported sign mode %s", signMode)
+	}
+
 	if kr == nil {
 		kr, err = keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), os.Stdin)
 		if err != nil {
@@ -280,7 +360,7 @@ func MakeSignature(kr keyring.Keyring, name string, msg StdSignMsg) (sig StdSign
 		}
 	}
 
-	sigBytes, pubkey, err := kr.Sign(name, msg.Bytes())
+	sigBytes, pubkey, err := kr.Sign(name, signBytes)
 	if err != nil {
 		return
 	}
@@ -288,5 +368,6 @@ func MakeSignature(kr keyring.Keyring, name string, msg StdSignMsg) (sig StdSign
 	return StdSignature{
 		PubKey:    pubkey.Bytes(),
 		Signature: sigBytes,
+		SignMode:  signMode,
 	}, nil
 }
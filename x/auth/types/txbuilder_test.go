@@ -2,11 +2,15 @@ package types
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -146,3 +150,19 @@ func TestTxBuilderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTxBuilderFromCLI(t *testing.T) {
+	viper.Set(flags.FlagKeyringBackend, keyring.BackendMemory)
+	defer viper.Set(flags.FlagKeyringBackend, flags.DefaultKeyringBackend)
+	defer viper.Set(flags.FlagSignMode, "")
+
+	viper.Set(flags.FlagSignMode, "textual")
+	txBldr, err := NewTxBuilderFromCLI(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Equal(t, SignModeTextual, txBldr.SignMode())
+
+	// an unknown sign mode is rejected instead of panicking
+	viper.Set(flags.FlagSignMode, "unknown")
+	_, err = NewTxBuilderFromCLI(strings.NewReader(""))
+	require.Error(t, err)
+}
@@ -113,6 +113,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	MaxTxBytes             uint64 `protobuf:"varint,6,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty" yaml:"max_tx_bytes"`
+	SigVerifyCostSecp256r1 uint64 `protobuf:"varint,7,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
+	SigVerifyCostSr25519   uint64 `protobuf:"varint,8,opt,name=sig_verify_cost_sr25519,json=sigVerifyCostSr25519,proto3" json:"sig_verify_cost_sr25519,omitempty" yaml:"sig_verify_cost_sr25519"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,6 +185,27 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxTxBytes() uint64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostSr25519() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSr25519
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos_sdk.x.auth.v1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos_sdk.x.auth.v1.ModuleAccount")
@@ -191,49 +215,51 @@ func init() {
 func init() { proto.RegisterFile("x/auth/types/types.proto", fileDescriptor_2d526fa662daab74) }
 
 var fileDescriptor_2d526fa662daab74 = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
-	0x14, 0x8f, 0x49, 0x96, 0x3f, 0x13, 0x40, 0xc2, 0x04, 0x30, 0xd9, 0x95, 0x27, 0xeb, 0xc3, 0x8a,
-	0x55, 0x1b, 0x47, 0xa1, 0xa2, 0x12, 0x39, 0x54, 0xc5, 0xb4, 0x95, 0x10, 0x05, 0x21, 0x23, 0xf5,
-	0xd0, 0x8b, 0x35, 0xb6, 0xa7, 0x89, 0x95, 0x4c, 0xc6, 0x78, 0xc6, 0x28, 0xe6, 0x13, 0xf4, 0xd8,
-	0x53, 0xd5, 0x23, 0x1f, 0xa2, 0x1f, 0xa1, 0x87, 0x1e, 0x51, 0x4f, 0x3d, 0xb9, 0x55, 0xb8, 0x54,
-	0x3d, 0xfa, 0xd8, 0x53, 0x65, 0x4f, 0x08, 0x0e, 0x4d, 0x7b, 0xb1, 0xe6, 0xbd, 0xf7, 0xfb, 0xf3,
-	0xe6, 0x3d, 0x79, 0x80, 0x32, 0x68, 0xa0, 0x90, 0x77, 0x1a, 0x3c, 0xf2, 0x31, 0x13, 0x5f, 0xdd,
-	0x0f, 0x28, 0xa7, 0x72, 0xc5, 0xa1, 0x8c, 0x50, 0x66, 0x31, 0xb7, 0xab, 0x0f, 0xf4, 0x14, 0xa4,
-	0x9f, 0x37, 0xab, 0xf7, 0x78, 0xc7, 0x0b, 0x5c, 0xcb, 0x47, 0x01, 0x8f, 0x1a, 0x19, 0xb0, 0x21,
-	0x70, 0xf5, 0x7c, 0x20, 0x24, 0xaa, 0xff, 0xfd, 0x0a, 0x6e, 0xd3, 0x36, 0xbd, 0x3d, 0x09, 0x9c,
-	0xf6, 0x76, 0x06, 0x94, 0x0d, 0xc4, 0xf0, 0x9e, 0xe3, 0xd0, 0xb0, 0xcf, 0xe5, 0x43, 0x30, 0x87,
-	0x5c, 0x37, 0xc0, 0x8c, 0x29, 0x52, 0x4d, 0xda, 0x5a, 0x34, 0x9a, 0x3f, 0x62, 0x58, 0x6f, 0x7b,
-	0xbc, 0x13, 0xda, 0xba, 0x43, 0xc9, 0xc8, 0xe5, 0xc6, 0x99, 0xb9, 0xdd, 0x51, 0xe7, 0x7b, 0x8e,
-	0xb3, 0x27, 0x88, 0xe6, 0x8d, 0x82, 0xfc, 0x0c, 0xcc, 0xf9, 0xa1, 0x6d, 0x75, 0x71, 0xa4, 0xcc,
-	0x64, 0x62, 0xf5, 0xef, 0x31, 0xac, 0xf8, 0xa1, 0xdd, 0xf3, 0x9c, 0x34, 0x7b, 0x9f, 0x12, 0x8f,
-	0x63, 0xe2, 0xf3, 0x28, 0x89, 0xe1, 0x4a, 0x84, 0x48, 0xaf, 0xa5, 0xdd, 0x56, 0x35, 0x73, 0xd6,
-	0x0f, 0xed, 0x43, 0x1c, 0xc9, 0x8f, 0xc1, 0x32, 0x12, 0xfd, 0x59, 0xfd, 0x90, 0xd8, 0x38, 0x50,
-	0x8a, 0x35, 0x69, 0xab, 0x64, 0x6c, 0x26, 0x31, 0x5c, 0x13, 0xb4, 0xc9, 0xba, 0x66, 0x2e, 0x8d,
-	0x12, 0xc7, 0x59, 0x2c, 0x57, 0xc1, 0x3c, 0xc3, 0x67, 0x21, 0xee, 0x3b, 0x58, 0x29, 0xa5, 0x5c,
-	0x73, 0x1c, 0xb7, 0x2a, 0xaf, 0x2f, 0x61, 0xe1, 0xdd, 0x25, 0x2c, 0x7c, 0x7a, 0x5f, 0x9f, 0x1f,
-	0xcd, 0xe1, 0x40, 0xfb, 0x20, 0x81, 0xa5, 0x23, 0xea, 0x86, 0xbd, 0xf1, 0x68, 0x10, 0x58, 0xb4,
-	0x11, 0xc3, 0xd6, 0x48, 0x39, 0x9b, 0x4f, 0x79, 0xfb, 0x5f, 0x7d, 0xda, 0xb2, 0xf4, 0xdc, 0x4c,
-	0x8d, 0xbf, 0xaf, 0x62, 0x28, 0x25, 0x31, 0x5c, 0x15, 0xad, 0xe6, 0x45, 0x34, 0xb3, 0x6c, 0xe7,
-	0xa6, 0x2f, 0x83, 0x52, 0x1f, 0x11, 0x9c, 0x4d, 0x6b, 0xc1, 0xcc, 0xce, 0x72, 0x0d, 0x94, 0x7d,
-	0x1c, 0x10, 0x8f, 0x31, 0x8f, 0xf6, 0x99, 0x52, 0xac, 0x15, 0xb7, 0x16, 0xcc, 0x7c, 0xaa, 0x55,
-	0xcd, 0x5d, 0x60, 0x79, 0xa2, 0xe7, 0x03, 0xed, 0x4b, 0x11, 0xcc, 0x9e, 0xa0, 0x00, 0x11, 0x26,
-	0x1f, 0x83, 0x55, 0x82, 0x06, 0x16, 0xc1, 0x84, 0x5a, 0x4e, 0x07, 0x05, 0xc8, 0xe1, 0x38, 0x10,
-	0x6b, 0x2e, 0x19, 0x6a, 0x12, 0xc3, 0xaa, 0xe8, 0x6f, 0x0a, 0x48, 0x33, 0x57, 0x08, 0x1a, 0x1c,
-	0x61, 0x42, 0xf7, 0xc7, 0x39, 0x79, 0x17, 0x2c, 0xf2, 0x81, 0xc5, 0xbc, 0xb6, 0xd5, 0xf3, 0x88,
-	0xc7, 0xb3, 0xa6, 0x4b, 0xc6, 0xc6, 0xed, 0x45, 0xf3, 0x55, 0xcd, 0x04, 0x7c, 0x70, 0xea, 0xb5,
-	0x9f, 0xa7, 0x81, 0x6c, 0x82, 0xb5, 0xac, 0x78, 0x81, 0x2d, 0x87, 0x32, 0x6e, 0xf9, 0x38, 0xb0,
-	0xec, 0x88, 0xe3, 0xd1, 0x5e, 0x6b, 0x49, 0x0c, 0xff, 0xc9, 0x69, 0xdc, 0x85, 0x69, 0xe6, 0x4a,
-	0x2a, 0x76, 0x81, 0xf7, 0x29, 0xe3, 0x27, 0x38, 0x30, 0x22, 0x8e, 0xe5, 0x33, 0xb0, 0x91, 0xba,
-	0x9d, 0xe3, 0xc0, 0x7b, 0x15, 0x09, 0x3c, 0x76, 0xb7, 0x77, 0x76, 0x9a, 0xbb, 0x62, 0xe3, 0x46,
-	0x6b, 0x18, 0xc3, 0xca, 0xa9, 0xd7, 0x7e, 0x91, 0x21, 0x52, 0xea, 0xd3, 0x27, 0x59, 0x3d, 0x89,
-	0xa1, 0x2a, 0xdc, 0x7e, 0x23, 0xa0, 0x99, 0x15, 0x36, 0xc1, 0x13, 0x69, 0x39, 0x02, 0x9b, 0x77,
-	0x19, 0x0c, 0x3b, 0xfe, 0xf6, 0xce, 0xc3, 0x6e, 0x53, 0xf9, 0x2b, 0x33, 0x7d, 0x34, 0x8c, 0xe1,
-	0xfa, 0x84, 0xe9, 0xe9, 0x0d, 0x22, 0x89, 0x61, 0x6d, 0xba, 0xed, 0x58, 0x44, 0x33, 0xd7, 0xd9,
-	0x54, 0x6e, 0x6b, 0x3e, 0xdd, 0xf7, 0xb7, 0x4b, 0x28, 0x19, 0xfb, 0x1f, 0x87, 0xaa, 0x74, 0x35,
-	0x54, 0xa5, 0xaf, 0x43, 0x55, 0x7a, 0x73, 0xad, 0x16, 0xae, 0xae, 0xd5, 0xc2, 0xe7, 0x6b, 0xb5,
-	0xf0, 0xf2, 0xff, 0x3f, 0xfe, 0xb6, 0xf9, 0xd7, 0xc7, 0x9e, 0xcd, 0x5e, 0x83, 0x07, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x11, 0xf8, 0x99, 0x15, 0x94, 0x04, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x6d, 0xda, 0x34, 0x0d, 0x9b, 0xb6, 0x52, 0xb7, 0x69, 0xeb, 0x06, 0x94, 0x04, 0x0b, 0x21,
+	0x10, 0xc4, 0x51, 0x8a, 0x8a, 0xd4, 0x1c, 0x10, 0x75, 0x01, 0xa9, 0x2a, 0xad, 0x2a, 0x17, 0x21,
+	0xc1, 0xc5, 0xb2, 0x9d, 0x25, 0xb1, 0x1a, 0xc7, 0xee, 0xee, 0xba, 0x8a, 0xfb, 0x05, 0x1c, 0x39,
+	0x21, 0x8e, 0xfd, 0x08, 0x3e, 0x81, 0x03, 0xc7, 0x8a, 0x03, 0xe2, 0x14, 0xa1, 0x72, 0x41, 0x1c,
+	0x39, 0x72, 0x62, 0xec, 0x75, 0x53, 0x27, 0x4d, 0xe0, 0xb0, 0x96, 0xe7, 0xcd, 0xcc, 0x9b, 0xb7,
+	0x6f, 0x2c, 0x23, 0xa9, 0x5b, 0x35, 0x7c, 0xde, 0xaa, 0xf2, 0xc0, 0x23, 0x4c, 0x3c, 0x15, 0x8f,
+	0xba, 0xdc, 0xc5, 0x79, 0xcb, 0x65, 0x8e, 0xcb, 0x74, 0xd6, 0x38, 0x54, 0xba, 0x4a, 0x58, 0xa4,
+	0x1c, 0xd7, 0x0a, 0xf7, 0x78, 0xcb, 0xa6, 0x0d, 0xdd, 0x33, 0x28, 0x0f, 0xaa, 0x51, 0x61, 0x55,
+	0xd4, 0x55, 0x92, 0x81, 0xa0, 0x28, 0xdc, 0xbe, 0x5a, 0xdc, 0x74, 0x9b, 0xee, 0xe5, 0x9b, 0xa8,
+	0x93, 0xdf, 0x4f, 0xa2, 0x9c, 0x6a, 0x30, 0xb2, 0x69, 0x59, 0xae, 0xdf, 0xe1, 0x78, 0x07, 0xcd,
+	0x18, 0x8d, 0x06, 0x25, 0x8c, 0x49, 0xa9, 0x72, 0xea, 0xce, 0xac, 0x5a, 0xfb, 0xd3, 0x2b, 0x55,
+	0x9a, 0x36, 0x6f, 0xf9, 0xa6, 0x62, 0xb9, 0x4e, 0x3c, 0xe5, 0x62, 0x32, 0x28, 0x8c, 0x95, 0x03,
+	0xc1, 0xa6, 0x68, 0xd4, 0x2e, 0x18, 0xf0, 0x33, 0x34, 0xe3, 0xf9, 0xa6, 0x7e, 0x48, 0x02, 0x69,
+	0x32, 0x22, 0xab, 0xfc, 0xea, 0x95, 0xf2, 0x00, 0xb5, 0x6d, 0x2b, 0x44, 0xef, 0xbb, 0x8e, 0xcd,
+	0x89, 0xe3, 0xf1, 0xe0, 0x77, 0xaf, 0xb4, 0x10, 0x18, 0x4e, 0xbb, 0x2e, 0x5f, 0x66, 0x65, 0x2d,
+	0x03, 0xc1, 0x0e, 0x09, 0xf0, 0x63, 0x34, 0x6f, 0x08, 0x7d, 0x7a, 0xc7, 0x77, 0x4c, 0x42, 0xa5,
+	0x29, 0xa0, 0x4b, 0xab, 0xab, 0xd0, 0xb6, 0x24, 0xda, 0x06, 0xf3, 0xb2, 0x36, 0x17, 0x03, 0x7b,
+	0x51, 0x8c, 0x0b, 0x28, 0xcb, 0xc8, 0x91, 0x4f, 0x3a, 0x16, 0x91, 0xd2, 0x61, 0xaf, 0xd6, 0x8f,
+	0xeb, 0xf9, 0xb7, 0xa7, 0xa5, 0x89, 0x0f, 0x70, 0xbe, 0x7c, 0xac, 0x64, 0x63, 0x1f, 0xb6, 0xe5,
+	0x4f, 0x29, 0x34, 0xb7, 0xeb, 0x36, 0xfc, 0x76, 0xdf, 0x1a, 0x03, 0xcd, 0x9a, 0xe0, 0x94, 0x1e,
+	0x33, 0x47, 0xfe, 0xe4, 0xd6, 0x6e, 0x2a, 0xa3, 0x96, 0xa5, 0x24, 0x3c, 0x55, 0xaf, 0x9f, 0xf5,
+	0x4a, 0x29, 0x90, 0xba, 0x28, 0xa4, 0x26, 0x49, 0x64, 0x2d, 0x67, 0x26, 0xdc, 0xc7, 0x28, 0xdd,
+	0x31, 0x1c, 0x12, 0xb9, 0x75, 0x4d, 0x8b, 0xde, 0x71, 0x19, 0xe5, 0x3c, 0x42, 0x1d, 0x9b, 0x31,
+	0xdb, 0xed, 0x30, 0xb8, 0xf9, 0x14, 0xa4, 0x92, 0x50, 0xbd, 0x90, 0xb8, 0xc0, 0xfc, 0x80, 0xe6,
+	0x6d, 0xf9, 0xeb, 0x34, 0xca, 0xec, 0x1b, 0xd4, 0x70, 0x18, 0xde, 0x43, 0x8b, 0x8e, 0xd1, 0xd5,
+	0x1d, 0xe2, 0xb8, 0xba, 0xd5, 0x02, 0xcc, 0xe2, 0x84, 0x8a, 0x35, 0xa7, 0xd5, 0x22, 0xe8, 0x2b,
+	0x08, 0x7d, 0x23, 0x8a, 0x64, 0x6d, 0x01, 0xd0, 0x5d, 0x00, 0xb7, 0xfa, 0x18, 0xde, 0x40, 0xb3,
+	0xbc, 0xab, 0x33, 0xbb, 0xa9, 0xb7, 0x6d, 0xd8, 0x63, 0x24, 0x3a, 0xad, 0xae, 0x5c, 0x5e, 0x34,
+	0x99, 0x95, 0x35, 0xc4, 0xbb, 0x07, 0x76, 0xf3, 0x79, 0x18, 0x60, 0x0d, 0x2d, 0x45, 0xc9, 0x13,
+	0xa2, 0x83, 0x7b, 0x5c, 0x87, 0xdb, 0xe8, 0x66, 0xc0, 0x49, 0xbc, 0xd7, 0x32, 0x70, 0xdc, 0x48,
+	0x70, 0x0c, 0x97, 0x81, 0x9c, 0x90, 0xec, 0x84, 0x6c, 0x01, 0xba, 0x4f, 0xa8, 0x0a, 0x18, 0x3e,
+	0x42, 0x2b, 0xe1, 0xb4, 0x63, 0x42, 0xed, 0x37, 0x81, 0xa8, 0x27, 0x8d, 0xb5, 0xf5, 0xf5, 0xda,
+	0x86, 0xd8, 0xb8, 0x5a, 0x3f, 0x87, 0x8f, 0x0f, 0x24, 0xbc, 0x8c, 0x2a, 0xc2, 0xd6, 0xa7, 0x4f,
+	0xa2, 0x3c, 0x4c, 0x2b, 0x8a, 0x69, 0x63, 0x08, 0x64, 0x2d, 0xcf, 0x06, 0xfa, 0x04, 0x8c, 0x03,
+	0xb4, 0x3a, 0xdc, 0xc1, 0x88, 0xe5, 0xad, 0xad, 0x3f, 0x3c, 0xac, 0x49, 0xd3, 0xd1, 0xd0, 0x47,
+	0x30, 0x74, 0x79, 0x60, 0xe8, 0xc1, 0x45, 0x05, 0x8c, 0x2d, 0x8f, 0x1e, 0xdb, 0x27, 0x91, 0xb5,
+	0x65, 0x36, 0xb2, 0x37, 0x34, 0x3f, 0xdc, 0x13, 0xd8, 0x13, 0x1a, 0xc2, 0xa4, 0xcc, 0xb0, 0xf9,
+	0xc9, 0x2c, 0x98, 0x0f, 0xe1, 0x8b, 0x6e, 0xe8, 0x13, 0xc3, 0xfa, 0x58, 0xd5, 0xb4, 0x26, 0xcd,
+	0x44, 0x3c, 0xb7, 0xfe, 0xab, 0x8d, 0x8e, 0xd3, 0x46, 0x6b, 0xf8, 0xd5, 0xd5, 0x4d, 0x30, 0x2a,
+	0x36, 0x91, 0x8d, 0xe8, 0xe5, 0xf1, 0x8e, 0xc7, 0x85, 0xc3, 0x8e, 0x1f, 0x08, 0xb8, 0x9e, 0x0d,
+	0x3f, 0xf3, 0x9f, 0xa7, 0xa5, 0x94, 0xba, 0xf5, 0xf9, 0xbc, 0x98, 0x3a, 0x83, 0xf3, 0x1d, 0xce,
+	0xbb, 0x1f, 0xc5, 0x89, 0x33, 0x38, 0xdf, 0xe0, 0xbc, 0xbe, 0xfb, 0xcf, 0xbf, 0x55, 0xf2, 0xa7,
+	0x6b, 0x66, 0xa2, 0x9f, 0xe0, 0x83, 0xbf, 0xc5, 0x21, 0x2d, 0xe4, 0x8b, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -270,6 +296,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.MaxTxBytes != that1.MaxTxBytes {
+		return false
+	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	if this.SigVerifyCostSr25519 != that1.SigVerifyCostSr25519 {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -390,6 +425,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostSr25519 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSr25519))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -496,6 +546,15 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTxBytes))
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256r1))
+	}
+	if m.SigVerifyCostSr25519 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSr25519))
+	}
 	return n
 }
 
@@ -941,6 +1000,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSr25519", wireType)
+			}
+			m.SigVerifyCostSr25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSr25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
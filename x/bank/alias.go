@@ -8,6 +8,7 @@ import (
 const (
 	QueryBalance       = types.QueryBalance
 	QueryAllBalances   = types.QueryAllBalances
+	QueryDenomOwners   = types.QueryDenomOwners
 	DefaultParamspace  = types.DefaultParamspace
 	DefaultSendEnabled = types.DefaultSendEnabled
 
@@ -15,6 +16,14 @@ const (
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
 	AttributeKeySender     = types.AttributeKeySender
 	AttributeValueCategory = types.AttributeValueCategory
+	EventTypeCoinSpent     = types.EventTypeCoinSpent
+	EventTypeCoinReceived  = types.EventTypeCoinReceived
+	EventTypeCoinMint      = types.EventTypeCoinMint
+	EventTypeCoinBurn      = types.EventTypeCoinBurn
+	AttributeKeySpender    = types.AttributeKeySpender
+	AttributeKeyReceiver   = types.AttributeKeyReceiver
+	AttributeKeyMinter     = types.AttributeKeyMinter
+	AttributeKeyBurner     = types.AttributeKeyBurner
 
 	ModuleName   = types.ModuleName
 	StoreKey     = types.StoreKey
@@ -46,10 +55,17 @@ var (
 	ParamKeyTable               = types.ParamKeyTable
 	NewQueryBalanceParams       = types.NewQueryBalanceParams
 	NewQueryAllBalancesParams   = types.NewQueryAllBalancesParams
+	NewQueryDenomOwnersParams   = types.NewQueryDenomOwnersParams
+	NewDenomOwner               = types.NewDenomOwner
+	NewCoinSpentEvent           = types.NewCoinSpentEvent
+	NewCoinReceivedEvent        = types.NewCoinReceivedEvent
+	NewCoinMintEvent            = types.NewCoinMintEvent
+	NewCoinBurnEvent            = types.NewCoinBurnEvent
 	ModuleCdc                   = types.ModuleCdc
 	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
 	BalancesPrefix              = types.BalancesPrefix
 	AddressFromBalancesStore    = types.AddressFromBalancesStore
+	DenomOwnersPrefix           = types.DenomOwnersPrefix
 	AllInvariants               = keeper.AllInvariants
 	TotalSupply                 = keeper.TotalSupply
 	NewSupply                   = types.NewSupply
@@ -69,6 +85,8 @@ type (
 	Output                  = types.Output
 	QueryBalanceParams      = types.QueryBalanceParams
 	QueryAllBalancesParams  = types.QueryAllBalancesParams
+	QueryDenomOwnersParams  = types.QueryDenomOwnersParams
+	DenomOwner              = types.DenomOwner
 	GenesisBalancesIterator = types.GenesisBalancesIterator
 	Keeper                  = keeper.Keeper
 	GenesisState            = types.GenesisState
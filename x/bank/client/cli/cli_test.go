@@ -46,16 +46,22 @@ func TestCLISend(t *testing.T) {
 	require.Equal(t, startTokens.Sub(sendTokens).String(), testutil.QueryBalances(f, fooAddr).AmountOf(cli.Denom).String())
 
 	// Test --dry-run
-	success, _, _ = testutil.TxSend(f, cli.KeyFoo, barAddr, sdk.NewCoin(cli.Denom, sendTokens), "--dry-run")
+	success, stdout, stderr := testutil.TxSend(f, cli.KeyFoo, barAddr, sdk.NewCoin(cli.Denom, sendTokens), "--dry-run")
 	require.True(t, success)
+	require.Contains(t, stderr, "gas estimate")
+	require.Contains(t, stderr, fooAddr.String())
+	msg := cli.UnmarshalStdTx(f.T, f.Cdc, stdout)
+	require.NotZero(t, msg.Fee.Gas)
+	require.Len(t, msg.Msgs, 1)
+	require.Len(t, msg.GetSignatures(), 0)
 
 	// Test --generate-only
-	success, stdout, stderr := testutil.TxSend(
+	success, stdout, stderr = testutil.TxSend(
 		f, fooAddr.String(), barAddr, sdk.NewCoin(cli.Denom, sendTokens), "--generate-only=true",
 	)
 	require.Empty(t, stderr)
 	require.True(t, success)
-	msg := cli.UnmarshalStdTx(f.T, f.Cdc, stdout)
+	msg = cli.UnmarshalStdTx(f.T, f.Cdc, stdout)
 	t.Log(msg)
 	require.NotZero(t, msg.Fee.Gas)
 	require.Len(t, msg.Msgs, 1)
@@ -18,6 +18,7 @@ import (
 
 const (
 	flagDenom = "denom"
+	flagAll   = "all"
 )
 
 // ---------------------------------------------------------------------------
@@ -42,6 +43,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(cdc),
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryCirculatingSupply(cdc),
+		GetCmdQueryDenomOwners(cdc),
 	)
 
 	return cmd
@@ -148,3 +151,79 @@ $ %s query %s total stake
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdQueryCirculatingSupply(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circulating [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the circulating supply of coins of the chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the circulating supply of coins of the chain, i.e. the
+total supply minus the coins held by module accounts and the coins locked in
+vesting accounts.
+
+Example:
+$ %s query %s circulating
+
+To query for the circulating supply of a specific coin denomination use:
+$ %s query %s circulating stake
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				return queryCirculatingSupply(cliCtx, cdc)
+			}
+
+			return queryCirculatingSupplyOf(cliCtx, cdc, args[0])
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdQueryDenomOwners(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the accounts holding a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a page of the accounts holding a non-zero balance of a coin
+denomination, along with their balance, in the order of their addresses.
+
+With --all, every holder is streamed, one per line, by querying the pages of
+--limit holders following the last holder printed, all at the height of the
+first page, so that a snapshot of the holders is taken without holding them all
+in a single response.
+
+Example:
+$ %s query %s denom-owners stake --page=2 --limit=100
+$ %s query %s denom-owners stake --all --limit=1000
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if viper.GetBool(flagAll) {
+				return streamDenomOwners(cliCtx, cdc, args[0], viper.GetInt(flags.FlagLimit))
+			}
+
+			return queryDenomOwners(cliCtx, cdc, args[0], viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Page of the holders to query")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Number of holders per page")
+	cmd.Flags().Bool(flagAll, false, "Stream all the holders, one page after the other")
+
+	return flags.GetCommands(cmd)[0]
+}
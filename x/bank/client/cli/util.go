@@ -50,3 +50,101 @@ func querySupplyOf(cliCtx context.CLIContext, cdc *codec.Codec, denom string) er
 
 	return cliCtx.PrintOutput(supply)
 }
+
+func queryCirculatingSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCirculatingSupply), nil)
+	if err != nil {
+		return err
+	}
+
+	var supply sdk.Coins
+	err = cdc.UnmarshalJSON(res, &supply)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(supply)
+}
+
+func queryCirculatingSupplyOf(cliCtx context.CLIContext, cdc *codec.Codec, denom string) error {
+	params := types.NewQuerySupplyOfParams(denom)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCirculatingSupplyOf), bz)
+	if err != nil {
+		return err
+	}
+
+	var supply sdk.Int
+	err = cdc.UnmarshalJSON(res, &supply)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(supply)
+}
+
+func queryDenomOwners(cliCtx context.CLIContext, cdc *codec.Codec, denom string, page, limit int) error {
+	owners, _, err := queryDenomOwnersPage(cliCtx, cdc, types.NewQueryDenomOwnersParams(denom, page, limit))
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(owners)
+}
+
+// streamDenomOwners prints all the holders of a denomination, querying them
+// page by page from the last holder printed. The pages are queried at the
+// height of the first one, so that the holders printed are a consistent
+// snapshot.
+func streamDenomOwners(cliCtx context.CLIContext, cdc *codec.Codec, denom string, limit int) error {
+	var startAfter sdk.AccAddress
+	for {
+		owners, height, err := queryDenomOwnersPage(
+			cliCtx, cdc, types.NewQueryDenomOwnersAfterParams(denom, startAfter, limit),
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(owners) == 0 {
+			return nil
+		}
+
+		for _, owner := range owners {
+			if err := cliCtx.PrintOutput(owner); err != nil {
+				return err
+			}
+		}
+
+		if cliCtx.Height == 0 {
+			cliCtx = cliCtx.WithHeight(height)
+		}
+
+		startAfter = owners[len(owners)-1].Address
+	}
+}
+
+func queryDenomOwnersPage(
+	cliCtx context.CLIContext, cdc *codec.Codec, params types.QueryDenomOwnersParams,
+) ([]types.DenomOwner, int64, error) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomOwners), bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var owners []types.DenomOwner
+	if err := cdc.UnmarshalJSON(res, &owners); err != nil {
+		return nil, 0, err
+	}
+
+	return owners, height, nil
+}
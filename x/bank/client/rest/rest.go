@@ -13,6 +13,10 @@ func RegisterHandlers(ctx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(ctx)).Methods("GET")
 	r.HandleFunc("/bank/total", totalSupplyHandlerFn(ctx)).Methods("GET")
 	r.HandleFunc("/bank/total/{denom}", supplyOfHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/supply/total", totalSupplyHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/supply/total/{denom}", supplyOfHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/supply/circulating", circulatingSupplyHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/supply/circulating/{denom}", circulatingSupplyOfHandlerFn(ctx)).Methods("GET")
 }
 
 // ---------------------------------------------------------------------------
@@ -27,4 +31,8 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bank/total", totalSupplyHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bank/total/{denom}", supplyOfHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/supply/total", totalSupplyHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/supply/total/{denom}", supplyOfHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/supply/circulating", circulatingSupplyHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/supply/circulating/{denom}", circulatingSupplyOfHandlerFn(cliCtx)).Methods("GET")
 }
@@ -1,8 +1,11 @@
 package bank
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -59,6 +62,65 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	return NewGenesisState(keeper.GetSendEnabled(ctx), balances, keeper.GetSupply(ctx).GetTotal())
 }
 
+// ExportGenesisTo writes the bank module's genesis state as JSON to w, one
+// balance at a time, so that the balances are never all held in memory. The
+// balances are sorted by address.
+func ExportGenesisTo(ctx sdk.Context, keeper Keeper, cdc codec.JSONMarshaler, w io.Writer) error {
+	if _, err := fmt.Fprintf(w, `{"send_enabled":%t,"balances":[`, keeper.GetSendEnabled(ctx)); err != nil {
+		return err
+	}
+
+	var (
+		err     error
+		sep     string
+		balance Balance
+	)
+
+	writeBalance := func() error {
+		bz, err := cdc.MarshalJSON(balance)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "%s%s", sep, bz)
+		sep = ","
+		return err
+	}
+
+	// the balances of an address are stored next to each other, so they are
+	// written once the iteration reaches the next address
+	keeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if balance.Address != nil && !bytes.Equal(balance.Address, addr) {
+			if err = writeBalance(); err != nil {
+				return true
+			}
+
+			balance = Balance{}
+		}
+
+		balance.Address = addr
+		balance.Coins = balance.Coins.Add(coin)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if balance.Address != nil {
+		if err := writeBalance(); err != nil {
+			return err
+		}
+	}
+
+	supply, err := cdc.MarshalJSON(keeper.GetSupply(ctx).GetTotal())
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, `],"supply":%s}`, supply)
+	return err
+}
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
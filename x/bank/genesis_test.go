@@ -0,0 +1,53 @@
+package bank_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestInitGenesisDenomOwners(t *testing.T) {
+	balances := []bank.Balance{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))},
+		{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("foocoin", 7))},
+	}
+	genAccs := []auth.GenesisAccount{&auth.BaseAccount{Address: addr1}, &auth.BaseAccount{Address: addr2}}
+	app := simapp.SetupWithGenesisAccounts(genAccs, balances...)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// the genesis balances are indexed by denomination
+	owners := app.BankKeeper.GetDenomOwners(ctx, "foocoin", 1, 0)
+	require.Len(t, owners, 2)
+	for _, owner := range owners {
+		require.True(t, owner.Address.Equals(addr1) || owner.Address.Equals(addr2))
+	}
+}
+
+func TestExportGenesisTo(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 5))))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 7))))
+
+	var buf bytes.Buffer
+	require.NoError(t, bank.ExportGenesisTo(ctx, app.BankKeeper, app.Codec(), &buf))
+
+	var streamed bank.GenesisState
+	require.NoError(t, app.Codec().UnmarshalJSON(buf.Bytes(), &streamed))
+
+	// the streamed balances are sorted by address
+	expected := bank.ExportGenesis(ctx, app.BankKeeper)
+	sort.Slice(expected.Balances, func(i, j int) bool {
+		return bytes.Compare(expected.Balances[i].Address, expected.Balances[j].Address) < 0
+	})
+	require.Equal(t, expected, streamed)
+}
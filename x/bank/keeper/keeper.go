@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -26,6 +27,7 @@ type Keeper interface {
 
 	GetSupply(ctx sdk.Context) exported.SupplyI
 	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetCirculatingSupply(ctx sdk.Context) sdk.Coins
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -104,7 +106,11 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		}
 	}
 
-	if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockHeader().Time, balances, amt); err != nil {
+	if !amt.Empty() {
+		ctx.EventManager().EmitEvent(types.NewCoinSpentEvent(delegatorAddr, amt))
+	}
+
+	if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockTime(), balances, amt); err != nil {
 		return sdkerrors.Wrap(err, "failed to track delegation")
 	}
 
@@ -164,6 +170,39 @@ func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
 	return supply
 }
 
+// GetCirculatingSupply returns the circulating supply, i.e. the total supply
+// minus the balances of the module accounts and the coins locked in vesting
+// accounts. Coins delegated from vesting accounts are held by the staking
+// module accounts, so that they are only subtracted once.
+//
+// NOTE: all the accounts are iterated over, so that this should only be used
+// by queries.
+func (k BaseKeeper) GetCirculatingSupply(ctx sdk.Context) sdk.Coins {
+	excluded := sdk.NewCoins()
+
+	k.ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		switch acc := acc.(type) {
+		case authtypes.ModuleAccountI:
+			excluded = excluded.Add(k.GetAllBalances(ctx, acc.GetAddress())...)
+
+		case vestexported.VestingAccount:
+			// the locked coins may exceed the balance of the account once it
+			// delegated its vesting coins
+			locked := acc.LockedCoins(ctx.BlockTime()).Min(k.GetAllBalances(ctx, acc.GetAddress()))
+			excluded = excluded.Add(locked...)
+		}
+
+		return false
+	})
+
+	circulating, hasNeg := k.GetSupply(ctx).GetTotal().SafeSub(excluded)
+	if hasNeg {
+		panic(fmt.Sprintf("excluded supply %s exceeds the total supply", excluded))
+	}
+
+	return circulating
+}
+
 // SetSupply sets the Supply to store
 func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
 	store := ctx.KVStore(k.storeKey)
@@ -176,7 +215,8 @@ func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
-// It will panic if the module account does not exist.
+// It will panic if the module account does not exist. An error is returned if
+// the recipient address is blacklisted from receiving funds.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
 	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
 ) error {
@@ -186,6 +226,10 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
+	if k.BlacklistedAddr(recipientAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+
 	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
@@ -283,6 +327,8 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 
 	k.SetSupply(ctx, supply)
 
+	ctx.EventManager().EmitEvent(types.NewCoinMintEvent(acc.GetAddress(), amt))
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
 
@@ -311,6 +357,8 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	supply.Deflate(amt)
 	k.SetSupply(ctx, supply)
 
+	ctx.EventManager().EmitEvent(types.NewCoinBurnEvent(acc.GetAddress(), amt))
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
 
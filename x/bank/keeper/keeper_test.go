@@ -11,10 +11,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 const (
@@ -87,6 +89,42 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	suite.Require().Equal(totalSupply, total)
 }
 
+func (suite *IntegrationTestSuite) TestCirculatingSupply() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	app.AccountKeeper.SetModuleAccount(ctx, holderAcc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, holderAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 300))))
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	bacc := auth.NewBaseAccountWithAddress(addr1)
+	vacc := vesting.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), now.Add(24*time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, origCoins))
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 600))))
+
+	// the module account balance and all the vesting coins are excluded
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), app.BankKeeper.GetCirculatingSupply(ctx))
+
+	// vested coins are circulating
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 650)), app.BankKeeper.GetCirculatingSupply(ctx))
+
+	// the locked coins are capped by the balance of the vesting account
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("stake", 920))))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), app.BankKeeper.GetCirculatingSupply(ctx))
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
@@ -150,6 +188,37 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
 }
 
+func (suite *IntegrationTestSuite) TestSupply_SendCoinsToBlacklistedAddr() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	appCodec := app.AppCodec()
+
+	maccPerms := simapp.GetMaccPerms()
+	maccPerms[holder] = nil
+
+	authKeeper := auth.NewAccountKeeper(
+		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		auth.ProtoBaseAccount, maccPerms,
+	)
+
+	blockedAcc := authKeeper.NewAccountWithAddress(ctx, auth.NewModuleAddress("blocked"))
+	keeper := bank.NewBaseKeeper(
+		appCodec, app.GetKey(types.StoreKey), authKeeper,
+		app.GetSubspace(bank.ModuleName), map[string]bool{blockedAcc.GetAddress().String(): true},
+	)
+
+	suite.Require().NoError(keeper.SetBalances(ctx, holderAcc.GetAddress(), initCoins))
+	authKeeper.SetModuleAccount(ctx, holderAcc)
+	authKeeper.SetAccount(ctx, blockedAcc)
+
+	suite.Require().True(keeper.BlacklistedAddr(blockedAcc.GetAddress()))
+
+	err := keeper.SendCoinsFromModuleToAccount(ctx, holderAcc.GetName(), blockedAcc.GetAddress(), initCoins)
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err))
+	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, holderAcc.GetName()))
+	suite.Require().True(keeper.GetAllBalances(ctx, blockedAcc.GetAddress()).IsZero())
+}
+
 func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
@@ -259,6 +328,42 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
 }
 
+func (suite *IntegrationTestSuite) TestMintBurnEvents() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	appCodec := app.AppCodec()
+
+	// add module accounts to supply keeper
+	maccPerms := simapp.GetMaccPerms()
+	maccPerms[multiPerm] = []string{auth.Burner, auth.Minter, auth.Staking}
+
+	authKeeper := auth.NewAccountKeeper(
+		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		auth.ProtoBaseAccount, maccPerms,
+	)
+	keeper := bank.NewBaseKeeper(
+		appCodec, app.GetKey(types.StoreKey), authKeeper,
+		app.GetSubspace(bank.ModuleName), make(map[string]bool),
+	)
+
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+	maccAddr := multiPermAcc.GetAddress()
+
+	suite.Require().NoError(keeper.MintCoins(ctx, multiPerm, initCoins))
+
+	events := ctx.EventManager().ABCIEvents()
+	suite.Require().Equal(2, len(events))
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(maccAddr, initCoins)), events[0])
+	suite.Require().Equal(abci.Event(types.NewCoinMintEvent(maccAddr, initCoins)), events[1])
+
+	suite.Require().NoError(keeper.BurnCoins(ctx, multiPerm, initCoins))
+
+	events = ctx.EventManager().ABCIEvents()
+	suite.Require().Equal(4, len(events))
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(maccAddr, initCoins)), events[2])
+	suite.Require().Equal(abci.Event(types.NewCoinBurnEvent(maccAddr, initCoins)), events[3])
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -396,6 +501,77 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(expected, acc2Balances)
 }
 
+func (suite *IntegrationTestSuite) TestDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins(newBarCoin(10))))
+
+	suite.Require().Equal([]types.DenomOwner{
+		types.NewDenomOwner(addr1, newFooCoin(50)),
+		types.NewDenomOwner(addr2, newFooCoin(20)),
+	}, app.BankKeeper.GetDenomOwners(ctx, fooDenom, 1, 0))
+
+	// accounts are removed from the index when their balance drops to zero
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr3, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().Equal([]types.DenomOwner{
+		types.NewDenomOwner(addr1, newFooCoin(50)),
+		types.NewDenomOwner(addr3, newFooCoin(20)),
+	}, app.BankKeeper.GetDenomOwners(ctx, fooDenom, 1, 0))
+
+	// pagination
+	suite.Require().Equal(
+		[]types.DenomOwner{types.NewDenomOwner(addr3, newFooCoin(20))},
+		app.BankKeeper.GetDenomOwners(ctx, fooDenom, 2, 1),
+	)
+	suite.Require().Empty(app.BankKeeper.GetDenomOwners(ctx, fooDenom, 3, 1))
+	suite.Require().Empty(app.BankKeeper.GetDenomOwners(ctx, fooDenom, 0, 1))
+
+	// streaming from the last holder returned
+	suite.Require().Equal(
+		[]types.DenomOwner{types.NewDenomOwner(addr1, newFooCoin(50))},
+		app.BankKeeper.GetDenomOwnersAfter(ctx, fooDenom, nil, 1),
+	)
+	suite.Require().Equal(
+		[]types.DenomOwner{types.NewDenomOwner(addr3, newFooCoin(20))},
+		app.BankKeeper.GetDenomOwnersAfter(ctx, fooDenom, addr1, 1),
+	)
+	suite.Require().Empty(app.BankKeeper.GetDenomOwnersAfter(ctx, fooDenom, addr3, 1))
+
+	var iterated []sdk.AccAddress
+	app.BankKeeper.IterateDenomOwners(ctx, barDenom, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		iterated = append(iterated, addr)
+		return true
+	})
+	suite.Require().Equal([]sdk.AccAddress{addr1}, iterated)
+
+	// clearing the balances of an account removes it from the index
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newBarCoin(30))))
+	suite.Require().Equal(
+		[]types.DenomOwner{types.NewDenomOwner(addr3, newFooCoin(20))},
+		app.BankKeeper.GetDenomOwners(ctx, fooDenom, 1, 0),
+	)
+
+	// the index is rebuilt from the balances by the upgrade handler
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(append(append(types.DenomOwnersPrefix, types.CreateDenomOwnersPrefix(barDenom)...), addr1...))
+	suite.Require().Len(app.BankKeeper.GetDenomOwners(ctx, barDenom, 1, 0), 1)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: simapp.UpgradeDenomOwners, Height: ctx.BlockHeight()})
+	suite.Require().Equal([]types.DenomOwner{
+		types.NewDenomOwner(addr1, newBarCoin(30)),
+		types.NewDenomOwner(addr3, newBarCoin(10)),
+	}, app.BankKeeper.GetDenomOwners(ctx, barDenom, 1, 0))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -495,6 +671,10 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 		event1.Attributes,
 		tmkv.Pair{Key: []byte(types.AttributeKeyRecipient), Value: []byte(addr2.String())},
 	)
+	event1.Attributes = append(
+		event1.Attributes,
+		tmkv.Pair{Key: []byte(types.AttributeKeySender), Value: []byte(addr.String())},
+	)
 	event1.Attributes = append(
 		event1.Attributes,
 		tmkv.Pair{Key: []byte(sdk.AttributeKeyAmount), Value: []byte(newCoins.String())},
@@ -517,9 +697,11 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(4, len(events))
+	suite.Require().Equal(6, len(events))
 	suite.Require().Equal(abci.Event(event1), events[2])
 	suite.Require().Equal(abci.Event(event2), events[3])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr, newCoins)), events[4])
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(addr2, newCoins)), events[5])
 }
 
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
@@ -559,7 +741,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(1, len(events))
+	suite.Require().Equal(2, len(events))
 
 	event1 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -569,7 +751,8 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		event1.Attributes,
 		tmkv.Pair{Key: []byte(types.AttributeKeySender), Value: []byte(addr.String())},
 	)
-	suite.Require().Equal(abci.Event(event1), events[0])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr, newCoins)), events[0])
+	suite.Require().Equal(abci.Event(event1), events[1])
 
 	// Set addr's coins and addr2's coins
 	app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50)))
@@ -581,7 +764,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(5, len(events))
+	suite.Require().Equal(10, len(events))
 
 	event2 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -615,10 +798,14 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		tmkv.Pair{Key: []byte(sdk.AttributeKeyAmount), Value: []byte(newCoins2.String())},
 	)
 
-	suite.Require().Equal(abci.Event(event1), events[1])
-	suite.Require().Equal(abci.Event(event2), events[2])
-	suite.Require().Equal(abci.Event(event3), events[3])
-	suite.Require().Equal(abci.Event(event4), events[4])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr, newCoins)), events[2])
+	suite.Require().Equal(abci.Event(event1), events[3])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr2, newCoins2)), events[4])
+	suite.Require().Equal(abci.Event(event2), events[5])
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(addr3, newCoins)), events[6])
+	suite.Require().Equal(abci.Event(event3), events[7])
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(addr4, newCoins2)), events[8])
+	suite.Require().Equal(abci.Event(event4), events[9])
 }
 
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
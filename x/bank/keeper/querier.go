@@ -26,6 +26,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySupplyOf:
 			return querySupplyOf(ctx, req, k)
 
+		case types.QueryCirculatingSupply:
+			return queryCirculatingSupply(ctx, k)
+
+		case types.QueryCirculatingSupplyOf:
+			return queryCirculatingSupplyOf(ctx, req, k)
+
+		case types.QueryDenomOwners:
+			return queryDenomOwners(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -108,3 +117,57 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 
 	return res, nil
 }
+
+func queryCirculatingSupply(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := k.GetCirculatingSupply(ctx).MarshalJSON()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryCirculatingSupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySupplyOfParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	supply := k.GetCirculatingSupply(ctx).AmountOf(params.Denom)
+
+	res, err := supply.MarshalJSON()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDenomOwners(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomOwnersParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var owners []types.DenomOwner
+	if len(params.StartAfter) > 0 {
+		owners = k.GetDenomOwnersAfter(ctx, params.Denom, params.StartAfter, params.Limit)
+	} else {
+		owners = k.GetDenomOwners(ctx, params.Denom, params.Page, params.Limit)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, owners)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -150,3 +150,76 @@ func (suite *IntegrationTestSuite) TestQuerierRouteNotFound() {
 	_, err := querier(ctx, []string{"invalid"}, req)
 	suite.Error(err)
 }
+
+func (suite *IntegrationTestSuite) TestQuerier_QueryCirculatingSupply() {
+	app, ctx := suite.app, suite.ctx
+
+	test1Supply := sdk.NewInt64Coin("test1", 4000000)
+	test2Supply := sdk.NewInt64Coin("test2", 700000000)
+	app.BankKeeper.SetSupply(ctx, bank.NewSupply(sdk.NewCoins(test1Supply, test2Supply)))
+
+	app.AccountKeeper.SetModuleAccount(ctx, holderAcc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, holderAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("test1", 1000000))))
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryCirculatingSupply}, abci.RequestQuery{})
+	suite.Require().NoError(err)
+
+	var coins sdk.Coins
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &coins))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test1", 3000000), test2Supply), coins)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryCirculatingSupplyOf),
+		Data: []byte{},
+	}
+
+	res, err = querier(ctx, []string{types.QueryCirculatingSupplyOf}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQuerySupplyOfParams(test1Supply.Denom))
+	res, err = querier(ctx, []string{types.QueryCirculatingSupplyOf}, req)
+	suite.Require().NoError(err)
+
+	var amount sdk.Int
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &amount))
+	suite.Require().Equal(sdk.NewInt(3000000), amount)
+}
+
+func (suite *IntegrationTestSuite) TestQuerier_QueryDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := authtypes.KeyTestPubAddr()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDenomOwners),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersParams("", 1, 0))
+	_, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().Error(err)
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(newFooCoin(50))))
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersParams(fooDenom, 1, 0))
+	res, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NoError(err)
+
+	var owners []types.DenomOwner
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &owners))
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addr, newFooCoin(50))}, owners)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersAfterParams(fooDenom, addr, 0))
+	res, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &owners))
+	suite.Require().Empty(owners)
+}
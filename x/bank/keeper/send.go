@@ -22,6 +22,7 @@ type SendKeeper interface {
 
 	SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error
 	SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
+	IndexDenomOwners(ctx sdk.Context)
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
@@ -117,6 +118,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		sdk.NewEvent(
 			types.EventTypeTransfer,
 			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
 		),
 		sdk.NewEvent(
@@ -147,8 +149,9 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 	return nil
 }
 
-// SubtractCoins removes amt coins the account by the given address. An error is
-// returned if the resulting balance is negative or the initial amount is invalid.
+// SubtractCoins removes amt coins the account by the given address and emits a
+// coin_spent event. An error is returned if the resulting balance is negative or
+// the initial amount is invalid.
 func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
 	if !amt.IsValid() {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
@@ -176,12 +179,16 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 		}
 	}
 
+	if !amt.Empty() {
+		ctx.EventManager().EmitEvent(types.NewCoinSpentEvent(addr, amt))
+	}
+
 	return resultCoins, nil
 }
 
-// AddCoins adds amt to the account balance given by the provided address. An
-// error is returned if the initial amount is invalid or if any resulting new
-// balance is negative.
+// AddCoins adds amt to the account balance given by the provided address and
+// emits a coin_received event. An error is returned if the initial amount is
+// invalid or if any resulting new balance is negative.
 func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
 	if !amt.IsValid() {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
@@ -200,6 +207,10 @@ func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 		}
 	}
 
+	if !amt.Empty() {
+		ctx.EventManager().EmitEvent(types.NewCoinReceivedEvent(addr, amt))
+	}
+
 	return resultCoins, nil
 }
 
@@ -217,6 +228,7 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 
 	for _, key := range keys {
 		accountStore.Delete(key)
+		k.denomOwnersStore(ctx, string(key)).Delete(addr.Bytes())
 	}
 }
 
@@ -249,9 +261,31 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	bz := k.cdc.MustMarshalBinaryBare(&balance)
 	accountStore.Set([]byte(balance.Denom), bz)
 
+	// keep the reverse index of the holders of the denomination up to date
+	denomOwnersStore := k.denomOwnersStore(ctx, balance.Denom)
+	if balance.IsZero() {
+		denomOwnersStore.Delete(addr.Bytes())
+	} else {
+		denomOwnersStore.Set(addr.Bytes(), []byte{})
+	}
+
 	return nil
 }
 
+// IndexDenomOwners builds the reverse index of the holders of every
+// denomination from the balances in store. It must be run once, by an upgrade
+// handler, on chains whose balances were set before the index existed. The
+// balances set by InitGenesis are indexed as they are set.
+func (k BaseSendKeeper) IndexDenomOwners(ctx sdk.Context) {
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if !balance.IsZero() {
+			k.denomOwnersStore(ctx, balance.Denom).Set(addr.Bytes(), []byte{})
+		}
+
+		return false
+	})
+}
+
 // GetSendEnabled returns the current SendEnabled
 func (k BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
 	var enabled bool
@@ -29,8 +29,15 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+	IterateDenomOwners(ctx sdk.Context, denom string, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+	GetDenomOwners(ctx sdk.Context, denom string, page, limit int) []types.DenomOwner
+	GetDenomOwnersAfter(ctx sdk.Context, denom string, startAfter sdk.AccAddress, limit int) []types.DenomOwner
 }
 
+// defaultDenomOwnersLimit is the number of holders of a denomination returned
+// when no limit is given.
+const defaultDenomOwnersLimit = 100
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	cdc      codec.Marshaler
@@ -129,6 +136,98 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 	}
 }
 
+// IterateDenomOwners iterates over the accounts holding a non-zero balance of
+// the given denomination, in the order of their addresses, and provides their
+// balance to a callback. If true is returned from the callback, iteration is
+// halted. It walks the reverse index maintained when balances are set, rather
+// than all the balances of all accounts.
+func (k BaseViewKeeper) IterateDenomOwners(ctx sdk.Context, denom string, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	k.iterateDenomOwners(ctx, denom, nil, cb)
+}
+
+// iterateDenomOwners iterates over the holders of the given denomination from
+// the given address on.
+func (k BaseViewKeeper) iterateDenomOwners(ctx sdk.Context, denom string, start []byte, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	iterator := k.denomOwnersStore(ctx, denom).Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := types.AddressFromDenomOwnersStore(iterator.Key())
+
+		if cb(address, k.GetBalance(ctx, address, denom)) {
+			break
+		}
+	}
+}
+
+// GetDenomOwners returns the given page of the accounts holding a non-zero
+// balance of the given denomination, along with their balance. Pages start at
+// 1, and a zero limit defaults to 100 holders per page. Only the holders up to
+// the requested page are iterated over, so that streaming all the holders
+// page by page is better done with GetDenomOwnersAfter.
+func (k BaseViewKeeper) GetDenomOwners(ctx sdk.Context, denom string, page, limit int) []types.DenomOwner {
+	if limit == 0 {
+		limit = defaultDenomOwnersLimit
+	}
+
+	owners := []types.DenomOwner{}
+	if page <= 0 || limit < 0 {
+		return owners
+	}
+
+	skip := (page - 1) * limit
+	k.IterateDenomOwners(ctx, denom, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if skip > 0 {
+			skip--
+			return false
+		}
+
+		owners = append(owners, types.NewDenomOwner(addr, balance))
+		return len(owners) == limit
+	})
+
+	return owners
+}
+
+// GetDenomOwnersAfter returns up to limit accounts holding a non-zero balance of
+// the given denomination whose address follows startAfter, along with their
+// balance. A nil startAfter returns the first holders, and a zero limit
+// defaults to 100 holders. Passing the address of the last holder returned as
+// the next startAfter streams all the holders, each page costing the same
+// regardless of how many holders precede it.
+func (k BaseViewKeeper) GetDenomOwnersAfter(
+	ctx sdk.Context, denom string, startAfter sdk.AccAddress, limit int,
+) []types.DenomOwner {
+	if limit == 0 {
+		limit = defaultDenomOwnersLimit
+	}
+
+	owners := []types.DenomOwner{}
+	if limit < 0 {
+		return owners
+	}
+
+	// the smallest key following startAfter
+	var start []byte
+	if len(startAfter) > 0 {
+		start = append(append([]byte{}, startAfter...), 0x00)
+	}
+
+	k.iterateDenomOwners(ctx, denom, start, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		owners = append(owners, types.NewDenomOwner(addr, balance))
+		return len(owners) == limit
+	})
+
+	return owners
+}
+
+// denomOwnersStore returns the store of the reverse index of the accounts
+// holding a non-zero balance of the given denomination, keyed by address.
+func (k BaseViewKeeper) denomOwnersStore(ctx sdk.Context, denom string) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomOwnersPrefix)
+	return prefix.NewStore(store, types.CreateDenomOwnersPrefix(denom))
+}
+
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
@@ -3,6 +3,7 @@ package bank
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 
 	"github.com/gorilla/mux"
@@ -27,6 +28,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.InterfaceModule     = AppModuleBasic{}
+
+	_ module.StreamingGenesisModule = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -37,6 +40,11 @@ type AppModuleBasic struct {
 // Name returns the bank module's name.
 func (AppModuleBasic) Name() string { return ModuleName }
 
+// StoreKeys returns the keys of the bank module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the bank module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) { RegisterCodec(cdc) }
 
@@ -133,6 +141,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(gs)
 }
 
+// ExportGenesisTo writes the exported genesis state of the bank module as JSON
+// to w, without holding all the balances in memory.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONMarshaler, w io.Writer) error {
+	return ExportGenesisTo(ctx, am.keeper, cdc, w)
+}
+
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
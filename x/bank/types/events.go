@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // bank module event types
 const (
 	EventTypeTransfer = "transfer"
@@ -8,4 +12,56 @@ const (
 	AttributeKeySender    = "sender"
 
 	AttributeValueCategory = ModuleName
+
+	// balance change event types, emitted by the keeper on every change of the
+	// balance of an account
+	EventTypeCoinSpent    = "coin_spent"
+	EventTypeCoinReceived = "coin_received"
+	EventTypeCoinMint     = "coinbase"
+	EventTypeCoinBurn     = "burn"
+
+	AttributeKeySpender  = "spender"
+	AttributeKeyReceiver = "receiver"
+	AttributeKeyMinter   = "minter"
+	AttributeKeyBurner   = "burner"
 )
+
+// NewCoinSpentEvent returns the event of the amount of coins spent by the
+// given address.
+func NewCoinSpentEvent(spender sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeCoinSpent,
+		sdk.NewAttribute(AttributeKeySpender, spender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewCoinReceivedEvent returns the event of the amount of coins received by
+// the given address.
+func NewCoinReceivedEvent(receiver sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeCoinReceived,
+		sdk.NewAttribute(AttributeKeyReceiver, receiver.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewCoinMintEvent returns the event of the amount of coins minted to the
+// given module account address.
+func NewCoinMintEvent(minter sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeCoinMint,
+		sdk.NewAttribute(AttributeKeyMinter, minter.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewCoinBurnEvent returns the event of the amount of coins burned from the
+// given module account address.
+func NewCoinBurnEvent(burner sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeCoinBurn,
+		sdk.NewAttribute(AttributeKeyBurner, burner.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,10 +23,22 @@ const (
 
 // KVStore keys
 var (
-	BalancesPrefix = []byte("balances")
-	SupplyKey      = []byte{0x00}
+	BalancesPrefix    = []byte("balances")
+	SupplyKey         = []byte{0x00}
+	DenomOwnersPrefix = []byte{0x01}
 )
 
+// CreateDenomOwnersPrefix returns the prefix of the reverse index of the
+// accounts holding a non-zero balance of the given denomination, which is
+// followed by the address of each holder. The denomination is prefixed by its
+// uvarint encoded length, so that no denomination is a prefix of another.
+func CreateDenomOwnersPrefix(denom string) []byte {
+	prefix := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(denom))
+	n := binary.PutUvarint(prefix, uint64(len(denom)))
+
+	return append(prefix[:n], denom...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -37,3 +50,10 @@ func AddressFromBalancesStore(key []byte) sdk.AccAddress {
 
 	return sdk.AccAddress(addr)
 }
+
+// AddressFromDenomOwnersStore returns an account address from a denomination
+// owners prefix store. The key must not contain the DenomOwnersPrefix nor the
+// denomination prefix, so that it is the address itself.
+func AddressFromDenomOwnersStore(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key)
+}
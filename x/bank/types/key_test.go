@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,3 +25,17 @@ func TestAddressFromBalancesStore(t *testing.T) {
 	res := types.AddressFromBalancesStore(key)
 	require.Equal(t, res, addr)
 }
+
+func TestCreateDenomOwnersPrefix(t *testing.T) {
+	require.Equal(t, append([]byte{5}, "stake"...), types.CreateDenomOwnersPrefix("stake"))
+
+	// the denomination is length prefixed, so that it is not a prefix of another
+	require.NotEqual(t,
+		types.CreateDenomOwnersPrefix("stake"),
+		types.CreateDenomOwnersPrefix("stakes")[:len("stake")+1],
+	)
+
+	// lengths over 127 bytes take several bytes
+	long := strings.Repeat("a", 300)
+	require.Equal(t, append([]byte{0xac, 0x02}, long...), types.CreateDenomOwnersPrefix(long))
+}
@@ -10,6 +10,11 @@ const (
 	QueryAllBalances = "all_balances"
 	QueryTotalSupply = "total_supply"
 	QuerySupplyOf    = "supply_of"
+
+	QueryCirculatingSupply   = "circulating_supply"
+	QueryCirculatingSupplyOf = "circulating_supply_of"
+
+	QueryDenomOwners = "denom_owners"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -48,6 +53,7 @@ func NewQueryTotalSupplyParams(page, limit int) QueryTotalSupplyParams {
 // QuerySupplyOfParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupplyOf'
+// - 'custom/bank/circulating_supply_of'
 type QuerySupplyOfParams struct {
 	Denom string
 }
@@ -57,3 +63,34 @@ type QuerySupplyOfParams struct {
 func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
 	return QuerySupplyOfParams{denom}
 }
+
+// QueryDenomOwnersParams defines the params for querying a page of the holders
+// of a denomination. If StartAfter is set, the page holds the holders whose
+// address follows it, and Page is ignored.
+type QueryDenomOwnersParams struct {
+	Denom       string
+	Page, Limit int
+	StartAfter  sdk.AccAddress
+}
+
+// NewQueryDenomOwnersParams creates a new instance of QueryDenomOwnersParams.
+func NewQueryDenomOwnersParams(denom string, page, limit int) QueryDenomOwnersParams {
+	return QueryDenomOwnersParams{Denom: denom, Page: page, Limit: limit}
+}
+
+// NewQueryDenomOwnersAfterParams creates a new instance of
+// QueryDenomOwnersParams querying the holders following the given address.
+func NewQueryDenomOwnersAfterParams(denom string, startAfter sdk.AccAddress, limit int) QueryDenomOwnersParams {
+	return QueryDenomOwnersParams{Denom: denom, Limit: limit, StartAfter: startAfter}
+}
+
+// DenomOwner is an account holding a non-zero balance of a denomination.
+type DenomOwner struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Balance sdk.Coin       `json:"balance" yaml:"balance"`
+}
+
+// NewDenomOwner creates a new instance of DenomOwner.
+func NewDenomOwner(addr sdk.AccAddress, balance sdk.Coin) DenomOwner {
+	return DenomOwner{Address: addr, Balance: balance}
+}
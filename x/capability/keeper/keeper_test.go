@@ -92,6 +92,18 @@ func (suite *KeeperTestSuite) TestNewCapability() {
 	cap, err = sk.NewCapability(suite.ctx, "transfer")
 	suite.Require().Error(err)
 	suite.Require().Nil(cap)
+
+	cap, err = sk.NewCapability(suite.ctx, "   ")
+	suite.Require().Error(err)
+	suite.Require().Nil(cap)
+
+	got, ok = sk.GetCapability(suite.ctx, "")
+	suite.Require().False(ok)
+	suite.Require().Nil(got)
+
+	suite.Require().Panics(func() {
+		_ = suite.keeper.ScopeToModule(" ")
+	})
 }
 
 func (suite *KeeperTestSuite) TestOriginalCapabilityKeeper() {
@@ -157,6 +169,12 @@ func (suite *KeeperTestSuite) TestClaimCapability() {
 	got, ok = sk2.GetCapability(suite.ctx, "transfer")
 	suite.Require().True(ok)
 	suite.Require().Equal(cap, got)
+
+	suite.Require().Error(sk2.ClaimCapability(suite.ctx, nil, "transfer"))
+	suite.Require().Error(sk2.ClaimCapability(suite.ctx, cap, ""))
+	suite.Require().Error(sk2.ReleaseCapability(suite.ctx, nil))
+	suite.Require().False(sk2.AuthenticateCapability(suite.ctx, nil, "transfer"))
+	suite.Require().False(sk2.AuthenticateCapability(suite.ctx, cap, ""))
 }
 
 func (suite *KeeperTestSuite) TestGetOwners() {
@@ -42,6 +42,11 @@ func (AppModuleBasic) Name() string {
 	return ModuleName
 }
 
+// StoreKeys returns the keys of the capability module stores.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey), sdk.NewMemoryStoreKey(MemStoreKey)}
+}
+
 // RegisterCodec registers the capability module's types to the provided codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
@@ -13,4 +13,6 @@ var (
 	ErrCapabilityNotOwned       = sdkerrors.Register(ModuleName, 4, "capability not owned by module")
 	ErrCapabilityNotFound       = sdkerrors.Register(ModuleName, 5, "capability not found")
 	ErrCapabilityOwnersNotFound = sdkerrors.Register(ModuleName, 6, "owners not found for capability")
+	ErrInvalidCapabilityName    = sdkerrors.Register(ModuleName, 7, "capability name not valid")
+	ErrNilCapability            = sdkerrors.Register(ModuleName, 8, "provided capability is nil")
 )
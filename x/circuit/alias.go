@@ -0,0 +1,45 @@
+package circuit
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+const (
+	ModuleName                 = types.ModuleName
+	StoreKey                   = types.StoreKey
+	RouterKey                  = types.RouterKey
+	QuerierRoute               = types.QuerierRoute
+	QueryDisabledMsgRoutes     = types.QueryDisabledMsgRoutes
+	QueryAuthorities           = types.QueryAuthorities
+	TypeMsgTripCircuitBreaker  = types.TypeMsgTripCircuitBreaker
+	TypeMsgResetCircuitBreaker = types.TypeMsgResetCircuitBreaker
+)
+
+var (
+	// functions aliases
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	NewCircuitBreakerDecorator = ante.NewCircuitBreakerDecorator
+	RegisterCodec              = types.RegisterCodec
+	MsgRoutes                  = types.MsgRoutes
+	ValidateMsgRoute           = types.ValidateMsgRoute
+	NewMsgTripCircuitBreaker   = types.NewMsgTripCircuitBreaker
+	NewMsgResetCircuitBreaker  = types.NewMsgResetCircuitBreaker
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrMsgDisabled     = types.ErrMsgDisabled
+	ErrInvalidMsgRoute = types.ErrInvalidMsgRoute
+)
+
+type (
+	Keeper                  = keeper.Keeper
+	CircuitBreakerDecorator = ante.CircuitBreakerDecorator
+	MsgTripCircuitBreaker   = types.MsgTripCircuitBreaker
+	MsgResetCircuitBreaker  = types.MsgResetCircuitBreaker
+	GenesisState            = types.GenesisState
+)
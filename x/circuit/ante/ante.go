@@ -0,0 +1,37 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// CircuitBreakerDecorator rejects the transactions containing a message
+// disabled by the circuit breaker. It should be the outermost decorator, so
+// that the rejected transactions are neither charged fees nor increment the
+// sequence of their signers.
+//
+// NOTE: only the messages of the transactions are checked; the messages
+// dispatched by modules, e.g. by x/group proposals or x/wasm contracts, are
+// not.
+type CircuitBreakerDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewCircuitBreakerDecorator constructs a new CircuitBreakerDecorator
+func NewCircuitBreakerDecorator(k keeper.Keeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{keeper: k}
+}
+
+// AnteHandle returns an ErrMsgDisabled error if any message of the transaction
+// is disabled.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for i, msg := range tx.GetMsgs() {
+		if cbd.keeper.IsMsgDisabled(ctx, msg) {
+			return ctx, sdkerrors.Wrapf(types.ErrMsgDisabled, "message index %d: %s/%s", i, msg.Route(), msg.Type())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
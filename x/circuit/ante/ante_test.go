@@ -0,0 +1,48 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx testTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.CircuitKeeper
+
+	var nextCalled bool
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	cbd := ante.NewCircuitBreakerDecorator(k)
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tx := testTx{msgs: []sdk.Msg{sdk.NewTestMsg(addr), sdk.NewTestMsg(addr)}}
+
+	_, err := cbd.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+
+	// a disabled message rejects the whole transaction
+	nextCalled = false
+	require.NoError(t, k.DisableMsgRoute(ctx, "TestMsg"))
+
+	_, err = cbd.AnteHandle(ctx, tx, false, next)
+	require.True(t, types.ErrMsgDisabled.Is(err))
+	require.False(t, nextCalled)
+}
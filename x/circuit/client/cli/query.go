@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetQueryCmd returns the cli query commands for the circuit module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	circuitQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryDisabledMsgRoutes(cdc),
+			GetCmdQueryAuthorities(cdc),
+		)...,
+	)
+
+	return circuitQueryCmd
+}
+
+// GetCmdQueryDisabledMsgRoutes implements a command to return the message
+// routes disabled by the circuit breaker.
+func GetCmdQueryDisabledMsgRoutes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disabled-msg-routes",
+		Short: "Query the message routes disabled by the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var msgRoutes []string
+			if err := query(cliCtx, types.QueryDisabledMsgRoutes, &msgRoutes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(msgRoutes)
+		},
+	}
+}
+
+// GetCmdQueryAuthorities implements a command to return the circuit
+// authorities.
+func GetCmdQueryAuthorities(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorities",
+		Short: "Query the accounts allowed to trip and reset the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var authorities []sdk.AccAddress
+			if err := query(cliCtx, types.QueryAuthorities, &authorities); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(authorities)
+		},
+	}
+}
+
+func query(cliCtx context.CLIContext, path string, res interface{}) error {
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	out, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.Codec.UnmarshalJSON(out, res)
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
+func NewTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		NewTripCircuitBreakerTxCmd(cliCtx),
+		NewResetCircuitBreakerTxCmd(cliCtx),
+	)...)
+
+	return txCmd
+}
+
+// NewTripCircuitBreakerTxCmd returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewTripCircuitBreakerTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trip [authority_key_or_address] [msg-route]...",
+		Short: "Disable message routes, e.g. staking or bank/send",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			msg := types.NewMsgTripCircuitBreaker(cliCtx.GetFromAddress(), args[1:])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
+// NewResetCircuitBreakerTxCmd returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewResetCircuitBreakerTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset [authority_key_or_address] [msg-route]...",
+		Short: "Enable again disabled message routes",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			msg := types.NewMsgResetCircuitBreaker(cliCtx.GetFromAddress(), args[1:])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the circuit module state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, authority := range data.Authorities {
+		k.SetAuthority(ctx, authority)
+	}
+
+	for _, msgRoute := range data.DisabledMsgRoutes {
+		if err := k.DisableMsgRoute(ctx, msgRoute); err != nil {
+			panic(fmt.Sprintf("failed to disable genesis msg route %s: %s", msgRoute, err))
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAuthorities(ctx), k.GetDisabledMsgRoutes(ctx))
+}
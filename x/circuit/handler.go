@@ -0,0 +1,54 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewHandler returns a handler for "circuit" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgTripCircuitBreaker:
+			return handleMsgTripCircuitBreaker(ctx, k, msg)
+
+		case types.MsgResetCircuitBreaker:
+			return handleMsgResetCircuitBreaker(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized circuit message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgTripCircuitBreaker(ctx sdk.Context, k keeper.Keeper, msg types.MsgTripCircuitBreaker) (*sdk.Result, error) {
+	if err := k.TripCircuitBreaker(ctx, msg.Authority, msg.MsgRoutes); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Authority)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgResetCircuitBreaker(ctx sdk.Context, k keeper.Keeper, msg types.MsgResetCircuitBreaker) (*sdk.Result, error) {
+	if err := k.ResetCircuitBreaker(ctx, msg.Authority, msg.MsgRoutes); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Authority)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
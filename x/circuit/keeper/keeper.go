@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new circuit Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsAuthority returns true if the account is allowed to trip and reset the
+// circuit breakers.
+func (k Keeper) IsAuthority(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.AuthorityKey(addr))
+}
+
+// SetAuthority allows an account to trip and reset the circuit breakers.
+func (k Keeper) SetAuthority(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.AuthorityKey(addr), []byte{0x01})
+}
+
+// GetAuthorities returns the circuit authorities.
+func (k Keeper) GetAuthorities(ctx sdk.Context) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuthorityKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	authorities := []sdk.AccAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		authorities = append(authorities, sdk.AccAddress(iterator.Key()))
+	}
+
+	return authorities
+}
+
+// TripCircuitBreaker disables message routes on behalf of an authority.
+func (k Keeper) TripCircuitBreaker(ctx sdk.Context, authority sdk.AccAddress, msgRoutes []string) error {
+	if !k.IsAuthority(ctx, authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a circuit authority", authority)
+	}
+
+	for _, msgRoute := range msgRoutes {
+		if err := k.DisableMsgRoute(ctx, msgRoute); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyMsgRoute, msgRoute),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("circuit breaker tripped", "authority", authority, "msg_routes", msgRoutes)
+	return nil
+}
+
+// ResetCircuitBreaker enables again message routes on behalf of an authority.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, authority sdk.AccAddress, msgRoutes []string) error {
+	if !k.IsAuthority(ctx, authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a circuit authority", authority)
+	}
+
+	for _, msgRoute := range msgRoutes {
+		k.EnableMsgRoute(ctx, msgRoute)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyMsgRoute, msgRoute),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("circuit breaker reset", "authority", authority, "msg_routes", msgRoutes)
+	return nil
+}
+
+// DisableMsgRoute disables a message route, see types.MsgRoutes.
+func (k Keeper) DisableMsgRoute(ctx sdk.Context, msgRoute string) error {
+	if err := types.ValidateMsgRoute(msgRoute); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.DisabledMsgRouteKey(msgRoute), []byte{0x01})
+	return nil
+}
+
+// EnableMsgRoute enables again a disabled message route.
+func (k Keeper) EnableMsgRoute(ctx sdk.Context, msgRoute string) {
+	ctx.KVStore(k.storeKey).Delete(types.DisabledMsgRouteKey(msgRoute))
+}
+
+// IsMsgRouteDisabled returns true if the message route is disabled.
+func (k Keeper) IsMsgRouteDisabled(ctx sdk.Context, msgRoute string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisabledMsgRouteKey(msgRoute))
+}
+
+// IsMsgDisabled returns true if any route of the message is disabled. The
+// messages of the circuit module are never disabled, so that the circuit
+// breakers can always be reset.
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, msg sdk.Msg) bool {
+	if msg.Route() == types.RouterKey {
+		return false
+	}
+
+	for _, msgRoute := range types.MsgRoutes(msg) {
+		if k.IsMsgRouteDisabled(ctx, msgRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetDisabledMsgRoutes returns the disabled message routes, in lexicographic
+// order.
+func (k Keeper) GetDisabledMsgRoutes(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledMsgRouteKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	msgRoutes := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		msgRoutes = append(msgRoutes, string(iterator.Key()))
+	}
+
+	return msgRoutes
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+// testMsg is a message with a configurable route and type.
+type testMsg struct {
+	*sdk.TestMsg
+	route, typ string
+}
+
+func (msg testMsg) Route() string { return msg.route }
+func (msg testMsg) Type() string  { return msg.typ }
+
+func TestTripAndResetCircuitBreaker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.CircuitKeeper
+	k.SetAuthority(ctx, addr1)
+
+	require.True(t, k.IsAuthority(ctx, addr1))
+	require.False(t, k.IsAuthority(ctx, addr2))
+	require.Equal(t, []sdk.AccAddress{addr1}, k.GetAuthorities(ctx))
+
+	err := k.TripCircuitBreaker(ctx, addr2, []string{"staking"})
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	require.Empty(t, k.GetDisabledMsgRoutes(ctx))
+
+	require.NoError(t, k.TripCircuitBreaker(ctx, addr1, []string{"staking", "bank/send"}))
+	require.Equal(t, []string{"bank/send", "staking"}, k.GetDisabledMsgRoutes(ctx))
+
+	// the messages of the circuit module cannot be disabled
+	err = k.TripCircuitBreaker(ctx, addr1, []string{types.RouterKey})
+	require.True(t, types.ErrInvalidMsgRoute.Is(err))
+
+	err = k.ResetCircuitBreaker(ctx, addr2, []string{"staking"})
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	require.NoError(t, k.ResetCircuitBreaker(ctx, addr1, []string{"staking"}))
+	require.Equal(t, []string{"bank/send"}, k.GetDisabledMsgRoutes(ctx))
+}
+
+func TestIsMsgDisabled(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.CircuitKeeper
+
+	delegate := testMsg{TestMsg: sdk.NewTestMsg(addr1), route: "staking", typ: "delegate"}
+	send := testMsg{TestMsg: sdk.NewTestMsg(addr1), route: "bank", typ: "send"}
+	multiSend := testMsg{TestMsg: sdk.NewTestMsg(addr1), route: "bank", typ: "multisend"}
+	serviceMsg := sdk.ServiceMsg{MethodName: "/cosmos.bank.Msg/Send"}
+
+	require.NoError(t, k.DisableMsgRoute(ctx, "staking"))
+	require.NoError(t, k.DisableMsgRoute(ctx, "bank/send"))
+	require.NoError(t, k.DisableMsgRoute(ctx, "/cosmos.bank.Msg/Send"))
+
+	// a route disables all its messages, a route and type a single message
+	require.True(t, k.IsMsgDisabled(ctx, delegate))
+	require.True(t, k.IsMsgDisabled(ctx, send))
+	require.False(t, k.IsMsgDisabled(ctx, multiSend))
+	require.True(t, k.IsMsgDisabled(ctx, serviceMsg))
+
+	k.EnableMsgRoute(ctx, "staking")
+	require.False(t, k.IsMsgDisabled(ctx, delegate))
+}
+
+func TestCircuitMsgsCannotBeDisabled(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.CircuitKeeper
+	k.SetAuthority(ctx, addr1)
+
+	msgRoutes := []string{
+		types.RouterKey,
+		types.RouterKey + "/" + types.TypeMsgTripCircuitBreaker,
+		types.RouterKey + "/" + types.TypeMsgResetCircuitBreaker,
+	}
+	for _, msgRoute := range msgRoutes {
+		err := k.TripCircuitBreaker(ctx, addr1, []string{msgRoute})
+		require.True(t, types.ErrInvalidMsgRoute.Is(err), msgRoute)
+	}
+	require.Empty(t, k.GetDisabledMsgRoutes(ctx))
+
+	// the circuit messages are accepted even if their routes are in the store
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, msgRoute := range msgRoutes {
+		store.Set(types.DisabledMsgRouteKey(msgRoute), []byte{0x01})
+	}
+
+	require.False(t, k.IsMsgDisabled(ctx, types.NewMsgTripCircuitBreaker(addr1, []string{"staking"})))
+	require.False(t, k.IsMsgDisabled(ctx, types.NewMsgResetCircuitBreaker(addr1, []string{"staking"})))
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewQuerier returns a circuit Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryDisabledMsgRoutes:
+			return marshalJSON(k.cdc, k.GetDisabledMsgRoutes(ctx))
+
+		case types.QueryAuthorities:
+			return marshalJSON(k.cdc, k.GetAuthorities(ctx))
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
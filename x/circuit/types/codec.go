@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the necessary x/circuit interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON
+// serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgTripCircuitBreaker{}, "cosmos-sdk/MsgTripCircuitBreaker", nil)
+	cdc.RegisterConcrete(MsgResetCircuitBreaker{}, "cosmos-sdk/MsgResetCircuitBreaker", nil)
+}
+
+// ModuleCdc defines the amino codec used to encode the circuit module messages.
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
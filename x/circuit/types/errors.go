@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrMsgDisabled     = sdkerrors.Register(ModuleName, 2, "message disabled by the circuit breaker")
+	ErrInvalidMsgRoute = sdkerrors.Register(ModuleName, 3, "invalid message route")
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState defines the circuit module genesis state
+type GenesisState struct {
+	// Authorities are the accounts allowed to trip and reset the circuit
+	// breakers.
+	Authorities       []sdk.AccAddress `json:"authorities" yaml:"authorities"`
+	DisabledMsgRoutes []string         `json:"disabled_msg_routes" yaml:"disabled_msg_routes"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(authorities []sdk.AccAddress, disabledMsgRoutes []string) GenesisState {
+	return GenesisState{
+		Authorities:       authorities,
+		DisabledMsgRoutes: disabledMsgRoutes,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, without authority
+// nor disabled message route.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]sdk.AccAddress{}, []string{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	authorities := make(map[string]bool)
+	for _, authority := range gs.Authorities {
+		if authority.Empty() {
+			return fmt.Errorf("authority address cannot be empty")
+		}
+
+		if authorities[authority.String()] {
+			return fmt.Errorf("duplicate authority %s", authority)
+		}
+
+		authorities[authority.String()] = true
+	}
+
+	msgRoutes := make(map[string]bool)
+	for _, msgRoute := range gs.DisabledMsgRoutes {
+		if err := ValidateMsgRoute(msgRoute); err != nil {
+			return err
+		}
+
+		if msgRoutes[msgRoute] {
+			return fmt.Errorf("duplicate disabled msg route %s", msgRoute)
+		}
+
+		msgRoutes[msgRoute] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for circuit store
+// Items are stored with the following key: values
+//
+// - 0x01<msgRoute_Bytes>: []byte{0x01}
+//
+// - 0x02<authority_Bytes>: []byte{0x01}
+var (
+	DisabledMsgRouteKeyPrefix = []byte{0x01}
+	AuthorityKeyPrefix        = []byte{0x02}
+)
+
+// DisabledMsgRouteKey returns the store key of a disabled message route.
+func DisabledMsgRouteKey(msgRoute string) []byte {
+	return append(DisabledMsgRouteKeyPrefix, []byte(msgRoute)...)
+}
+
+// AuthorityKey returns the store key of an authority.
+func AuthorityKey(authority sdk.AccAddress) []byte {
+	return append(AuthorityKeyPrefix, authority.Bytes()...)
+}
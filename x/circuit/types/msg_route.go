@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRoutes returns the message routes matching a message, which is disabled
+// if any of them is: the route of the message, e.g. "staking" to disable all
+// the staking messages, and its route and type, e.g. "staking/delegate" to
+// disable a single message type. The route of service messages is their
+// method name, e.g. "/cosmos.bank.Msg/Send".
+func MsgRoutes(msg sdk.Msg) []string {
+	if msg.Route() == msg.Type() {
+		return []string{msg.Route()}
+	}
+
+	return []string{msg.Route(), msg.Route() + "/" + msg.Type()}
+}
+
+// ValidateMsgRoute validates a message route to disable. The messages of the
+// circuit module cannot be disabled, so that the circuit breakers can always
+// be reset.
+func ValidateMsgRoute(msgRoute string) error {
+	if strings.TrimSpace(msgRoute) != msgRoute || msgRoute == "" {
+		return sdkerrors.Wrapf(ErrInvalidMsgRoute, "%q", msgRoute)
+	}
+
+	if msgRoute == RouterKey || strings.HasPrefix(msgRoute, RouterKey+"/") {
+		return sdkerrors.Wrapf(ErrInvalidMsgRoute, "%s messages cannot be disabled", ModuleName)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// circuit message types
+const (
+	TypeMsgTripCircuitBreaker  = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+)
+
+var (
+	_ sdk.Msg = MsgTripCircuitBreaker{}
+	_ sdk.Msg = MsgResetCircuitBreaker{}
+)
+
+// MsgTripCircuitBreaker defines a message disabling message routes, signed by
+// a circuit authority.
+type MsgTripCircuitBreaker struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgRoutes []string       `json:"msg_routes" yaml:"msg_routes"`
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance
+func NewMsgTripCircuitBreaker(authority sdk.AccAddress, msgRoutes []string) MsgTripCircuitBreaker {
+	return MsgTripCircuitBreaker{Authority: authority, MsgRoutes: msgRoutes}
+}
+
+// Route Implements Msg.
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgTripCircuitBreaker) Type() string { return TypeMsgTripCircuitBreaker }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	return validateMsgRoutes(msg.Authority, msg.MsgRoutes)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgResetCircuitBreaker defines a message enabling again disabled message
+// routes, signed by a circuit authority.
+type MsgResetCircuitBreaker struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgRoutes []string       `json:"msg_routes" yaml:"msg_routes"`
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgRoutes []string) MsgResetCircuitBreaker {
+	return MsgResetCircuitBreaker{Authority: authority, MsgRoutes: msgRoutes}
+}
+
+// Route Implements Msg.
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgResetCircuitBreaker) Type() string { return TypeMsgResetCircuitBreaker }
+
+// ValidateBasic Implements Msg.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	return validateMsgRoutes(msg.Authority, msg.MsgRoutes)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+func validateMsgRoutes(authority sdk.AccAddress, msgRoutes []string) error {
+	if authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+
+	if len(msgRoutes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgRoute, "msg routes cannot be empty")
+	}
+
+	for _, msgRoute := range msgRoutes {
+		if err := ValidateMsgRoute(msgRoute); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
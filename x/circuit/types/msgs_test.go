@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"trip", NewMsgTripCircuitBreaker(addr, []string{"staking", "bank/send"}), false},
+		{"trip service msg", NewMsgTripCircuitBreaker(addr, []string{"/cosmos.bank.Msg/Send"}), false},
+		{"trip no authority", NewMsgTripCircuitBreaker(nil, []string{"staking"}), true},
+		{"trip no routes", NewMsgTripCircuitBreaker(addr, nil), true},
+		{"trip empty route", NewMsgTripCircuitBreaker(addr, []string{""}), true},
+		{"trip circuit route", NewMsgTripCircuitBreaker(addr, []string{"circuit"}), true},
+		{"trip circuit msg type", NewMsgTripCircuitBreaker(addr, []string{"circuit/reset_circuit_breaker"}), true},
+		{"reset", NewMsgResetCircuitBreaker(addr, []string{"staking"}), false},
+		{"reset padded route", NewMsgResetCircuitBreaker(addr, []string{" staking"}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package types
+
+// Querier path constants
+const (
+	QueryDisabledMsgRoutes = "disabled_msg_routes"
+	QueryAuthorities       = "authorities"
+)
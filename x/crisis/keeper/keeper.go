@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -72,7 +71,7 @@ func (k Keeper) Invariants() []sdk.Invariant {
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
-	start := time.Now()
+	start := sdk.WallClockNow()
 	invarRoutes := k.Routes()
 
 	for _, ir := range invarRoutes {
@@ -85,7 +84,7 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 		}
 	}
 
-	end := time.Now()
+	end := sdk.WallClockNow()
 	diff := end.Sub(start)
 
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
@@ -1,21 +1,19 @@
 package distribution
 
 import (
-	abci "github.com/tendermint/tendermint/abci/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
 // and distribute rewards for the previous block
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+func BeginBlocker(ctx sdk.Context, votes []sdk.VoteInfo, k keeper.Keeper) {
 	// determine the total power signing the block
 	var previousTotalPower, sumPreviousPrecommitPower int64
-	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
-		previousTotalPower += voteInfo.Validator.Power
-		if voteInfo.SignedLastBlock {
-			sumPreviousPrecommitPower += voteInfo.Validator.Power
+	for _, vote := range votes {
+		previousTotalPower += vote.Power
+		if vote.SignedLastBlock {
+			sumPreviousPrecommitPower += vote.Power
 		}
 	}
 
@@ -23,10 +21,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
 	if ctx.BlockHeight() > 1 {
 		previousProposer := k.GetPreviousProposerConsAddr(ctx)
-		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
+		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, votes)
 	}
 
 	// record the proposer for when we payout on the next block
-	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
+	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
@@ -64,6 +64,7 @@ var (
 	ErrNoValidatorDistInfo                     = types.ErrNoValidatorDistInfo
 	ErrNoValidatorExists                       = types.ErrNoValidatorExists
 	ErrNoDelegationExists                      = types.ErrNoDelegationExists
+	ErrRestakeWithdrawAddr                     = types.ErrRestakeWithdrawAddr
 	ErrNoValidatorCommission                   = types.ErrNoValidatorCommission
 	ErrSetWithdrawAddrDisabled                 = types.ErrSetWithdrawAddrDisabled
 	ErrBadDistribution                         = types.ErrBadDistribution
@@ -75,6 +76,7 @@ var (
 	ValidateGenesis                            = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
+	NewMsgWithdrawDelegatorRewardAndDelegate   = types.NewMsgWithdrawDelegatorRewardAndDelegate
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
 	MsgFundCommunityPool                       = types.NewMsgFundCommunityPool
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
@@ -104,6 +106,7 @@ var (
 	ParamStoreKeyCommunityTax            = types.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = types.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = types.ParamStoreKeyBonusProposerReward
+	ParamStoreKeyProposerTipRatio        = types.ParamStoreKeyProposerTipRatio
 	ParamStoreKeyWithdrawAddrEnabled     = types.ParamStoreKeyWithdrawAddrEnabled
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
@@ -134,6 +137,7 @@ type (
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
+	MsgWithdrawDelegatorRewardAndDelegate  = types.MsgWithdrawDelegatorRewardAndDelegate
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
@@ -23,6 +23,7 @@ var (
 	flagOnlyFromValidator = "only-from-validator"
 	flagIsValidator       = "is-validator"
 	flagCommission        = "commission"
+	flagRestake           = "restake"
 	flagMaxMessagesPerTx  = "max-msgs"
 )
 
@@ -87,12 +88,14 @@ func NewWithdrawRewardsCmd(ctx context.CLIContext) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Withdraw rewards from a given delegation address,
 and optionally withdraw validator commission if the delegation address given is a validator operator.
+With --restake, the withdrawn rewards in the staking denomination are delegated back to the same validator.
 
 Example:
 $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
 $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey --commission
+$ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey --restake
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		Args: cobra.ExactArgs(1),
@@ -105,7 +108,13 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 				return err
 			}
 
-			msgs := []sdk.Msg{types.NewMsgWithdrawDelegatorReward(delAddr, valAddr)}
+			var msgs []sdk.Msg
+			if viper.GetBool(flagRestake) {
+				msgs = append(msgs, types.NewMsgWithdrawDelegatorRewardAndDelegate(delAddr, valAddr))
+			} else {
+				msgs = append(msgs, types.NewMsgWithdrawDelegatorReward(delAddr, valAddr))
+			}
+
 			if viper.GetBool(flagCommission) {
 				msgs = append(msgs, types.NewMsgWithdrawValidatorCommission(valAddr))
 			}
@@ -120,6 +129,7 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 		},
 	}
 	cmd.Flags().Bool(flagCommission, false, "also withdraw validator's commission")
+	cmd.Flags().Bool(flagRestake, false, "delegate the withdrawn rewards back to the validator")
 	return cmd
 }
 
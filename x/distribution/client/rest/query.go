@@ -51,6 +51,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		outstandingRewardsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Slashes of a single validator over a block height range
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/slashes",
+		validatorSlashesHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get the current distribution parameter values
 	r.HandleFunc(
 		"/distribution/parameters",
@@ -296,6 +302,51 @@ func outstandingRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// HTTP request handler to query the slashes of a validator over a block height range
+func validatorSlashesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		startHeight, ok := rest.ParseUint64OrReturnBadRequest(w, r.FormValue("start_height"))
+		if !ok {
+			return
+		}
+
+		endHeight, ok := rest.ParseUint64OrReturnBadRequest(w, r.FormValue("end_height"))
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryValidatorSlashesParams(validatorAddr, startHeight, endHeight, page, limit)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSlashes)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func checkResponseQueryDelegationRewards(
 	w http.ResponseWriter, cliCtx context.CLIContext, queryRoute, delAddr, valAddr string,
 ) (res []byte, height int64, ok bool) {
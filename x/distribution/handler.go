@@ -19,6 +19,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgWithdrawDelegatorReward:
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 
+		case types.MsgWithdrawDelegatorRewardAndDelegate:
+			return handleMsgWithdrawDelegatorRewardAndDelegate(ctx, msg, k)
+
 		case types.MsgWithdrawValidatorCommission:
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
 
@@ -67,6 +70,23 @@ func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg types.MsgWithdrawDele
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgWithdrawDelegatorRewardAndDelegate(ctx sdk.Context, msg types.MsgWithdrawDelegatorRewardAndDelegate, k keeper.Keeper) (*sdk.Result, error) {
+	_, err := k.WithdrawDelegationRewardsAndDelegate(ctx, msg.DelegatorAddress, msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdrawValidatorCommission, k keeper.Keeper) (*sdk.Result, error) {
 	_, err := k.WithdrawValidatorCommission(ctx, msg.ValidatorAddress)
 	if err != nil {
@@ -3,9 +3,8 @@ package keeper
 import (
 	"fmt"
 
-	abci "github.com/tendermint/tendermint/abci/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
@@ -13,11 +12,15 @@ import (
 // AllocateTokens handles distribution of the collected fees
 func (k Keeper) AllocateTokens(
 	ctx sdk.Context, sumPreviousPrecommitPower, totalPreviousPower int64,
-	previousProposer sdk.ConsAddress, previousVotes []abci.VoteInfo,
+	previousProposer sdk.ConsAddress, previousVotes []sdk.VoteInfo,
 ) {
 
 	logger := k.Logger(ctx)
 
+	// split the tips of the previous block first, so that the share of the fee
+	// collector is distributed along with the collected fees
+	k.allocateTips(ctx, previousProposer)
+
 	// fetch and clear the collected fees for distribution, since this is
 	// called in BeginBlock, collected fees will be from the previous block
 	// (and distributed to the previous proposer)
@@ -31,6 +34,14 @@ func (k Keeper) AllocateTokens(
 		panic(err)
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeesCollected,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, feesCollectedInt.String()),
+			sdk.NewAttribute(sdk.AttributeKeyProposer, previousProposer.String()),
+		),
+	)
+
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool := k.GetFeePool(ctx)
@@ -84,11 +95,11 @@ func (k Keeper) AllocateTokens(
 	// allocate tokens proportionally to voting power
 	// TODO consider parallelizing later, ref https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
 	for _, vote := range previousVotes {
-		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
+		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.ConsAddress)
 
 		// TODO consider microslashing for missing votes.
 		// ref https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
-		powerFraction := sdk.NewDec(vote.Validator.Power).QuoTruncate(sdk.NewDec(totalPreviousPower))
+		powerFraction := sdk.NewDec(vote.Power).QuoTruncate(sdk.NewDec(totalPreviousPower))
 		reward := feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
 		k.AllocateTokensToValidator(ctx, validator, reward)
 		remaining = remaining.Sub(reward)
@@ -99,6 +110,53 @@ func (k Keeper) AllocateTokens(
 	k.SetFeePool(ctx, feePool)
 }
 
+// allocateTips splits the tips collected in the previous block between its
+// proposer, which gets the proposer tip ratio of them, and the fee collector.
+// All the tips go to the fee collector if the proposer is unknown. It is a no-op
+// if the tip collector module account is not set.
+func (k Keeper) allocateTips(ctx sdk.Context, previousProposer sdk.ConsAddress) {
+	tipCollector := k.authKeeper.GetModuleAddress(authtypes.TipCollectorName)
+	if tipCollector == nil {
+		return
+	}
+
+	tipsCollected := k.bankKeeper.GetAllBalances(ctx, tipCollector)
+	if tipsCollected.IsZero() {
+		return
+	}
+
+	attributes := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyProposer, previousProposer.String())}
+
+	proposerTip := sdk.NewCoins()
+	if proposerValidator := k.stakingKeeper.ValidatorByConsAddr(ctx, previousProposer); proposerValidator != nil {
+		proposerTip, _ = sdk.NewDecCoinsFromCoins(tipsCollected...).MulDecTruncate(k.GetProposerTipRatio(ctx)).TruncateDecimal()
+		if !proposerTip.IsZero() {
+			err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.TipCollectorName, types.ModuleName, proposerTip)
+			if err != nil {
+				panic(err)
+			}
+
+			k.AllocateTokensToValidator(ctx, proposerValidator, sdk.NewDecCoinsFromCoins(proposerTip...))
+		}
+
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyValidator, proposerValidator.GetOperator().String()))
+	}
+
+	feeCollectorTip := tipsCollected.Sub(proposerTip)
+	if !feeCollectorTip.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.TipCollectorName, k.feeCollectorName, feeCollectorTip)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	attributes = append(attributes,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, proposerTip.String()),
+		sdk.NewAttribute(types.AttributeKeyFeeCollectorAmount, feeCollectorTip.String()),
+	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeProposerTip, attributes...))
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commission
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val exported.ValidatorI, tokens sdk.DecCoins) {
 	// split tokens between validator and delegators according to commission
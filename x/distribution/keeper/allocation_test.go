@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -104,18 +105,27 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	require.NoError(t, err)
 	app.AccountKeeper.SetAccount(ctx, feeCollector)
 
-	votes := []abci.VoteInfo{
+	votes := []sdk.VoteInfo{
 		{
-			Validator:       abciValA,
+			ConsAddress:     sdk.ConsAddress(abciValA.Address),
+			Power:           abciValA.Power,
 			SignedLastBlock: true,
 		},
 		{
-			Validator:       abciValB,
+			ConsAddress:     sdk.ConsAddress(abciValB.Address),
+			Power:           abciValB.Power,
 			SignedLastBlock: true,
 		},
 	}
 	app.DistrKeeper.AllocateTokens(ctx, 200, 200, valConsAddr2, votes)
 
+	// the collected fees and the proposer of the block are emitted
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		distrtypes.EventTypeFeesCollected,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		sdk.NewAttribute(sdk.AttributeKeyProposer, valConsAddr2.String()),
+	))
+
 	// 98 outstanding rewards (100 less 2 to community pool)
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(465, 1)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(515, 1)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards)
@@ -197,17 +207,20 @@ func TestAllocateTokensTruncation(t *testing.T) {
 
 	app.AccountKeeper.SetAccount(ctx, feeCollector)
 
-	votes := []abci.VoteInfo{
+	votes := []sdk.VoteInfo{
 		{
-			Validator:       abciValA,
+			ConsAddress:     sdk.ConsAddress(abciValA.Address),
+			Power:           abciValA.Power,
 			SignedLastBlock: true,
 		},
 		{
-			Validator:       abciValB,
+			ConsAddress:     sdk.ConsAddress(abciValB.Address),
+			Power:           abciValB.Power,
 			SignedLastBlock: true,
 		},
 		{
-			Validator:       abciValС,
+			ConsAddress:     sdk.ConsAddress(abciValС.Address),
+			Power:           abciValС.Power,
 			SignedLastBlock: true,
 		},
 	}
@@ -217,3 +230,55 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[2]).Rewards.IsValid())
 }
+
+func TestAllocateTips(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	// create validator with 0% commission
+	commission := staking.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.ProposerTipRatio = sdk.NewDecWithPrec(4, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	tips := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101)))
+	tipCollector := app.AccountKeeper.GetModuleAccount(ctx, types.TipCollectorName)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, tipCollector.GetAddress(), tips))
+
+	votes := []sdk.VoteInfo{{ConsAddress: valConsAddr1, Power: 100, SignedLastBlock: true}}
+	app.DistrKeeper.AllocateTokens(ctx, 100, 100, valConsAddr1, votes)
+
+	// the proposer gets 40% of the tips, truncated, and the rest is distributed
+	// along with the collected fees
+	proposerTip := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40)))
+	feeCollectorTip := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(61)))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		distrtypes.EventTypeProposerTip,
+		sdk.NewAttribute(sdk.AttributeKeyProposer, valConsAddr1.String()),
+		sdk.NewAttribute(distrtypes.AttributeKeyValidator, valAddrs[0].String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, proposerTip.String()),
+		sdk.NewAttribute(distrtypes.AttributeKeyFeeCollectorAmount, feeCollectorTip.String()),
+	))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		distrtypes.EventTypeFeesCollected,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, feeCollectorTip.String()),
+		sdk.NewAttribute(sdk.AttributeKeyProposer, valConsAddr1.String()),
+	))
+
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, tipCollector.GetAddress()).IsZero())
+
+	// the only validator gets all the tips but the community tax: 40 + 61 * 98%
+	expected := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(9978, 2)}}
+	require.Equal(t, expected, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+}
@@ -112,6 +112,45 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawDelegationRewardsAndDelegate withdraws the rewards of a delegation and
+// delegates the bond denomination portion of them back to the same validator.
+// Rewards are only restaked when they are paid out to the delegator itself.
+func (k Keeper) WithdrawDelegationRewardsAndDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil, types.ErrRestakeWithdrawAddr
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return rewards, nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorExists
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRestakeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
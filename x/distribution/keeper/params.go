@@ -41,3 +41,10 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetProposerTipRatio returns the current ratio of the tips paid to the block
+// proposer, the rest going to the fee collector.
+func (k Keeper) GetProposerTipRatio(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyProposerTipRatio, &percent)
+	return percent
+}
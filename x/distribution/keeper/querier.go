@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -114,6 +115,13 @@ func queryValidatorSlashes(ctx sdk.Context, _ []string, req abci.RequestQuery, k
 		},
 	)
 
+	start, end := client.Paginate(len(events), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		events = []types.ValidatorSlashEvent{}
+	} else {
+		events = events[start:end]
+	}
+
 	bz, err := codec.MarshalJSONIndent(k.cdc, events)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -27,6 +27,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.InterfaceModule     = AppModuleBasic{}
+
+	_ module.BeginBlockVotesHandler = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the distribution module.
@@ -39,6 +41,11 @@ func (AppModuleBasic) Name() string {
 	return ModuleName
 }
 
+// StoreKeys returns the keys of the distribution module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the distribution module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
@@ -152,11 +159,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the distribution module.
-func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, req, am.keeper)
+// HandleVotes distributes the rewards for the previous block according to the
+// votes of the validators for it, and records the proposer of the block.
+func (am AppModule) HandleVotes(ctx sdk.Context, votes []sdk.VoteInfo) {
+	BeginBlocker(ctx, votes, am.keeper)
 }
 
+// BeginBlock returns the begin blocker for the distribution module. The
+// rewards are distributed by HandleVotes.
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
 // EndBlock returns the end blocker for the distribution module. It returns no validator
 // updates.
 func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
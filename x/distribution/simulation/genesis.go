@@ -19,6 +19,7 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	ProposerTipRatio    = "proposer_tip_ratio"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -36,6 +37,11 @@ func GenBonusProposerReward(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 }
 
+// GenProposerTipRatio randomized ProposerTipRatio
+func GenProposerTipRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
 // GenWithdrawEnabled returns a randomized WithdrawEnabled parameter.
 func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
@@ -67,6 +73,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var proposerTipRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProposerTipRatio, &proposerTipRatio, simState.Rand,
+		func(r *rand.Rand) { proposerTipRatio = GenProposerTipRatio(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -74,6 +86,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			ProposerTipRatio:    proposerTipRatio,
 		},
 	}
 
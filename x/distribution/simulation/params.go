@@ -16,6 +16,7 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+	keyProposerTipRatio    = "proposertipratio"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +38,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyProposerTipRatio,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenProposerTipRatio(r))
+			},
+		),
 	}
 }
@@ -6,11 +6,15 @@ const (
 	EventTypeRewards            = "rewards"
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawRewards    = "withdraw_rewards"
+	EventTypeRestakeRewards     = "restake_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeFeesCollected      = "fees_collected"
+	EventTypeProposerTip        = "proposer_tip"
 
-	AttributeKeyWithdrawAddress = "withdraw_address"
-	AttributeKeyValidator       = "validator"
+	AttributeKeyWithdrawAddress    = "withdraw_address"
+	AttributeKeyValidator          = "validator"
+	AttributeKeyFeeCollectorAmount = "fee_collector_amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -8,14 +8,16 @@ import (
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetWithdrawAddress                 = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward            = "withdraw_delegator_reward"
+	TypeMsgWithdrawDelegatorRewardAndDelegate = "withdraw_delegator_reward_and_delegate"
+	TypeMsgWithdrawValidatorCommission        = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool                  = "fund_community_pool"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{},
+	&MsgWithdrawDelegatorRewardAndDelegate{}, &MsgWithdrawValidatorCommission{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) MsgSetWithdrawAddress {
 	return MsgSetWithdrawAddress{
@@ -82,6 +84,40 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWithdrawDelegatorRewardAndDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress) MsgWithdrawDelegatorRewardAndDelegate {
+	return MsgWithdrawDelegatorRewardAndDelegate{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
+
+func (msg MsgWithdrawDelegatorRewardAndDelegate) Route() string { return ModuleName }
+func (msg MsgWithdrawDelegatorRewardAndDelegate) Type() string {
+	return TypeMsgWithdrawDelegatorRewardAndDelegate
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawDelegatorRewardAndDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawDelegatorRewardAndDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawDelegatorRewardAndDelegate) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) MsgWithdrawValidatorCommission {
 	return MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr,
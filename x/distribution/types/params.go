@@ -20,6 +20,7 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyProposerTipRatio    = []byte("proposertipratio")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -34,6 +35,7 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		ProposerTipRatio:    sdk.NewDecWithPrec(5, 1), // 50%
 	}
 }
 
@@ -49,6 +51,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyProposerTipRatio, &p.ProposerTipRatio, validateProposerTipRatio),
 	}
 }
 
@@ -74,6 +77,9 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if err := validateProposerTipRatio(p.ProposerTipRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -135,6 +141,25 @@ func validateBonusProposerReward(i interface{}) error {
 	return nil
 }
 
+func validateProposerTipRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("proposer tip ratio must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("proposer tip ratio must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposer tip ratio too large: %s", v)
+	}
+
+	return nil
+}
+
 func validateWithdrawAddrEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -29,6 +29,7 @@ func Test_validateAuxFuncs(t *testing.T) {
 			require.Equal(t, tt.wantErr, validateCommunityTax(tt.args.i) != nil)
 			require.Equal(t, tt.wantErr, validateBaseProposerReward(tt.args.i) != nil)
 			require.Equal(t, tt.wantErr, validateBonusProposerReward(tt.args.i) != nil)
+			require.Equal(t, tt.wantErr, validateProposerTipRatio(tt.args.i) != nil)
 		})
 	}
 }
@@ -44,14 +44,20 @@ type QueryValidatorSlashesParams struct {
 	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
 	StartingHeight   uint64         `json:"starting_height" yaml:"starting_height"`
 	EndingHeight     uint64         `json:"ending_height" yaml:"ending_height"`
+	Page             int            `json:"page" yaml:"page"`
+	Limit            int            `json:"limit" yaml:"limit"`
 }
 
 // creates a new instance of QueryValidatorSlashesParams
-func NewQueryValidatorSlashesParams(validatorAddr sdk.ValAddress, startingHeight uint64, endingHeight uint64) QueryValidatorSlashesParams {
+func NewQueryValidatorSlashesParams(
+	validatorAddr sdk.ValAddress, startingHeight, endingHeight uint64, page, limit int,
+) QueryValidatorSlashesParams {
 	return QueryValidatorSlashesParams{
 		ValidatorAddress: validatorAddr,
 		StartingHeight:   startingHeight,
 		EndingHeight:     endingHeight,
+		Page:             page,
+		Limit:            limit,
 	}
 }
 
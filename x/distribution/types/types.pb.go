@@ -237,6 +237,7 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	ProposerTipRatio    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=proposer_tip_ratio,json=proposerTipRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposer_tip_ratio" yaml:"proposer_tip_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -727,6 +728,60 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 	return 0
 }
 
+// msg struct for withdrawing delegation rewards from a single validator and
+// delegating them back to the same validator
+type MsgWithdrawDelegatorRewardAndDelegate struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *MsgWithdrawDelegatorRewardAndDelegate) Reset()         { *m = MsgWithdrawDelegatorRewardAndDelegate{} }
+func (m *MsgWithdrawDelegatorRewardAndDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDelegatorRewardAndDelegate) ProtoMessage()    {}
+func (*MsgWithdrawDelegatorRewardAndDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fddf2a8e4a90b09, []int{14}
+}
+func (m *MsgWithdrawDelegatorRewardAndDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDelegatorRewardAndDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDelegatorRewardAndDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDelegatorRewardAndDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDelegatorRewardAndDelegate.Merge(m, src)
+}
+func (m *MsgWithdrawDelegatorRewardAndDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDelegatorRewardAndDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDelegatorRewardAndDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDelegatorRewardAndDelegate proto.InternalMessageInfo
+
+func (m *MsgWithdrawDelegatorRewardAndDelegate) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgWithdrawDelegatorRewardAndDelegate) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos_sdk.x.distribution.v1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawDelegatorReward")
@@ -742,82 +797,86 @@ func init() {
 	proto.RegisterType((*FeePool)(nil), "cosmos_sdk.x.distribution.v1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos_sdk.x.distribution.v1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos_sdk.x.distribution.v1.DelegatorStartingInfo")
+	proto.RegisterType((*MsgWithdrawDelegatorRewardAndDelegate)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawDelegatorRewardAndDelegate")
 }
 
 func init() { proto.RegisterFile("x/distribution/types/types.proto", fileDescriptor_9fddf2a8e4a90b09) }
 
 var fileDescriptor_9fddf2a8e4a90b09 = []byte{
-	// 1116 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x6e, 0x9a, 0x4c, 0xd3, 0xa4, 0xd9, 0xd8, 0x49, 0xe4, 0x14, 0xaf, 0x35, 0x12,
-	0x55, 0x24, 0x14, 0x87, 0xd0, 0x5b, 0x0e, 0x48, 0x71, 0x7e, 0x08, 0x50, 0x43, 0xa2, 0x4d, 0x28,
-	0x12, 0x12, 0x5a, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xeb, 0x9d, 0xd5, 0xcc, 0xd8, 0x4e, 0x7a, 0x41,
-	0xe2, 0x04, 0x02, 0x2a, 0x0e, 0x08, 0x7a, 0xe0, 0xd0, 0x0b, 0x12, 0x54, 0xe2, 0xdf, 0x40, 0x3d,
-	0xf6, 0x06, 0xe2, 0xe0, 0xa2, 0xe4, 0xc6, 0x31, 0x37, 0x38, 0xa1, 0xdd, 0x19, 0xef, 0x6e, 0x1c,
-	0xab, 0x8d, 0x23, 0x95, 0x5e, 0x12, 0xef, 0x9b, 0x99, 0xef, 0xfb, 0xe6, 0x9b, 0x79, 0xef, 0xed,
-	0xc2, 0xf2, 0xd1, 0xb2, 0x4b, 0x85, 0xe4, 0xb4, 0xd6, 0x92, 0x94, 0xf9, 0xcb, 0xf2, 0x38, 0x20,
-	0x42, 0xfd, 0xad, 0x04, 0x9c, 0x49, 0x66, 0xdc, 0x76, 0x98, 0x68, 0x32, 0x61, 0x0b, 0xf7, 0xb0,
-	0x72, 0x54, 0x49, 0x4f, 0xae, 0xb4, 0x57, 0x8a, 0x77, 0x64, 0x83, 0x72, 0xd7, 0x0e, 0x30, 0x97,
-	0xc7, 0xcb, 0xd1, 0x82, 0xe5, 0x3a, 0xab, 0xb3, 0xe4, 0x97, 0x42, 0x29, 0x4e, 0x5f, 0x00, 0x46,
-	0x5f, 0x67, 0x61, 0x61, 0x5b, 0xd4, 0xf7, 0x88, 0xfc, 0x98, 0xca, 0x86, 0xcb, 0x71, 0x67, 0xcd,
-	0x75, 0x39, 0x11, 0xc2, 0x78, 0x00, 0xa7, 0x5d, 0xe2, 0x91, 0x3a, 0x96, 0x8c, 0xdb, 0x58, 0x05,
-	0xe7, 0x41, 0x19, 0x2c, 0x4e, 0x54, 0xb7, 0xcf, 0xba, 0xe6, 0xfc, 0x31, 0x6e, 0x7a, 0xab, 0xe8,
-	0xc2, 0x14, 0xf4, 0x6f, 0xd7, 0x5c, 0xaa, 0x53, 0xd9, 0x68, 0xd5, 0x2a, 0x0e, 0x6b, 0x2e, 0x2b,
-	0xe1, 0xfa, 0xdf, 0x92, 0x70, 0x0f, 0x35, 0xfd, 0x9a, 0xe3, 0x68, 0x26, 0xeb, 0x56, 0x0c, 0xd2,
-	0xe3, 0xee, 0xc0, 0x5b, 0x1d, 0x2d, 0x27, 0xa6, 0xce, 0x46, 0xd4, 0xf7, 0xce, 0xba, 0xe6, 0x9c,
-	0xa2, 0xee, 0x9f, 0x71, 0x05, 0xe6, 0xa9, 0xce, 0xf9, 0x4d, 0xa3, 0xef, 0xb2, 0xb0, 0xb8, 0x2d,
-	0xea, 0x3d, 0x2f, 0x36, 0x7a, 0xc2, 0x2c, 0xd2, 0xc1, 0xdc, 0x7d, 0xad, 0x9e, 0x3c, 0x80, 0xd3,
-	0x6d, 0xec, 0x51, 0xf7, 0x1c, 0x77, 0xb6, 0x9f, 0xfb, 0xc2, 0x94, 0xcb, 0x72, 0xdf, 0xc7, 0x5e,
-	0xcc, 0x1d, 0x83, 0xf4, 0x6c, 0xf9, 0x11, 0xc0, 0x52, 0xca, 0x96, 0xfb, 0xbd, 0xf1, 0x75, 0xd6,
-	0x6c, 0x52, 0x21, 0x28, 0xf3, 0x07, 0xcb, 0x03, 0xff, 0x8f, 0xbc, 0xdf, 0x00, 0xcc, 0x6f, 0x8b,
-	0xfa, 0x56, 0xcb, 0x77, 0x43, 0x45, 0x2d, 0x9f, 0xca, 0xe3, 0x5d, 0xc6, 0x3c, 0xe3, 0x53, 0x38,
-	0x8a, 0x9b, 0xac, 0xe5, 0xcb, 0x79, 0x50, 0x1e, 0x59, 0xbc, 0xf1, 0xce, 0x4c, 0x25, 0x95, 0x47,
-	0xed, 0x95, 0xca, 0x3a, 0xa3, 0x7e, 0xf5, 0xed, 0xa7, 0x5d, 0x33, 0xf3, 0xe4, 0xb9, 0xb9, 0x78,
-	0x09, 0x19, 0xe1, 0x02, 0x61, 0x69, 0x50, 0x63, 0x07, 0x8e, 0xbb, 0x24, 0x60, 0x82, 0x4a, 0xc6,
-	0xf5, 0x51, 0xac, 0x0c, 0x7f, 0xd4, 0x09, 0x06, 0xfa, 0x7d, 0x04, 0x8e, 0xee, 0x62, 0x8e, 0x9b,
-	0xc2, 0x38, 0x84, 0x37, 0x9d, 0xde, 0x5e, 0x6c, 0x89, 0x8f, 0x22, 0x2f, 0xc7, 0xab, 0x5b, 0xa1,
-	0xd8, 0x3f, 0xbb, 0xe6, 0x9d, 0x4b, 0x70, 0x6c, 0x10, 0xe7, 0xac, 0x6b, 0xe6, 0x95, 0xf3, 0xe7,
-	0xc0, 0x90, 0x35, 0x11, 0x3f, 0xef, 0xe3, 0x23, 0xe3, 0x33, 0x98, 0xaf, 0x61, 0x41, 0xec, 0x80,
-	0xb3, 0x80, 0x09, 0xc2, 0x6d, 0x1e, 0xdd, 0xf7, 0x68, 0x4f, 0xe3, 0xd5, 0xed, 0xa1, 0x39, 0x17,
-	0x14, 0xe7, 0x20, 0x4c, 0x64, 0x19, 0x61, 0x78, 0x57, 0x47, 0x75, 0x62, 0x7d, 0x0e, 0x60, 0xa1,
-	0xc6, 0xfc, 0x96, 0xb8, 0x20, 0x61, 0x24, 0x92, 0xf0, 0xe1, 0xd0, 0x12, 0x6e, 0x6b, 0x09, 0x83,
-	0x40, 0x91, 0x35, 0x13, 0xc5, 0xfb, 0x44, 0xec, 0xc3, 0xc2, 0xb9, 0x9a, 0x62, 0x13, 0x1f, 0xd7,
-	0x3c, 0xe2, 0xce, 0xe7, 0xca, 0x60, 0x71, 0xac, 0x5a, 0x4e, 0x50, 0x07, 0x4e, 0x43, 0xd6, 0x4c,
-	0xba, 0x9c, 0x6c, 0xaa, 0xe8, 0x6a, 0xee, 0xd1, 0x63, 0x33, 0x83, 0xbe, 0xcc, 0xc2, 0x62, 0x9c,
-	0x36, 0xef, 0x51, 0x21, 0x19, 0xa7, 0x0e, 0xf6, 0x14, 0xb3, 0x30, 0x7e, 0x02, 0x70, 0xce, 0x69,
-	0x35, 0x5b, 0x1e, 0x96, 0xb4, 0x4d, 0xb4, 0x4c, 0x9b, 0x63, 0x49, 0x99, 0xbe, 0xba, 0xb3, 0x7d,
-	0x57, 0x77, 0x83, 0x38, 0xd1, 0xed, 0xfd, 0x28, 0x74, 0xe6, 0xac, 0x6b, 0x96, 0xf4, 0x31, 0x0f,
-	0x06, 0x41, 0x4f, 0x9e, 0x9b, 0x6f, 0x5d, 0xce, 0x3b, 0x75, 0xc5, 0x0b, 0x09, 0x90, 0xd2, 0x68,
-	0x85, 0x30, 0xc6, 0x3a, 0x9c, 0xe2, 0xe4, 0x80, 0x70, 0xe2, 0x3b, 0xc4, 0x76, 0xa2, 0xcc, 0x0a,
-	0xef, 0xc8, 0xcd, 0x6a, 0xf1, 0xac, 0x6b, 0xce, 0x2a, 0x09, 0x7d, 0x13, 0x90, 0x35, 0x19, 0x47,
-	0xd6, 0xa3, 0xc0, 0x23, 0x00, 0xe7, 0x92, 0x12, 0xd2, 0xe2, 0x9c, 0xf8, 0xb2, 0x67, 0x04, 0x81,
-	0xd7, 0x95, 0x6e, 0xf1, 0x92, 0x7d, 0xdf, 0xd5, 0x59, 0x3b, 0xd4, 0xae, 0x7a, 0xd8, 0xc6, 0x2c,
-	0x1c, 0x0d, 0x08, 0xa7, 0x4c, 0x5d, 0xf1, 0x9c, 0xa5, 0x9f, 0xd0, 0x37, 0x00, 0x96, 0x62, 0x69,
-	0x6b, 0x8e, 0x36, 0x81, 0xb8, 0xa9, 0x42, 0x77, 0x08, 0xa1, 0x13, 0x3f, 0xbd, 0x0a, 0x91, 0x29,
-	0x78, 0xf4, 0x3d, 0x80, 0x0b, 0xb1, 0x9e, 0x9d, 0x96, 0x14, 0x12, 0xfb, 0x2e, 0xf5, 0xeb, 0x3d,
-	0xbb, 0x3a, 0x97, 0xb5, 0x6b, 0x53, 0x5f, 0x93, 0xc9, 0xde, 0x19, 0x45, 0x8b, 0xd0, 0x55, 0x0d,
-	0x44, 0xbf, 0x00, 0x38, 0x13, 0x0b, 0xdb, 0xf3, 0xb0, 0x68, 0x6c, 0xb6, 0x89, 0x2f, 0x8d, 0x2d,
-	0x98, 0x94, 0x67, 0x5b, 0x5b, 0x1c, 0x56, 0xae, 0x5c, 0x75, 0x21, 0xe9, 0xdc, 0xfd, 0x33, 0x90,
-	0x35, 0x15, 0x87, 0x76, 0xa3, 0x88, 0xf1, 0x01, 0x1c, 0x3b, 0xe0, 0xd8, 0x09, 0xdf, 0x70, 0x74,
-	0x15, 0xaa, 0x0c, 0x57, 0x02, 0xac, 0x78, 0x3d, 0xfa, 0x15, 0xc0, 0xfc, 0x00, 0xad, 0xc2, 0x78,
-	0x08, 0xe0, 0x6c, 0xa2, 0x45, 0x84, 0x23, 0x36, 0x89, 0x86, 0xb4, 0x9b, 0x2b, 0x95, 0x17, 0xbd,
-	0x77, 0x55, 0x06, 0x80, 0x56, 0xdf, 0xd4, 0x46, 0xbf, 0xd1, 0xbf, 0xd5, 0x34, 0x3c, 0xb2, 0xf2,
-	0xed, 0x01, 0x82, 0x74, 0xad, 0xf8, 0x01, 0xc0, 0xeb, 0x5b, 0x84, 0x44, 0x1d, 0xec, 0x2b, 0x00,
-	0x27, 0x93, 0xd2, 0x1d, 0x30, 0xe6, 0xbd, 0xe4, 0xa0, 0xef, 0x69, 0xfe, 0x42, 0x7f, 0xd9, 0x0f,
-	0xd7, 0x0e, 0x7d, 0xde, 0x49, 0x0f, 0x0a, 0xd5, 0xa0, 0x87, 0x59, 0x58, 0x3c, 0xd7, 0x61, 0xf7,
-	0x02, 0xe2, 0xbb, 0xaa, 0x8c, 0x62, 0xcf, 0xc8, 0xc3, 0x6b, 0x92, 0x4a, 0x8f, 0xa8, 0x5e, 0x65,
-	0xa9, 0x07, 0xa3, 0x0c, 0x6f, 0xb8, 0x44, 0x38, 0x9c, 0x06, 0xc9, 0x69, 0x5a, 0xe9, 0x50, 0xd8,
-	0x47, 0x39, 0x71, 0x68, 0x40, 0x89, 0x2f, 0xa3, 0x82, 0x7f, 0xb5, 0x3e, 0x1a, 0x63, 0xa4, 0xfa,
-	0x7e, 0xee, 0x15, 0xf4, 0xfd, 0xd5, 0xb1, 0x2f, 0x1e, 0x9b, 0x99, 0xe8, 0xa8, 0xfe, 0x01, 0xb0,
-	0x10, 0xbf, 0x24, 0xee, 0x49, 0xcc, 0x25, 0xf5, 0xeb, 0xef, 0xfb, 0x07, 0x51, 0xa5, 0x0c, 0x38,
-	0x69, 0x53, 0x16, 0xb6, 0x9f, 0x74, 0x1e, 0xa4, 0x2a, 0x65, 0xdf, 0x04, 0x64, 0x4d, 0xf6, 0x22,
-	0x3a, 0x0b, 0xf6, 0xe1, 0x35, 0x21, 0xf1, 0x21, 0xd1, 0x29, 0xf0, 0xee, 0xd0, 0x5d, 0x70, 0x42,
-	0x11, 0x45, 0x20, 0xc8, 0x52, 0x60, 0xc6, 0x26, 0x1c, 0x6d, 0x10, 0x5a, 0x6f, 0x28, 0xaf, 0x73,
-	0xd5, 0xa5, 0xbf, 0xbb, 0xe6, 0x94, 0xc3, 0x49, 0x58, 0xe1, 0x7d, 0x5b, 0x0d, 0x25, 0x22, 0xfb,
-	0x06, 0x90, 0xa5, 0x17, 0x57, 0x77, 0x7e, 0x3e, 0x29, 0x81, 0xa7, 0x27, 0x25, 0xf0, 0xec, 0xa4,
-	0x04, 0xfe, 0x3a, 0x29, 0x81, 0x6f, 0x4f, 0x4b, 0x99, 0x67, 0xa7, 0xa5, 0xcc, 0x1f, 0xa7, 0xa5,
-	0xcc, 0x27, 0x2b, 0x2f, 0xd4, 0x38, 0xe8, 0x83, 0xa7, 0x36, 0x1a, 0x7d, 0x92, 0xdc, 0xfd, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x34, 0x5a, 0x18, 0x60, 0x0f, 0x0d, 0x00, 0x00,
+	// 1161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x57, 0xcd, 0x4f, 0x24, 0x45,
+	0x14, 0xa7, 0xd9, 0x81, 0x85, 0x5a, 0x16, 0xd8, 0xe6, 0x63, 0x11, 0x56, 0x86, 0x54, 0xb2, 0x1b,
+	0x12, 0xc3, 0x20, 0xee, 0x8d, 0x83, 0x09, 0xc3, 0x42, 0xfc, 0x58, 0x84, 0x34, 0xb8, 0x26, 0x9b,
+	0x6c, 0x3a, 0x35, 0xdd, 0xc5, 0x50, 0xa1, 0xa7, 0xbb, 0x53, 0xd5, 0x33, 0x03, 0x5e, 0x4c, 0x3c,
+	0x69, 0xd4, 0x8d, 0x07, 0xa3, 0x7b, 0x30, 0xd1, 0x8b, 0x89, 0x9a, 0xf8, 0x6f, 0x98, 0x3d, 0x6e,
+	0x3c, 0x19, 0x0f, 0x68, 0xf4, 0xb6, 0x47, 0x6f, 0x7a, 0xf2, 0x75, 0x55, 0xf5, 0x07, 0x33, 0x23,
+	0x4b, 0x93, 0xac, 0x1e, 0x7a, 0x66, 0xfa, 0x55, 0xd5, 0xef, 0xfd, 0xde, 0x7b, 0xf5, 0x3e, 0x06,
+	0x2d, 0x1c, 0x2d, 0xbb, 0x4c, 0x44, 0x9c, 0xd5, 0x9a, 0x11, 0x0b, 0xfc, 0xe5, 0xe8, 0x38, 0xa4,
+	0x42, 0x7d, 0x56, 0x42, 0x1e, 0x44, 0x81, 0x79, 0xc3, 0x09, 0x44, 0x23, 0x10, 0xb6, 0x70, 0x0f,
+	0x2b, 0x47, 0x95, 0xfc, 0xe6, 0x4a, 0x6b, 0x65, 0xf6, 0x56, 0x74, 0xc0, 0xb8, 0x6b, 0x87, 0x84,
+	0x47, 0xc7, 0xcb, 0xf2, 0xc0, 0x72, 0x3d, 0xa8, 0x07, 0xd9, 0x2f, 0x85, 0x32, 0x7b, 0xad, 0x0b,
+	0x18, 0x7f, 0xdc, 0x8f, 0xa6, 0xb6, 0x44, 0x7d, 0x97, 0x46, 0xef, 0xb0, 0xe8, 0xc0, 0xe5, 0xa4,
+	0xbd, 0xe6, 0xba, 0x9c, 0x0a, 0x61, 0xbe, 0x8b, 0xae, 0xb9, 0xd4, 0xa3, 0x75, 0x12, 0x05, 0xdc,
+	0x26, 0x4a, 0x38, 0x63, 0x2c, 0x18, 0x8b, 0x23, 0xd5, 0xad, 0x3f, 0x4f, 0xca, 0x33, 0xc7, 0xa4,
+	0xe1, 0xad, 0xe2, 0xae, 0x2d, 0xf8, 0xef, 0x93, 0xf2, 0x52, 0x1d, 0xb0, 0x9a, 0xb5, 0x8a, 0x13,
+	0x34, 0x96, 0x15, 0x71, 0xfd, 0xb5, 0x04, 0xfc, 0xb5, 0xfa, 0x35, 0xc7, 0xd1, 0x9a, 0xac, 0xf1,
+	0x14, 0x24, 0xd1, 0xdd, 0x46, 0xe3, 0x6d, 0x4d, 0x27, 0x55, 0xdd, 0x2f, 0x55, 0xdf, 0x05, 0xd5,
+	0xd7, 0x95, 0xea, 0xce, 0x1d, 0x17, 0xd0, 0x3c, 0xd6, 0x3e, 0x6d, 0x34, 0xfe, 0xac, 0x1f, 0xcd,
+	0x82, 0x3b, 0x12, 0x5f, 0xdc, 0x49, 0x88, 0x59, 0xb4, 0x4d, 0xb8, 0xfb, 0xbf, 0xfa, 0x04, 0x74,
+	0xb7, 0x88, 0xc7, 0xdc, 0x53, 0xba, 0xfb, 0x3b, 0x75, 0x77, 0x6d, 0x39, 0xaf, 0xee, 0x7b, 0xc4,
+	0x4b, 0x75, 0xa7, 0x20, 0x89, 0x5b, 0xbe, 0x34, 0xd0, 0x7c, 0xce, 0x2d, 0xf7, 0x92, 0xf5, 0xf5,
+	0xa0, 0xd1, 0x60, 0x42, 0xc0, 0x35, 0xec, 0x4d, 0xcf, 0xf8, 0x6f, 0xe8, 0xfd, 0x68, 0xa0, 0x49,
+	0xa0, 0xb7, 0xd9, 0xf4, 0xdd, 0x98, 0x51, 0xd3, 0x67, 0xd1, 0xf1, 0x4e, 0x10, 0x78, 0xe6, 0x03,
+	0x34, 0x48, 0x1a, 0x41, 0xd3, 0x8f, 0x80, 0xc9, 0xa5, 0xc5, 0x2b, 0xaf, 0x4c, 0x54, 0x72, 0x79,
+	0xd4, 0x5a, 0xa9, 0xac, 0x07, 0xcc, 0xaf, 0xbe, 0xfc, 0xf8, 0xa4, 0xdc, 0xf7, 0xfd, 0xaf, 0xe5,
+	0xc5, 0x73, 0xd0, 0x88, 0x0f, 0x08, 0x4b, 0x83, 0x9a, 0xdb, 0x68, 0xd8, 0xa5, 0x61, 0x20, 0x18,
+	0x70, 0xd1, 0xa1, 0x58, 0x29, 0x1e, 0xea, 0x0c, 0x03, 0xff, 0x54, 0x42, 0x83, 0x3b, 0x84, 0x93,
+	0x86, 0x30, 0x0f, 0xd1, 0x55, 0x27, 0xb1, 0xc5, 0x8e, 0xc8, 0x91, 0xf4, 0xe5, 0x70, 0x75, 0x33,
+	0x26, 0xfb, 0xcb, 0x49, 0xf9, 0xd6, 0x39, 0x74, 0xdc, 0xa1, 0x0e, 0x78, 0x7e, 0x52, 0x79, 0xfe,
+	0x14, 0x18, 0xb6, 0x46, 0xd2, 0xf7, 0x3d, 0x72, 0x64, 0xbe, 0x87, 0x26, 0x6b, 0x44, 0x50, 0x1b,
+	0x6a, 0x02, 0x50, 0xa1, 0xdc, 0xe6, 0xf2, 0xbe, 0x4b, 0x9b, 0x86, 0xab, 0x5b, 0x85, 0x75, 0xce,
+	0x29, 0x9d, 0xbd, 0x30, 0xb1, 0x65, 0xc6, 0xe2, 0x1d, 0x2d, 0xd5, 0x89, 0xf5, 0xbe, 0x81, 0xa6,
+	0x6a, 0x81, 0xdf, 0x14, 0x5d, 0x14, 0x2e, 0x49, 0x0a, 0x6f, 0x15, 0xa6, 0x70, 0x43, 0x53, 0xe8,
+	0x05, 0x8a, 0xad, 0x09, 0x29, 0xef, 0x20, 0xb1, 0x87, 0xa6, 0x4e, 0xd5, 0x14, 0x9b, 0xfa, 0xa4,
+	0xe6, 0x51, 0x77, 0xa6, 0x04, 0x1c, 0x86, 0xaa, 0x0b, 0x19, 0x6a, 0xcf, 0x6d, 0x80, 0x9a, 0x2f,
+	0x27, 0x1b, 0x4a, 0x6a, 0x1e, 0x23, 0x33, 0x55, 0x1f, 0xb1, 0xd0, 0xe6, 0x04, 0xca, 0xf6, 0xcc,
+	0x80, 0x34, 0xeb, 0xcd, 0xc2, 0x66, 0xbd, 0xa0, 0x08, 0x74, 0x23, 0x62, 0x6b, 0x3c, 0x11, 0xee,
+	0xb1, 0xd0, 0x8a, 0x45, 0xab, 0xa5, 0x47, 0x5f, 0x97, 0xfb, 0xf0, 0x87, 0x50, 0xd3, 0xd2, 0x8c,
+	0x7d, 0x0d, 0x5a, 0x47, 0xc0, 0x99, 0x43, 0x3c, 0x65, 0xb4, 0x30, 0xbf, 0x31, 0xd0, 0x75, 0xa7,
+	0xd9, 0x68, 0x7a, 0x70, 0xa4, 0x45, 0xb5, 0x87, 0x34, 0x4b, 0x95, 0x35, 0xd3, 0x1d, 0x59, 0x03,
+	0x4c, 0x64, 0xe2, 0xbc, 0x1d, 0xb3, 0x07, 0x4e, 0xf3, 0xfa, 0x86, 0xf5, 0x06, 0xc1, 0x90, 0x5a,
+	0x2f, 0x9d, 0xcf, 0x3e, 0x95, 0x5d, 0x53, 0x19, 0x90, 0xe2, 0x28, 0x8d, 0x31, 0xd7, 0xd1, 0x18,
+	0xa7, 0xfb, 0x94, 0x53, 0xdf, 0xa1, 0xb6, 0x23, 0x93, 0x3a, 0xbe, 0x9e, 0x57, 0xab, 0xb3, 0x40,
+	0x61, 0x5a, 0x51, 0xe8, 0xd8, 0x80, 0xad, 0xd1, 0x54, 0xb2, 0x2e, 0x05, 0x8f, 0xc0, 0xd8, 0xac,
+	0x7a, 0x35, 0x39, 0x2c, 0x45, 0x89, 0x23, 0x28, 0xba, 0xac, 0x78, 0x8b, 0x67, 0xd8, 0x7d, 0x5b,
+	0x17, 0x8c, 0x42, 0x56, 0x25, 0xd8, 0xe6, 0x34, 0x1a, 0x0c, 0x29, 0x67, 0x81, 0xca, 0xae, 0x92,
+	0xa5, 0xdf, 0xf0, 0x27, 0x50, 0x63, 0x53, 0x6a, 0x50, 0x1e, 0x94, 0x13, 0xa8, 0x9b, 0xab, 0xb1,
+	0x87, 0x08, 0x39, 0xe9, 0xdb, 0xf3, 0x20, 0x99, 0x83, 0xc7, 0x9f, 0x1b, 0x68, 0x2e, 0xe5, 0xb3,
+	0xdd, 0x8c, 0x44, 0x44, 0x7c, 0x97, 0xf9, 0xf5, 0xc4, 0x5d, 0xed, 0xf3, 0xba, 0x6b, 0x43, 0x5f,
+	0x93, 0xd1, 0x24, 0x46, 0xf2, 0x10, 0xbe, 0xa8, 0x03, 0xf1, 0x77, 0x06, 0x9a, 0x48, 0x89, 0xed,
+	0x7a, 0x44, 0x1c, 0x6c, 0xb4, 0x20, 0x8c, 0xe6, 0x26, 0xca, 0x3a, 0x83, 0xad, 0x5d, 0x1c, 0x17,
+	0xcd, 0x52, 0x75, 0x2e, 0x1b, 0x1a, 0x3a, 0x77, 0x60, 0x6b, 0x2c, 0x15, 0xed, 0x48, 0x89, 0xf9,
+	0x06, 0x1a, 0xda, 0xe7, 0xc4, 0x89, 0x87, 0x2b, 0x5d, 0x00, 0x2b, 0xc5, 0xd2, 0xd4, 0x4a, 0xcf,
+	0xe3, 0x1f, 0xa0, 0x33, 0xf5, 0xe0, 0x2a, 0xcc, 0x87, 0x06, 0x9a, 0xce, 0xb8, 0x88, 0x78, 0xc5,
+	0xa6, 0x72, 0x49, 0x7b, 0x73, 0xa5, 0x72, 0xd6, 0xc8, 0x57, 0xe9, 0x01, 0x5a, 0xbd, 0xa9, 0x1d,
+	0xfd, 0x62, 0xa7, 0xa9, 0x79, 0x78, 0x6c, 0x4d, 0xb6, 0x7a, 0x10, 0xd2, 0xb5, 0xe2, 0x0b, 0x03,
+	0x5d, 0xde, 0xa4, 0x54, 0x36, 0xcf, 0x8f, 0x0c, 0x34, 0x9a, 0x75, 0x8d, 0x10, 0x44, 0xcf, 0x08,
+	0xf4, 0x5d, 0xad, 0x7f, 0xaa, 0xb3, 0xe3, 0xc4, 0x67, 0x0b, 0xc7, 0x3b, 0x6b, 0x7f, 0x31, 0x1b,
+	0xfc, 0x10, 0xaa, 0xd8, 0xa9, 0xe6, 0xbe, 0x1b, 0x52, 0xdf, 0x55, 0x15, 0x9c, 0x78, 0xe6, 0x24,
+	0x1a, 0x88, 0x58, 0xe4, 0x51, 0xd5, 0x26, 0x2d, 0xf5, 0x62, 0x2e, 0xa0, 0x2b, 0x2e, 0x15, 0x0e,
+	0x67, 0x61, 0x16, 0x4d, 0x2b, 0x2f, 0x8a, 0x5b, 0x38, 0xa7, 0x0e, 0x0b, 0x19, 0x38, 0x41, 0xf6,
+	0x9a, 0x8b, 0xb5, 0xf0, 0x14, 0x23, 0x37, 0x72, 0x94, 0x9e, 0xc3, 0xc8, 0xb1, 0x3a, 0xf4, 0x01,
+	0x84, 0x49, 0x86, 0xea, 0x2f, 0x68, 0x99, 0xe9, 0x7c, 0xba, 0x1b, 0xc1, 0xec, 0x0f, 0xc9, 0xf9,
+	0xba, 0xbf, 0x2f, 0x2b, 0x65, 0xc8, 0x69, 0x8b, 0x05, 0x71, 0xe7, 0xcb, 0xe7, 0x41, 0xae, 0x52,
+	0x76, 0x6c, 0x80, 0x4a, 0x99, 0x48, 0x74, 0x16, 0xec, 0xa1, 0x01, 0xc8, 0xf8, 0x43, 0xaa, 0x53,
+	0xe0, 0xd5, 0xc2, 0x9d, 0x6a, 0x44, 0x29, 0x92, 0x20, 0xd8, 0x52, 0x60, 0xe6, 0x06, 0x1a, 0x3c,
+	0xa0, 0xac, 0x7e, 0xa0, 0x7c, 0x5d, 0xaa, 0x2e, 0x3d, 0x3d, 0x29, 0x8f, 0x39, 0x9c, 0xc6, 0x15,
+	0xde, 0xb7, 0xd5, 0x52, 0x46, 0xb2, 0x63, 0x01, 0x5b, 0xfa, 0x30, 0xfe, 0xaa, 0x1f, 0xdd, 0xfc,
+	0xf7, 0x31, 0x7d, 0xcd, 0x77, 0xb5, 0x84, 0x9e, 0x39, 0xb1, 0x17, 0x8e, 0xf3, 0x59, 0x23, 0x7e,
+	0xc1, 0x89, 0xbd, 0xf0, 0xd8, 0x7b, 0xd6, 0x0c, 0xdd, 0x3d, 0x12, 0x57, 0xb7, 0xbf, 0xfd, 0x7d,
+	0xde, 0x78, 0x0c, 0xcf, 0x13, 0x78, 0x7e, 0x83, 0xe7, 0xd3, 0x3f, 0xe6, 0xfb, 0x9e, 0xc0, 0xf3,
+	0x33, 0x3c, 0xf7, 0x57, 0xce, 0x54, 0xdd, 0xeb, 0xdf, 0x68, 0x6d, 0x50, 0xfe, 0x5f, 0xbc, 0xfd,
+	0x0f, 0xbe, 0x21, 0xb0, 0x27, 0xac, 0x0e, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -961,6 +1020,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.ProposerTipRatio.Equal(that1.ProposerTipRatio) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1238,6 +1300,33 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawDelegatorRewardAndDelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawDelegatorRewardAndDelegate)
+	if !ok {
+		that2, ok := that.(MsgWithdrawDelegatorRewardAndDelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	return true
+}
 func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1406,6 +1495,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ProposerTipRatio.Size()
+		i -= size
+		if _, err := m.ProposerTipRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1820,6 +1919,43 @@ func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawDelegatorRewardAndDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDelegatorRewardAndDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDelegatorRewardAndDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1912,6 +2048,8 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.ProposerTipRatio.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -2069,6 +2207,23 @@ func (m *DelegatorStartingInfo) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawDelegatorRewardAndDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2676,6 +2831,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerTipRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerTipRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3676,6 +3865,127 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawDelegatorRewardAndDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardAndDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardAndDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
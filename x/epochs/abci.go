@@ -0,0 +1,62 @@
+package epochs
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// BeginBlocker starts the first epoch of each epoch identifier once its start
+// time is reached, and moves to the next epoch once the current epoch
+// duration has elapsed. At most one epoch boundary of an identifier is
+// processed per block, so that each epoch lasts at least one block.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	logger := k.Logger(ctx)
+
+	// the epochs are collected first, since they are updated while processed
+	for _, info := range k.AllEpochInfos(ctx) {
+		if ctx.BlockTime().Before(info.StartTime) {
+			continue
+		}
+
+		switch {
+		case !info.EpochCountingStarted:
+			info.EpochCountingStarted = true
+			info.CurrentEpoch = 1
+			info.CurrentEpochStartTime = info.StartTime
+			logger.Info(fmt.Sprintf("starting %s epochs", info.Identifier))
+
+		case !ctx.BlockTime().Before(info.EndTime()):
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeKeyEpochIdentifier, info.Identifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", info.CurrentEpoch)),
+				),
+			)
+			k.AfterEpochEnd(ctx, info.Identifier, info.CurrentEpoch)
+
+			info.CurrentEpoch++
+			info.CurrentEpochStartTime = info.EndTime()
+
+		default:
+			continue
+		}
+
+		info.CurrentEpochStartHeight = ctx.BlockHeight()
+		k.SetEpochInfo(ctx, info)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyEpochIdentifier, info.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", info.CurrentEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEpochStartTime, info.CurrentEpochStartTime.Format(time.RFC3339Nano)),
+			),
+		)
+		k.BeforeEpochStart(ctx, info.Identifier, info.CurrentEpoch)
+		logger.Debug(fmt.Sprintf("%s epoch %d started", info.Identifier, info.CurrentEpoch))
+	}
+}
@@ -0,0 +1,108 @@
+package epochs_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+// recordingHooks records the epoch boundaries it is called for.
+type recordingHooks struct {
+	calls []string
+}
+
+func (h *recordingHooks) AfterEpochEnd(_ sdk.Context, identifier string, epochNumber int64) {
+	h.calls = append(h.calls, fmt.Sprintf("end %s %d", identifier, epochNumber))
+}
+
+func (h *recordingHooks) BeforeEpochStart(_ sdk.Context, identifier string, epochNumber int64) {
+	h.calls = append(h.calls, fmt.Sprintf("start %s %d", identifier, epochNumber))
+}
+
+// setupKeeper returns the epochs keeper of a new simapp, without the epochs of
+// its genesis, along with the hooks it calls at the epoch boundaries.
+func setupKeeper() (sdk.Context, epochs.Keeper, *recordingHooks) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	k := app.EpochsKeeper
+	for _, info := range k.AllEpochInfos(ctx) {
+		k.DeleteEpochInfo(ctx, info.Identifier)
+	}
+
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	return ctx, k, hooks
+}
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, k, hooks := setupKeeper()
+
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	epochs.InitGenesis(ctx, k, epochs.NewGenesisState([]epochs.EpochInfo{
+		{Identifier: "hour", StartTime: startTime, Duration: time.Hour},
+	}))
+
+	beginBlock := func(height int64, blockTime time.Time) epochs.EpochInfo {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+		epochs.BeginBlocker(ctx, k)
+
+		info, found := k.GetEpochInfo(ctx, "hour")
+		require.True(t, found)
+		return info
+	}
+
+	// epochs do not start before their start time
+	info := beginBlock(1, startTime.Add(-time.Minute))
+	require.False(t, info.EpochCountingStarted)
+	require.Empty(t, hooks.calls)
+
+	info = beginBlock(2, startTime.Add(time.Minute))
+	require.True(t, info.EpochCountingStarted)
+	require.Equal(t, int64(1), info.CurrentEpoch)
+	require.Equal(t, startTime, info.CurrentEpochStartTime)
+	require.Equal(t, int64(2), info.CurrentEpochStartHeight)
+	require.Equal(t, []string{"start hour 1"}, hooks.calls)
+
+	info = beginBlock(3, startTime.Add(59*time.Minute))
+	require.Equal(t, int64(1), info.CurrentEpoch)
+	require.Len(t, hooks.calls, 1)
+
+	info = beginBlock(4, startTime.Add(time.Hour))
+	require.Equal(t, int64(2), info.CurrentEpoch)
+	require.Equal(t, startTime.Add(time.Hour), info.CurrentEpochStartTime)
+	require.Equal(t, int64(4), info.CurrentEpochStartHeight)
+	require.Equal(t, []string{"start hour 1", "end hour 1", "start hour 2"}, hooks.calls)
+
+	// a single epoch boundary is processed per block
+	info = beginBlock(5, startTime.Add(5*time.Hour))
+	require.Equal(t, int64(3), info.CurrentEpoch)
+	require.Equal(t, startTime.Add(2*time.Hour), info.CurrentEpochStartTime)
+
+	info = beginBlock(6, startTime.Add(5*time.Hour))
+	require.Equal(t, int64(4), info.CurrentEpoch)
+	require.Equal(t, startTime.Add(3*time.Hour), info.CurrentEpochStartTime)
+}
+
+func TestInitGenesis(t *testing.T) {
+	ctx, k, _ := setupKeeper()
+
+	genesisTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(genesisTime)
+
+	epochs.InitGenesis(ctx, k, epochs.DefaultGenesisState())
+
+	exported := epochs.ExportGenesis(ctx, k)
+	require.Len(t, exported.Epochs, 2)
+	for _, info := range exported.Epochs {
+		require.Equal(t, genesisTime, info.StartTime)
+	}
+}
@@ -0,0 +1,40 @@
+package epochs
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	QuerierRoute      = types.QuerierRoute
+	QueryEpochs       = types.QueryEpochs
+	QueryCurrentEpoch = types.QueryCurrentEpoch
+)
+
+var (
+	// functions aliases
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+	NewGenesisEpochInfo        = types.NewGenesisEpochInfo
+	NewMultiEpochHooks         = types.NewMultiEpochHooks
+	NewQueryCurrentEpochParams = types.NewQueryCurrentEpochParams
+	EpochKey                   = types.EpochKey
+
+	// variable aliases
+	ModuleCdc       = types.ModuleCdc
+	KeyPrefixEpoch  = types.KeyPrefixEpoch
+	ErrUnknownEpoch = types.ErrUnknownEpoch
+)
+
+type (
+	Keeper                  = keeper.Keeper
+	EpochInfo               = types.EpochInfo
+	EpochHooks              = types.EpochHooks
+	MultiEpochHooks         = types.MultiEpochHooks
+	GenesisState            = types.GenesisState
+	QueryCurrentEpochParams = types.QueryCurrentEpochParams
+)
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// GetQueryCmd returns the cli query commands for the epochs module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	epochsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epochs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochsQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryEpochs(cdc),
+			GetCmdQueryCurrentEpoch(cdc),
+		)...,
+	)
+
+	return epochsQueryCmd
+}
+
+// GetCmdQueryEpochs implements a command to return the running epochs.
+func GetCmdQueryEpochs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "epochs",
+		Short: "Query the running epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEpochs)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var epochs []types.EpochInfo
+			if err := cdc.UnmarshalJSON(res, &epochs); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(epochs)
+		},
+	}
+}
+
+// GetCmdQueryCurrentEpoch implements a command to return the current epoch of
+// an epoch identifier.
+func GetCmdQueryCurrentEpoch(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "current-epoch [identifier]",
+		Short:   "Query the current epoch of an epoch identifier",
+		Example: fmt.Sprintf("$ <appcli> query %s current-epoch week", types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryCurrentEpochParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var epoch int64
+			if err := cdc.UnmarshalJSON(res, &epoch); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(epoch)
+		},
+	}
+}
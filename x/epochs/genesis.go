@@ -0,0 +1,22 @@
+package epochs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the epochs module state from a genesis state. Epochs
+// without a start time start with the chain.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, epoch := range data.Epochs {
+		if epoch.StartTime.IsZero() {
+			epoch.StartTime = ctx.BlockTime()
+		}
+
+		k.SetEpochInfo(ctx, epoch)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.AllEpochInfos(ctx))
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ types.EpochHooks = Keeper{}
+
+// AfterEpochEnd calls the AfterEpochEnd hook, if hooks are set.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart calls the BeforeEpochStart hook, if hooks are set.
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	}
+}
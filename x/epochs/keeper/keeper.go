@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Keeper of the epochs store
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	hooks    types.EpochHooks
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// SetHooks sets the hooks called at the epoch boundaries.
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epoch hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetEpochInfo returns the EpochInfo of an epoch identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (info types.EpochInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochKey(identifier))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// SetEpochInfo stores the EpochInfo of an epoch identifier.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, info types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochKey(info.Identifier), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// DeleteEpochInfo deletes the EpochInfo of an epoch identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochKey(identifier))
+}
+
+// IterateEpochInfos iterates over the EpochInfo of all the epoch identifiers,
+// ordered by identifier, until the callback returns true.
+func (k Keeper) IterateEpochInfos(ctx sdk.Context, cb func(info types.EpochInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.EpochInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns the EpochInfo of all the epoch identifiers.
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	var epochs []types.EpochInfo
+	k.IterateEpochInfos(ctx, func(info types.EpochInfo) bool {
+		epochs = append(epochs, info)
+		return false
+	})
+
+	return epochs
+}
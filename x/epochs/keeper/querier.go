@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// NewQuerier returns an epochs Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryEpochs:
+			return queryEpochs(ctx, k)
+
+		case types.QueryCurrentEpoch:
+			return queryCurrentEpoch(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryEpochs(ctx sdk.Context, k Keeper) ([]byte, error) {
+	epochs := k.AllEpochInfos(ctx)
+	if epochs == nil {
+		epochs = []types.EpochInfo{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, epochs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryCurrentEpoch(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCurrentEpochParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	info, found := k.GetEpochInfo(ctx, params.Identifier)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownEpoch, params.Identifier)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, info.CurrentEpoch)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// EpochInfo defines an epoch identifier, e.g. "day" or "week", the duration of
+// its epochs and the state of its current epoch.
+type EpochInfo struct {
+	// Identifier is the unique identifier of the epochs.
+	Identifier string `json:"identifier" yaml:"identifier"`
+	// StartTime is the time at which the first epoch starts.
+	StartTime time.Time `json:"start_time" yaml:"start_time"`
+	// Duration is the duration of each epoch.
+	Duration time.Duration `json:"duration" yaml:"duration"`
+	// CurrentEpoch is the number of the current epoch, starting at 1. It is 0
+	// until the first epoch starts.
+	CurrentEpoch int64 `json:"current_epoch" yaml:"current_epoch"`
+	// CurrentEpochStartTime is the time at which the current epoch started.
+	CurrentEpochStartTime time.Time `json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
+	// CurrentEpochStartHeight is the block height at which the current epoch
+	// started.
+	CurrentEpochStartHeight int64 `json:"current_epoch_start_height" yaml:"current_epoch_start_height"`
+	// EpochCountingStarted is true once the first epoch started.
+	EpochCountingStarted bool `json:"epoch_counting_started" yaml:"epoch_counting_started"`
+}
+
+// NewGenesisEpochInfo returns the EpochInfo of epochs of the given duration,
+// starting with the chain.
+func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Validate performs a basic validation of the EpochInfo.
+func (info EpochInfo) Validate() error {
+	if info.Identifier == "" {
+		return errors.New("epoch identifier cannot be empty")
+	}
+
+	if info.Duration <= 0 {
+		return fmt.Errorf("epoch %s duration must be positive: %s", info.Identifier, info.Duration)
+	}
+
+	if info.CurrentEpoch < 0 {
+		return fmt.Errorf("epoch %s current epoch cannot be negative: %d", info.Identifier, info.CurrentEpoch)
+	}
+
+	if info.CurrentEpochStartHeight < 0 {
+		return fmt.Errorf(
+			"epoch %s current epoch start height cannot be negative: %d", info.Identifier, info.CurrentEpochStartHeight,
+		)
+	}
+
+	return nil
+}
+
+// EndTime returns the time at which the current epoch ends.
+func (info EpochInfo) EndTime() time.Time {
+	return info.CurrentEpochStartTime.Add(info.Duration)
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrUnknownEpoch = sdkerrors.Register(ModuleName, 2, "unknown epoch identifier")
+)
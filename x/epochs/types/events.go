@@ -0,0 +1,11 @@
+package types
+
+// epochs module event types
+const (
+	EventTypeEpochEnd   = "epoch_end"
+	EventTypeEpochStart = "epoch_start"
+
+	AttributeKeyEpochIdentifier = "epoch_identifier"
+	AttributeKeyEpochNumber     = "epoch_number"
+	AttributeKeyEpochStartTime  = "start_time"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// GenesisState defines the epochs module genesis state
+type GenesisState struct {
+	Epochs []EpochInfo `json:"epochs" yaml:"epochs"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(epochs []EpochInfo) GenesisState {
+	return GenesisState{
+		Epochs: epochs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, tracking daily and
+// weekly epochs.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewGenesisEpochInfo("day", 24*time.Hour),
+		NewGenesisEpochInfo("week", 7*24*time.Hour),
+	})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	identifiers := make(map[string]bool)
+
+	for _, epoch := range gs.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+
+		if identifiers[epoch.Identifier] {
+			return fmt.Errorf("duplicate epoch identifier %s", epoch.Identifier)
+		}
+
+		identifiers[epoch.Identifier] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		genesis   types.GenesisState
+		expectErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"empty", types.NewGenesisState(nil), false},
+		{"empty identifier", types.NewGenesisState([]types.EpochInfo{
+			types.NewGenesisEpochInfo("", time.Hour),
+		}), true},
+		{"zero duration", types.NewGenesisState([]types.EpochInfo{
+			types.NewGenesisEpochInfo("day", 0),
+		}), true},
+		{"duplicate identifier", types.NewGenesisState([]types.EpochInfo{
+			types.NewGenesisEpochInfo("day", time.Hour),
+			types.NewGenesisEpochInfo("day", 24*time.Hour),
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
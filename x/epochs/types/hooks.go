@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks defines the hooks called by the epochs module at the epoch
+// boundaries, which other modules implement to run logic once per epoch.
+type EpochHooks interface {
+	// AfterEpochEnd is called when the given epoch of an epoch identifier ends.
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	// BeforeEpochStart is called when the given epoch of an epoch identifier
+	// starts, before any transaction of the epoch is executed.
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks, which are called in order.
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns the MultiEpochHooks calling the given hooks.
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd implements EpochHooks.
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range h {
+		h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart implements EpochHooks.
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range h {
+		h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	}
+}
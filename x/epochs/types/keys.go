@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the epochs querier
+	QueryEpochs       = "epochs"
+	QueryCurrentEpoch = "current_epoch"
+)
+
+// KeyPrefixEpoch defines the prefix of the keys storing the EpochInfo of each
+// epoch identifier.
+var KeyPrefixEpoch = []byte{0x01}
+
+// EpochKey returns the key storing the EpochInfo of an epoch identifier.
+func EpochKey(identifier string) []byte {
+	return append(KeyPrefixEpoch, []byte(identifier)...)
+}
@@ -0,0 +1,11 @@
+package types
+
+// QueryCurrentEpochParams defines the params of a current epoch query.
+type QueryCurrentEpochParams struct {
+	Identifier string `json:"identifier" yaml:"identifier"`
+}
+
+// NewQueryCurrentEpochParams creates a new QueryCurrentEpochParams instance.
+func NewQueryCurrentEpochParams(identifier string) QueryCurrentEpochParams {
+	return QueryCurrentEpochParams{Identifier: identifier}
+}
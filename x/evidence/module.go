@@ -27,6 +27,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.InterfaceModule     = AppModuleBasic{}
+
+	_ module.BeginBlockMisbehaviorHandler = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -50,6 +52,11 @@ func (AppModuleBasic) Name() string {
 	return ModuleName
 }
 
+// StoreKeys returns the keys of the evidence module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the evidence module's types to the provided codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
@@ -165,11 +172,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
 }
 
-// BeginBlock executes all ABCI BeginBlock logic respective to the evidence module.
-func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, req, am.keeper)
+// HandleMisbehaviors handles the evidence of misbehavior submitted by
+// Tendermint at the beginning of the block.
+func (am AppModule) HandleMisbehaviors(ctx sdk.Context, misbehaviors []sdk.Misbehavior) {
+	BeginBlocker(ctx, misbehaviors, am.keeper)
 }
 
+// BeginBlock executes all ABCI BeginBlock logic respective to the evidence
+// module. The evidence of misbehavior is handled by HandleMisbehaviors.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
 // EndBlock executes all ABCI EndBlock logic respective to the evidence module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
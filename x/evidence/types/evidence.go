@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 
-	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"gopkg.in/yaml.v2"
@@ -80,13 +79,13 @@ func (e Equivocation) GetValidatorPower() int64 {
 // GetTotalPower is a no-op for the Equivocation type.
 func (e Equivocation) GetTotalPower() int64 { return 0 }
 
-// ConvertDuplicateVoteEvidence converts a Tendermint concrete Evidence type to
-// SDK Evidence using Equivocation as the concrete type.
-func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) exported.Evidence {
+// ConvertDuplicateVoteEvidence converts a duplicate vote misbehavior submitted
+// by Tendermint to SDK Evidence using Equivocation as the concrete type.
+func ConvertDuplicateVoteEvidence(dupVote sdk.Misbehavior) exported.Evidence {
 	return &Equivocation{
 		Height:           dupVote.Height,
-		Power:            dupVote.Validator.Power,
-		ConsensusAddress: sdk.ConsAddress(dupVote.Validator.Address),
+		Power:            dupVote.Power,
+		ConsensusAddress: dupVote.ConsAddress,
 		Time:             dupVote.Time,
 	}
 }
@@ -120,7 +120,11 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 				return errors.Wrap(err, "failed to validate account in genesis")
 			}
 
-			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			txBldr, err := auth.NewTxBuilderFromCLI(inBuf)
+			if err != nil {
+				return err
+			}
+			txBldr = txBldr.WithTxEncoder(authclient.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 
 			// Set the generate-only flag here after the CLI context has
@@ -161,6 +165,10 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 				return errors.Wrap(err, "failed to sign std tx")
 			}
 
+			if err := types.ValidateGenTx(signedTx); err != nil {
+				return errors.Wrap(err, "invalid genesis transaction")
+			}
+
 			// Fetch output file name
 			outputDocument := viper.GetString(flags.FlagOutputDocument)
 			if outputDocument == "" {
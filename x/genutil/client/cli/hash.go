@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+// GenesisHashCmd returns a command that prints the hash of a genesis file,
+// computed on its canonical JSON encoding, so that independent parties may
+// check they run the same genesis regardless of the formatting of their files.
+func GenesisHashCmd(ctx *server.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "genesis-hash [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "prints the hash of the genesis file at the default location or at the location passed as an arg",
+		Long: `Prints the SHA-256 hash of the canonical JSON encoding of the genesis file,
+i.e. with sorted keys and without whitespace, at the default location or at the
+location passed as an arg. The hash does not depend on the formatting of the file.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Load default if passed no args, otherwise load passed file
+			var genesis string
+			if len(args) == 0 {
+				genesis = ctx.Config.GenesisFile()
+			} else {
+				genesis = args[0]
+			}
+
+			hash, err := genutil.GenesisHash(genesis)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%X\n", hash)
+			return nil
+		},
+	}
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
 
-// Validate genesis command takes
+// ValidateGenesisCmd returns a command that validates a genesis file. It runs
+// the ValidateGenesis of every module and the cross-module genesis checks,
+// reporting all the errors found.
 func ValidateGenesisCmd(ctx *server.Context, cdc codec.JSONMarshaler, mbm module.BasicManager) *cobra.Command {
 	return &cobra.Command{
 		Use:   "validate-genesis [file]",
@@ -41,8 +44,16 @@ func ValidateGenesisCmd(ctx *server.Context, cdc codec.JSONMarshaler, mbm module
 				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
 			}
 
-			if err = mbm.ValidateGenesis(cdc, genState); err != nil {
-				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			// report every module and cross-module error at once
+			errs := mbm.ValidateGenesisAll(cdc, genState)
+			errs = append(errs, genutil.ValidateGenesisCrossModule(cdc, genDoc.ChainID, genState)...)
+
+			if len(errs) != 0 {
+				for _, err := range errs {
+					fmt.Fprintf(os.Stderr, "  - %s\n", err)
+				}
+
+				return fmt.Errorf("error validating genesis file %s: found %d errors", genesis, len(errs))
 			}
 
 			// TODO test to make sure initchain doesn't panic
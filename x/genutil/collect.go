@@ -98,6 +98,8 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
 
+	validators := make(map[string]bool)
+
 	for _, fo := range fos {
 		filename := filepath.Join(genTxsDir, fo.Name())
 		if !fo.IsDir() && (filepath.Ext(filename) != ".json") {
@@ -126,14 +128,24 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 			return appGenTxs, persistentPeers, fmt.Errorf("failed to find node's address and IP in %s", fo.Name())
 		}
 
-		// genesis transactions must be single-message
-		msgs := genStdTx.GetMsgs()
-		if len(msgs) != 1 {
-			return appGenTxs, persistentPeers, errors.New("each genesis transaction must provide a single genesis message")
+		// genesis transactions must be single-message, validly signed
+		// MsgCreateValidator transactions
+		if err := types.ValidateGenTx(genStdTx); err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis transaction in %s: %w", fo.Name(), err)
+		}
+
+		if err := VerifyGenTxSignatures(genDoc.ChainID, genStdTx); err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis transaction in %s: %w", fo.Name(), err)
 		}
 
 		// TODO abstract out staking message validation back to staking
-		msg := msgs[0].(stakingtypes.MsgCreateValidator)
+		msg := genStdTx.GetMsgs()[0].(stakingtypes.MsgCreateValidator)
+
+		// a validator may only be created once
+		if _, ok := validators[msg.ValidatorAddress.String()]; ok {
+			return appGenTxs, persistentPeers, fmt.Errorf("duplicate genesis transaction for validator %s in %s", msg.ValidatorAddress, fo.Name())
+		}
+		validators[msg.ValidatorAddress.String()] = true
 
 		// validate delegator and validator addresses and funds against the accounts in the state
 		delAddr := msg.DelegatorAddress.String()
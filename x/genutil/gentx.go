@@ -37,6 +37,35 @@ func SetGenTxsInAppGenesisState(
 	return SetGenesisStateInAppState(cdc, appGenesisState, genesisState), nil
 }
 
+// VerifyGenTxSignatures verifies the signatures of a genesis transaction for
+// the given chain ID. Genesis transactions are delivered before any block is
+// committed and are thus signed with an account number and a sequence of 0.
+func VerifyGenTxSignatures(chainID string, tx authtypes.StdTx) error {
+	signers := tx.GetSigners()
+	if len(tx.Signatures) != len(signers) {
+		return fmt.Errorf("wrong number of signatures; expected %d, got %d", len(signers), len(tx.Signatures))
+	}
+
+	signBytes := authtypes.StdSignBytes(chainID, 0, 0, tx.Fee, tx.Msgs, tx.Memo)
+
+	for i, sig := range tx.Signatures {
+		pubKey := sig.GetPubKey()
+		if pubKey == nil {
+			return fmt.Errorf("missing public key for signer %s", signers[i])
+		}
+
+		if !signers[i].Equals(sdk.AccAddress(pubKey.Address())) {
+			return fmt.Errorf("public key does not match signer %s", signers[i])
+		}
+
+		if !pubKey.VerifyBytes(signBytes, sig.Signature) {
+			return fmt.Errorf("invalid signature for signer %s; check the chain ID of the genesis transaction", signers[i])
+		}
+	}
+
+	return nil
+}
+
 // ValidateAccountInGenesis checks that the provided account has a sufficient
 // balance in the set of genesis accounts.
 func ValidateAccountInGenesis(
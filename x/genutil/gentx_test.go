@@ -1,6 +1,16 @@
 package genutil
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
 
 func TestGenTx(t *testing.T) {
 
@@ -12,3 +22,36 @@ func TestGenTx(t *testing.T) {
 	// TODO test with both one and two genesis transactions:
 	// TODO        correct: genesis account created, canididates created, pool token variance
 }
+
+func TestVerifyGenTxSignatures(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	consPubKey := ed25519.GenPrivKey().PubKey()
+
+	msg := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr), consPubKey, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50),
+		stakingtypes.NewDescription("testname", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	fee := authtypes.NewStdFee(200000, nil)
+	memo := "nodeid@127.0.0.1:26656"
+
+	signTx := func(chainID string) authtypes.StdTx {
+		sig, err := priv.Sign(authtypes.StdSignBytes(chainID, 0, 0, fee, []sdk.Msg{msg}, memo))
+		require.NoError(t, err)
+
+		stdSig := authtypes.StdSignature{PubKey: priv.PubKey().Bytes(), Signature: sig}
+		return authtypes.NewStdTx([]sdk.Msg{msg}, fee, []authtypes.StdSignature{stdSig}, memo)
+	}
+
+	require.NoError(t, VerifyGenTxSignatures("test-chain", signTx("test-chain")))
+	require.Error(t, VerifyGenTxSignatures("test-chain", signTx("other-chain")))
+	require.Error(t, VerifyGenTxSignatures("test-chain", authtypes.NewStdTx([]sdk.Msg{msg}, fee, nil, memo)))
+
+	// the signer must match the delegator
+	other := secp256k1.GenPrivKey()
+	sig, err := other.Sign(authtypes.StdSignBytes("test-chain", 0, 0, fee, []sdk.Msg{msg}, memo))
+	require.NoError(t, err)
+	stdSig := authtypes.StdSignature{PubKey: other.PubKey().Bytes(), Signature: sig}
+	require.Error(t, VerifyGenTxSignatures("test-chain", authtypes.NewStdTx([]sdk.Msg{msg}, fee, []authtypes.StdSignature{stdSig}, memo)))
+}
@@ -105,17 +105,27 @@ func ValidateGenesis(genesisState GenesisState) error {
 			return err
 		}
 
-		msgs := tx.GetMsgs()
-		if len(msgs) != 1 {
-			return errors.New(
-				"must provide genesis StdTx with exactly 1 CreateValidator message")
-		}
-
-		// TODO: abstract back to staking
-		if _, ok := msgs[0].(stakingtypes.MsgCreateValidator); !ok {
-			return fmt.Errorf(
-				"genesis transaction %v does not contain a MsgCreateValidator", i)
+		if err := ValidateGenTx(tx); err != nil {
+			return fmt.Errorf("invalid genesis transaction %d: %w", i, err)
 		}
 	}
 	return nil
 }
+
+// ValidateGenTx performs stateless validation of a genesis transaction, which
+// must carry exactly one valid MsgCreateValidator.
+func ValidateGenTx(tx authtypes.StdTx) error {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return errors.New(
+			"must provide genesis StdTx with exactly 1 CreateValidator message")
+	}
+
+	// TODO: abstract back to staking
+	msg, ok := msgs[0].(stakingtypes.MsgCreateValidator)
+	if !ok {
+		return errors.New("genesis transaction does not contain a MsgCreateValidator")
+	}
+
+	return msg.ValidateBasic()
+}
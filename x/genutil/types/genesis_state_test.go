@@ -59,3 +59,17 @@ func TestValidateGenesisBadMessage(t *testing.T) {
 	err := ValidateGenesis(genesisState)
 	require.Error(t, err)
 }
+
+func TestValidateGenTx(t *testing.T) {
+	desc := stakingtypes.NewDescription("testname", "", "", "", "")
+	comm := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec())
+
+	msg := stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk1.Address()), pk1,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), desc, comm, sdk.OneInt())
+	require.NoError(t, ValidateGenTx(authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.StdFee{}, nil, "")))
+
+	// the self delegation must be positive
+	msg = stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk1.Address()), pk1,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), desc, comm, sdk.OneInt())
+	require.Error(t, ValidateGenTx(authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.StdFee{}, nil, "")))
+}
@@ -1,7 +1,10 @@
 package genutil
 
 import (
+	"crypto/sha256"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"time"
 
@@ -11,6 +14,8 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/privval"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ExportGenesisFile creates and writes the genesis configuration to disk. An
@@ -44,6 +49,29 @@ func ExportGenesisFileWithTime(
 	return genDoc.SaveAs(genFile)
 }
 
+// GenesisHash returns the SHA-256 hash of the canonical JSON encoding of the
+// given genesis file, which does not depend on the formatting of the file, so
+// that independent parties may check they run the same genesis. An error is
+// returned if the file is not a valid genesis doc.
+func GenesisHash(genFile string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(genFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tmtypes.GenesisDocFromJSON(bz); err != nil {
+		return nil, fmt.Errorf("invalid genesis doc %s: %w", genFile, err)
+	}
+
+	bz, err = sdk.CanonicalJSON(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis doc %s: %w", genFile, err)
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
 // InitializeNodeValidatorFiles creates private validator and p2p configuration files.
 func InitializeNodeValidatorFiles(config *cfg.Config) (nodeID string, valPubKey crypto.PubKey, err error) {
 	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
@@ -1,7 +1,9 @@
 package genutil
 
 import (
+	"bytes"
 	"encoding/json"
+	"io/ioutil"
 	"path/filepath"
 	"testing"
 	"time"
@@ -19,3 +21,46 @@ func TestExportGenesisFileWithTime(t *testing.T) {
 	fname := filepath.Join(dir, "genesis.json")
 	require.NoError(t, ExportGenesisFileWithTime(fname, "test", nil, json.RawMessage(""), time.Now()))
 }
+
+func TestGenesisHash(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	fname := filepath.Join(dir, "genesis.json")
+	genTime := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, ExportGenesisFileWithTime(fname, "test", nil, json.RawMessage(`{"b":1,"a":[]}`), genTime))
+
+	hash, err := GenesisHash(fname)
+	require.NoError(t, err)
+	require.Len(t, hash, 32)
+
+	// the hash does not depend on the formatting of the file
+	bz, err := ioutil.ReadFile(fname)
+	require.NoError(t, err)
+
+	var indented bytes.Buffer
+	require.NoError(t, json.Indent(&indented, bz, "", "    "))
+
+	reformatted := filepath.Join(dir, "reformatted.json")
+	require.NoError(t, ioutil.WriteFile(reformatted, indented.Bytes(), 0600))
+
+	reformattedHash, err := GenesisHash(reformatted)
+	require.NoError(t, err)
+	require.Equal(t, hash, reformattedHash)
+
+	// but on its content
+	other := filepath.Join(dir, "other.json")
+	require.NoError(t, ExportGenesisFileWithTime(other, "other", nil, json.RawMessage(`{"b":1,"a":[]}`), genTime))
+
+	otherHash, err := GenesisHash(other)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	// invalid genesis docs are rejected
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte(`{"app_state":{}}`), 0600))
+
+	_, err = GenesisHash(invalid)
+	require.Error(t, err)
+}
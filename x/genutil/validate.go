@@ -0,0 +1,153 @@
+package genutil
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidateGenesisCrossModule performs the genesis state checks that span
+// several modules and therefore cannot be done by a single module's
+// ValidateGenesis. It checks that:
+//
+//   - every genesis transaction is signed for the given chain ID and its
+//     delegator holds enough funds in the bank genesis state,
+//   - the staking pools' balances in the bank genesis state, when set, match
+//     the tokens held by the staking genesis state,
+//   - the total supply, when set, matches the sum of all the balances.
+//
+// All the errors found are returned instead of stopping at the first one.
+func ValidateGenesisCrossModule(
+	cdc codec.JSONMarshaler, chainID string, appState map[string]json.RawMessage,
+) (errs []error) {
+
+	var bankGenState banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenState); err != nil {
+		return append(errs, fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err))
+	}
+
+	var stakingGenState stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState); err != nil {
+		return append(errs, fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err))
+	}
+
+	var genutilGenState types.GenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(appState[types.ModuleName], &genutilGenState); err != nil {
+		return append(errs, fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err))
+	}
+
+	balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	totalBalances := sdk.NewCoins()
+
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address.String()] = balances[balance.Address.String()].Add(balance.Coins...)
+		totalBalances = totalBalances.Add(balance.Coins...)
+	}
+
+	errs = append(errs, validateGenTxs(chainID, genutilGenState, balances)...)
+	errs = append(errs, validateStakingPools(stakingGenState, balances)...)
+
+	supply := bankGenState.Supply
+	if !supply.Empty() && !(supply.IsAllGTE(totalBalances) && totalBalances.IsAllGTE(supply)) {
+		errs = append(errs, fmt.Errorf(
+			"total supply %s does not match the sum of all balances %s", supply, totalBalances,
+		))
+	}
+
+	return errs
+}
+
+// validateGenTxs checks the signatures of all the genesis transactions and
+// that their delegators can fund the self-delegation.
+func validateGenTxs(chainID string, genState types.GenesisState, balances map[string]sdk.Coins) (errs []error) {
+	for i, genTx := range genState.GenTxs {
+		var tx authtypes.StdTx
+		if err := types.ModuleCdc.UnmarshalJSON(genTx, &tx); err != nil {
+			errs = append(errs, fmt.Errorf("invalid genesis transaction %d: %w", i, err))
+			continue
+		}
+
+		if err := types.ValidateGenTx(tx); err != nil {
+			errs = append(errs, fmt.Errorf("invalid genesis transaction %d: %w", i, err))
+			continue
+		}
+
+		if err := VerifyGenTxSignatures(chainID, tx); err != nil {
+			errs = append(errs, fmt.Errorf("invalid genesis transaction %d: %w", i, err))
+		}
+
+		msg := tx.GetMsgs()[0].(stakingtypes.MsgCreateValidator)
+
+		coins, ok := balances[msg.DelegatorAddress.String()]
+		if !ok {
+			errs = append(errs, fmt.Errorf(
+				"invalid genesis transaction %d: account %s balance not in genesis state", i, msg.DelegatorAddress,
+			))
+			continue
+		}
+
+		if coins.AmountOf(msg.Value.Denom).LT(msg.Value.Amount) {
+			errs = append(errs, fmt.Errorf(
+				"invalid genesis transaction %d: insufficient funds for delegation %s: %v < %v",
+				i, msg.DelegatorAddress, coins.AmountOf(msg.Value.Denom), msg.Value.Amount,
+			))
+		}
+	}
+
+	return errs
+}
+
+// validateStakingPools checks that the bonded and not bonded pool balances, if
+// provided in the bank genesis state, match the tokens of the staking genesis
+// state.
+func validateStakingPools(genState stakingtypes.GenesisState, balances map[string]sdk.Coins) (errs []error) {
+	bondedTokens := sdk.ZeroInt()
+	notBondedTokens := sdk.ZeroInt()
+
+	for _, validator := range genState.Validators {
+		switch validator.GetStatus() {
+		case sdk.Bonded:
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+		case sdk.Unbonding, sdk.Unbonded:
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+		}
+	}
+
+	for _, ubd := range genState.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	pools := []struct {
+		name   string
+		tokens sdk.Int
+	}{
+		{stakingtypes.BondedPoolName, bondedTokens},
+		{stakingtypes.NotBondedPoolName, notBondedTokens},
+	}
+
+	bondDenom := genState.Params.BondDenom
+	for _, pool := range pools {
+		coins, ok := balances[authtypes.NewModuleAddress(pool.name).String()]
+		if !ok {
+			// the staking module funds the pools at InitGenesis
+			continue
+		}
+
+		if !coins.AmountOf(bondDenom).Equal(pool.tokens) {
+			errs = append(errs, fmt.Errorf(
+				"%s pool balance %v%s does not match the staking genesis tokens %v%s",
+				pool.name, coins.AmountOf(bondDenom), bondDenom, pool.tokens, bondDenom,
+			))
+		}
+	}
+
+	return errs
+}
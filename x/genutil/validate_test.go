@@ -0,0 +1,63 @@
+package genutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidateGenesisCrossModule(t *testing.T) {
+	cdc := types.ModuleCdc
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+
+	msg := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50),
+		stakingtypes.NewDescription("testname", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	fee := authtypes.NewStdFee(200000, nil)
+	memo := "nodeid@127.0.0.1:26656"
+
+	sig, err := priv.Sign(authtypes.StdSignBytes("test-chain", 0, 0, fee, []sdk.Msg{msg}, memo))
+	require.NoError(t, err)
+
+	stdSig := authtypes.StdSignature{PubKey: priv.PubKey().Bytes(), Signature: sig}
+	tx := authtypes.NewStdTx([]sdk.Msg{msg}, fee, []authtypes.StdSignature{stdSig}, memo)
+
+	balance := banktypes.Balance{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))}
+	appState := func(balances []banktypes.Balance, supply sdk.Coins) map[string]json.RawMessage {
+		return map[string]json.RawMessage{
+			banktypes.ModuleName:    cdc.MustMarshalJSON(banktypes.NewGenesisState(true, balances, supply)),
+			stakingtypes.ModuleName: cdc.MustMarshalJSON(stakingtypes.DefaultGenesisState()),
+			types.ModuleName:        cdc.MustMarshalJSON(types.NewGenesisStateFromStdTx([]authtypes.StdTx{tx})),
+		}
+	}
+
+	// valid genesis state
+	require.Empty(t, ValidateGenesisCrossModule(cdc, "test-chain", appState([]banktypes.Balance{balance}, nil)))
+	require.Empty(t, ValidateGenesisCrossModule(cdc, "test-chain", appState([]banktypes.Balance{balance}, balance.Coins)))
+
+	// all the errors are reported at once
+	bondedPool := banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+	}
+	errs := ValidateGenesisCrossModule(
+		cdc, "other-chain", appState([]banktypes.Balance{balance, bondedPool}, balance.Coins),
+	)
+	require.Len(t, errs, 3)
+
+	// the delegator must have a balance
+	require.Len(t, ValidateGenesisCrossModule(cdc, "test-chain", appState(nil, nil)), 1)
+}
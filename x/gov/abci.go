@@ -12,7 +12,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	logger := keeper.Logger(ctx)
 
 	// delete inactive proposal from store and its deposits
-	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
+	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockTime(), func(proposal Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
 		keeper.DeleteDeposits(ctx, proposal.ProposalID)
 
@@ -36,7 +36,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	})
 
 	// fetch active proposals whose voting periods have ended (are passed the block time)
-	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
+	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockTime(), func(proposal Proposal) bool {
 		var tagValue, logMsg string
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
@@ -60,13 +60,8 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
 
-				// The cached context is created with a new EventManager. However, since
-				// the proposal handler execution was successful, we want to track/keep
-				// any events emitted, so we re-emit to "merge" the events into the
-				// original Context's EventManager.
-				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-
-				// write state to the underlying multi-store
+				// write state to the underlying multi-store, and emit the events
+				// of the proposal handler on the original Context's EventManager
 				writeCache()
 			} else {
 				proposal.Status = StatusFailed
@@ -6,40 +6,44 @@ import (
 )
 
 const (
-	MaxDescriptionLength  = types.MaxDescriptionLength
-	MaxTitleLength        = types.MaxTitleLength
-	DefaultPeriod         = types.DefaultPeriod
-	ModuleName            = types.ModuleName
-	StoreKey              = types.StoreKey
-	RouterKey             = types.RouterKey
-	QuerierRoute          = types.QuerierRoute
-	DefaultParamspace     = types.DefaultParamspace
-	TypeMsgDeposit        = types.TypeMsgDeposit
-	TypeMsgVote           = types.TypeMsgVote
-	TypeMsgSubmitProposal = types.TypeMsgSubmitProposal
-	StatusNil             = types.StatusNil
-	StatusDepositPeriod   = types.StatusDepositPeriod
-	StatusVotingPeriod    = types.StatusVotingPeriod
-	StatusPassed          = types.StatusPassed
-	StatusRejected        = types.StatusRejected
-	StatusFailed          = types.StatusFailed
-	ProposalTypeText      = types.ProposalTypeText
-	QueryParams           = types.QueryParams
-	QueryProposals        = types.QueryProposals
-	QueryProposal         = types.QueryProposal
-	QueryDeposits         = types.QueryDeposits
-	QueryDeposit          = types.QueryDeposit
-	QueryVotes            = types.QueryVotes
-	QueryVote             = types.QueryVote
-	QueryTally            = types.QueryTally
-	ParamDeposit          = types.ParamDeposit
-	ParamVoting           = types.ParamVoting
-	ParamTallying         = types.ParamTallying
-	OptionEmpty           = types.OptionEmpty
-	OptionYes             = types.OptionYes
-	OptionAbstain         = types.OptionAbstain
-	OptionNo              = types.OptionNo
-	OptionNoWithVeto      = types.OptionNoWithVeto
+	MaxDescriptionLength     = types.MaxDescriptionLength
+	MaxTitleLength           = types.MaxTitleLength
+	DefaultPeriod            = types.DefaultPeriod
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	ExecRouterKey            = types.ExecRouterKey
+	QuerierRoute             = types.QuerierRoute
+	DefaultParamspace        = types.DefaultParamspace
+	TypeMsgDeposit           = types.TypeMsgDeposit
+	TypeMsgVote              = types.TypeMsgVote
+	TypeMsgVoteWeighted      = types.TypeMsgVoteWeighted
+	TypeMsgSubmitProposal    = types.TypeMsgSubmitProposal
+	TypeMsgExecLegacyContent = types.TypeMsgExecLegacyContent
+	StatusNil                = types.StatusNil
+	StatusDepositPeriod      = types.StatusDepositPeriod
+	StatusVotingPeriod       = types.StatusVotingPeriod
+	StatusPassed             = types.StatusPassed
+	StatusRejected           = types.StatusRejected
+	StatusFailed             = types.StatusFailed
+	ProposalTypeText         = types.ProposalTypeText
+	ProposalTypeExec         = types.ProposalTypeExec
+	QueryParams              = types.QueryParams
+	QueryProposals           = types.QueryProposals
+	QueryProposal            = types.QueryProposal
+	QueryDeposits            = types.QueryDeposits
+	QueryDeposit             = types.QueryDeposit
+	QueryVotes               = types.QueryVotes
+	QueryVote                = types.QueryVote
+	QueryTally               = types.QueryTally
+	ParamDeposit             = types.ParamDeposit
+	ParamVoting              = types.ParamVoting
+	ParamTallying            = types.ParamTallying
+	OptionEmpty              = types.OptionEmpty
+	OptionYes                = types.OptionYes
+	OptionAbstain            = types.OptionAbstain
+	OptionNo                 = types.OptionNo
+	OptionNoWithVeto         = types.OptionNoWithVeto
 )
 
 var (
@@ -61,6 +65,7 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrMinDepositTooSmall         = types.ErrMinDepositTooSmall
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -81,8 +86,10 @@ var (
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
+	NewMsgExecLegacyContent       = types.NewMsgExecLegacyContent
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -93,6 +100,8 @@ var (
 	ProposalStatusFromString      = types.ProposalStatusFromString
 	ValidProposalStatus           = types.ValidProposalStatus
 	NewTextProposal               = types.NewTextProposal
+	NewExecProposal               = types.NewExecProposal
+	NewExecProposalHandler        = types.NewExecProposalHandler
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -106,7 +115,11 @@ var (
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
 	VoteOptionFromString          = types.VoteOptionFromString
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
 	ValidVoteOption               = types.ValidVoteOption
 
 	// variable aliases
@@ -131,8 +144,10 @@ type (
 	GenesisState         = types.GenesisState
 	MsgSubmitProposalI   = types.MsgSubmitProposalI
 	MsgSubmitProposal    = types.MsgSubmitProposal
+	MsgExecLegacyContent = types.MsgExecLegacyContent
 	MsgDeposit           = types.MsgDeposit
 	MsgVote              = types.MsgVote
+	MsgVoteWeighted      = types.MsgVoteWeighted
 	DepositParams        = types.DepositParams
 	TallyParams          = types.TallyParams
 	VotingParams         = types.VotingParams
@@ -142,6 +157,7 @@ type (
 	ProposalQueue        = types.ProposalQueue
 	ProposalStatus       = types.ProposalStatus
 	TextProposal         = types.TextProposal
+	ExecProposal         = types.ExecProposal
 	QueryProposalParams  = types.QueryProposalParams
 	QueryDepositParams   = types.QueryDepositParams
 	QueryVoteParams      = types.QueryVoteParams
@@ -150,5 +166,7 @@ type (
 	TallyResult          = types.TallyResult
 	Vote                 = types.Vote
 	Votes                = types.Votes
+	WeightedVoteOption   = types.WeightedVoteOption
+	WeightedVoteOptions  = types.WeightedVoteOptions
 	VoteOption           = types.VoteOption
 )
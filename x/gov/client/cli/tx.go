@@ -71,6 +71,7 @@ func NewTxCmd(
 	govTxCmd.AddCommand(flags.PostCommands(
 		NewCmdDeposit(ctx),
 		NewCmdVote(ctx),
+		NewCmdWeightedVote(ctx),
 		cmdSubmitProp,
 	)...)
 
@@ -234,4 +235,52 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote(ctx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the vote across options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power across
+several options whose weights add up to one. You can find the proposal-id by
+running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := ctx.InitWithInput(cmd.InOrStdin())
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
 // DONTCOVER
@@ -7,8 +7,13 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestInvalidMsg(t *testing.T) {
@@ -20,3 +25,37 @@ func TestInvalidMsg(t *testing.T) {
 	require.Nil(t, res)
 	require.True(t, strings.Contains(err.Error(), "unrecognized gov message type"))
 }
+
+func TestHandleMsgExecLegacyContent(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	govHandler := gov.NewHandler(app.GovKeeper)
+	govAddr := authtypes.NewModuleAddress(gov.ModuleName)
+
+	content := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(staking.DefaultParamspace, string(staking.KeyMaxValidators), "1"),
+	})
+
+	// only the governance module account may execute a content
+	msg, err := gov.NewMsgExecLegacyContent(content, addrs[0])
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err = govHandler(ctx, msg)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+
+	// the content is executed through an exec proposal once passed
+	msg, err = gov.NewMsgExecLegacyContent(content, govAddr)
+	require.NoError(t, err)
+
+	ep, err := gov.NewExecProposal("title", "description", []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.NoError(t, ep.ValidateBasic())
+
+	handler := app.GovKeeper.Router().GetRoute(ep.ProposalRoute())
+	require.NoError(t, handler(ctx, ep))
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+}
@@ -99,6 +99,21 @@ func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func
 	}
 }
 
+// ValidateInitialDeposit checks that the deposit made when submitting a proposal
+// covers the minimum initial deposit derived from the deposit params
+func (keeper Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+	minInitialDeposit := keeper.GetDepositParams(ctx).MinInitialDeposit()
+	if minInitialDeposit.Empty() {
+		return nil
+	}
+
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minInitialDeposit)
+	}
+
+	return nil
+}
+
 // AddDeposit adds or updates a deposit of a specific depositor on a specific proposal
 // Activates voting period when appropriate
 func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error) {
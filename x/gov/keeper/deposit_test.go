@@ -101,3 +101,37 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 }
+
+func TestValidateInitialDeposit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// no minimum initial deposit by default
+	require.NoError(t, app.GovKeeper.ValidateInitialDeposit(ctx, sdk.NewCoins()))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	depositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(25, 2)
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	testCases := []struct {
+		name      string
+		deposit   sdk.Coins
+		expectErr bool
+	}{
+		{"empty deposit", sdk.NewCoins(), true},
+		{"below minimum", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 24)), true},
+		{"wrong denom", sdk.NewCoins(sdk.NewInt64Coin("foo", 25)), true},
+		{"exact minimum", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)), false},
+		{"above minimum", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), false},
+	}
+
+	for _, tc := range testCases {
+		err := app.GovKeeper.ValidateInitialDeposit(ctx, tc.deposit)
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -470,3 +470,111 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs, valAddrs := createValidators(ctx, app, []int64{5, 5, 10})
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// the delegator splits its vote, overriding the vote of its validator
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionNo))
+	require.NoError(t, app.GovKeeper.AddWeightedVote(ctx, proposalID, addrs[2], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(5, 1)),
+	}))
+	require.NoError(t, app.GovKeeper.AddWeightedVote(ctx, proposalID, addrs[4], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}))
+
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[4])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.Len(t, vote.Options, 2)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, sdk.TokensFromConsensusPower(12).Equal(tallyResults.Yes))
+	require.True(t, sdk.TokensFromConsensusPower(3).Equal(tallyResults.Abstain))
+	require.True(t, sdk.TokensFromConsensusPower(5).Equal(tallyResults.No))
+	require.True(t, sdk.TokensFromConsensusPower(5).Equal(tallyResults.NoWithVeto))
+}
+
+func TestAddWeightedVoteInvalid(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs, _ := createValidators(ctx, app, []int64{5, 5, 5})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// the weights must add up to one
+	err = app.GovKeeper.AddWeightedVote(ctx, proposal.ProposalID, addrs[0], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.True(t, types.ErrInvalidVote.Is(err))
+
+	_, found := app.GovKeeper.GetVote(ctx, proposal.ProposalID, addrs[0])
+	require.False(t, found)
+}
+
+func TestTallyBurnDepositsParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	valAccAddrs, _ := createValidators(ctx, app, []int64{6, 6, 7})
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.BurnVoteQuorum = false
+	depositParams.BurnVoteVeto = false
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	// a proposal without quorum is refunded
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+
+	// a vetoed proposal is refunded
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, valAccAddrs[0], types.OptionYes))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, valAccAddrs[1], types.OptionYes))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, valAccAddrs[2], types.OptionNoWithVeto))
+
+	passes, burnDeposits, _ = app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+}
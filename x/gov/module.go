@@ -50,6 +50,11 @@ func (AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
+// StoreKeys returns the keys of the gov module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the gov module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
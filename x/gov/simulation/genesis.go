@@ -19,6 +19,8 @@ import (
 const (
 	DepositParamsMinDeposit    = "deposit_params_min_deposit"
 	DepositParamsDepositPeriod = "deposit_params_deposit_period"
+	DepositParamsBurnQuorum    = "deposit_params_burn_vote_quorum"
+	DepositParamsBurnVeto      = "deposit_params_burn_vote_veto"
 	VotingParamsVotingPeriod   = "voting_params_voting_period"
 	TallyParamsQuorum          = "tally_params_quorum"
 	TallyParamsThreshold       = "tally_params_threshold"
@@ -35,6 +37,11 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsBurnVote randomized DepositParamsBurnQuorum and DepositParamsBurnVeto
+func GenDepositParamsBurnVote(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
@@ -71,6 +78,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
+	var burnVoteQuorum bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnQuorum, &burnVoteQuorum, simState.Rand,
+		func(r *rand.Rand) { burnVoteQuorum = GenDepositParamsBurnVote(r) },
+	)
+
+	var burnVoteVeto bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVeto, &burnVoteVeto, simState.Rand,
+		func(r *rand.Rand) { burnVoteVeto = GenDepositParamsBurnVote(r) },
+	)
+
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
@@ -97,7 +116,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
+		types.NewDepositParams(minDeposit, depositPeriod, types.DefaultMinInitialDepositRatio, burnVoteQuorum, burnVoteVeto),
 		types.NewVotingParams(votingPeriod),
 		types.NewTallyParams(quorum, threshold, veto),
 	)
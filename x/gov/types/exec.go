@@ -0,0 +1,161 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ Content                       = &ExecProposal{}
+	_ types.UnpackInterfacesMessage = &ExecProposal{}
+)
+
+// NewExecProposal creates a proposal Content executing the given messages on
+// behalf of the governance module account once passed.
+func NewExecProposal(title, description string, msgs []sdk.Msg) (*ExecProposal, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		pm, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("%T does not implement proto.Message", msg)
+		}
+
+		any, err := types.NewAnyWithValue(pm)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return &ExecProposal{Title: title, Description: description, Msgs: anys}, nil
+}
+
+// GetTitle returns the proposal title
+func (ep *ExecProposal) GetTitle() string { return ep.Title }
+
+// GetDescription returns the proposal description
+func (ep *ExecProposal) GetDescription() string { return ep.Description }
+
+// ProposalRoute returns the proposal router key
+func (ep *ExecProposal) ProposalRoute() string { return ExecRouterKey }
+
+// ProposalType is "Exec"
+func (ep *ExecProposal) ProposalType() string { return ProposalTypeExec }
+
+// GetMsgs returns the unpacked messages of the proposal. It returns nil for the
+// messages which are not unpacked.
+func (ep *ExecProposal) GetMsgs() []sdk.Msg {
+	msgs := make([]sdk.Msg, len(ep.Msgs))
+	for i, any := range ep.Msgs {
+		msg, _ := any.GetCachedValue().(sdk.Msg)
+		msgs[i] = msg
+	}
+
+	return msgs
+}
+
+// ValidateBasic validates the title and description of the proposal and its
+// messages, which must all be signed by the governance module account only.
+func (ep *ExecProposal) ValidateBasic() error {
+	if err := ValidateAbstract(ep); err != nil {
+		return err
+	}
+
+	if len(ep.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal must contain at least one message")
+	}
+
+	for i, msg := range ep.GetMsgs() {
+		if msg == nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d is not unpacked", i)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+
+		if err := validateExecSigners(msg); err != nil {
+			return sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ep *ExecProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range ep.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (ep ExecProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Exec Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, ep.Title, ep.Description))
+
+	for _, any := range ep.Msgs {
+		b.WriteString(fmt.Sprintf("    %s\n", any.TypeUrl))
+	}
+
+	return b.String()
+}
+
+// validateExecSigners returns an error unless the governance module account is
+// the only signer of the message.
+func validateExecSigners(msg sdk.Msg) error {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	for _, signer := range msg.GetSigners() {
+		if !bytes.Equal(signer, govAddr) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "expected %s as signer, got %s", govAddr, signer,
+			)
+		}
+	}
+
+	return nil
+}
+
+// NewExecProposalHandler returns the Handler of the proposals executing
+// messages, which dispatches each message of a passed proposal on behalf of the
+// governance module account. The proposal fails, and none of its messages is
+// committed, if any message fails.
+func NewExecProposalHandler(msgRouter sdk.MsgRouterService) Handler {
+	return func(ctx sdk.Context, content Content) error {
+		ep, ok := content.(*ExecProposal)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov exec proposal content type: %T", content)
+		}
+
+		govAddr := authtypes.NewModuleAddress(ModuleName)
+		for i, msg := range ep.GetMsgs() {
+			if msg == nil {
+				return sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d is not unpacked", i)
+			}
+
+			if _, err := msgRouter.DispatchMsg(ctx, govAddr, msg); err != nil {
+				return sdkerrors.Wrapf(err, "message index: %d", i)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestExecProposalValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+
+	vote := NewMsgVote(govAddr, 1, OptionYes)
+	ep, err := NewExecProposal("title", "description", []sdk.Msg{&vote})
+	require.NoError(t, err)
+	require.NoError(t, ep.ValidateBasic())
+	require.Equal(t, ExecRouterKey, ep.ProposalRoute())
+	require.Equal(t, ProposalTypeExec, ep.ProposalType())
+	require.True(t, IsValidProposalType(ep.ProposalType()))
+
+	// a proposal must execute at least one message
+	ep, err = NewExecProposal("title", "description", nil)
+	require.NoError(t, err)
+	require.True(t, ErrInvalidProposalContent.Is(ep.ValidateBasic()))
+
+	// the messages must be signed by the governance module account only
+	other := NewMsgVote(sdk.AccAddress([]byte("other_______________")), 1, OptionYes)
+	ep, err = NewExecProposal("title", "description", []sdk.Msg{&vote, &other})
+	require.NoError(t, err)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(ep.ValidateBasic()))
+
+	// the messages must be valid
+	invalid := NewMsgVote(govAddr, 1, VoteOption(0x13))
+	ep, err = NewExecProposal("title", "description", []sdk.Msg{&invalid})
+	require.NoError(t, err)
+	require.Error(t, ep.ValidateBasic())
+}
+
+func TestExecProposalHandler(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	var executed []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute(RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if vote, ok := msg.(*MsgVote); ok && vote.ProposalID == 0 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vote failure")
+		}
+
+		executed = append(executed, msg)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("exec"))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	})
+	hdlr := NewExecProposalHandler(baseapp.NewMsgRouterService(router))
+
+	vote1, vote2 := NewMsgVote(govAddr, 1, OptionYes), NewMsgVote(govAddr, 2, OptionNo)
+	ep, err := NewExecProposal("title", "description", []sdk.Msg{&vote1, &vote2})
+	require.NoError(t, err)
+	require.NoError(t, hdlr(ctx, ep))
+	require.Equal(t, []sdk.Msg{&vote1, &vote2}, executed)
+	require.Len(t, ctx.EventManager().Events(), 2)
+
+	// the failure of a message fails the proposal
+	failing := NewMsgVote(govAddr, 0, OptionYes)
+	ep, err = NewExecProposal("title", "description", []sdk.Msg{&vote1, &failing})
+	require.NoError(t, err)
+
+	err = hdlr(ctx, ep)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+	require.Contains(t, err.Error(), "message index: 1")
+
+	// the messages not signed by the governance module account are rejected
+	other := NewMsgVote(sdk.AccAddress([]byte("other_______________")), 1, OptionYes)
+	ep, err = NewExecProposal("title", "description", []sdk.Msg{&other})
+	require.NoError(t, err)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(hdlr(ctx, ep)))
+
+	// other contents are rejected
+	require.Error(t, hdlr(ctx, NewTextProposal("title", "description")))
+}
@@ -18,6 +18,9 @@ const (
 	// RouterKey is the message route for gov
 	RouterKey = ModuleName
 
+	// ExecRouterKey is the proposal route of the proposals executing messages
+	ExecRouterKey = "govexec"
+
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
@@ -50,7 +53,7 @@ var (
 	VotesKeyPrefix = []byte{0x20}
 )
 
-var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+var lenTime = len(sdk.FormatTimeBytes(time.Time{}))
 
 // GetProposalIDBytes returns the byte representation of the proposalID
 func GetProposalIDBytes(proposalID uint64) (proposalIDBz []byte) {
@@ -71,7 +74,7 @@ func ProposalKey(proposalID uint64) []byte {
 
 // ActiveProposalByTimeKey gets the active proposal queue key by endTime
 func ActiveProposalByTimeKey(endTime time.Time) []byte {
-	return append(ActiveProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+	return sdk.TimeQueueKey(ActiveProposalQueuePrefix, endTime)
 }
 
 // ActiveProposalQueueKey returns the key for a proposalID in the activeProposalQueue
@@ -81,7 +84,7 @@ func ActiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 
 // InactiveProposalByTimeKey gets the inactive proposal queue key by endTime
 func InactiveProposalByTimeKey(endTime time.Time) []byte {
-	return append(InactiveProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+	return sdk.TimeQueueKey(InactiveProposalQueuePrefix, endTime)
 }
 
 // InactiveProposalQueueKey returns the key for a proposalID in the inactiveProposalQueue
@@ -14,15 +14,20 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgDeposit        = "deposit"
-	TypeMsgVote           = "vote"
-	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgDeposit           = "deposit"
+	TypeMsgVote              = "vote"
+	TypeMsgVoteWeighted      = "weighted_vote"
+	TypeMsgSubmitProposal    = "submit_proposal"
+	TypeMsgExecLegacyContent = "exec_legacy_content"
 )
 
 var (
 	_, _, _ sdk.Msg                       = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
 	_       MsgSubmitProposalI            = &MsgSubmitProposal{}
 	_       types.UnpackInterfacesMessage = MsgSubmitProposal{}
+	_       sdk.Msg                       = MsgVoteWeighted{}
+	_       sdk.Msg                       = &MsgExecLegacyContent{}
+	_       types.UnpackInterfacesMessage = MsgExecLegacyContent{}
 )
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
@@ -224,3 +229,112 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote on an active proposal,
+// splitting the voting power of the voter across the given options
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{ProposalID: proposalID, Voter: voter, Options: options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return msg.Options.Validate()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// NewMsgExecLegacyContent creates a new MsgExecLegacyContent, executing the
+// given Content once signed by the authority.
+func NewMsgExecLegacyContent(content Content, authority sdk.AccAddress) (*MsgExecLegacyContent, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", content)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgExecLegacyContent{Content: any, Authority: authority}, nil
+}
+
+// GetContent returns the Content executed by the message
+func (m MsgExecLegacyContent) GetContent() Content {
+	content, ok := m.Content.GetCachedValue().(Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// Route implements Msg
+func (m MsgExecLegacyContent) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgExecLegacyContent) Type() string { return TypeMsgExecLegacyContent }
+
+// ValidateBasic implements Msg
+func (m MsgExecLegacyContent) ValidateBasic() error {
+	if m.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority cannot be empty")
+	}
+
+	content := m.GetContent()
+	if content == nil {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
+	}
+	if !IsValidProposalType(content.ProposalType()) {
+		return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
+	}
+
+	return content.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (m MsgExecLegacyContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgExecLegacyContent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Authority}
+}
+
+// String implements the Stringer interface
+func (m MsgExecLegacyContent) String() string {
+	out, _ := yaml.Marshal(m)
+	return string(out)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgExecLegacyContent) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var content Content
+	return unpacker.UnpackAny(m.Content, &content)
+}
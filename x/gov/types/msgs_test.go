@@ -118,6 +118,55 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes")
+	require.NoError(t, err)
+	require.Equal(t, NewNonSplitVoteOption(OptionYes), options)
+	require.Equal(t, "Yes", options.String())
+
+	options, err = WeightedVoteOptionsFromString("Yes=0.6,NoWithVeto=0.4")
+	require.NoError(t, err)
+	require.NoError(t, options.Validate())
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	}, options)
+
+	_, err = WeightedVoteOptionsFromString("Yes=0.6,Maybe=0.4")
+	require.Error(t, err)
+
+	_, err = WeightedVoteOptionsFromString("Yes=0.6=0.4")
+	require.Error(t, err)
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
@@ -21,6 +21,8 @@ var (
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+
+	DefaultMinInitialDepositRatio = sdk.ZeroDec()
 )
 
 // Parameter store key
@@ -43,13 +45,27 @@ func ParamKeyTable() paramtypes.KeyTable {
 type DepositParams struct {
 	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
 	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+
+	// MinInitialDepositRatio is the fraction of MinDeposit that must be paid
+	// when a proposal is submitted. A zero value disables the check.
+	MinInitialDepositRatio sdk.Dec `json:"min_initial_deposit_ratio,omitempty" yaml:"min_initial_deposit_ratio,omitempty"`
+	// BurnVoteQuorum burns the deposits of proposals that do not reach quorum.
+	BurnVoteQuorum bool `json:"burn_vote_quorum,omitempty" yaml:"burn_vote_quorum,omitempty"`
+	// BurnVoteVeto burns the deposits of proposals that are vetoed.
+	BurnVoteVeto bool `json:"burn_vote_veto,omitempty" yaml:"burn_vote_veto,omitempty"`
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, minInitialDepositRatio sdk.Dec,
+	burnVoteQuorum, burnVoteVeto bool,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:             minDeposit,
+		MaxDepositPeriod:       maxDepositPeriod,
+		MinInitialDepositRatio: minInitialDepositRatio,
+		BurnVoteQuorum:         burnVoteQuorum,
+		BurnVoteVeto:           burnVoteVeto,
 	}
 }
 
@@ -58,6 +74,9 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		DefaultMinInitialDepositRatio,
+		true,
+		true,
 	)
 }
 
@@ -69,7 +88,34 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.GetMinInitialDepositRatio().Equal(dp2.GetMinInitialDepositRatio()) &&
+		dp.BurnVoteQuorum == dp2.BurnVoteQuorum && dp.BurnVoteVeto == dp2.BurnVoteVeto
+}
+
+// GetMinInitialDepositRatio returns the minimum initial deposit ratio, treating
+// an unset value as zero.
+func (dp DepositParams) GetMinInitialDepositRatio() sdk.Dec {
+	if dp.MinInitialDepositRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return dp.MinInitialDepositRatio
+}
+
+// MinInitialDeposit returns the minimum amount of coins that must be deposited
+// when a proposal is submitted.
+func (dp DepositParams) MinInitialDeposit() sdk.Coins {
+	ratio := dp.GetMinInitialDepositRatio()
+	if ratio.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	minInitialDeposit := make(sdk.Coins, 0, len(dp.MinDeposit))
+	for _, coin := range dp.MinDeposit {
+		amount := coin.Amount.ToDec().Mul(ratio).Ceil().TruncateInt()
+		minInitialDeposit = append(minInitialDeposit, sdk.NewCoin(coin.Denom, amount))
+	}
+	return sdk.NewCoins(minInitialDeposit...)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -84,6 +130,14 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.MinInitialDepositRatio.IsNil() {
+		if v.MinInitialDepositRatio.IsNegative() {
+			return fmt.Errorf("minimum initial deposit ratio cannot be negative: %s", v.MinInitialDepositRatio)
+		}
+		if v.MinInitialDepositRatio.GT(sdk.OneDec()) {
+			return fmt.Errorf("minimum initial deposit ratio too large: %s", v.MinInitialDepositRatio)
+		}
+	}
 
 	return nil
 }
@@ -245,6 +245,7 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 // Proposal types
 const (
 	ProposalTypeText string = "Text"
+	ProposalTypeExec string = "Exec"
 )
 
 // Implements Content Interface
@@ -278,6 +279,7 @@ func (tp TextProposal) String() string {
 
 var validProposalTypes = map[string]struct{}{
 	ProposalTypeText: {},
+	ProposalTypeExec: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -395,6 +395,7 @@ type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
@@ -429,6 +430,165 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// ExecProposal defines a proposal executing messages on behalf of the
+// governance module account once passed
+type ExecProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Msgs        []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *ExecProposal) Reset()      { *m = ExecProposal{} }
+func (*ExecProposal) ProtoMessage() {}
+func (*ExecProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{8}
+}
+func (m *ExecProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecProposal.Merge(m, src)
+}
+func (m *ExecProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecProposal proto.InternalMessageInfo
+
+// MsgExecLegacyContent defines an sdk.Msg executing a legacy proposal Content
+// through the governance proposal router, signed by the governance module
+// account through an ExecProposal.
+type MsgExecLegacyContent struct {
+	Content   *types.Any                                    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+}
+
+func (m *MsgExecLegacyContent) Reset()      { *m = MsgExecLegacyContent{} }
+func (*MsgExecLegacyContent) ProtoMessage() {}
+func (*MsgExecLegacyContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{9}
+}
+func (m *MsgExecLegacyContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecLegacyContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecLegacyContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecLegacyContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecLegacyContent.Merge(m, src)
+}
+func (m *MsgExecLegacyContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecLegacyContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecLegacyContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecLegacyContent proto.InternalMessageInfo
+
+// WeightedVoteOption defines a vote option voted with a fraction of the voting
+// power of a voter.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{10}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
+// MsgVoteWeighted defines a message to cast a vote split across several vote
+// options, with weights adding up to one.
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{11}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -440,92 +600,103 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos_sdk.x.gov.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos_sdk.x.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos_sdk.x.gov.v1.Vote")
+	proto.RegisterType((*ExecProposal)(nil), "cosmos_sdk.x.gov.v1.ExecProposal")
+	proto.RegisterType((*MsgExecLegacyContent)(nil), "cosmos_sdk.x.gov.v1.MsgExecLegacyContent")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos_sdk.x.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos_sdk.x.gov.v1.MsgVoteWeighted")
 }
 
 func init() { proto.RegisterFile("x/gov/types/types.proto", fileDescriptor_a5ae5e91b5b3fb03) }
 
 var fileDescriptor_a5ae5e91b5b3fb03 = []byte{
-	// 1275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xbd, 0x6f, 0xdb, 0xd6,
-	0x17, 0x15, 0xe9, 0x0f, 0xd9, 0x4f, 0xb2, 0x4d, 0x3f, 0x1b, 0xb1, 0xc2, 0x1f, 0x7e, 0x24, 0xa3,
-	0x04, 0x81, 0x91, 0x26, 0x74, 0xe2, 0x0c, 0x45, 0x53, 0xa0, 0xa8, 0x64, 0x31, 0x89, 0x82, 0x58,
-	0x12, 0x28, 0xc6, 0x46, 0x5a, 0x14, 0x04, 0x2d, 0xbe, 0xc8, 0x6c, 0x24, 0x3e, 0x55, 0xef, 0x59,
-	0x8d, 0xb6, 0xa0, 0x43, 0x11, 0x68, 0xca, 0xd8, 0x45, 0x40, 0x81, 0x66, 0x28, 0x3a, 0x65, 0xe8,
-	0x1f, 0x61, 0x74, 0x0a, 0x8a, 0x16, 0x08, 0x3a, 0x28, 0x8d, 0x33, 0xb4, 0xe8, 0xd0, 0x21, 0x63,
-	0xa7, 0x82, 0x7c, 0x8f, 0x11, 0x2d, 0x3b, 0x4d, 0xdc, 0x0f, 0xa0, 0xe8, 0x62, 0x98, 0x97, 0xe7,
-	0x9c, 0xfb, 0xee, 0xe1, 0xbd, 0x97, 0x14, 0x58, 0xba, 0xb3, 0x52, 0xc7, 0x9d, 0x15, 0xda, 0x6d,
-	0x21, 0xc2, 0xfe, 0xea, 0xad, 0x36, 0xa6, 0x18, 0x2e, 0xd4, 0x30, 0x69, 0x62, 0x62, 0x13, 0xf7,
-	0xb6, 0x7e, 0x47, 0xaf, 0xe3, 0x8e, 0xde, 0xb9, 0x20, 0xcf, 0x1f, 0xc0, 0xc9, 0xa7, 0xe9, 0xb6,
-	0xd7, 0x76, 0xed, 0x96, 0xd3, 0xa6, 0xdd, 0x95, 0x30, 0xb4, 0x52, 0xc7, 0x75, 0x3c, 0xfc, 0x8f,
-	0xe3, 0xde, 0x38, 0x88, 0x63, 0x19, 0xce, 0xc5, 0x2f, 0x38, 0x58, 0xad, 0x63, 0x5c, 0x6f, 0x20,
-	0x86, 0xdb, 0xda, 0xb9, 0xb5, 0x42, 0xbd, 0x26, 0x22, 0xd4, 0x69, 0xb6, 0x38, 0xe0, 0xf8, 0x28,
-	0xc0, 0xf1, 0xbb, 0xec, 0x56, 0xf6, 0xa1, 0x08, 0xe6, 0xd7, 0x49, 0xbd, 0xba, 0xb3, 0xd5, 0xf4,
-	0x68, 0xa5, 0x8d, 0x5b, 0x98, 0x38, 0x0d, 0xf8, 0x36, 0x48, 0xd6, 0xb0, 0x4f, 0x91, 0x4f, 0x33,
-	0x82, 0x26, 0x2c, 0xa7, 0x56, 0x17, 0x75, 0x26, 0xa1, 0x47, 0x12, 0x7a, 0xce, 0xef, 0xe6, 0x53,
-	0xdf, 0x7c, 0x7d, 0x2e, 0xb9, 0xc6, 0x80, 0x66, 0xc4, 0x80, 0xf7, 0x04, 0x30, 0xe7, 0xf9, 0x1e,
-	0xf5, 0x9c, 0x86, 0xed, 0xa2, 0x16, 0x26, 0x1e, 0xcd, 0x88, 0xda, 0xd8, 0x72, 0x6a, 0x75, 0x41,
-	0x8f, 0xd9, 0xd4, 0xb9, 0xa0, 0xaf, 0x61, 0xcf, 0xcf, 0x5f, 0xdb, 0x1d, 0xa8, 0x89, 0xe7, 0x03,
-	0xf5, 0x58, 0xd7, 0x69, 0x36, 0x2e, 0x65, 0x47, 0x98, 0xd9, 0xaf, 0x9e, 0xa8, 0xcb, 0x75, 0x8f,
-	0x6e, 0xef, 0x6c, 0xe9, 0x35, 0xdc, 0xe4, 0x85, 0x47, 0x66, 0x10, 0xf7, 0x36, 0xb7, 0x37, 0x90,
-	0x22, 0xe6, 0x2c, 0x67, 0x17, 0x18, 0x19, 0xae, 0x83, 0xa9, 0x56, 0x58, 0x13, 0x6a, 0x67, 0xc6,
-	0x34, 0x61, 0x39, 0x9d, 0xbf, 0xf0, 0xdb, 0x40, 0x3d, 0xf7, 0x1a, 0x7a, 0xb9, 0x5a, 0x2d, 0xe7,
-	0xba, 0x6d, 0x44, 0x88, 0xf9, 0x42, 0xe2, 0xd2, 0xf8, 0xcf, 0x9f, 0xab, 0x42, 0xf6, 0x27, 0x01,
-	0x24, 0xd7, 0x49, 0x7d, 0x03, 0x53, 0x04, 0x2d, 0x90, 0x6a, 0x71, 0xd3, 0x6c, 0xcf, 0x0d, 0xcd,
-	0x1a, 0xcf, 0x5f, 0xdc, 0x1b, 0xa8, 0x20, 0xf2, 0xb2, 0x58, 0xf8, 0x65, 0xa0, 0xc6, 0x41, 0xcf,
-	0x07, 0x2a, 0x64, 0xa5, 0xc6, 0x82, 0x59, 0x13, 0x44, 0x57, 0x45, 0x17, 0x5e, 0x01, 0x13, 0x1d,
-	0x4c, 0x51, 0x3b, 0x23, 0xfe, 0xd9, 0x33, 0x33, 0x3e, 0x7c, 0x13, 0x4c, 0xe2, 0x16, 0xf5, 0xb0,
-	0x1f, 0x56, 0x3f, 0xbb, 0xaa, 0xea, 0x87, 0xf4, 0xa9, 0x1e, 0x54, 0x52, 0x0e, 0x61, 0x26, 0x87,
-	0xf3, 0x4a, 0x3f, 0x13, 0x01, 0x58, 0x27, 0xf5, 0xc8, 0xcd, 0x7f, 0xa6, 0xd8, 0x32, 0x98, 0xe6,
-	0xcf, 0x1a, 0xff, 0x85, 0x82, 0x87, 0x1a, 0xf0, 0x03, 0x30, 0xe9, 0x34, 0xf1, 0x8e, 0x4f, 0x33,
-	0x63, 0x2f, 0xef, 0xba, 0xf3, 0x41, 0xd7, 0x1d, 0xa9, 0xb7, 0xb8, 0x28, 0xb7, 0x66, 0x13, 0xa4,
-	0x2d, 0x74, 0x67, 0x38, 0x31, 0x8b, 0x60, 0x82, 0x7a, 0xb4, 0x81, 0x42, 0x57, 0xa6, 0x4d, 0x76,
-	0x01, 0x35, 0x90, 0x72, 0x11, 0xa9, 0xb5, 0x3d, 0xf6, 0x10, 0xc4, 0xf0, 0x5e, 0x3c, 0x74, 0x69,
-	0x2e, 0x50, 0xfb, 0x76, 0x38, 0x46, 0xd9, 0x4f, 0x45, 0x90, 0x8c, 0x0c, 0x37, 0x0e, 0x33, 0xfc,
-	0xd4, 0x7e, 0xc3, 0xff, 0xb3, 0x0e, 0xdf, 0x4d, 0x82, 0xa9, 0x17, 0xf6, 0xe6, 0x0f, 0x73, 0xe2,
-	0xc4, 0x81, 0xd6, 0x13, 0xc3, 0x8e, 0x9b, 0xe6, 0x9b, 0x64, 0xc4, 0x86, 0xd8, 0x52, 0x13, 0x8f,
-	0xbc, 0xd4, 0x36, 0xc1, 0x24, 0xa1, 0x0e, 0xdd, 0x21, 0x7c, 0x92, 0x4e, 0x1e, 0x3a, 0x49, 0xd1,
-	0x61, 0xaa, 0x21, 0x34, 0x2f, 0x0f, 0xd7, 0xda, 0x8b, 0xd3, 0x33, 0x95, 0xac, 0xc9, 0xe5, 0xe0,
-	0x47, 0x00, 0xde, 0xf2, 0x7c, 0xa7, 0x61, 0x53, 0xa7, 0xd1, 0xe8, 0xda, 0x6d, 0x44, 0x76, 0x1a,
-	0x34, 0x33, 0x1e, 0x1e, 0x50, 0x3b, 0x34, 0x89, 0x15, 0x00, 0xcd, 0x10, 0x97, 0x3f, 0xc1, 0x97,
-	0xe7, 0x71, 0x96, 0xe5, 0xa0, 0x52, 0xd6, 0x94, 0xc2, 0x60, 0x8c, 0x04, 0xdf, 0x07, 0x29, 0x12,
-	0xee, 0x7b, 0x3b, 0x78, 0x51, 0x64, 0x26, 0xc2, 0x5c, 0xf2, 0x01, 0x33, 0xac, 0xe8, 0x2d, 0x92,
-	0x57, 0x78, 0x16, 0xde, 0x68, 0x31, 0x72, 0xf6, 0xfe, 0x13, 0x55, 0x30, 0x01, 0x8b, 0x04, 0x04,
-	0xe8, 0x01, 0x89, 0x37, 0x8a, 0x8d, 0x7c, 0x97, 0x65, 0x98, 0x7c, 0x65, 0x86, 0x93, 0x3c, 0xc3,
-	0x12, 0xcb, 0x30, 0xaa, 0xc0, 0xd2, 0xcc, 0xf2, 0xb0, 0xe1, 0xbb, 0x61, 0xaa, 0x4f, 0x04, 0x30,
-	0x43, 0x31, 0x8d, 0xbd, 0x66, 0x92, 0x2f, 0x6f, 0xc7, 0xab, 0x3c, 0xc3, 0x22, 0xcb, 0xb0, 0x8f,
-	0x77, 0xb4, 0x97, 0x4c, 0x3a, 0xe4, 0x46, 0x33, 0xda, 0x00, 0xf3, 0x1d, 0x4c, 0x3d, 0xbf, 0x1e,
-	0x3c, 0xd9, 0x36, 0xb7, 0x74, 0xea, 0x95, 0x05, 0x9f, 0xe2, 0xc7, 0xc9, 0xb0, 0xe3, 0x1c, 0x90,
-	0x60, 0x15, 0xcf, 0xb1, 0x78, 0x35, 0x08, 0x87, 0x25, 0xdf, 0x02, 0x3c, 0x34, 0x34, 0x77, 0xfa,
-	0x95, 0xb9, 0xb2, 0xfb, 0xdf, 0xb0, 0x23, 0x02, 0x2c, 0xd3, 0x0c, 0x8b, 0x72, 0x6b, 0xf9, 0x08,
-	0xee, 0x8a, 0x20, 0x15, 0x6f, 0x9c, 0x77, 0xc1, 0x58, 0x17, 0x11, 0xb6, 0xe2, 0xf2, 0x7a, 0xa0,
-	0xfa, 0xc3, 0x40, 0x3d, 0xfd, 0x1a, 0xc6, 0x15, 0x7d, 0x6a, 0x06, 0x54, 0x78, 0x15, 0x24, 0x9d,
-	0x2d, 0x42, 0x1d, 0x8f, 0x2f, 0xc3, 0x23, 0xab, 0x44, 0x74, 0xf8, 0x0e, 0x10, 0x7d, 0x1c, 0x0e,
-	0xe3, 0xd1, 0x45, 0x44, 0x1f, 0xc3, 0x3a, 0x48, 0xfb, 0xd8, 0xfe, 0xd8, 0xa3, 0xdb, 0x76, 0x07,
-	0x51, 0x1c, 0x4e, 0xdc, 0x74, 0xde, 0x38, 0x9a, 0xd2, 0xf3, 0x81, 0xba, 0xc0, 0x4c, 0x8d, 0x6b,
-	0x65, 0x4d, 0xe0, 0xe3, 0x4d, 0x8f, 0x6e, 0x6f, 0x20, 0x8a, 0xb9, 0x95, 0xdf, 0x0b, 0x60, 0x3c,
-	0xfc, 0x62, 0xf8, 0x9b, 0x76, 0xfa, 0xbf, 0xe4, 0x13, 0xe1, 0xcc, 0xaf, 0x02, 0x00, 0xc3, 0x9b,
-	0xf0, 0x2c, 0x58, 0xda, 0x28, 0x5b, 0x86, 0x5d, 0xae, 0x58, 0xc5, 0x72, 0xc9, 0xbe, 0x51, 0xaa,
-	0x56, 0x8c, 0xb5, 0xe2, 0xe5, 0xa2, 0x51, 0x90, 0x12, 0xf2, 0x5c, 0xaf, 0xaf, 0xa5, 0x18, 0xd0,
-	0x68, 0xb6, 0x68, 0x17, 0x66, 0xc1, 0x5c, 0x1c, 0x7d, 0xd3, 0xa8, 0x4a, 0x82, 0x3c, 0xd3, 0xeb,
-	0x6b, 0xd3, 0x0c, 0x75, 0x13, 0x11, 0x78, 0x06, 0x2c, 0xc4, 0x31, 0xb9, 0x7c, 0xd5, 0xca, 0x15,
-	0x4b, 0x92, 0x28, 0xcf, 0xf7, 0xfa, 0xda, 0x0c, 0xc3, 0xe5, 0x78, 0x4f, 0x68, 0x60, 0x36, 0x8e,
-	0x2d, 0x95, 0xa5, 0x31, 0x39, 0xdd, 0xeb, 0x6b, 0x53, 0x0c, 0x56, 0xc2, 0x70, 0x15, 0x64, 0xf6,
-	0x23, 0xec, 0xcd, 0xa2, 0x75, 0xd5, 0xde, 0x30, 0xac, 0xb2, 0x34, 0x2e, 0x2f, 0xf6, 0xfa, 0x9a,
-	0x14, 0x61, 0xa3, 0x07, 0x28, 0xa7, 0xef, 0x7d, 0xa1, 0x24, 0xbe, 0x7c, 0xa0, 0x24, 0x1e, 0x3e,
-	0x50, 0x12, 0x67, 0xbe, 0x13, 0xc1, 0xec, 0xfe, 0x35, 0x0f, 0x75, 0xf0, 0xbf, 0x8a, 0x59, 0xae,
-	0x94, 0xab, 0xb9, 0xeb, 0x76, 0xd5, 0xca, 0x59, 0x37, 0xaa, 0x23, 0x85, 0x87, 0x25, 0x31, 0x70,
-	0xc9, 0x0b, 0xbe, 0xae, 0x95, 0x51, 0x7c, 0xc1, 0xa8, 0x94, 0xab, 0x45, 0xcb, 0xae, 0x18, 0x66,
-	0xb1, 0x5c, 0x90, 0x04, 0x79, 0xa9, 0xd7, 0xd7, 0x16, 0x18, 0x85, 0x6f, 0x9a, 0x0a, 0x6a, 0x7b,
-	0xd8, 0x85, 0x6f, 0x81, 0xff, 0x8f, 0x92, 0x37, 0xca, 0x56, 0xb1, 0x74, 0x25, 0xe2, 0x8a, 0xf2,
-	0xb1, 0x5e, 0x5f, 0x83, 0x8c, 0xbb, 0x11, 0x4e, 0x35, 0xa7, 0x9e, 0x05, 0xc7, 0x46, 0xa9, 0x95,
-	0x5c, 0xb5, 0x6a, 0x14, 0xa4, 0x31, 0x59, 0xea, 0xf5, 0xb5, 0x34, 0xe3, 0x54, 0x1c, 0x42, 0x90,
-	0x0b, 0xcf, 0x83, 0xcc, 0x28, 0xda, 0x34, 0xae, 0x19, 0x6b, 0x96, 0x51, 0x90, 0xc6, 0x65, 0xd8,
-	0xeb, 0x6b, 0xb3, 0x0c, 0x6f, 0xa2, 0x0f, 0x51, 0x8d, 0xa2, 0x43, 0xf5, 0x2f, 0xe7, 0x8a, 0xd7,
-	0x8d, 0x82, 0x34, 0x11, 0xd7, 0xbf, 0xec, 0x78, 0x0d, 0xe4, 0xee, 0xb7, 0x35, 0x5f, 0xda, 0x7d,
-	0xaa, 0x24, 0x1e, 0x3f, 0x55, 0x12, 0x77, 0xf7, 0x94, 0xc4, 0xee, 0x9e, 0x22, 0x3c, 0xda, 0x53,
-	0x84, 0x1f, 0xf7, 0x14, 0xe1, 0xfe, 0x33, 0x25, 0xf1, 0xe8, 0x99, 0x92, 0x78, 0xfc, 0x4c, 0x49,
-	0xbc, 0xf7, 0xc7, 0x4b, 0x3a, 0xf6, 0xe3, 0x6c, 0x6b, 0x32, 0xdc, 0x83, 0x17, 0x7f, 0x0f, 0x00,
-	0x00, 0xff, 0xff, 0xd6, 0x34, 0x18, 0x2d, 0xb2, 0x0d, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x58, 0xcf, 0x6f, 0xdb, 0x54,
+	0x1c, 0x8f, 0x9d, 0xb4, 0x69, 0x5f, 0xd2, 0x36, 0x7d, 0xad, 0xda, 0xcc, 0x13, 0x49, 0xe7, 0x4d,
+	0x63, 0x1a, 0xab, 0xbb, 0x1f, 0x07, 0x44, 0x91, 0x80, 0xb8, 0xf5, 0xb6, 0x4c, 0x5b, 0x12, 0x39,
+	0x59, 0xab, 0x81, 0xc0, 0x72, 0x63, 0x37, 0x35, 0x24, 0x76, 0x88, 0x5f, 0xba, 0xe6, 0x36, 0x71,
+	0x40, 0x13, 0xa7, 0xdd, 0xe0, 0x82, 0x84, 0x04, 0x42, 0x88, 0xd3, 0x0e, 0xf0, 0x3f, 0x54, 0x9c,
+	0x26, 0xc4, 0x61, 0xe2, 0xd0, 0xc1, 0x38, 0x80, 0x38, 0x70, 0xe0, 0xc8, 0x89, 0xaf, 0xfd, 0x9e,
+	0x1b, 0x37, 0xc9, 0xe8, 0x42, 0x99, 0x40, 0x1c, 0x5c, 0xd9, 0xcf, 0x9f, 0xef, 0xe7, 0xfb, 0xbe,
+	0x1f, 0x7f, 0x7f, 0xbc, 0x14, 0xcd, 0xef, 0x2c, 0xd5, 0x9c, 0xed, 0x25, 0xd2, 0x69, 0x9a, 0x2e,
+	0xfd, 0x2b, 0x35, 0x5b, 0x0e, 0x71, 0xf0, 0x4c, 0xd5, 0x71, 0x1b, 0x8e, 0xab, 0xb9, 0xc6, 0x3b,
+	0xd2, 0x8e, 0x04, 0x18, 0x69, 0xfb, 0x82, 0x30, 0xdd, 0x87, 0x13, 0x4e, 0x93, 0x2d, 0xab, 0x65,
+	0x68, 0x4d, 0xbd, 0x45, 0x3a, 0x4b, 0xfe, 0x12, 0x10, 0xd6, 0x9c, 0xee, 0x1d, 0xc3, 0xbd, 0xd0,
+	0x8f, 0xa3, 0x1e, 0x16, 0xc3, 0x0f, 0x0c, 0x9c, 0xad, 0x39, 0x4e, 0xad, 0x6e, 0x52, 0xdc, 0x46,
+	0x7b, 0x73, 0x89, 0x58, 0x0d, 0xd3, 0x25, 0x7a, 0xa3, 0xc9, 0x00, 0xc7, 0x7a, 0x01, 0xba, 0xdd,
+	0xa1, 0xaf, 0xc4, 0xfb, 0x3c, 0x9a, 0xbe, 0xe1, 0xd6, 0xca, 0xed, 0x8d, 0x86, 0x45, 0x4a, 0x2d,
+	0xa7, 0xe9, 0xb8, 0x7a, 0x1d, 0xbf, 0x8c, 0xe2, 0x55, 0xc7, 0x26, 0xa6, 0x4d, 0xd2, 0xdc, 0x02,
+	0x77, 0x26, 0x71, 0x71, 0x56, 0xa2, 0x14, 0x52, 0x40, 0x21, 0xe5, 0xec, 0x8e, 0x9c, 0xf8, 0xe6,
+	0xab, 0xc5, 0xf8, 0x0a, 0x05, 0xaa, 0x81, 0x05, 0xbe, 0xcb, 0xa1, 0x29, 0xcb, 0xb6, 0x88, 0xa5,
+	0xd7, 0x35, 0xc3, 0x04, 0x42, 0x8b, 0xa4, 0xf9, 0x85, 0x28, 0xb0, 0xcc, 0x48, 0x21, 0x99, 0xb6,
+	0x2f, 0x48, 0x2b, 0x8e, 0x65, 0xcb, 0xd7, 0x76, 0xf7, 0xb2, 0x91, 0xdf, 0xf7, 0xb2, 0x73, 0x1d,
+	0xbd, 0x51, 0x5f, 0x16, 0x7b, 0x2c, 0xc5, 0x2f, 0x1f, 0x65, 0xcf, 0xd4, 0x2c, 0xb2, 0xd5, 0xde,
+	0x00, 0xe3, 0x06, 0x0b, 0x3c, 0x10, 0x03, 0x78, 0x98, 0xbc, 0x1e, 0x95, 0xab, 0x4e, 0x32, 0xeb,
+	0x55, 0x6a, 0x8c, 0x6f, 0xa0, 0xb1, 0xa6, 0x1f, 0x93, 0xd9, 0x4a, 0x47, 0x21, 0x90, 0xa4, 0x7c,
+	0xe1, 0x8f, 0xbd, 0xec, 0xe2, 0x53, 0xf0, 0xe5, 0xaa, 0xd5, 0x9c, 0x61, 0xb4, 0x4c, 0xd7, 0x55,
+	0xf7, 0x29, 0x96, 0x63, 0xbf, 0x7c, 0x92, 0xe5, 0xc4, 0x9f, 0x39, 0x14, 0x07, 0xc9, 0xd6, 0x1c,
+	0x62, 0xe2, 0x0a, 0x4a, 0x34, 0x99, 0x68, 0x9a, 0x65, 0xf8, 0x62, 0xc5, 0xe4, 0x4b, 0x8f, 0xf7,
+	0xb2, 0x28, 0xd0, 0x32, 0xbf, 0xfa, 0xeb, 0x5e, 0x36, 0x0c, 0x82, 0x50, 0x31, 0x0d, 0x35, 0xb4,
+	0x28, 0xaa, 0x28, 0x78, 0xca, 0x1b, 0xf8, 0x0a, 0x1a, 0xd9, 0x06, 0xf6, 0x16, 0xc8, 0xf6, 0x37,
+	0xf7, 0x4c, 0xed, 0xf1, 0x8b, 0x68, 0xd4, 0x69, 0x12, 0xcb, 0xb1, 0xfd, 0xe8, 0x27, 0x2f, 0x66,
+	0xa5, 0x01, 0x79, 0x2a, 0x79, 0x91, 0x14, 0x7d, 0x98, 0xca, 0xe0, 0x2c, 0xd2, 0x8f, 0x78, 0x84,
+	0x20, 0xd2, 0x40, 0xcd, 0x67, 0x13, 0x6c, 0x11, 0x8d, 0xb3, 0x6f, 0xed, 0x1c, 0x21, 0xe0, 0x2e,
+	0x07, 0x7e, 0x13, 0x8d, 0xea, 0x0d, 0xa7, 0x0d, 0xb9, 0x1b, 0x7d, 0x72, 0xd6, 0x9d, 0xf7, 0xb2,
+	0x6e, 0xa8, 0xdc, 0x62, 0xa4, 0x4c, 0x9a, 0x75, 0x94, 0xac, 0x98, 0x3b, 0xdd, 0x8a, 0x99, 0x45,
+	0x23, 0xc4, 0x22, 0x75, 0xd3, 0x57, 0x65, 0x5c, 0xa5, 0x0f, 0x78, 0x01, 0x25, 0x0c, 0xd3, 0xad,
+	0xb6, 0x2c, 0xfa, 0x11, 0x78, 0xff, 0x5d, 0x78, 0x69, 0x79, 0xca, 0x63, 0xfb, 0xb6, 0x5b, 0x46,
+	0xe2, 0xfb, 0x3c, 0x8a, 0x07, 0x82, 0x2b, 0x83, 0x04, 0x3f, 0x75, 0x50, 0xf0, 0xff, 0xad, 0xc2,
+	0x77, 0xe2, 0x68, 0x6c, 0x5f, 0x5e, 0x79, 0x90, 0x12, 0x27, 0xfa, 0x52, 0x8f, 0xf7, 0x33, 0x6e,
+	0x9c, 0x75, 0x92, 0x1e, 0x19, 0x42, 0x4d, 0x8d, 0x1f, 0xba, 0xa9, 0xad, 0xa3, 0x51, 0xe8, 0xa8,
+	0xa4, 0xed, 0xb2, 0x4a, 0x3a, 0x39, 0xb0, 0x92, 0x82, 0xcd, 0x94, 0x7d, 0xa8, 0x2c, 0x74, 0xdb,
+	0xda, 0xfe, 0xee, 0x29, 0x8b, 0xa8, 0x32, 0x3a, 0xfc, 0x2e, 0xc2, 0x9b, 0x96, 0x0d, 0x2f, 0x88,
+	0x5e, 0xaf, 0x77, 0x34, 0x90, 0xba, 0x5d, 0x27, 0xe9, 0x98, 0xbf, 0xc1, 0x85, 0x81, 0x4e, 0x2a,
+	0x1e, 0x50, 0xf5, 0x71, 0xf2, 0x09, 0xd6, 0x3c, 0x8f, 0x51, 0x2f, 0xfd, 0x4c, 0xa2, 0x9a, 0xf2,
+	0x17, 0x43, 0x46, 0xf8, 0x0d, 0x94, 0x70, 0xfd, 0x7e, 0xaf, 0x79, 0x83, 0x22, 0x3d, 0xe2, 0xfb,
+	0x12, 0xfa, 0xc4, 0xa8, 0x04, 0x53, 0x44, 0xce, 0x30, 0x2f, 0x2c, 0xd1, 0x42, 0xc6, 0xe2, 0xbd,
+	0x47, 0x59, 0x4e, 0x45, 0x74, 0xc5, 0x33, 0xc0, 0x16, 0x4a, 0xb1, 0x44, 0xd1, 0x4c, 0xdb, 0xa0,
+	0x1e, 0x46, 0x0f, 0xf5, 0x70, 0x92, 0x79, 0x98, 0xa7, 0x1e, 0x7a, 0x19, 0xa8, 0x9b, 0x49, 0xb6,
+	0xac, 0xd8, 0x86, 0xef, 0xea, 0x3d, 0x0e, 0x4d, 0x10, 0x87, 0x84, 0xc6, 0x4c, 0xfc, 0xc9, 0xe9,
+	0x78, 0x95, 0x79, 0x98, 0xa5, 0x1e, 0x0e, 0xd8, 0x0d, 0x37, 0x64, 0x92, 0xbe, 0x6d, 0x50, 0xa3,
+	0x75, 0x34, 0x0d, 0xbd, 0xd6, 0xb2, 0x6b, 0xde, 0x97, 0x6d, 0x31, 0x49, 0xc7, 0x0e, 0x0d, 0xf8,
+	0x14, 0xdb, 0x4e, 0x9a, 0x6e, 0xa7, 0x8f, 0x82, 0x46, 0x3c, 0x45, 0xd7, 0xcb, 0xde, 0xb2, 0x1f,
+	0xf2, 0x26, 0x62, 0x4b, 0x5d, 0x71, 0xc7, 0x0f, 0xf5, 0x25, 0x1e, 0x9c, 0xb0, 0x3d, 0x04, 0xd4,
+	0xd3, 0x04, 0x5d, 0x65, 0xd2, 0xb2, 0x12, 0xdc, 0xe5, 0x51, 0x22, 0x9c, 0x38, 0xaf, 0xa1, 0x68,
+	0xc7, 0x74, 0x69, 0x8b, 0x93, 0x25, 0x8f, 0xf5, 0xfb, 0xbd, 0xec, 0xe9, 0xa7, 0x10, 0x2e, 0x0f,
+	0xa5, 0xe4, 0x99, 0xe2, 0xab, 0x28, 0xae, 0x6f, 0xc0, 0xae, 0x2c, 0xd6, 0x0c, 0x87, 0x66, 0x09,
+	0xcc, 0xf1, 0x2b, 0x88, 0xb7, 0x1d, 0xbf, 0x18, 0x87, 0x27, 0x01, 0x4b, 0x5c, 0x43, 0x49, 0xdb,
+	0xd1, 0x6e, 0x83, 0x81, 0xb6, 0x6d, 0x12, 0xc7, 0xaf, 0xb8, 0x71, 0x59, 0x19, 0x8e, 0x09, 0x44,
+	0x9d, 0xa1, 0xa2, 0x86, 0xb9, 0xa0, 0xed, 0xd8, 0xce, 0x3a, 0x3c, 0xad, 0xc1, 0x03, 0x93, 0xf2,
+	0x6b, 0x1e, 0xc5, 0xfc, 0x13, 0xc3, 0x3f, 0xd4, 0xd3, 0xff, 0xf5, 0x23, 0x02, 0x7e, 0x0b, 0xc5,
+	0xe9, 0x9d, 0x0b, 0xda, 0x79, 0x65, 0xf7, 0xfc, 0x40, 0xcb, 0x75, 0xd3, 0xaa, 0x6d, 0x11, 0xd3,
+	0xe8, 0x32, 0xc8, 0xc7, 0xd9, 0x64, 0x98, 0xe9, 0x7f, 0xe7, 0xaa, 0x01, 0x29, 0xd3, 0xad, 0x89,
+	0x92, 0xca, 0x8e, 0x59, 0x3d, 0xea, 0x9c, 0xc5, 0x67, 0x50, 0xac, 0xe1, 0xd6, 0x5c, 0x36, 0xb0,
+	0x06, 0x76, 0x7e, 0xd5, 0x47, 0x88, 0x1f, 0x72, 0x68, 0x16, 0x0e, 0x3d, 0x9e, 0xd7, 0xeb, 0x66,
+	0x4d, 0xaf, 0x76, 0xd8, 0x2c, 0xc0, 0xd2, 0x53, 0x1d, 0x8a, 0xbb, 0x23, 0x03, 0xc6, 0xae, 0xde,
+	0x26, 0x5b, 0x4e, 0xcb, 0x22, 0x9d, 0x23, 0x8c, 0xdd, 0x7d, 0x0e, 0xf1, 0x73, 0x0e, 0xe1, 0x7e,
+	0xc9, 0x42, 0x5f, 0x90, 0x1b, 0xee, 0x0b, 0xc2, 0x4c, 0xbb, 0xed, 0xd3, 0xb1, 0x5a, 0x7c, 0x75,
+	0x88, 0xe4, 0x5f, 0x35, 0xab, 0x90, 0xa5, 0x13, 0x34, 0x4b, 0x29, 0x0b, 0xcc, 0x34, 0x76, 0x73,
+	0x8f, 0x47, 0x53, 0xec, 0x84, 0x1c, 0xec, 0xf7, 0xbf, 0x7e, 0x52, 0x0e, 0x65, 0x73, 0xf4, 0x19,
+	0x64, 0xf3, 0xd9, 0xdf, 0x38, 0x84, 0x42, 0xdf, 0xec, 0x1c, 0x9a, 0x5f, 0x2b, 0x56, 0x14, 0xad,
+	0x58, 0xaa, 0xe4, 0x8b, 0x05, 0xed, 0x66, 0xa1, 0x5c, 0x52, 0x56, 0xf2, 0x97, 0xf3, 0xca, 0x6a,
+	0x2a, 0x22, 0x4c, 0x7d, 0xf0, 0xf1, 0x42, 0x82, 0x02, 0x95, 0x46, 0x93, 0x74, 0xb0, 0x88, 0xa6,
+	0xc2, 0xe8, 0x5b, 0x4a, 0x39, 0xc5, 0x09, 0x13, 0x80, 0x1a, 0xa7, 0xa8, 0x5b, 0xd0, 0x59, 0xcf,
+	0xa2, 0x99, 0x30, 0x26, 0x27, 0x97, 0x2b, 0xb9, 0x7c, 0x21, 0xc5, 0x0b, 0xd3, 0x80, 0x9b, 0xa0,
+	0xb8, 0x1c, 0xeb, 0x9d, 0x0b, 0x68, 0x32, 0x8c, 0x2d, 0x14, 0x53, 0x51, 0x21, 0x09, 0xb0, 0x31,
+	0x0a, 0x2b, 0x38, 0xf8, 0x22, 0x4a, 0x1f, 0x44, 0x68, 0xeb, 0xf9, 0xca, 0x55, 0x6d, 0x4d, 0xa9,
+	0x14, 0x53, 0x31, 0x61, 0x16, 0xb0, 0xa9, 0x00, 0x1b, 0x34, 0x3a, 0x21, 0x79, 0xf7, 0xd3, 0x4c,
+	0xe4, 0x8b, 0xcf, 0x32, 0x91, 0xfb, 0x70, 0x9d, 0xfd, 0x8e, 0x47, 0x93, 0x07, 0x8f, 0x43, 0x50,
+	0x40, 0xc7, 0x4b, 0x6a, 0xb1, 0x54, 0x2c, 0xe7, 0xae, 0x6b, 0xb0, 0xbb, 0xca, 0xcd, 0x72, 0x4f,
+	0xe0, 0x7e, 0x48, 0x14, 0x5c, 0xb0, 0xbc, 0x5f, 0xa1, 0x99, 0x5e, 0xfc, 0xaa, 0x02, 0x8f, 0xf9,
+	0x8a, 0x56, 0x52, 0xd4, 0x7c, 0x71, 0x15, 0x54, 0x98, 0x07, 0x93, 0x19, 0x6a, 0xc2, 0x26, 0x72,
+	0xc9, 0x6c, 0x59, 0x8e, 0x81, 0x5f, 0x42, 0xcf, 0xf5, 0x1a, 0x43, 0x44, 0xf9, 0xc2, 0x95, 0xc0,
+	0x96, 0x17, 0xe6, 0xc0, 0x16, 0x53, 0xdb, 0x35, 0x7f, 0xfa, 0x31, 0xd3, 0x73, 0x68, 0xae, 0xd7,
+	0xb4, 0x94, 0x2b, 0x97, 0x61, 0x8b, 0x51, 0x21, 0x05, 0x36, 0x49, 0x6a, 0x53, 0xd2, 0x5d, 0x17,
+	0x12, 0xfb, 0x3c, 0x4a, 0xf7, 0xa2, 0x55, 0xe5, 0x9a, 0xb2, 0x52, 0x01, 0x7c, 0x4c, 0xc0, 0x80,
+	0x9f, 0xa4, 0x78, 0xd5, 0x7c, 0xdb, 0xac, 0x7a, 0xa5, 0x30, 0x80, 0xff, 0x72, 0x2e, 0x7f, 0x1d,
+	0xf0, 0x23, 0x61, 0xfe, 0xcb, 0xba, 0x55, 0x37, 0x8d, 0x83, 0xb2, 0xca, 0x85, 0xdd, 0x1f, 0x33,
+	0x91, 0x87, 0x70, 0xdd, 0x79, 0x9c, 0x89, 0xec, 0x3e, 0xce, 0x70, 0x0f, 0xe0, 0xfa, 0x01, 0xae,
+	0x7b, 0x3f, 0x65, 0x22, 0x0f, 0xe0, 0x7a, 0x08, 0xd7, 0xeb, 0x7f, 0x7d, 0x98, 0x09, 0xfd, 0x13,
+	0x63, 0x63, 0xd4, 0xef, 0x5d, 0x97, 0xfe, 0x04, 0x61, 0xe4, 0x05, 0x99, 0xda, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposal) Equal(that interface{}) bool {
@@ -804,6 +975,138 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExecProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecProposal)
+	if !ok {
+		that2, ok := that.(ExecProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Msgs) != len(that1.Msgs) {
+		return false
+	}
+	for i := range this.Msgs {
+		if !this.Msgs[i].Equal(that1.Msgs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgExecLegacyContent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgExecLegacyContent)
+	if !ok {
+		that2, ok := that.(MsgExecLegacyContent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Content.Equal(that1.Content) {
+		return false
+	}
+	if !bytes.Equal(this.Authority, that1.Authority) {
+		return false
+	}
+	return true
+}
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
@@ -1221,6 +1524,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
 		i--
@@ -1241,52 +1558,232 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExecProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.InitialDeposit) > 0 {
-		for _, e := range m.InitialDeposit {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+
+func (m *ExecProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExecProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTypes(uint64(m.ProposalID))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecLegacyContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecLegacyContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecLegacyContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	if m.Option != 0 {
 		n += 1 + sovTypes(uint64(m.Option))
@@ -1423,6 +1920,88 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovTypes(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecLegacyContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2727,6 +3306,560 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecLegacyContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecLegacyContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3,15 +3,37 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power of the
+// voter across the given options. A vote on a single option is a plain vote.
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	if len(options) == 1 {
+		return NewVote(proposalID, voter, options[0].Option)
+	}
+
+	return Vote{ProposalID: proposalID, Voter: voter, Option: OptionEmpty, Options: options}
+}
+
+// WeightedOptions returns the weighted options of the vote, as the option of a
+// plain vote with a weight of one.
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 {
+		return NewNonSplitVoteOption(v.Option)
+	}
+
+	return v.Options
 }
 
 func (v Vote) String() string {
@@ -43,7 +65,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.WeightedOptions())
 	}
 	return out
 }
@@ -53,6 +75,110 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// IsValid returns true if the option is valid and its weight is positive and
+// at most one.
+func (w WeightedVoteOption) IsValid() bool {
+	return ValidVoteOption(w.Option) && w.Weight.IsPositive() && w.Weight.LTE(sdk.OneDec())
+}
+
+// String implements the Stringer interface.
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the weighted options of a vote on a single
+// option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// Validate returns an error unless all the options are valid and distinct,
+// and their weights add up to one.
+func (w WeightedVoteOptions) Validate() error {
+	if len(w) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote option")
+	}
+
+	seen := make(map[VoteOption]bool)
+	total := sdk.ZeroDec()
+	for _, option := range w {
+		if !option.IsValid() {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if seen[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		seen[option.Option] = true
+		total = total.Add(option.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight %s must be one", total)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface. It returns the option of a vote on
+// a single option, or the comma separated weighted options otherwise.
+func (w WeightedVoteOptions) String() string {
+	if len(w) == 1 && w[0].Weight.Equal(sdk.OneDec()) {
+		return w[0].Option.String()
+	}
+
+	options := make([]string, len(w))
+	for i, option := range w {
+		options[i] = option.String()
+	}
+
+	return strings.Join(options, ",")
+}
+
+// WeightedVoteOptionsFromString parses weighted vote options from a comma
+// separated list of option=weight entries, eg. "Yes=0.6,No=0.4". A single
+// option without weight is voted with a weight of one.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	if !strings.Contains(str, "=") {
+		option, err := VoteOptionFromString(str)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewNonSplitVoteOption(option), nil
+	}
+
+	var options WeightedVoteOptions
+	for _, entry := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(entry), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", entry)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -0,0 +1,115 @@
+package group
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	QueryGroup                = types.QueryGroup
+	QueryGroupMembers         = types.QueryGroupMembers
+	QueryGroupPolicy          = types.QueryGroupPolicy
+	QueryGroupPoliciesByGroup = types.QueryGroupPoliciesByGroup
+	QueryProposal             = types.QueryProposal
+	QueryProposalsByPolicy    = types.QueryProposalsByPolicy
+	QueryVotes                = types.QueryVotes
+	TypeMsgCreateGroup        = types.TypeMsgCreateGroup
+	TypeMsgUpdateGroupMembers = types.TypeMsgUpdateGroupMembers
+	TypeMsgUpdateGroupAdmin   = types.TypeMsgUpdateGroupAdmin
+	TypeMsgCreateGroupPolicy  = types.TypeMsgCreateGroupPolicy
+	TypeMsgUpdateGroupPolicy  = types.TypeMsgUpdateGroupPolicy
+	TypeMsgSubmitProposal     = types.TypeMsgSubmitProposal
+	TypeMsgVote               = types.TypeMsgVote
+	TypeMsgExec               = types.TypeMsgExec
+	StatusSubmitted           = types.StatusSubmitted
+	StatusClosed              = types.StatusClosed
+	StatusAborted             = types.StatusAborted
+	ResultUnfinalized         = types.ResultUnfinalized
+	ResultAccepted            = types.ResultAccepted
+	ResultRejected            = types.ResultRejected
+	ExecutorNotRun            = types.ExecutorNotRun
+	ExecutorSuccess           = types.ExecutorSuccess
+	ExecutorFailure           = types.ExecutorFailure
+	ChoiceYes                 = types.ChoiceYes
+	ChoiceNo                  = types.ChoiceNo
+	ChoiceAbstain             = types.ChoiceAbstain
+	ChoiceVeto                = types.ChoiceVeto
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	RegisterCodec               = types.RegisterCodec
+	NewGroup                    = types.NewGroup
+	NewMember                   = types.NewMember
+	NewThresholdDecisionPolicy  = types.NewThresholdDecisionPolicy
+	NewPercentageDecisionPolicy = types.NewPercentageDecisionPolicy
+	NewGroupPolicy              = types.NewGroupPolicy
+	GroupPolicyAddress          = types.GroupPolicyAddress
+	NewTally                    = types.NewTally
+	NewVote                     = types.NewVote
+	VoteChoiceFromString        = types.VoteChoiceFromString
+	NewMsgCreateGroup           = types.NewMsgCreateGroup
+	NewMsgUpdateGroupMembers    = types.NewMsgUpdateGroupMembers
+	NewMsgUpdateGroupAdmin      = types.NewMsgUpdateGroupAdmin
+	NewMsgCreateGroupPolicy     = types.NewMsgCreateGroupPolicy
+	NewMsgUpdateGroupPolicy     = types.NewMsgUpdateGroupPolicy
+	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
+	NewMsgVote                  = types.NewMsgVote
+	NewMsgExec                  = types.NewMsgExec
+	DefaultGenesisState         = types.DefaultGenesisState
+	NewQueryGroupParams         = types.NewQueryGroupParams
+	NewQueryGroupPolicyParams   = types.NewQueryGroupPolicyParams
+	NewQueryProposalParams      = types.NewQueryProposalParams
+
+	// variable aliases
+	ModuleCdc              = types.ModuleCdc
+	NextGroupIDKey         = types.NextGroupIDKey
+	NextGroupPolicySeqKey  = types.NextGroupPolicySeqKey
+	NextProposalIDKey      = types.NextProposalIDKey
+	ErrInvalid             = types.ErrInvalid
+	ErrGroupNotFound       = types.ErrGroupNotFound
+	ErrGroupPolicyNotFound = types.ErrGroupPolicyNotFound
+	ErrProposalNotFound    = types.ErrProposalNotFound
+	ErrNotMember           = types.ErrNotMember
+	ErrNotAdmin            = types.ErrNotAdmin
+	ErrProposalClosed      = types.ErrProposalClosed
+	ErrVotingPeriodEnded   = types.ErrVotingPeriodEnded
+	ErrDuplicateVote       = types.ErrDuplicateVote
+	ErrProposalNotAccepted = types.ErrProposalNotAccepted
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	Group                    = types.Group
+	Member                   = types.Member
+	DecisionPolicy           = types.DecisionPolicy
+	DecisionPolicyResult     = types.DecisionPolicyResult
+	ThresholdDecisionPolicy  = types.ThresholdDecisionPolicy
+	PercentageDecisionPolicy = types.PercentageDecisionPolicy
+	GroupPolicy              = types.GroupPolicy
+	ProposalStatus           = types.ProposalStatus
+	ProposalResult           = types.ProposalResult
+	ExecutorResult           = types.ExecutorResult
+	Tally                    = types.Tally
+	Proposal                 = types.Proposal
+	VoteChoice               = types.VoteChoice
+	Vote                     = types.Vote
+	MsgCreateGroup           = types.MsgCreateGroup
+	MsgUpdateGroupMembers    = types.MsgUpdateGroupMembers
+	MsgUpdateGroupAdmin      = types.MsgUpdateGroupAdmin
+	MsgCreateGroupPolicy     = types.MsgCreateGroupPolicy
+	MsgUpdateGroupPolicy     = types.MsgUpdateGroupPolicy
+	MsgSubmitProposal        = types.MsgSubmitProposal
+	MsgVote                  = types.MsgVote
+	MsgExec                  = types.MsgExec
+	GenesisState             = types.GenesisState
+	QueryGroupParams         = types.QueryGroupParams
+	QueryGroupPolicyParams   = types.QueryGroupPolicyParams
+	QueryProposalParams      = types.QueryProposalParams
+)
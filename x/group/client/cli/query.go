@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the cli query commands for the group module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	groupQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryGroup(cdc),
+			GetCmdQueryGroupMembers(cdc),
+			GetCmdQueryGroupPolicy(cdc),
+			GetCmdQueryGroupPolicies(cdc),
+			GetCmdQueryProposal(cdc),
+			GetCmdQueryProposals(cdc),
+			GetCmdQueryVotes(cdc),
+		)...,
+	)
+
+	return groupQueryCmd
+}
+
+// GetCmdQueryGroup implements a command to return a group.
+func GetCmdQueryGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group [group-id]",
+		Short: "Query a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint: %w", args[0], err)
+			}
+
+			var group types.Group
+			if err := query(cliCtx, types.QueryGroup, types.NewQueryGroupParams(groupID), &group); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(group)
+		},
+	}
+}
+
+// GetCmdQueryGroupMembers implements a command to return the members of a
+// group.
+func GetCmdQueryGroupMembers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "members [group-id]",
+		Short: "Query the members of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint: %w", args[0], err)
+			}
+
+			var members []types.Member
+			if err := query(cliCtx, types.QueryGroupMembers, types.NewQueryGroupParams(groupID), &members); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(members)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicy implements a command to return a group policy.
+func GetCmdQueryGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "policy [address]",
+		Short: "Query a group policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var policy types.GroupPolicy
+			if err := query(cliCtx, types.QueryGroupPolicy, types.NewQueryGroupPolicyParams(address), &policy); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(policy)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicies implements a command to return the policies of a
+// group.
+func GetCmdQueryGroupPolicies(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "policies [group-id]",
+		Short: "Query the policies of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint: %w", args[0], err)
+			}
+
+			var policies []types.GroupPolicy
+			if err := query(cliCtx, types.QueryGroupPoliciesByGroup, types.NewQueryGroupParams(groupID), &policies); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(policies)
+		},
+	}
+}
+
+// GetCmdQueryProposal implements a command to return a proposal.
+func GetCmdQueryProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Short: "Query a group proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[0], err)
+			}
+
+			var proposal types.Proposal
+			if err := query(cliCtx, types.QueryProposal, types.NewQueryProposalParams(proposalID), &proposal); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(proposal)
+		},
+	}
+}
+
+// GetCmdQueryProposals implements a command to return the proposals of a
+// group policy.
+func GetCmdQueryProposals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposals [policy-address]",
+		Short: "Query the proposals of a group policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposals []types.Proposal
+			if err := query(cliCtx, types.QueryProposalsByPolicy, types.NewQueryGroupPolicyParams(address), &proposals); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(proposals)
+		},
+	}
+}
+
+// GetCmdQueryVotes implements a command to return the votes of a proposal.
+func GetCmdQueryVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes [proposal-id]",
+		Short: "Query the votes of a group proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[0], err)
+			}
+
+			var votes []types.Vote
+			if err := query(cliCtx, types.QueryVotes, types.NewQueryProposalParams(proposalID), &votes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(votes)
+		},
+	}
+}
+
+// query queries a group querier route with the given params, and decodes its
+// result into res.
+func query(cliCtx context.CLIContext, path string, params, res interface{}) error {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	out, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.Codec.UnmarshalJSON(out, res)
+}
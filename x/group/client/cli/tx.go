@@ -0,0 +1,388 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+const (
+	flagMetadata     = "metadata"
+	flagThreshold    = "threshold"
+	flagPercentage   = "percentage"
+	flagVotingPeriod = "voting-period"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/group transaction commands.
+func NewTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		NewCreateGroupTxCmd(cliCtx),
+		NewUpdateGroupMembersTxCmd(cliCtx),
+		NewUpdateGroupAdminTxCmd(cliCtx),
+		NewCreateGroupPolicyTxCmd(cliCtx),
+		NewUpdateGroupPolicyTxCmd(cliCtx),
+		NewSubmitProposalTxCmd(cliCtx),
+		NewVoteTxCmd(cliCtx),
+		NewExecTxCmd(cliCtx),
+	)...)
+
+	return txCmd
+}
+
+// NewCreateGroupTxCmd returns a CLI command handler for creating a
+// MsgCreateGroup transaction.
+func NewCreateGroupTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin_key_or_address] [members-json-file]",
+		Short: "Create a group of weighted members, administered by the sender",
+		Long: `Create a group of weighted members, administered by the sender. The members
+file contains a JSON array of members:
+
+[
+  {
+    "address": "cosmos1...",
+    "weight": "1",
+    "metadata": "..."
+  }
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			members, err := parseMembers(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, _ := cmd.Flags().GetString(flagMetadata)
+
+			msg := types.NewMsgCreateGroup(cliCtx.GetFromAddress(), members, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagMetadata, "", "The metadata of the group")
+
+	return cmd
+}
+
+// NewUpdateGroupMembersTxCmd returns a CLI command handler for creating a
+// MsgUpdateGroupMembers transaction.
+func NewUpdateGroupMembersTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-members [admin_key_or_address] [group-id] [members-json-file]",
+		Short: "Add, update or remove members of a group",
+		Long: `Add, update or remove members of a group. The members file contains a JSON
+array of members, in the same format as for create-group. A member with a zero
+weight is removed from the group.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint: %w", args[1], err)
+			}
+
+			members, err := parseMembers(cliCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(cliCtx.GetFromAddress(), groupID, members)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
+// NewUpdateGroupAdminTxCmd returns a CLI command handler for creating a
+// MsgUpdateGroupAdmin transaction.
+func NewUpdateGroupAdminTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-admin [admin_key_or_address] [group-id] [new-admin]",
+		Short: "Set the admin of a group",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint: %w", args[1], err)
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(cliCtx.GetFromAddress(), groupID, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
+// NewCreateGroupPolicyTxCmd returns a CLI command handler for creating a
+// MsgCreateGroupPolicy transaction.
+func NewCreateGroupPolicyTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [admin_key_or_address] [group-id]",
+		Short: "Create a group policy account with a threshold or percentage decision policy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint: %w", args[1], err)
+			}
+
+			policy, err := parseDecisionPolicy(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata, _ := cmd.Flags().GetString(flagMetadata)
+
+			msg := types.NewMsgCreateGroupPolicy(cliCtx.GetFromAddress(), groupID, metadata, policy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagMetadata, "", "The metadata of the group policy")
+	addDecisionPolicyFlags(cmd)
+
+	return cmd
+}
+
+// NewUpdateGroupPolicyTxCmd returns a CLI command handler for creating a
+// MsgUpdateGroupPolicy transaction.
+func NewUpdateGroupPolicyTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy [admin_key_or_address] [policy-address]",
+		Short: "Set the decision policy of a group policy, aborting its pending proposals",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicy(cliCtx.GetFromAddress(), address, policy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	addDecisionPolicyFlags(cmd)
+
+	return cmd
+}
+
+// NewSubmitProposalTxCmd returns a CLI command handler for creating a
+// MsgSubmitProposal transaction.
+func NewSubmitProposalTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [proposer_key_or_address] [policy-address] [msgs-json-file]",
+		Short: "Submit a proposal of messages to be executed by a group policy account",
+		Long: `Submit a proposal of messages to be executed by a group policy account. The
+messages file contains a JSON array of messages, whose signer must be the group
+policy account, e.g.:
+
+[
+  {
+    "type": "cosmos-sdk/MsgSend",
+    "value": {
+      "from_address": "cosmos1...",
+      "to_address": "cosmos1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			if err := cliCtx.Codec.UnmarshalJSON(contents, &msgs); err != nil {
+				return err
+			}
+
+			metadata, _ := cmd.Flags().GetString(flagMetadata)
+
+			msg := types.NewMsgSubmitProposal(address, []sdk.AccAddress{cliCtx.GetFromAddress()}, metadata, msgs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagMetadata, "", "The metadata of the proposal")
+
+	return cmd
+}
+
+// NewVoteTxCmd returns a CLI command handler for creating a MsgVote
+// transaction.
+func NewVoteTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [voter_key_or_address] [proposal-id] [choice]",
+		Short: "Vote on a group proposal, with a choice of Yes, No, Abstain or Veto",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[1], err)
+			}
+
+			choice, err := types.VoteChoiceFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			metadata, _ := cmd.Flags().GetString(flagMetadata)
+
+			msg := types.NewMsgVote(proposalID, cliCtx.GetFromAddress(), choice, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagMetadata, "", "The metadata of the vote")
+
+	return cmd
+}
+
+// NewExecTxCmd returns a CLI command handler for creating a MsgExec
+// transaction.
+func NewExecTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [executor_key_or_address] [proposal-id]",
+		Short: "Execute the messages of an accepted group proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint: %w", args[1], err)
+			}
+
+			msg := types.NewMsgExec(proposalID, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
+func addDecisionPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagThreshold, "", "The yes vote weight threshold of a threshold decision policy")
+	cmd.Flags().String(flagPercentage, "", "The yes vote percentage of a percentage decision policy, e.g. 0.5")
+	cmd.Flags().Duration(flagVotingPeriod, 0, "The voting period of the proposals, e.g. 72h")
+}
+
+// parseDecisionPolicy returns the decision policy of the threshold or
+// percentage flags.
+func parseDecisionPolicy(cmd *cobra.Command) (types.DecisionPolicy, error) {
+	threshold, _ := cmd.Flags().GetString(flagThreshold)
+	percentage, _ := cmd.Flags().GetString(flagPercentage)
+	votingPeriod, _ := cmd.Flags().GetDuration(flagVotingPeriod)
+
+	switch {
+	case threshold != "" && percentage == "":
+		dec, err := sdk.NewDecFromStr(threshold)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewThresholdDecisionPolicy(dec, votingPeriod), nil
+
+	case percentage != "" && threshold == "":
+		dec, err := sdk.NewDecFromStr(percentage)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewPercentageDecisionPolicy(dec, votingPeriod), nil
+
+	default:
+		return nil, fmt.Errorf("exactly one of --%s and --%s must be set", flagThreshold, flagPercentage)
+	}
+}
+
+// parseMembers reads a JSON array of members from a file.
+func parseMembers(cliCtx context.CLIContext, membersFile string) ([]types.Member, error) {
+	contents, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []types.Member
+	if err := cliCtx.Codec.UnmarshalJSON(contents, &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
@@ -0,0 +1,46 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the group module state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetSequence(ctx, NextGroupIDKey, data.NextGroupID)
+	k.SetSequence(ctx, NextGroupPolicySeqKey, data.NextGroupPolicySeq)
+	k.SetSequence(ctx, NextProposalIDKey, data.NextProposalID)
+
+	for _, group := range data.Groups {
+		k.SetGroup(ctx, group)
+	}
+
+	for _, member := range data.Members {
+		k.SetMember(ctx, member)
+	}
+
+	for _, policy := range data.GroupPolicies {
+		k.SetGroupPolicy(ctx, policy)
+	}
+
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
+
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return GenesisState{
+		NextGroupID:        k.GetSequence(ctx, NextGroupIDKey),
+		NextGroupPolicySeq: k.GetSequence(ctx, NextGroupPolicySeqKey),
+		NextProposalID:     k.GetSequence(ctx, NextProposalIDKey),
+		Groups:             k.GetGroups(ctx),
+		Members:            k.GetAllMembers(ctx),
+		GroupPolicies:      k.GetGroupPolicies(ctx),
+		Proposals:          k.GetProposals(ctx),
+		Votes:              k.GetAllVotes(ctx),
+	}
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// CreateGroup creates a group with the given admin and members, and returns
+// its ID.
+func (k Keeper) CreateGroup(
+	ctx sdk.Context, admin sdk.AccAddress, members []types.Member, metadata string,
+) (uint64, error) {
+	if err := types.ValidateMembers(members, false); err != nil {
+		return 0, err
+	}
+
+	groupID := k.nextSequence(ctx, types.NextGroupIDKey)
+
+	totalWeight := sdk.ZeroDec()
+	for _, member := range members {
+		member.GroupID = groupID
+		k.SetMember(ctx, member)
+		totalWeight = totalWeight.Add(member.Weight)
+	}
+
+	k.SetGroup(ctx, types.NewGroup(groupID, admin, metadata, totalWeight))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+
+	return groupID, nil
+}
+
+// UpdateGroupMembers adds, updates or removes members of a group. A member
+// update with a zero weight removes the member.
+func (k Keeper) UpdateGroupMembers(
+	ctx sdk.Context, admin sdk.AccAddress, groupID uint64, updates []types.Member,
+) error {
+	if err := types.ValidateMembers(updates, true); err != nil {
+		return err
+	}
+
+	group, err := k.getGroupAsAdmin(ctx, groupID, admin)
+	if err != nil {
+		return err
+	}
+
+	for _, update := range updates {
+		update.GroupID = groupID
+
+		if member, found := k.GetMember(ctx, groupID, update.Address); found {
+			group.TotalWeight = group.TotalWeight.Sub(member.Weight)
+		} else if update.Weight.IsZero() {
+			return sdkerrors.Wrapf(types.ErrNotMember, "%s in group %d", update.Address, groupID)
+		}
+
+		if update.Weight.IsZero() {
+			k.deleteMember(ctx, groupID, update.Address)
+			continue
+		}
+
+		k.SetMember(ctx, update)
+		group.TotalWeight = group.TotalWeight.Add(update.Weight)
+	}
+
+	k.updateGroup(ctx, group)
+	return nil
+}
+
+// UpdateGroupAdmin sets the admin of a group.
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) error {
+	group, err := k.getGroupAsAdmin(ctx, groupID, admin)
+	if err != nil {
+		return err
+	}
+
+	group.Admin = newAdmin
+	k.updateGroup(ctx, group)
+
+	return nil
+}
+
+// getGroupAsAdmin returns a group, checking that admin is its admin.
+func (k Keeper) getGroupAsAdmin(ctx sdk.Context, groupID uint64, admin sdk.AccAddress) (types.Group, error) {
+	group, found := k.GetGroup(ctx, groupID)
+	if !found {
+		return group, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", groupID)
+	}
+
+	if !group.Admin.Equals(admin) {
+		return group, sdkerrors.Wrapf(types.ErrNotAdmin, "%s is not the admin of group %d", admin, groupID)
+	}
+
+	return group, nil
+}
+
+// updateGroup increments the version of an updated group and stores it. The
+// pending proposals of the policies of the group are aborted, since they were
+// submitted to a previous version of the group.
+func (k Keeper) updateGroup(ctx sdk.Context, group types.Group) {
+	group.Version++
+	k.SetGroup(ctx, group)
+
+	for _, policy := range k.GetGroupPoliciesByGroup(ctx, group.ID) {
+		k.abortProposals(ctx, policy.Address)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", group.ID)),
+		),
+	)
+}
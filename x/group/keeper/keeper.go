@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper of the group store
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	accountKeeper types.AccountKeeper
+	msgRouter     sdk.MsgRouterService
+}
+
+// NewKeeper creates a new group Keeper instance. The codec must have the
+// messages of all the modules registered, since they are stored in the
+// proposals, and the msg router dispatches the messages of the accepted
+// proposals.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, msgRouter sdk.MsgRouterService) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		accountKeeper: ak,
+		msgRouter:     msgRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetSequence returns the next value of a sequence, which starts at 1.
+func (k Keeper) GetSequence(ctx sdk.Context, key []byte) uint64 {
+	return sdk.GetSequence(ctx.KVStore(k.storeKey), key)
+}
+
+// SetSequence sets the next value of a sequence.
+func (k Keeper) SetSequence(ctx sdk.Context, key []byte, seq uint64) {
+	sdk.SetSequence(ctx.KVStore(k.storeKey), key, seq)
+}
+
+// nextSequence returns the next value of a sequence and increments it.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	return sdk.NextSequence(ctx.KVStore(k.storeKey), key)
+}
+
+// GetGroup returns a group.
+func (k Keeper) GetGroup(ctx sdk.Context, groupID uint64) (group types.Group, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupKey(groupID))
+	if bz == nil {
+		return group, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, true
+}
+
+// SetGroup stores a group.
+func (k Keeper) SetGroup(ctx sdk.Context, group types.Group) {
+	ctx.KVStore(k.storeKey).Set(types.GroupKey(group.ID), k.cdc.MustMarshalBinaryBare(group))
+}
+
+// GetGroups returns all the groups.
+func (k Keeper) GetGroups(ctx sdk.Context) []types.Group {
+	groups := []types.Group{}
+	k.iterate(ctx, types.GroupKeyPrefix, func(bz []byte) {
+		var group types.Group
+		k.cdc.MustUnmarshalBinaryBare(bz, &group)
+		groups = append(groups, group)
+	})
+
+	return groups
+}
+
+// GetMember returns a member of a group.
+func (k Keeper) GetMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) (member types.Member, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MemberKey(groupID, address))
+	if bz == nil {
+		return member, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &member)
+	return member, true
+}
+
+// SetMember stores a member of a group.
+func (k Keeper) SetMember(ctx sdk.Context, member types.Member) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MemberKey(member.GroupID, member.Address), k.cdc.MustMarshalBinaryBare(member))
+}
+
+func (k Keeper) deleteMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MemberKey(groupID, address))
+}
+
+// GetGroupMembers returns the members of a group, ordered by address.
+func (k Keeper) GetGroupMembers(ctx sdk.Context, groupID uint64) []types.Member {
+	members := []types.Member{}
+	k.iterate(ctx, types.MembersPrefix(groupID), func(bz []byte) {
+		var member types.Member
+		k.cdc.MustUnmarshalBinaryBare(bz, &member)
+		members = append(members, member)
+	})
+
+	return members
+}
+
+// GetAllMembers returns the members of all the groups.
+func (k Keeper) GetAllMembers(ctx sdk.Context) []types.Member {
+	members := []types.Member{}
+	k.iterate(ctx, types.MemberKeyPrefix, func(bz []byte) {
+		var member types.Member
+		k.cdc.MustUnmarshalBinaryBare(bz, &member)
+		members = append(members, member)
+	})
+
+	return members
+}
+
+// GetGroupPolicy returns a group policy.
+func (k Keeper) GetGroupPolicy(ctx sdk.Context, address sdk.AccAddress) (policy types.GroupPolicy, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupPolicyKey(address))
+	if bz == nil {
+		return policy, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+	return policy, true
+}
+
+// SetGroupPolicy stores a group policy and indexes it by group.
+func (k Keeper) SetGroupPolicy(ctx sdk.Context, policy types.GroupPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupPolicyKey(policy.Address), k.cdc.MustMarshalBinaryBare(policy))
+	store.Set(types.GroupPolicyByGroupKey(policy.GroupID, policy.Address), types.Placeholder)
+}
+
+// GetGroupPolicies returns all the group policies.
+func (k Keeper) GetGroupPolicies(ctx sdk.Context) []types.GroupPolicy {
+	policies := []types.GroupPolicy{}
+	k.iterate(ctx, types.GroupPolicyKeyPrefix, func(bz []byte) {
+		var policy types.GroupPolicy
+		k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+		policies = append(policies, policy)
+	})
+
+	return policies
+}
+
+// GetGroupPoliciesByGroup returns the policies of a group.
+func (k Keeper) GetGroupPoliciesByGroup(ctx sdk.Context, groupID uint64) []types.GroupPolicy {
+	prefix := types.GroupPoliciesByGroupPrefix(groupID)
+
+	policies := []types.GroupPolicy{}
+	k.iterateKeys(ctx, prefix, func(key []byte) {
+		address := sdk.AccAddress(key[len(prefix):])
+		policy, found := k.GetGroupPolicy(ctx, address)
+		if !found {
+			panic(fmt.Sprintf("indexed group policy %s not found", address))
+		}
+
+		policies = append(policies, policy)
+	})
+
+	return policies
+}
+
+// GetProposal returns a proposal.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(proposalID))
+	if bz == nil {
+		return proposal, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal stores a proposal and indexes it by group policy.
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.ID), k.cdc.MustMarshalBinaryBare(proposal))
+	store.Set(types.ProposalByPolicyKey(proposal.Address, proposal.ID), types.Placeholder)
+}
+
+// GetProposals returns all the proposals.
+func (k Keeper) GetProposals(ctx sdk.Context) []types.Proposal {
+	proposals := []types.Proposal{}
+	k.iterate(ctx, types.ProposalKeyPrefix, func(bz []byte) {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+		proposals = append(proposals, proposal)
+	})
+
+	return proposals
+}
+
+// GetProposalsByPolicy returns the proposals of a group policy, ordered by ID.
+func (k Keeper) GetProposalsByPolicy(ctx sdk.Context, address sdk.AccAddress) []types.Proposal {
+	prefix := types.ProposalsByPolicyPrefix(address)
+
+	proposals := []types.Proposal{}
+	k.iterateKeys(ctx, prefix, func(key []byte) {
+		proposalID := sdk.BigEndianToUint64(key[len(prefix):])
+		proposal, found := k.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("indexed proposal %d not found", proposalID))
+		}
+
+		proposals = append(proposals, proposal)
+	})
+
+	return proposals
+}
+
+// GetVote returns the vote of a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (vote types.Vote, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.VoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// SetVote stores a vote.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VoteKey(vote.ProposalID, vote.Voter), k.cdc.MustMarshalBinaryBare(vote))
+}
+
+// GetVotes returns the votes of a proposal, ordered by voter.
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) []types.Vote {
+	return k.getVotes(ctx, types.VotesPrefix(proposalID))
+}
+
+// GetAllVotes returns the votes of all the proposals.
+func (k Keeper) GetAllVotes(ctx sdk.Context) []types.Vote {
+	return k.getVotes(ctx, types.VoteKeyPrefix)
+}
+
+func (k Keeper) getVotes(ctx sdk.Context, prefix []byte) []types.Vote {
+	votes := []types.Vote{}
+	k.iterate(ctx, prefix, func(bz []byte) {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+		votes = append(votes, vote)
+	})
+
+	return votes
+}
+
+// iterate calls fn with the values of all the keys with the given prefix.
+func (k Keeper) iterate(ctx sdk.Context, prefix []byte, fn func(value []byte)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		fn(iterator.Value())
+	}
+}
+
+// iterateKeys calls fn with all the keys with the given prefix.
+func (k Keeper) iterateKeys(ctx sdk.Context, prefix []byte, fn func(key []byte)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		fn(iterator.Key())
+	}
+}
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+const votingPeriod = time.Hour
+
+func setup(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(10000))
+
+	return app, ctx, addrs
+}
+
+// setupGroupPolicy creates a group of addrs[1] and addrs[2], of weights 1 and
+// 2, administered by addrs[0], and a group policy with a threshold of 2.
+func setupGroupPolicy(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) (uint64, sdk.AccAddress) {
+	members := []types.Member{
+		types.NewMember(0, addrs[1], sdk.NewDec(1), ""),
+		types.NewMember(0, addrs[2], sdk.NewDec(2), ""),
+	}
+	groupID, err := app.GroupKeeper.CreateGroup(ctx, addrs[0], members, "group")
+	require.NoError(t, err)
+
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(2), votingPeriod)
+	address, err := app.GroupKeeper.CreateGroupPolicy(ctx, addrs[0], groupID, "policy", policy)
+	require.NoError(t, err)
+
+	return groupID, address
+}
+
+func sendMsgs(from, to sdk.AccAddress, amount int64) []sdk.Msg {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	return []sdk.Msg{bank.NewMsgSend(from, to, coins)}
+}
+
+func TestCreateAndUpdateGroup(t *testing.T) {
+	app, ctx, addrs := setup(t)
+	k := app.GroupKeeper
+
+	groupID, address := setupGroupPolicy(t, app, ctx, addrs)
+
+	group, found := k.GetGroup(ctx, groupID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), group.Version)
+	require.True(t, sdk.NewDec(3).Equal(group.TotalWeight))
+	require.Len(t, k.GetGroupMembers(ctx, groupID), 2)
+
+	policy, found := k.GetGroupPolicy(ctx, address)
+	require.True(t, found)
+	require.Equal(t, groupID, policy.GroupID)
+	require.True(t, app.AccountKeeper.HasAccount(ctx, address))
+	require.Equal(t, []types.GroupPolicy{policy}, k.GetGroupPoliciesByGroup(ctx, groupID))
+
+	// only the admin updates the group
+	updates := []types.Member{
+		types.NewMember(0, addrs[1], sdk.ZeroDec(), ""),
+		types.NewMember(0, addrs[3], sdk.NewDec(4), ""),
+	}
+	err := k.UpdateGroupMembers(ctx, addrs[1], groupID, updates)
+	require.True(t, types.ErrNotAdmin.Is(err))
+
+	require.NoError(t, k.UpdateGroupMembers(ctx, addrs[0], groupID, updates))
+
+	group, _ = k.GetGroup(ctx, groupID)
+	require.Equal(t, uint64(2), group.Version)
+	require.True(t, sdk.NewDec(6).Equal(group.TotalWeight))
+
+	_, found = k.GetMember(ctx, groupID, addrs[1])
+	require.False(t, found)
+	member, found := k.GetMember(ctx, groupID, addrs[3])
+	require.True(t, found)
+	require.Equal(t, groupID, member.GroupID)
+
+	// removing a non member fails
+	err = k.UpdateGroupMembers(ctx, addrs[0], groupID, []types.Member{types.NewMember(0, addrs[1], sdk.ZeroDec(), "")})
+	require.True(t, types.ErrNotMember.Is(err))
+
+	require.NoError(t, k.UpdateGroupAdmin(ctx, addrs[0], groupID, addrs[3]))
+	group, _ = k.GetGroup(ctx, groupID)
+	require.Equal(t, addrs[3], group.Admin)
+	require.Equal(t, uint64(3), group.Version)
+
+	_, err = k.CreateGroupPolicy(ctx, addrs[0], groupID, "", types.NewPercentageDecisionPolicy(sdk.OneDec(), votingPeriod))
+	require.True(t, types.ErrNotAdmin.Is(err))
+}
+
+func TestCreateGroupPolicyFrontRun(t *testing.T) {
+	app, ctx, addrs := setup(t)
+	k := app.GroupKeeper
+
+	groupID, _ := setupGroupPolicy(t, app, ctx, addrs)
+
+	// sending tokens to the next group policy address does not prevent the
+	// creation of group policies, the address being skipped
+	next := types.GroupPolicyAddress(k.GetSequence(ctx, types.NextGroupPolicySeqKey))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], next, coins))
+
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(2), votingPeriod)
+	address, err := k.CreateGroupPolicy(ctx, addrs[0], groupID, "policy", policy)
+	require.NoError(t, err)
+	require.NotEqual(t, next, address)
+
+	_, found := k.GetGroupPolicy(ctx, address)
+	require.True(t, found)
+	_, found = k.GetGroupPolicy(ctx, next)
+	require.False(t, found)
+}
+
+func TestProposalAcceptedAndExecuted(t *testing.T) {
+	app, ctx, addrs := setup(t)
+	k := app.GroupKeeper
+
+	_, address := setupGroupPolicy(t, app, ctx, addrs)
+
+	// proposers must be members
+	_, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{addrs[0]}, "", sendMsgs(address, addrs[3], 10))
+	require.True(t, types.ErrNotMember.Is(err))
+
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{addrs[1]}, "", sendMsgs(address, addrs[3], 10))
+	require.NoError(t, err)
+
+	require.NoError(t, k.Vote(ctx, proposalID, addrs[1], types.ChoiceYes, ""))
+	err = k.Vote(ctx, proposalID, addrs[1], types.ChoiceYes, "")
+	require.True(t, types.ErrDuplicateVote.Is(err))
+	err = k.Vote(ctx, proposalID, addrs[3], types.ChoiceYes, "")
+	require.True(t, types.ErrNotMember.Is(err))
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, types.StatusSubmitted, proposal.Status)
+
+	// the proposal cannot be executed before its result is final
+	err = k.Exec(ctx, proposalID)
+	require.True(t, types.ErrProposalNotAccepted.Is(err))
+
+	require.NoError(t, k.Vote(ctx, proposalID, addrs[2], types.ChoiceYes, ""))
+
+	proposal, _ = k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.StatusClosed, proposal.Status)
+	require.Equal(t, types.ResultAccepted, proposal.Result)
+	require.True(t, sdk.NewDec(3).Equal(proposal.Tally.Yes))
+
+	// the execution fails until the group policy account is funded
+	require.NoError(t, k.Exec(ctx, proposalID))
+	proposal, _ = k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.ExecutorFailure, proposal.ExecutorResult)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, address, coins))
+
+	expected := app.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount.AddRaw(10)
+
+	require.NoError(t, k.Exec(ctx, proposalID))
+	proposal, _ = k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.ExecutorSuccess, proposal.ExecutorResult)
+	require.True(t, expected.Equal(app.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount))
+
+	// a successful proposal is only executed once
+	require.NoError(t, k.Exec(ctx, proposalID))
+	require.True(t, expected.Equal(app.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount))
+}
+
+func TestProposalRejectedAfterVotingPeriod(t *testing.T) {
+	app, ctx, addrs := setup(t)
+	k := app.GroupKeeper
+
+	_, address := setupGroupPolicy(t, app, ctx, addrs)
+
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{addrs[1]}, "", sendMsgs(address, addrs[3], 10))
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, proposalID, addrs[1], types.ChoiceYes, ""))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod))
+
+	err = k.Vote(ctx, proposalID, addrs[2], types.ChoiceYes, "")
+	require.True(t, types.ErrVotingPeriodEnded.Is(err))
+
+	require.NoError(t, k.Exec(ctx, proposalID))
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.StatusClosed, proposal.Status)
+	require.Equal(t, types.ResultRejected, proposal.Result)
+	require.Equal(t, types.ExecutorNotRun, proposal.ExecutorResult)
+}
+
+func TestProposalsAbortedOnUpdate(t *testing.T) {
+	app, ctx, addrs := setup(t)
+	k := app.GroupKeeper
+
+	groupID, address := setupGroupPolicy(t, app, ctx, addrs)
+
+	accepted, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{addrs[2]}, "", sendMsgs(address, addrs[3], 10))
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, accepted, addrs[2], types.ChoiceYes, ""))
+
+	pending, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{addrs[1]}, "", sendMsgs(address, addrs[3], 10))
+	require.NoError(t, err)
+	require.Len(t, k.GetProposalsByPolicy(ctx, address), 2)
+
+	require.NoError(t, k.UpdateGroupMembers(ctx, addrs[0], groupID, []types.Member{types.NewMember(0, addrs[3], sdk.OneDec(), "")}))
+
+	// only the pending proposal is aborted
+	proposal, _ := k.GetProposal(ctx, accepted)
+	require.Equal(t, types.StatusClosed, proposal.Status)
+	proposal, _ = k.GetProposal(ctx, pending)
+	require.Equal(t, types.StatusAborted, proposal.Status)
+
+	err = k.Vote(ctx, pending, addrs[1], types.ChoiceYes, "")
+	require.True(t, types.ErrProposalClosed.Is(err))
+	err = k.Exec(ctx, pending)
+	require.True(t, types.ErrProposalClosed.Is(err))
+
+	// updating the decision policy aborts the pending proposals of the policy
+	pending, err = k.SubmitProposal(ctx, address, []sdk.AccAddress{addrs[1]}, "", sendMsgs(address, addrs[3], 10))
+	require.NoError(t, err)
+
+	policy := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), votingPeriod)
+	err = k.UpdateGroupPolicy(ctx, addrs[1], address, policy)
+	require.True(t, types.ErrNotAdmin.Is(err))
+	require.NoError(t, k.UpdateGroupPolicy(ctx, addrs[0], address, policy))
+
+	proposal, _ = k.GetProposal(ctx, pending)
+	require.Equal(t, types.StatusAborted, proposal.Status)
+
+	groupPolicy, _ := k.GetGroupPolicy(ctx, address)
+	require.Equal(t, uint64(2), groupPolicy.Version)
+	percentage, ok := groupPolicy.DecisionPolicy.(types.PercentageDecisionPolicy)
+	require.True(t, ok)
+	require.True(t, policy.Percentage.Equal(percentage.Percentage))
+}
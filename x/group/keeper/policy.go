@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// CreateGroupPolicy creates a group policy account for a group, and returns
+// its address. The admin must be the admin of the group.
+func (k Keeper) CreateGroupPolicy(
+	ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy types.DecisionPolicy,
+) (sdk.AccAddress, error) {
+	if err := decisionPolicy.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getGroupAsAdmin(ctx, groupID, admin); err != nil {
+		return nil, err
+	}
+
+	address := k.newGroupPolicyAddress(ctx)
+	k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, address))
+	k.SetGroupPolicy(ctx, types.NewGroupPolicy(address, groupID, admin, metadata, decisionPolicy))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyGroupPolicy, address.String()),
+		),
+	)
+
+	return address, nil
+}
+
+// newGroupPolicyAddress returns the address of a new group policy account,
+// derived from the next value of the group policy sequence. The addresses
+// holding an account are skipped, so that sending tokens to the next address
+// cannot prevent the creation of group policies.
+func (k Keeper) newGroupPolicyAddress(ctx sdk.Context) sdk.AccAddress {
+	for {
+		address := types.GroupPolicyAddress(k.nextSequence(ctx, types.NextGroupPolicySeqKey))
+		if !k.accountKeeper.HasAccount(ctx, address) {
+			return address
+		}
+	}
+}
+
+// UpdateGroupPolicy sets the decision policy of a group policy. The pending
+// proposals of the group policy are aborted.
+func (k Keeper) UpdateGroupPolicy(
+	ctx sdk.Context, admin, address sdk.AccAddress, decisionPolicy types.DecisionPolicy,
+) error {
+	if err := decisionPolicy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	policy, found := k.GetGroupPolicy(ctx, address)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "%s", address)
+	}
+
+	if !policy.Admin.Equals(admin) {
+		return sdkerrors.Wrapf(types.ErrNotAdmin, "%s is not the admin of group policy %s", admin, address)
+	}
+
+	policy.DecisionPolicy = decisionPolicy
+	policy.Version++
+	k.SetGroupPolicy(ctx, policy)
+
+	k.abortProposals(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyGroupPolicy, address.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// SubmitProposal submits a proposal of messages to be executed by a group
+// policy account, and returns its ID. The proposers must be members of the
+// group of the policy.
+func (k Keeper) SubmitProposal(
+	ctx sdk.Context, address sdk.AccAddress, proposers []sdk.AccAddress, metadata string, msgs []sdk.Msg,
+) (uint64, error) {
+	policy, found := k.GetGroupPolicy(ctx, address)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "%s", address)
+	}
+
+	group, found := k.GetGroup(ctx, policy.GroupID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", policy.GroupID)
+	}
+
+	for _, proposer := range proposers {
+		if _, found := k.GetMember(ctx, group.ID, proposer); !found {
+			return 0, sdkerrors.Wrapf(types.ErrNotMember, "proposer %s in group %d", proposer, group.ID)
+		}
+	}
+
+	proposalID := k.nextSequence(ctx, types.NextProposalIDKey)
+	k.SetProposal(ctx, types.Proposal{
+		ID:                 proposalID,
+		Address:            address,
+		Metadata:           metadata,
+		Proposers:          proposers,
+		Msgs:               msgs,
+		SubmitTime:         ctx.BlockTime(),
+		VotingPeriodEnd:    ctx.BlockTime().Add(policy.DecisionPolicy.GetVotingPeriod()),
+		GroupVersion:       group.Version,
+		GroupPolicyVersion: policy.Version,
+		Status:             types.StatusSubmitted,
+		Result:             types.ResultUnfinalized,
+		ExecutorResult:     types.ExecutorNotRun,
+		Tally:              types.NewTally(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyGroupPolicy, address.String()),
+		),
+	)
+
+	return proposalID, nil
+}
+
+// Vote casts the vote of a group member on a proposal. The proposal is
+// closed as soon as its result is final.
+func (k Keeper) Vote(
+	ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, choice types.VoteChoice, metadata string,
+) error {
+	if !types.ValidVoteChoice(choice) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "vote choice %d", choice)
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", proposalID)
+	}
+
+	if proposal.Status != types.StatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrProposalClosed, "proposal %d is %s", proposalID, proposal.Status)
+	}
+
+	if !ctx.BlockTime().Before(proposal.VotingPeriodEnd) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d", proposalID)
+	}
+
+	policy, group, err := k.getProposalPolicyAndGroup(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	member, found := k.GetMember(ctx, group.ID, voter)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotMember, "voter %s in group %d", voter, group.ID)
+	}
+
+	if _, found := k.GetVote(ctx, proposalID, voter); found {
+		return sdkerrors.Wrapf(types.ErrDuplicateVote, "%s on proposal %d", voter, proposalID)
+	}
+
+	k.SetVote(ctx, types.NewVote(proposalID, voter, choice, metadata, ctx.BlockTime()))
+
+	proposal.Tally = proposal.Tally.Add(choice, member.Weight)
+	if result := policy.DecisionPolicy.Allow(proposal.Tally, group.TotalWeight); result.Final {
+		closeProposal(&proposal, result.Allow)
+	}
+
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyChoice, choice.String()),
+		),
+	)
+
+	return nil
+}
+
+// Exec executes the messages of an accepted proposal from the group policy
+// account. A proposal whose voting period ended is closed first, and is
+// rejected unless its tally is accepted by the decision policy. The execution
+// of the messages is atomic; a failed execution is recorded in the proposal
+// rather than returned, so that it can be retried.
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", proposalID)
+	}
+
+	switch proposal.Status {
+	case types.StatusAborted:
+		return sdkerrors.Wrapf(types.ErrProposalClosed, "proposal %d is %s", proposalID, proposal.Status)
+
+	case types.StatusSubmitted:
+		if ctx.BlockTime().Before(proposal.VotingPeriodEnd) {
+			return sdkerrors.Wrapf(types.ErrProposalNotAccepted, "proposal %d is still open for votes", proposalID)
+		}
+
+		policy, group, err := k.getProposalPolicyAndGroup(ctx, proposal)
+		if err != nil {
+			return err
+		}
+
+		result := policy.DecisionPolicy.Allow(proposal.Tally, group.TotalWeight)
+		closeProposal(&proposal, result.Allow)
+	}
+
+	if proposal.Result == types.ResultAccepted && proposal.ExecutorResult != types.ExecutorSuccess {
+		proposal.ExecutorResult = types.ExecutorSuccess
+		if err := k.execMsgs(ctx, proposal); err != nil {
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposalID, "err", err)
+			proposal.ExecutorResult = types.ExecutorFailure
+		}
+	}
+
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyResult, proposal.Result.String()),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	return nil
+}
+
+// execMsgs runs the messages of a proposal in a cached context, which is only
+// written if all the messages succeed. The messages must only be signed by the
+// group policy account.
+func (k Keeper) execMsgs(ctx sdk.Context, proposal types.Proposal) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	for i, msg := range proposal.Msgs {
+		if _, err := k.msgRouter.DispatchMsg(cacheCtx, proposal.Address, msg); err != nil {
+			return sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+	}
+
+	writeCache()
+	return nil
+}
+
+func (k Keeper) getProposalPolicyAndGroup(
+	ctx sdk.Context, proposal types.Proposal,
+) (types.GroupPolicy, types.Group, error) {
+	policy, found := k.GetGroupPolicy(ctx, proposal.Address)
+	if !found {
+		return policy, types.Group{}, sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "%s", proposal.Address)
+	}
+
+	group, found := k.GetGroup(ctx, policy.GroupID)
+	if !found {
+		return policy, group, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", policy.GroupID)
+	}
+
+	return policy, group, nil
+}
+
+// abortProposals aborts the pending proposals of a group policy.
+func (k Keeper) abortProposals(ctx sdk.Context, address sdk.AccAddress) {
+	for _, proposal := range k.GetProposalsByPolicy(ctx, address) {
+		if proposal.Status != types.StatusSubmitted {
+			continue
+		}
+
+		proposal.Status = types.StatusAborted
+		k.SetProposal(ctx, proposal)
+	}
+}
+
+// closeProposal sets the final result of a proposal.
+func closeProposal(proposal *types.Proposal, accepted bool) {
+	proposal.Status = types.StatusClosed
+	proposal.Result = types.ResultRejected
+	if accepted {
+		proposal.Result = types.ResultAccepted
+	}
+}
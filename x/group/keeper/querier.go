@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// NewQuerier returns a group Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryGroup:
+			return queryGroup(ctx, req, k)
+
+		case types.QueryGroupMembers:
+			return queryGroupMembers(ctx, req, k)
+
+		case types.QueryGroupPolicy:
+			return queryGroupPolicy(ctx, req, k)
+
+		case types.QueryGroupPoliciesByGroup:
+			return queryGroupPoliciesByGroup(ctx, req, k)
+
+		case types.QueryProposal:
+			return queryProposal(ctx, req, k)
+
+		case types.QueryProposalsByPolicy:
+			return queryProposalsByPolicy(ctx, req, k)
+
+		case types.QueryVotes:
+			return queryVotes(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	group, found := k.GetGroup(ctx, params.GroupID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", params.GroupID)
+	}
+
+	return marshalJSON(k, group)
+}
+
+func queryGroupMembers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetGroupMembers(ctx, params.GroupID))
+}
+
+func queryGroupPolicy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupPolicyParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	policy, found := k.GetGroupPolicy(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "%s", params.Address)
+	}
+
+	return marshalJSON(k, policy)
+}
+
+func queryGroupPoliciesByGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetGroupPoliciesByGroup(ctx, params.GroupID))
+}
+
+func queryProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", params.ProposalID)
+	}
+
+	return marshalJSON(k, proposal)
+}
+
+func queryProposalsByPolicy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupPolicyParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetProposalsByPolicy(ctx, params.Address))
+}
+
+func queryVotes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetVotes(ctx, params.ProposalID))
+}
+
+func marshalJSON(k Keeper, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
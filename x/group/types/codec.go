@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the necessary x/group interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON
+// serialization.
+//
+// NOTE: the proposals of a group embed arbitrary messages, so the codec used
+// by the keeper must have the messages of all the modules registered.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
+	cdc.RegisterConcrete(ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+
+	cdc.RegisterConcrete(MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAdmin{}, "cosmos-sdk/MsgUpdateGroupAdmin", nil)
+	cdc.RegisterConcrete(MsgCreateGroupPolicy{}, "cosmos-sdk/MsgCreateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupPolicy{}, "cosmos-sdk/MsgUpdateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/group/MsgVote", nil)
+	cdc.RegisterConcrete(MsgExec{}, "cosmos-sdk/group/MsgExec", nil)
+}
+
+// ModuleCdc defines the amino codec used to encode the group module messages.
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	ErrInvalid             = sdkerrors.Register(ModuleName, 2, "invalid value")
+	ErrGroupNotFound       = sdkerrors.Register(ModuleName, 3, "group not found")
+	ErrGroupPolicyNotFound = sdkerrors.Register(ModuleName, 4, "group policy not found")
+	ErrProposalNotFound    = sdkerrors.Register(ModuleName, 5, "proposal not found")
+	ErrNotMember           = sdkerrors.Register(ModuleName, 6, "not a group member")
+	ErrNotAdmin            = sdkerrors.Register(ModuleName, 7, "not the admin")
+	ErrProposalClosed      = sdkerrors.Register(ModuleName, 8, "proposal is closed")
+	ErrVotingPeriodEnded   = sdkerrors.Register(ModuleName, 9, "voting period has ended")
+	ErrDuplicateVote       = sdkerrors.Register(ModuleName, 10, "member already voted")
+	ErrProposalNotAccepted = sdkerrors.Register(ModuleName, 11, "proposal is not accepted")
+)
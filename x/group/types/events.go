@@ -0,0 +1,22 @@
+package types
+
+// group module event types
+const (
+	EventTypeCreateGroup       = "create_group"
+	EventTypeUpdateGroup       = "update_group"
+	EventTypeCreateGroupPolicy = "create_group_policy"
+	EventTypeUpdateGroupPolicy = "update_group_policy"
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeVote              = "vote"
+	EventTypeExec              = "exec"
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyGroupPolicy    = "group_policy"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyChoice         = "choice"
+	AttributeKeyResult         = "result"
+	AttributeKeyExecutorResult = "executor_result"
+
+	AttributeValueCategory = ModuleName
+)
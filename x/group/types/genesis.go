@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the group module genesis state
+type GenesisState struct {
+	NextGroupID        uint64        `json:"next_group_id" yaml:"next_group_id"`
+	NextGroupPolicySeq uint64        `json:"next_group_policy_seq" yaml:"next_group_policy_seq"`
+	NextProposalID     uint64        `json:"next_proposal_id" yaml:"next_proposal_id"`
+	Groups             []Group       `json:"groups" yaml:"groups"`
+	Members            []Member      `json:"members" yaml:"members"`
+	GroupPolicies      []GroupPolicy `json:"group_policies" yaml:"group_policies"`
+	Proposals          []Proposal    `json:"proposals" yaml:"proposals"`
+	Votes              []Vote        `json:"votes" yaml:"votes"`
+}
+
+// DefaultGenesisState returns the default genesis state, without any group.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextGroupID:        1,
+		NextGroupPolicySeq: 1,
+		NextProposalID:     1,
+		Groups:             []Group{},
+		Members:            []Member{},
+		GroupPolicies:      []GroupPolicy{},
+		Proposals:          []Proposal{},
+		Votes:              []Vote{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if gs.NextGroupID == 0 || gs.NextGroupPolicySeq == 0 || gs.NextProposalID == 0 {
+		return fmt.Errorf("next group, group policy and proposal sequences must be positive")
+	}
+
+	groups := make(map[uint64]bool)
+	for _, g := range gs.Groups {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+
+		if groups[g.ID] || g.ID >= gs.NextGroupID {
+			return fmt.Errorf("invalid or duplicate group id %d", g.ID)
+		}
+
+		groups[g.ID] = true
+	}
+
+	for _, m := range gs.Members {
+		if err := m.Validate(false); err != nil {
+			return err
+		}
+
+		if !groups[m.GroupID] {
+			return fmt.Errorf("member %s group %d does not exist", m.Address, m.GroupID)
+		}
+	}
+
+	policies := make(map[string]bool)
+	for _, p := range gs.GroupPolicies {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if !groups[p.GroupID] {
+			return fmt.Errorf("group policy %s group %d does not exist", p.Address, p.GroupID)
+		}
+
+		policies[p.Address.String()] = true
+	}
+
+	proposals := make(map[uint64]bool)
+	for _, p := range gs.Proposals {
+		if p.ID == 0 || p.ID >= gs.NextProposalID || proposals[p.ID] {
+			return fmt.Errorf("invalid or duplicate proposal id %d", p.ID)
+		}
+
+		if !policies[p.Address.String()] {
+			return fmt.Errorf("proposal %d group policy %s does not exist", p.ID, p.Address)
+		}
+
+		proposals[p.ID] = true
+	}
+
+	for _, v := range gs.Votes {
+		if !proposals[v.ProposalID] {
+			return fmt.Errorf("vote of %s proposal %d does not exist", v.Voter, v.ProposalID)
+		}
+	}
+
+	return nil
+}
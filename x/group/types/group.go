@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Group defines a group of weighted members, administered by an admin account.
+type Group struct {
+	ID       uint64         `json:"id" yaml:"id"`
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Metadata string         `json:"metadata" yaml:"metadata"`
+	// Version is incremented on every update of the group, so that the
+	// proposals submitted to a previous version of the group are aborted.
+	Version     uint64  `json:"version" yaml:"version"`
+	TotalWeight sdk.Dec `json:"total_weight" yaml:"total_weight"`
+}
+
+// NewGroup creates a new Group instance
+func NewGroup(id uint64, admin sdk.AccAddress, metadata string, totalWeight sdk.Dec) Group {
+	return Group{
+		ID:          id,
+		Admin:       admin,
+		Metadata:    metadata,
+		Version:     1,
+		TotalWeight: totalWeight,
+	}
+}
+
+// Validate performs a basic validation of the Group.
+func (g Group) Validate() error {
+	if g.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "group id cannot be zero")
+	}
+
+	if g.Admin.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "group %d admin cannot be empty", g.ID)
+	}
+
+	if g.Version == 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "group %d version cannot be zero", g.ID)
+	}
+
+	if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalid, "group %d total weight cannot be negative", g.ID)
+	}
+
+	return nil
+}
+
+// Member defines a weighted member of a group.
+type Member struct {
+	GroupID  uint64         `json:"group_id" yaml:"group_id"`
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Weight   sdk.Dec        `json:"weight" yaml:"weight"`
+	Metadata string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewMember creates a new Member instance
+func NewMember(groupID uint64, address sdk.AccAddress, weight sdk.Dec, metadata string) Member {
+	return Member{
+		GroupID:  groupID,
+		Address:  address,
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// Validate performs a basic validation of the Member. A zero weight is only
+// valid in member updates, where it removes the member.
+func (m Member) Validate(allowZeroWeight bool) error {
+	if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "member address cannot be empty")
+	}
+
+	if m.Weight.IsNil() || m.Weight.IsNegative() || (!allowZeroWeight && m.Weight.IsZero()) {
+		return sdkerrors.Wrapf(ErrInvalid, "member %s weight must be positive: %s", m.Address, m.Weight)
+	}
+
+	return nil
+}
+
+// ValidateMembers validates a list of members and checks that no member is
+// listed twice.
+func ValidateMembers(members []Member, allowZeroWeight bool) error {
+	seen := make(map[string]bool)
+	for _, m := range members {
+		if err := m.Validate(allowZeroWeight); err != nil {
+			return err
+		}
+
+		if seen[m.Address.String()] {
+			return sdkerrors.Wrap(ErrInvalid, fmt.Sprintf("duplicate member %s", m.Address))
+		}
+
+		seen[m.Address.String()] = true
+	}
+
+	return nil
+}
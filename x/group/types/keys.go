@@ -0,0 +1,114 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "group"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x01<groupID_Bytes>: Group
+//
+// - 0x02<groupID_Bytes><member_Bytes>: Member
+//
+// - 0x03<policy_Bytes>: GroupPolicy
+//
+// - 0x04<groupID_Bytes><policy_Bytes>: []byte{0x01}
+//
+// - 0x05<proposalID_Bytes>: Proposal
+//
+// - 0x06<policy_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x07<proposalID_Bytes><voter_Bytes>: Vote
+//
+// - 0x08: nextGroupID
+//
+// - 0x09: nextGroupPolicySeq
+//
+// - 0x0A: nextProposalID
+var (
+	GroupKeyPrefix              = []byte{0x01}
+	MemberKeyPrefix             = []byte{0x02}
+	GroupPolicyKeyPrefix        = []byte{0x03}
+	GroupPolicyByGroupKeyPrefix = []byte{0x04}
+	ProposalKeyPrefix           = []byte{0x05}
+	ProposalByPolicyKeyPrefix   = []byte{0x06}
+	VoteKeyPrefix               = []byte{0x07}
+	NextGroupIDKey              = []byte{0x08}
+	NextGroupPolicySeqKey       = []byte{0x09}
+	NextProposalIDKey           = []byte{0x0A}
+
+	// Placeholder is the value of the index keys.
+	Placeholder = []byte{0x01}
+)
+
+// GroupKey returns the key storing a group.
+func GroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// MembersPrefix returns the prefix of the keys storing the members of a group.
+func MembersPrefix(groupID uint64) []byte {
+	return append(MemberKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// MemberKey returns the key storing a group member.
+func MemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(MembersPrefix(groupID), member.Bytes()...)
+}
+
+// GroupPolicyKey returns the key storing a group policy.
+func GroupPolicyKey(policy sdk.AccAddress) []byte {
+	return append(GroupPolicyKeyPrefix, policy.Bytes()...)
+}
+
+// GroupPoliciesByGroupPrefix returns the prefix of the index keys of the
+// policies of a group.
+func GroupPoliciesByGroupPrefix(groupID uint64) []byte {
+	return append(GroupPolicyByGroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupPolicyByGroupKey returns the index key of a policy of a group.
+func GroupPolicyByGroupKey(groupID uint64, policy sdk.AccAddress) []byte {
+	return append(GroupPoliciesByGroupPrefix(groupID), policy.Bytes()...)
+}
+
+// ProposalKey returns the key storing a proposal.
+func ProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// ProposalsByPolicyPrefix returns the prefix of the index keys of the
+// proposals of a group policy.
+func ProposalsByPolicyPrefix(policy sdk.AccAddress) []byte {
+	return append(ProposalByPolicyKeyPrefix, policy.Bytes()...)
+}
+
+// ProposalByPolicyKey returns the index key of a proposal of a group policy.
+func ProposalByPolicyKey(policy sdk.AccAddress, proposalID uint64) []byte {
+	return append(ProposalsByPolicyPrefix(policy), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VotesPrefix returns the prefix of the keys storing the votes of a proposal.
+func VotesPrefix(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteKey returns the key storing a vote.
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesPrefix(proposalID), voter.Bytes()...)
+}
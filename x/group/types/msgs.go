@@ -0,0 +1,431 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup        = "create_group"
+	TypeMsgUpdateGroupMembers = "update_group_members"
+	TypeMsgUpdateGroupAdmin   = "update_group_admin"
+	TypeMsgCreateGroupPolicy  = "create_group_policy"
+	TypeMsgUpdateGroupPolicy  = "update_group_policy"
+	TypeMsgSubmitProposal     = "submit_proposal"
+	TypeMsgVote               = "vote"
+	TypeMsgExec               = "exec"
+)
+
+var (
+	_ sdk.Msg = MsgCreateGroup{}
+	_ sdk.Msg = MsgUpdateGroupMembers{}
+	_ sdk.Msg = MsgUpdateGroupAdmin{}
+	_ sdk.Msg = MsgCreateGroupPolicy{}
+	_ sdk.Msg = MsgUpdateGroupPolicy{}
+	_ sdk.Msg = MsgSubmitProposal{}
+	_ sdk.Msg = MsgVote{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgCreateGroup defines a message to create a group with the given members.
+// The GroupID of the members is ignored.
+type MsgCreateGroup struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Members  []Member       `json:"members" yaml:"members"`
+	Metadata string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewMsgCreateGroup creates a new MsgCreateGroup instance
+func NewMsgCreateGroup(admin sdk.AccAddress, members []Member, metadata string) MsgCreateGroup {
+	return MsgCreateGroup{Admin: admin, Members: members, Metadata: metadata}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+
+	return ValidateMembers(msg.Members, false)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupMembers defines a message to add, update or remove members of
+// a group. A member update with a zero weight removes the member.
+type MsgUpdateGroupMembers struct {
+	Admin         sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID       uint64         `json:"group_id" yaml:"group_id"`
+	MemberUpdates []Member       `json:"member_updates" yaml:"member_updates"`
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers instance
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, updates []Member) MsgUpdateGroupMembers {
+	return MsgUpdateGroupMembers{Admin: admin, GroupID: groupID, MemberUpdates: updates}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "group id cannot be zero")
+	}
+
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "member updates cannot be empty")
+	}
+
+	return ValidateMembers(msg.MemberUpdates, true)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupAdmin defines a message to change the admin of a group.
+type MsgUpdateGroupAdmin struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID  uint64         `json:"group_id" yaml:"group_id"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+// NewMsgUpdateGroupAdmin creates a new MsgUpdateGroupAdmin instance
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) MsgUpdateGroupAdmin {
+	return MsgUpdateGroupAdmin{Admin: admin, GroupID: groupID, NewAdmin: newAdmin}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "group id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgCreateGroupPolicy defines a message to create a group policy account for
+// a group. It is signed by the group admin, who becomes the policy admin.
+type MsgCreateGroupPolicy struct {
+	Admin          sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID        uint64         `json:"group_id" yaml:"group_id"`
+	Metadata       string         `json:"metadata" yaml:"metadata"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy instance
+func NewMsgCreateGroupPolicy(
+	admin sdk.AccAddress, groupID uint64, metadata string, policy DecisionPolicy,
+) MsgCreateGroupPolicy {
+	return MsgCreateGroupPolicy{Admin: admin, GroupID: groupID, Metadata: metadata, DecisionPolicy: policy}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateGroupPolicy) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateGroupPolicy) Type() string { return TypeMsgCreateGroupPolicy }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateGroupPolicy) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "group id cannot be zero")
+	}
+
+	if msg.DecisionPolicy == nil {
+		return sdkerrors.Wrap(ErrInvalid, "missing decision policy")
+	}
+
+	return msg.DecisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupPolicy defines a message to change the decision policy of a
+// group policy. It is signed by the policy admin.
+type MsgUpdateGroupPolicy struct {
+	Admin          sdk.AccAddress `json:"admin" yaml:"admin"`
+	Address        sdk.AccAddress `json:"address" yaml:"address"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// NewMsgUpdateGroupPolicy creates a new MsgUpdateGroupPolicy instance
+func NewMsgUpdateGroupPolicy(admin, address sdk.AccAddress, policy DecisionPolicy) MsgUpdateGroupPolicy {
+	return MsgUpdateGroupPolicy{Admin: admin, Address: address, DecisionPolicy: policy}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateGroupPolicy) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateGroupPolicy) Type() string { return TypeMsgUpdateGroupPolicy }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateGroupPolicy) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+
+	if msg.DecisionPolicy == nil {
+		return sdkerrors.Wrap(ErrInvalid, "missing decision policy")
+	}
+
+	return msg.DecisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSubmitProposal defines a message to submit a proposal of messages to be
+// executed by a group policy account. All the messages must be signed by the
+// group policy account only, and all the proposers must be group members.
+type MsgSubmitProposal struct {
+	Address   sdk.AccAddress   `json:"address" yaml:"address"`
+	Proposers []sdk.AccAddress `json:"proposers" yaml:"proposers"`
+	Metadata  string           `json:"metadata" yaml:"metadata"`
+	Msgs      []sdk.Msg        `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance
+func NewMsgSubmitProposal(
+	address sdk.AccAddress, proposers []sdk.AccAddress, metadata string, msgs []sdk.Msg,
+) MsgSubmitProposal {
+	return MsgSubmitProposal{Address: address, Proposers: proposers, Metadata: metadata, Msgs: msgs}
+}
+
+// Route Implements Msg.
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "proposers cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, proposer := range msg.Proposers {
+		if proposer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing proposer address")
+		}
+
+		if seen[proposer.String()] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate proposer %s", proposer)
+		}
+
+		seen[proposer.String()] = true
+	}
+
+	for i, m := range msg.Msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		for _, signer := range m.GetSigners() {
+			if !signer.Equals(msg.Address) {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrUnauthorized, "message %d signer %s is not the group policy account", i, signer,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg. The proposed messages are embedded with their
+// own sign bytes, since the module codec does not know their types.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = m.GetSignBytes()
+	}
+
+	bz, err := json.Marshal(struct {
+		Address   sdk.AccAddress    `json:"address"`
+		Proposers []sdk.AccAddress  `json:"proposers"`
+		Metadata  string            `json:"metadata"`
+		Msgs      []json.RawMessage `json:"msgs"`
+	}{msg.Address, msg.Proposers, msg.Metadata, msgs})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return msg.Proposers
+}
+
+// MsgVote defines a message to vote on a proposal, signed by a group member.
+type MsgVote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Choice     VoteChoice     `json:"choice" yaml:"choice"`
+	Metadata   string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewMsgVote creates a new MsgVote instance
+func NewMsgVote(proposalID uint64, voter sdk.AccAddress, choice VoteChoice, metadata string) MsgVote {
+	return MsgVote{ProposalID: proposalID, Voter: voter, Choice: choice, Metadata: metadata}
+}
+
+// Route Implements Msg.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic Implements Msg.
+func (msg MsgVote) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+
+	if msg.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "proposal id cannot be zero")
+	}
+
+	if !ValidVoteChoice(msg.Choice) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid vote choice %d", msg.Choice)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgExec defines a message to execute the messages of an accepted proposal.
+// Any account can execute a proposal.
+type MsgExec struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Executor   sdk.AccAddress `json:"executor" yaml:"executor"`
+}
+
+// NewMsgExec creates a new MsgExec instance
+func NewMsgExec(proposalID uint64, executor sdk.AccAddress) MsgExec {
+	return MsgExec{ProposalID: proposalID, Executor: executor}
+}
+
+// Route Implements Msg.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic Implements Msg.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Executor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing executor address")
+	}
+
+	if msg.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "proposal id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Executor}
+}
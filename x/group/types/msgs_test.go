@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________"))
+	policyAddr := GroupPolicyAddress(1)
+
+	members := []Member{NewMember(0, addr, sdk.OneDec(), "")}
+	removal := []Member{NewMember(0, addr, sdk.ZeroDec(), "")}
+	duplicates := []Member{NewMember(0, addr, sdk.OneDec(), ""), NewMember(0, addr, sdk.OneDec(), "")}
+	policy := NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour)
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"create group", NewMsgCreateGroup(addr, members, ""), false},
+		{"create group no admin", NewMsgCreateGroup(nil, members, ""), true},
+		{"create group zero weight", NewMsgCreateGroup(addr, removal, ""), true},
+		{"create group duplicate members", NewMsgCreateGroup(addr, duplicates, ""), true},
+		{"update members", NewMsgUpdateGroupMembers(addr, 1, removal), false},
+		{"update members no group", NewMsgUpdateGroupMembers(addr, 0, removal), true},
+		{"update members empty", NewMsgUpdateGroupMembers(addr, 1, nil), true},
+		{"update admin", NewMsgUpdateGroupAdmin(addr, 1, addr), false},
+		{"update admin no new admin", NewMsgUpdateGroupAdmin(addr, 1, nil), true},
+		{"create policy", NewMsgCreateGroupPolicy(addr, 1, "", policy), false},
+		{"create policy no policy", NewMsgCreateGroupPolicy(addr, 1, "", nil), true},
+		{"create policy invalid policy", NewMsgCreateGroupPolicy(addr, 1, "", NewThresholdDecisionPolicy(sdk.OneDec(), 0)), true},
+		{"update policy", NewMsgUpdateGroupPolicy(addr, policyAddr, policy), false},
+		{"update policy no address", NewMsgUpdateGroupPolicy(addr, nil, policy), true},
+		{"submit proposal", NewMsgSubmitProposal(policyAddr, []sdk.AccAddress{addr}, "", []sdk.Msg{sdk.NewTestMsg(policyAddr)}), false},
+		{"submit proposal no proposer", NewMsgSubmitProposal(policyAddr, nil, "", nil), true},
+		{"submit proposal duplicate proposer", NewMsgSubmitProposal(policyAddr, []sdk.AccAddress{addr, addr}, "", nil), true},
+		{"submit proposal other signer", NewMsgSubmitProposal(policyAddr, []sdk.AccAddress{addr}, "", []sdk.Msg{sdk.NewTestMsg(addr)}), true},
+		{"vote", NewMsgVote(1, addr, ChoiceYes, ""), false},
+		{"vote invalid choice", NewMsgVote(1, addr, VoteChoice(0x10), ""), true},
+		{"vote no proposal", NewMsgVote(0, addr, ChoiceNo, ""), true},
+		{"exec", NewMsgExec(1, addr), false},
+		{"exec no executor", NewMsgExec(1, nil), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitProposalGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________"))
+	policyAddr := GroupPolicyAddress(1)
+
+	msg := NewMsgSubmitProposal(policyAddr, []sdk.AccAddress{addr}, "", []sdk.Msg{sdk.NewTestMsg(policyAddr)})
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+	require.Contains(t, string(msg.GetSignBytes()), policyAddr.String())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+}
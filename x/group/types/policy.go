@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult defines the result of a decision policy applied to the
+// tally of a proposal.
+type DecisionPolicyResult struct {
+	// Allow is true if the proposal is accepted.
+	Allow bool
+	// Final is true if the result cannot change with further votes.
+	Final bool
+}
+
+// DecisionPolicy defines the rule by which the proposals of a group policy
+// are accepted or rejected.
+type DecisionPolicy interface {
+	// GetVotingPeriod returns the duration during which a proposal can be
+	// voted on.
+	GetVotingPeriod() time.Duration
+
+	// Allow returns the result of the policy for the given tally and total
+	// weight of the group.
+	Allow(tally Tally, totalWeight sdk.Dec) DecisionPolicyResult
+
+	// ValidateBasic performs a stateless validation of the policy.
+	ValidateBasic() error
+}
+
+var (
+	_ DecisionPolicy = ThresholdDecisionPolicy{}
+	_ DecisionPolicy = PercentageDecisionPolicy{}
+)
+
+// ThresholdDecisionPolicy accepts the proposals whose yes votes reach a
+// threshold weight. If the threshold is greater than the total weight of the
+// group, all the members must vote yes.
+type ThresholdDecisionPolicy struct {
+	Threshold    sdk.Dec       `json:"threshold" yaml:"threshold"`
+	VotingPeriod time.Duration `json:"voting_period" yaml:"voting_period"`
+}
+
+// NewThresholdDecisionPolicy creates a new ThresholdDecisionPolicy instance
+func NewThresholdDecisionPolicy(threshold sdk.Dec, votingPeriod time.Duration) ThresholdDecisionPolicy {
+	return ThresholdDecisionPolicy{Threshold: threshold, VotingPeriod: votingPeriod}
+}
+
+// GetVotingPeriod implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration { return p.VotingPeriod }
+
+// Allow implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec) DecisionPolicyResult {
+	threshold := sdk.MinDec(p.Threshold, totalWeight)
+	if tally.Yes.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}
+	}
+
+	undecided := totalWeight.Sub(tally.Total())
+	if tally.Yes.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalid, "threshold must be positive: %s", p.Threshold)
+	}
+
+	if p.VotingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "voting period must be positive: %s", p.VotingPeriod)
+	}
+
+	return nil
+}
+
+// PercentageDecisionPolicy accepts the proposals whose yes votes reach a
+// percentage of the total weight of the group.
+type PercentageDecisionPolicy struct {
+	Percentage   sdk.Dec       `json:"percentage" yaml:"percentage"`
+	VotingPeriod time.Duration `json:"voting_period" yaml:"voting_period"`
+}
+
+// NewPercentageDecisionPolicy creates a new PercentageDecisionPolicy instance
+func NewPercentageDecisionPolicy(percentage sdk.Dec, votingPeriod time.Duration) PercentageDecisionPolicy {
+	return PercentageDecisionPolicy{Percentage: percentage, VotingPeriod: votingPeriod}
+}
+
+// GetVotingPeriod implements DecisionPolicy.
+func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration { return p.VotingPeriod }
+
+// Allow implements DecisionPolicy.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec) DecisionPolicyResult {
+	if !totalWeight.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	if tally.Yes.Quo(totalWeight).GTE(p.Percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}
+	}
+
+	undecided := totalWeight.Sub(tally.Total())
+	if tally.Yes.Add(undecided).Quo(totalWeight).LT(p.Percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalid, "percentage must be in (0, 1]: %s", p.Percentage)
+	}
+
+	if p.VotingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "voting period must be positive: %s", p.VotingPeriod)
+	}
+
+	return nil
+}
+
+// GroupPolicy defines an account controlled by a group, which executes the
+// messages of the proposals accepted by its decision policy.
+type GroupPolicy struct {
+	Address        sdk.AccAddress `json:"address" yaml:"address"`
+	GroupID        uint64         `json:"group_id" yaml:"group_id"`
+	Admin          sdk.AccAddress `json:"admin" yaml:"admin"`
+	Metadata       string         `json:"metadata" yaml:"metadata"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+	// Version is incremented on every update of the policy, so that the
+	// proposals submitted to a previous version of the policy are aborted.
+	Version uint64 `json:"version" yaml:"version"`
+}
+
+// NewGroupPolicy creates a new GroupPolicy instance
+func NewGroupPolicy(
+	address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata string, policy DecisionPolicy,
+) GroupPolicy {
+	return GroupPolicy{
+		Address:        address,
+		GroupID:        groupID,
+		Admin:          admin,
+		Metadata:       metadata,
+		DecisionPolicy: policy,
+		Version:        1,
+	}
+}
+
+// Validate performs a basic validation of the GroupPolicy.
+func (p GroupPolicy) Validate() error {
+	if p.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "group policy address cannot be empty")
+	}
+
+	if p.GroupID == 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "group policy %s group id cannot be zero", p.Address)
+	}
+
+	if p.Admin.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "group policy %s admin cannot be empty", p.Address)
+	}
+
+	if p.DecisionPolicy == nil {
+		return sdkerrors.Wrapf(ErrInvalid, "group policy %s decision policy cannot be empty", p.Address)
+	}
+
+	return p.DecisionPolicy.ValidateBasic()
+}
+
+// GroupPolicyAddress returns the address of the account of the group policy
+// with the given sequence number.
+func GroupPolicyAddress(seq uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/policy/%d", ModuleName, seq))))
+}
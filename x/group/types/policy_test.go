@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func tally(yes, no int64) types.Tally {
+	return types.NewTally().Add(types.ChoiceYes, sdk.NewDec(yes)).Add(types.ChoiceNo, sdk.NewDec(no))
+}
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour)
+
+	testCases := []struct {
+		tally       types.Tally
+		totalWeight int64
+		expected    types.DecisionPolicyResult
+	}{
+		{tally(3, 0), 5, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{tally(2, 0), 5, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{tally(2, 2), 5, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{tally(1, 3), 5, types.DecisionPolicyResult{Allow: false, Final: true}},
+		// the threshold is capped to the total weight
+		{tally(2, 0), 2, types.DecisionPolicyResult{Allow: true, Final: true}},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, policy.Allow(tc.tally, sdk.NewDec(tc.totalWeight)), "test case #%d", i)
+	}
+
+	require.NoError(t, policy.ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy(sdk.OneDec(), 0).ValidateBasic())
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	policy := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour)
+
+	testCases := []struct {
+		tally       types.Tally
+		totalWeight int64
+		expected    types.DecisionPolicyResult
+	}{
+		{tally(2, 0), 4, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{tally(1, 1), 4, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{tally(1, 3), 4, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{tally(0, 0), 0, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, policy.Allow(tc.tally, sdk.NewDec(tc.totalWeight)), "test case #%d", i)
+	}
+
+	require.NoError(t, policy.ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.NewDec(2), time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.ZeroDec(), time.Hour).ValidateBasic())
+}
@@ -0,0 +1,271 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalStatus defines the status of a proposal.
+type ProposalStatus byte
+
+// Proposal statuses
+const (
+	// StatusSubmitted is the status of a proposal open for votes.
+	StatusSubmitted ProposalStatus = 0x01
+	// StatusClosed is the status of a proposal whose result is final.
+	StatusClosed ProposalStatus = 0x02
+	// StatusAborted is the status of a proposal whose group or group policy
+	// was updated before its result was final.
+	StatusAborted ProposalStatus = 0x03
+)
+
+// ProposalResult defines the result of a proposal.
+type ProposalResult byte
+
+// Proposal results
+const (
+	ResultUnfinalized ProposalResult = 0x01
+	ResultAccepted    ProposalResult = 0x02
+	ResultRejected    ProposalResult = 0x03
+)
+
+// ExecutorResult defines the result of the execution of the messages of an
+// accepted proposal.
+type ExecutorResult byte
+
+// Executor results
+const (
+	ExecutorNotRun  ExecutorResult = 0x01
+	ExecutorSuccess ExecutorResult = 0x02
+	ExecutorFailure ExecutorResult = 0x03
+)
+
+var (
+	proposalStatusNames = map[ProposalStatus]string{
+		StatusSubmitted: "Submitted",
+		StatusClosed:    "Closed",
+		StatusAborted:   "Aborted",
+	}
+	proposalResultNames = map[ProposalResult]string{
+		ResultUnfinalized: "Unfinalized",
+		ResultAccepted:    "Accepted",
+		ResultRejected:    "Rejected",
+	}
+	executorResultNames = map[ExecutorResult]string{
+		ExecutorNotRun:  "NotRun",
+		ExecutorSuccess: "Success",
+		ExecutorFailure: "Failure",
+	}
+)
+
+// String implements the Stringer interface.
+func (s ProposalStatus) String() string { return proposalStatusNames[s] }
+
+// MarshalJSON marshals the status as a string.
+func (s ProposalStatus) MarshalJSON() ([]byte, error) { return json.Marshal(s.String()) }
+
+// UnmarshalJSON unmarshals the status from a string.
+func (s *ProposalStatus) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	for status, name := range proposalStatusNames {
+		if name == str {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not a valid proposal status", str)
+}
+
+// String implements the Stringer interface.
+func (r ProposalResult) String() string { return proposalResultNames[r] }
+
+// MarshalJSON marshals the result as a string.
+func (r ProposalResult) MarshalJSON() ([]byte, error) { return json.Marshal(r.String()) }
+
+// UnmarshalJSON unmarshals the result from a string.
+func (r *ProposalResult) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	for result, name := range proposalResultNames {
+		if name == str {
+			*r = result
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not a valid proposal result", str)
+}
+
+// String implements the Stringer interface.
+func (r ExecutorResult) String() string { return executorResultNames[r] }
+
+// MarshalJSON marshals the result as a string.
+func (r ExecutorResult) MarshalJSON() ([]byte, error) { return json.Marshal(r.String()) }
+
+// UnmarshalJSON unmarshals the result from a string.
+func (r *ExecutorResult) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	for result, name := range executorResultNames {
+		if name == str {
+			*r = result
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not a valid executor result", str)
+}
+
+// Tally defines the sum of the weights of the votes of a proposal, by choice.
+type Tally struct {
+	Yes     sdk.Dec `json:"yes" yaml:"yes"`
+	No      sdk.Dec `json:"no" yaml:"no"`
+	Abstain sdk.Dec `json:"abstain" yaml:"abstain"`
+	Veto    sdk.Dec `json:"veto" yaml:"veto"`
+}
+
+// NewTally returns an empty Tally.
+func NewTally() Tally {
+	return Tally{
+		Yes:     sdk.ZeroDec(),
+		No:      sdk.ZeroDec(),
+		Abstain: sdk.ZeroDec(),
+		Veto:    sdk.ZeroDec(),
+	}
+}
+
+// Add returns the tally with the weight of a vote added.
+func (t Tally) Add(choice VoteChoice, weight sdk.Dec) Tally {
+	switch choice {
+	case ChoiceYes:
+		t.Yes = t.Yes.Add(weight)
+	case ChoiceNo:
+		t.No = t.No.Add(weight)
+	case ChoiceAbstain:
+		t.Abstain = t.Abstain.Add(weight)
+	case ChoiceVeto:
+		t.Veto = t.Veto.Add(weight)
+	}
+
+	return t
+}
+
+// Total returns the total weight of the votes.
+func (t Tally) Total() sdk.Dec {
+	return t.Yes.Add(t.No).Add(t.Abstain).Add(t.Veto)
+}
+
+// Proposal defines a proposal of messages to be executed by a group policy
+// account, voted on by the members of the group.
+type Proposal struct {
+	ID uint64 `json:"id" yaml:"id"`
+	// Address is the address of the group policy of the proposal.
+	Address   sdk.AccAddress   `json:"address" yaml:"address"`
+	Metadata  string           `json:"metadata" yaml:"metadata"`
+	Proposers []sdk.AccAddress `json:"proposers" yaml:"proposers"`
+	Msgs      []sdk.Msg        `json:"msgs" yaml:"msgs"`
+
+	SubmitTime      time.Time `json:"submit_time" yaml:"submit_time"`
+	VotingPeriodEnd time.Time `json:"voting_period_end" yaml:"voting_period_end"`
+	// GroupVersion and GroupPolicyVersion are the versions of the group and
+	// group policy the proposal was submitted to.
+	GroupVersion       uint64 `json:"group_version" yaml:"group_version"`
+	GroupPolicyVersion uint64 `json:"group_policy_version" yaml:"group_policy_version"`
+
+	Status         ProposalStatus `json:"status" yaml:"status"`
+	Result         ProposalResult `json:"result" yaml:"result"`
+	ExecutorResult ExecutorResult `json:"executor_result" yaml:"executor_result"`
+	Tally          Tally          `json:"tally" yaml:"tally"`
+}
+
+// VoteChoice defines the choice of a vote.
+type VoteChoice byte
+
+// Vote choices
+const (
+	ChoiceYes     VoteChoice = 0x01
+	ChoiceNo      VoteChoice = 0x02
+	ChoiceAbstain VoteChoice = 0x03
+	ChoiceVeto    VoteChoice = 0x04
+)
+
+var voteChoiceNames = map[VoteChoice]string{
+	ChoiceYes:     "Yes",
+	ChoiceNo:      "No",
+	ChoiceAbstain: "Abstain",
+	ChoiceVeto:    "Veto",
+}
+
+// VoteChoiceFromString returns a VoteChoice from a string. It returns an error
+// if the string is invalid.
+func VoteChoiceFromString(str string) (VoteChoice, error) {
+	for choice, name := range voteChoiceNames {
+		if name == str {
+			return choice, nil
+		}
+	}
+
+	return VoteChoice(0xff), fmt.Errorf("'%s' is not a valid vote choice", str)
+}
+
+// ValidVoteChoice returns true if the vote choice is valid and false otherwise.
+func ValidVoteChoice(choice VoteChoice) bool {
+	_, ok := voteChoiceNames[choice]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (c VoteChoice) String() string { return voteChoiceNames[c] }
+
+// MarshalJSON marshals the choice as a string.
+func (c VoteChoice) MarshalJSON() ([]byte, error) { return json.Marshal(c.String()) }
+
+// UnmarshalJSON unmarshals the choice from a string.
+func (c *VoteChoice) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	choice, err := VoteChoiceFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*c = choice
+	return nil
+}
+
+// Vote defines the vote of a group member on a proposal.
+type Vote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Choice     VoteChoice     `json:"choice" yaml:"choice"`
+	Metadata   string         `json:"metadata" yaml:"metadata"`
+	SubmitTime time.Time      `json:"submit_time" yaml:"submit_time"`
+}
+
+// NewVote creates a new Vote instance
+func NewVote(proposalID uint64, voter sdk.AccAddress, choice VoteChoice, metadata string, submitTime time.Time) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Choice:     choice,
+		Metadata:   metadata,
+		SubmitTime: submitTime,
+	}
+}
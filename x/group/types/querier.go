@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier path constants
+const (
+	QueryGroup                = "group"
+	QueryGroupMembers         = "group_members"
+	QueryGroupPolicy          = "group_policy"
+	QueryGroupPoliciesByGroup = "group_policies_by_group"
+	QueryProposal             = "proposal"
+	QueryProposalsByPolicy    = "proposals_by_policy"
+	QueryVotes                = "votes"
+)
+
+// QueryGroupParams defines the params for the following queries:
+//
+// - 'custom/group/group'
+//
+// - 'custom/group/group_members'
+//
+// - 'custom/group/group_policies_by_group'
+type QueryGroupParams struct {
+	GroupID uint64 `json:"group_id" yaml:"group_id"`
+}
+
+// NewQueryGroupParams creates a new QueryGroupParams instance.
+func NewQueryGroupParams(groupID uint64) QueryGroupParams {
+	return QueryGroupParams{GroupID: groupID}
+}
+
+// QueryGroupPolicyParams defines the params for the following queries:
+//
+// - 'custom/group/group_policy'
+//
+// - 'custom/group/proposals_by_policy'
+type QueryGroupPolicyParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQueryGroupPolicyParams creates a new QueryGroupPolicyParams instance.
+func NewQueryGroupPolicyParams(address sdk.AccAddress) QueryGroupPolicyParams {
+	return QueryGroupPolicyParams{Address: address}
+}
+
+// QueryProposalParams defines the params for the following queries:
+//
+// - 'custom/group/proposal'
+//
+// - 'custom/group/votes'
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+// NewQueryProposalParams creates a new QueryProposalParams instance.
+func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
+	return QueryProposalParams{ProposalID: proposalID}
+}
@@ -27,6 +27,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) {
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", ModuleName))
 	}
+
+	// move the funds escrowed at the addresses derived by previous versions
+	if err := keeper.MigrateEscrowAccounts(ctx); err != nil {
+		panic(fmt.Sprintf("could not migrate the escrow accounts: %v", err))
+	}
 }
 
 // ExportGenesis exports transfer module's portID into its geneis state
@@ -71,6 +71,33 @@ func (k Keeper) GetTransferAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
 
+// MigrateEscrowAccounts moves the funds escrowed at the addresses derived by
+// GetLegacyEscrowAddress for the channels of the transfer port to the escrow
+// addresses derived by GetEscrowAddress. It is run by InitGenesis, so that the
+// state exported by a chain using the legacy derivation is migrated, and must
+// be run once by an upgrade handler on chains upgrading in place.
+func (k Keeper) MigrateEscrowAccounts(ctx sdk.Context) error {
+	portID := k.GetPort(ctx)
+
+	var err error
+	k.channelKeeper.IterateChannels(ctx, func(ch channel.IdentifiedChannel) bool {
+		if ch.PortID != portID {
+			return false
+		}
+
+		legacyAddr := types.GetLegacyEscrowAddress(ch.PortID, ch.ID)
+		balances := k.bankKeeper.GetAllBalances(ctx, legacyAddr)
+		if balances.IsZero() {
+			return false
+		}
+
+		err = k.bankKeeper.SendCoins(ctx, legacyAddr, types.GetEscrowAddress(ch.PortID, ch.ID), balances)
+		return err != nil
+	})
+
+	return err
+}
+
 // PacketExecuted defines a wrapper function for the channel Keeper's function
 // in order to expose it to the ICS20 transfer handler.
 // Keeper retreives channel capability and passes it into channel keeper for authentication
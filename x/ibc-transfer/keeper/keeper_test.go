@@ -96,6 +96,33 @@ func (suite *KeeperTestSuite) TestGetTransferAccount() {
 	suite.Equal(expectedMaccAddr, macc.GetAddress())
 }
 
+func (suite *KeeperTestSuite) TestMigrateEscrowAccounts() {
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.App
+	portID := app.TransferKeeper.GetPort(ctx)
+
+	suite.chainA.createChannel(portID, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.UNORDERED, testConnection)
+	suite.chainA.createChannel(testPort2, testChannel2, portID, testChannel1, channeltypes.OPEN, channeltypes.UNORDERED, testConnection)
+
+	legacyEscrow := types.GetLegacyEscrowAddress(portID, testChannel1)
+	_, err := app.BankKeeper.AddCoins(ctx, legacyEscrow, testCoins)
+	suite.Require().NoError(err)
+
+	// the channels of other ports are not migrated
+	otherEscrow := types.GetLegacyEscrowAddress(testPort2, testChannel2)
+	_, err = app.BankKeeper.AddCoins(ctx, otherEscrow, testCoins)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(app.TransferKeeper.MigrateEscrowAccounts(ctx))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, legacyEscrow).IsZero())
+	suite.Require().Equal(testCoins, app.BankKeeper.GetAllBalances(ctx, types.GetEscrowAddress(portID, testChannel1)))
+	suite.Require().Equal(testCoins, app.BankKeeper.GetAllBalances(ctx, otherEscrow))
+
+	// migrating again is a no-op
+	suite.Require().NoError(app.TransferKeeper.MigrateEscrowAccounts(ctx))
+	suite.Require().Equal(testCoins, app.BankKeeper.GetAllBalances(ctx, types.GetEscrowAddress(portID, testChannel1)))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
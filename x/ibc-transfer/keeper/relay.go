@@ -161,6 +161,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
+	if k.bankKeeper.BlacklistedAddr(receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
 	if source {
 
 		// mint new tokens if the source of the transfer is the same chain
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 	connection "github.com/cosmos/cosmos-sdk/x/ibc/03-connection"
@@ -138,6 +139,14 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
 			}, true},
+		{"receiver is blacklisted",
+			func() {
+				data.Amount = prefixCoins
+				data.Receiver = auth.NewModuleAddress(auth.FeeCollectorName).String()
+				escrow := types.GetEscrowAddress(testPort2, testChannel2)
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
+				suite.Require().NoError(err)
+			}, false},
 	}
 
 	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
@@ -32,12 +32,29 @@ const (
 // PortKey defines the key to store the port ID in store
 var PortKey = []byte{0x01}
 
-// GetEscrowAddress returns the escrow address for the specified channel
+// GetEscrowAddress returns the escrow address for the specified channel. The
+// address is derived from the module version and the port and channel
+// identifiers, separated so that distinct port/channel pairs cannot collide
+// (e.g. "transfer"+"channel" and "transferc"+"hannel").
 //
 // CONTRACT: this assumes that there's only one bank bridge module that owns the
 // port associated with the channel ID so that the address created is actually
 // unique.
 func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+
+	return sdk.AccAddress(crypto.AddressHash(preImage))
+}
+
+// GetLegacyEscrowAddress returns the escrow address for the specified channel
+// as derived before GetEscrowAddress separated the port and channel
+// identifiers. It is only used to migrate the funds escrowed at these
+// addresses.
+func GetLegacyEscrowAddress(portID, channelID string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(portID + channelID)))
 }
 
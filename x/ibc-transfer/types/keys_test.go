@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+)
+
+func TestGetEscrowAddress(t *testing.T) {
+	addr := types.GetEscrowAddress("transfer", "channel")
+	require.Len(t, addr, 20)
+	require.Equal(t, addr, types.GetEscrowAddress("transfer", "channel"))
+
+	// port and channel identifiers must not be ambiguous when concatenated
+	require.NotEqual(t, addr, types.GetEscrowAddress("transferc", "hannel"))
+	require.NotEqual(t, addr, types.GetEscrowAddress("transfer", "channel-0"))
+
+	// the legacy derivation differs, so that the escrowed funds are migrated
+	require.NotEqual(t, addr, types.GetLegacyEscrowAddress("transfer", "channel"))
+	require.Equal(t, types.GetLegacyEscrowAddress("transfer", "channel"), types.GetLegacyEscrowAddress("transferc", "hannel"))
+}
@@ -46,7 +46,11 @@ $ %s tx ibc connection open-init [connection-id] [client-id] \
 		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
-			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			txBldr, err := authtypes.NewTxBuilderFromCLI(inBuf)
+			if err != nil {
+				return err
+			}
+			txBldr = txBldr.WithTxEncoder(authclient.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 
 			connectionID := args[0]
@@ -95,7 +99,11 @@ $ %s tx ibc connection open-try connection-id] [client-id] \
 		Args: cobra.ExactArgs(7),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
-			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			txBldr, err := authtypes.NewTxBuilderFromCLI(inBuf)
+			if err != nil {
+				return err
+			}
+			txBldr = txBldr.WithTxEncoder(authclient.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).
 				WithCodec(cdc).
 				WithHeight(viper.GetInt64(flags.FlagHeight))
@@ -157,7 +165,11 @@ $ %s tx ibc connection open-ack [connection-id] [path/to/proof_try.json] [versio
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
-			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			txBldr, err := authtypes.NewTxBuilderFromCLI(inBuf)
+			if err != nil {
+				return err
+			}
+			txBldr = txBldr.WithTxEncoder(authclient.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 
 			connectionID := args[0]
@@ -207,7 +219,11 @@ $ %s tx ibc connection open-confirm [connection-id] [path/to/proof_ack.json]
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
-			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			txBldr, err := authtypes.NewTxBuilderFromCLI(inBuf)
+			if err != nil {
+				return err
+			}
+			txBldr = txBldr.WithTxEncoder(authclient.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).
 				WithCodec(cdc).
 				WithHeight(viper.GetInt64(flags.FlagHeight))
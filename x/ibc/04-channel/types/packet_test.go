@@ -6,6 +6,22 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestCommitPacket(t *testing.T) {
+	packet := NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	commitment := CommitPacket(packet)
+	require.NotNil(t, commitment)
+
+	packet.TimeoutTimestamp++
+	require.NotEqual(t, commitment, CommitPacket(packet), "timeout timestamp must be committed")
+
+	packet.TimeoutTimestamp--
+	packet.TimeoutHeight++
+	require.NotEqual(t, commitment, CommitPacket(packet), "timeout height must be committed")
+
+	packet.TimeoutHeight--
+	require.Equal(t, commitment, CommitPacket(packet))
+}
+
 func TestPacketValidateBasic(t *testing.T) {
 	testCases := []struct {
 		packet  Packet
@@ -39,6 +39,11 @@ func (AppModuleBasic) Name() string {
 	return host.ModuleName
 }
 
+// StoreKeys returns the keys of the ibc module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the ibc module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
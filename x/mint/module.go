@@ -38,6 +38,11 @@ func (AppModuleBasic) Name() string {
 	return ModuleName
 }
 
+// StoreKeys returns the keys of the mint module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the mint module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {}
 
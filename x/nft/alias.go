@@ -0,0 +1,70 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	ModuleName         = types.ModuleName
+	StoreKey           = types.StoreKey
+	RouterKey          = types.RouterKey
+	QuerierRoute       = types.QuerierRoute
+	QueryClass         = types.QueryClass
+	QueryClasses       = types.QueryClasses
+	QueryNFT           = types.QueryNFT
+	QueryNFTs          = types.QueryNFTs
+	QueryOwner         = types.QueryOwner
+	QuerySupply        = types.QuerySupply
+	TypeMsgIssueClass  = types.TypeMsgIssueClass
+	TypeMsgMintNFT     = types.TypeMsgMintNFT
+	TypeMsgTransferNFT = types.TypeMsgTransferNFT
+	TypeMsgBurnNFT     = types.TypeMsgBurnNFT
+)
+
+var (
+	// functions aliases
+	NewKeeper             = keeper.NewKeeper
+	NewQuerier            = keeper.NewQuerier
+	RegisterCodec         = types.RegisterCodec
+	NewClass              = types.NewClass
+	NewNFT                = types.NewNFT
+	ValidateClassID       = types.ValidateClassID
+	ValidateNFTID         = types.ValidateNFTID
+	NewMsgIssueClass      = types.NewMsgIssueClass
+	NewMsgMintNFT         = types.NewMsgMintNFT
+	NewMsgTransferNFT     = types.NewMsgTransferNFT
+	NewMsgBurnNFT         = types.NewMsgBurnNFT
+	NewGenesisState       = types.NewGenesisState
+	DefaultGenesisState   = types.DefaultGenesisState
+	NewQueryClassParams   = types.NewQueryClassParams
+	NewQueryClassesParams = types.NewQueryClassesParams
+	NewQueryNFTParams     = types.NewQueryNFTParams
+	NewQueryNFTsParams    = types.NewQueryNFTsParams
+	NewQueryOwnerParams   = types.NewQueryOwnerParams
+
+	// variable aliases
+	ModuleCdc         = types.ModuleCdc
+	ErrInvalidClassID = types.ErrInvalidClassID
+	ErrInvalidNFTID   = types.ErrInvalidNFTID
+	ErrClassExists    = types.ErrClassExists
+	ErrClassNotFound  = types.ErrClassNotFound
+	ErrNFTExists      = types.ErrNFTExists
+	ErrNFTNotFound    = types.ErrNFTNotFound
+)
+
+type (
+	Keeper             = keeper.Keeper
+	Class              = types.Class
+	NFT                = types.NFT
+	MsgIssueClass      = types.MsgIssueClass
+	MsgMintNFT         = types.MsgMintNFT
+	MsgTransferNFT     = types.MsgTransferNFT
+	MsgBurnNFT         = types.MsgBurnNFT
+	GenesisState       = types.GenesisState
+	QueryClassParams   = types.QueryClassParams
+	QueryClassesParams = types.QueryClassesParams
+	QueryNFTParams     = types.QueryNFTParams
+	QueryNFTsParams    = types.QueryNFTsParams
+	QueryOwnerParams   = types.QueryOwnerParams
+)
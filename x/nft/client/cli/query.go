@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	flagClassID = "class-id"
+)
+
+// GetQueryCmd returns the cli query commands for the nft module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	nftQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nft module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryClass(cdc),
+			GetCmdQueryClasses(cdc),
+			GetCmdQueryNFT(cdc),
+			GetCmdQueryNFTs(cdc),
+			GetCmdQueryOwner(cdc),
+			GetCmdQuerySupply(cdc),
+		)...,
+	)
+
+	return nftQueryCmd
+}
+
+// GetCmdQueryClass implements a command to return an nft class.
+func GetCmdQueryClass(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "class [class-id]",
+		Short: "Query an nft class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var class types.Class
+			if err := query(cliCtx, types.QueryClass, types.NewQueryClassParams(args[0]), &class); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(class)
+		},
+	}
+}
+
+// GetCmdQueryClasses implements a command to return the nft classes.
+func GetCmdQueryClasses(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classes",
+		Short: "Query the nft classes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := types.NewQueryClassesParams(pagination(cmd))
+
+			var classes []types.Class
+			if err := query(cliCtx, types.QueryClasses, params, &classes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(classes)
+		},
+	}
+
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryNFT implements a command to return an nft.
+func GetCmdQueryNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "nft [class-id] [nft-id]",
+		Short: "Query an nft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var nft types.NFT
+			if err := query(cliCtx, types.QueryNFT, types.NewQueryNFTParams(args[0], args[1]), &nft); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(nft)
+		},
+	}
+}
+
+// GetCmdQueryNFTs implements a command to return the nfts of a class.
+func GetCmdQueryNFTs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts [class-id]",
+		Short: "Query the nfts of a class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page, limit := pagination(cmd)
+			params := types.NewQueryNFTsParams(args[0], page, limit)
+
+			var nfts []types.NFT
+			if err := query(cliCtx, types.QueryNFTs, params, &nfts); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(nfts)
+		},
+	}
+
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryOwner implements a command to return the nfts of an owner.
+func GetCmdQueryOwner(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner [address]",
+		Short:   "Query the nfts of an owner, optionally of a single class",
+		Example: fmt.Sprintf("$ <appcli> query %s owner cosmos1... --%s=kitties", types.ModuleName, flagClassID),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			classID, _ := cmd.Flags().GetString(flagClassID)
+			page, limit := pagination(cmd)
+			params := types.NewQueryOwnerParams(owner, classID, page, limit)
+
+			var nfts []types.NFT
+			if err := query(cliCtx, types.QueryOwner, params, &nfts); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(nfts)
+		},
+	}
+
+	cmd.Flags().String(flagClassID, "", "Only query the nfts of the given class")
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdQuerySupply implements a command to return the number of nfts of a
+// class.
+func GetCmdQuerySupply(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply [class-id]",
+		Short: "Query the number of nfts of a class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var supply uint64
+			if err := query(cliCtx, types.QuerySupply, types.NewQueryClassParams(args[0]), &supply); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(supply)
+		},
+	}
+}
+
+// pagination returns the page and limit flags of a paginated query command.
+func pagination(cmd *cobra.Command) (page, limit int) {
+	page, _ = cmd.Flags().GetInt(flags.FlagPage)
+	limit, _ = cmd.Flags().GetInt(flags.FlagLimit)
+
+	return page, limit
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+}
+
+// query queries an nft querier route with the given params, and decodes its
+// result into res.
+func query(cliCtx context.CLIContext, path string, params, res interface{}) error {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	out, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.Codec.UnmarshalJSON(out, res)
+}
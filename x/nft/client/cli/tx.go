@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	flagName        = "name"
+	flagSymbol      = "symbol"
+	flagDescription = "description"
+	flagURI         = "uri"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/nft transaction commands.
+func NewTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "NFT transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		NewIssueClassTxCmd(cliCtx),
+		NewMintNFTTxCmd(cliCtx),
+		NewTransferNFTTxCmd(cliCtx),
+		NewBurnNFTTxCmd(cliCtx),
+	)...)
+
+	return txCmd
+}
+
+// NewIssueClassTxCmd returns a CLI command handler for creating a
+// MsgIssueClass transaction.
+func NewIssueClassTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue [from_key_or_address] [class-id]",
+		Short: "Create a new nft class",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			name, _ := cmd.Flags().GetString(flagName)
+			symbol, _ := cmd.Flags().GetString(flagSymbol)
+			description, _ := cmd.Flags().GetString(flagDescription)
+			uri, _ := cmd.Flags().GetString(flagURI)
+
+			msg := types.NewMsgIssueClass(cliCtx.GetFromAddress(), args[1], name, symbol, description, uri)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "The name of the class")
+	cmd.Flags().String(flagSymbol, "", "The symbol of the class")
+	cmd.Flags().String(flagDescription, "", "The description of the class")
+	cmd.Flags().String(flagURI, "", "The URI of the class metadata")
+
+	return cmd
+}
+
+// NewMintNFTTxCmd returns a CLI command handler for creating a MsgMintNFT
+// transaction.
+func NewMintNFTTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [from_key_or_address] [recipient] [class-id] [nft-id]",
+		Short: "Mint an nft of a class created by the sender to a recipient",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(flagURI)
+
+			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), recipient, args[2], args[3], uri)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagURI, "", "The URI of the nft metadata")
+
+	return cmd
+}
+
+// NewTransferNFTTxCmd returns a CLI command handler for creating a
+// MsgTransferNFT transaction.
+func NewTransferNFTTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer [from_key_or_address] [recipient] [class-id] [nft-id]",
+		Short: "Transfer an nft owned by the sender to a recipient",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferNFT(cliCtx.GetFromAddress(), recipient, args[2], args[3])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
+// NewBurnNFTTxCmd returns a CLI command handler for creating a MsgBurnNFT
+// transaction.
+func NewBurnNFTTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn [from_key_or_address] [class-id] [nft-id]",
+		Short: "Burn an nft owned by the sender",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			msg := types.NewMsgBurnNFT(cliCtx.GetFromAddress(), args[1], args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
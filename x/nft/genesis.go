@@ -0,0 +1,27 @@
+package nft
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the nft module state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, class := range data.Classes {
+		if err := k.IssueClass(ctx, class); err != nil {
+			panic(fmt.Sprintf("failed to issue genesis class %s: %s", class.ID, err))
+		}
+	}
+
+	for _, nft := range data.NFTs {
+		if err := k.MintNFT(ctx, nft); err != nil {
+			panic(fmt.Sprintf("failed to mint genesis nft %s: %s", nft, err))
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetClasses(ctx), k.GetNFTs(ctx))
+}
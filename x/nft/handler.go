@@ -0,0 +1,152 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// NewHandler returns a handler for "nft" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgIssueClass:
+			return handleMsgIssueClass(ctx, k, msg)
+
+		case types.MsgMintNFT:
+			return handleMsgMintNFT(ctx, k, msg)
+
+		case types.MsgTransferNFT:
+			return handleMsgTransferNFT(ctx, k, msg)
+
+		case types.MsgBurnNFT:
+			return handleMsgBurnNFT(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized nft message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgIssueClass(ctx sdk.Context, k keeper.Keeper, msg types.MsgIssueClass) (*sdk.Result, error) {
+	class := types.NewClass(msg.ID, msg.Name, msg.Symbol, msg.Description, msg.URI, msg.Creator)
+	if err := k.IssueClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIssueClass,
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgMintNFT(ctx sdk.Context, k keeper.Keeper, msg types.MsgMintNFT) (*sdk.Result, error) {
+	class, found := k.GetClass(ctx, msg.ClassID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrClassNotFound, msg.ClassID)
+	}
+
+	if !class.Creator.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only the creator of class %s can mint its nfts", msg.ClassID)
+	}
+
+	if err := k.MintNFT(ctx, types.NewNFT(msg.ClassID, msg.ID, msg.URI, msg.Recipient)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMintNFT,
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassID),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgTransferNFT(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferNFT) (*sdk.Result, error) {
+	if err := checkOwner(ctx, k, msg.Sender, msg.ClassID, msg.ID); err != nil {
+		return nil, err
+	}
+
+	if err := k.TransferNFT(ctx, msg.ClassID, msg.ID, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferNFT,
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassID),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgBurnNFT(ctx sdk.Context, k keeper.Keeper, msg types.MsgBurnNFT) (*sdk.Result, error) {
+	if err := checkOwner(ctx, k, msg.Sender, msg.ClassID, msg.ID); err != nil {
+		return nil, err
+	}
+
+	if err := k.BurnNFT(ctx, msg.ClassID, msg.ID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnNFT,
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassID),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// checkOwner returns an error if the NFT does not exist or is not owned by the
+// given address.
+func checkOwner(ctx sdk.Context, k keeper.Keeper, owner sdk.AccAddress, classID, id string) error {
+	nftOwner := k.GetOwner(ctx, classID, id)
+	if nftOwner == nil {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "%s/%s", classID, id)
+	}
+
+	if !nftOwner.Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s/%s", owner, classID, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var (
+	addr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.NFTKeeper
+	h := nft.NewHandler(k)
+
+	_, err := h(ctx, nft.NewMsgIssueClass(addr1, "kitties", "Kitties", "KIT", "", ""))
+	require.NoError(t, err)
+
+	// only the class creator mints nfts
+	_, err = h(ctx, nft.NewMsgMintNFT(addr2, addr2, "kitties", "kitty1", ""))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	res, err := h(ctx, nft.NewMsgMintNFT(addr1, addr2, "kitties", "kitty1", ""))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Events)
+	require.Equal(t, addr2, k.GetOwner(ctx, "kitties", "kitty1"))
+
+	// only the owner transfers and burns an nft
+	_, err = h(ctx, nft.NewMsgTransferNFT(addr1, addr1, "kitties", "kitty1"))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, err = h(ctx, nft.NewMsgTransferNFT(addr2, addr1, "kitties", "kitty1"))
+	require.NoError(t, err)
+	require.Equal(t, addr1, k.GetOwner(ctx, "kitties", "kitty1"))
+
+	_, err = h(ctx, nft.NewMsgBurnNFT(addr2, "kitties", "kitty1"))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, err = h(ctx, nft.NewMsgBurnNFT(addr1, "kitties", "kitty1"))
+	require.NoError(t, err)
+	require.False(t, k.HasNFT(ctx, "kitties", "kitty1"))
+
+	_, err = h(ctx, nft.NewMsgBurnNFT(addr1, "kitties", "kitty1"))
+	require.True(t, nft.ErrNFTNotFound.Is(err))
+}
+
+func TestGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.NFTKeeper
+
+	genesis := nft.NewGenesisState(
+		[]nft.Class{nft.NewClass("kitties", "Kitties", "KIT", "", "", addr1)},
+		[]nft.NFT{nft.NewNFT("kitties", "kitty1", "", addr1), nft.NewNFT("kitties", "kitty2", "", addr2)},
+	)
+	require.NoError(t, genesis.Validate())
+
+	nft.InitGenesis(ctx, k, genesis)
+	require.Equal(t, uint64(2), k.GetTotalSupply(ctx, "kitties"))
+	require.Equal(t, genesis, nft.ExportGenesis(ctx, k))
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Keeper of the nft store
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new nft Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IssueClass creates a new class. It returns an error if the class already
+// exists.
+func (k Keeper) IssueClass(ctx sdk.Context, class types.Class) error {
+	if err := class.Validate(); err != nil {
+		return err
+	}
+
+	if k.HasClass(ctx, class.ID) {
+		return sdkerrors.Wrap(types.ErrClassExists, class.ID)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// GetClass returns a class.
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (class types.Class, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClassKey(classID))
+	if bz == nil {
+		return class, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &class)
+	return class, true
+}
+
+// HasClass returns true if the class exists.
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ClassKey(classID))
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class types.Class) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClassKey(class.ID), k.cdc.MustMarshalBinaryBare(class))
+}
+
+// IterateClasses iterates over all the classes, ordered by ID, until the
+// callback returns true.
+func (k Keeper) IterateClasses(ctx sdk.Context, cb func(class types.Class) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClassKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &class)
+
+		if cb(class) {
+			break
+		}
+	}
+}
+
+// GetClasses returns all the classes.
+func (k Keeper) GetClasses(ctx sdk.Context) []types.Class {
+	classes := []types.Class{}
+	k.IterateClasses(ctx, func(class types.Class) bool {
+		classes = append(classes, class)
+		return false
+	})
+
+	return classes
+}
+
+// GetClassesPaginated returns a page of the classes, ordered by ID.
+func (k Keeper) GetClassesPaginated(ctx sdk.Context, page, limit uint) []types.Class {
+	iterator := sdk.KVStorePrefixIteratorPaginated(ctx.KVStore(k.storeKey), types.ClassKeyPrefix, page, limit)
+	defer iterator.Close()
+
+	classes := []types.Class{}
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &class)
+		classes = append(classes, class)
+	}
+
+	return classes
+}
+
+// GetTotalSupply returns the number of NFTs of a class.
+func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClassSupplyKey(classID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setTotalSupply(ctx sdk.Context, classID string, supply uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClassSupplyKey(classID), sdk.Uint64ToBigEndian(supply))
+}
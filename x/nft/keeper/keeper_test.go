@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestIssueClass(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.NFTKeeper
+
+	kitties := types.NewClass("kitties", "Kitties", "KIT", "", "", addr1)
+	require.NoError(t, k.IssueClass(ctx, kitties))
+	require.True(t, types.ErrClassExists.Is(k.IssueClass(ctx, kitties)))
+	require.True(t, types.ErrInvalidClassID.Is(k.IssueClass(ctx, types.NewClass("x", "", "", "", "", addr1))))
+
+	class, found := k.GetClass(ctx, "kitties")
+	require.True(t, found)
+	require.Equal(t, kitties, class)
+
+	_, found = k.GetClass(ctx, "puppies")
+	require.False(t, found)
+
+	puppies := types.NewClass("puppies", "Puppies", "PUP", "", "", addr2)
+	require.NoError(t, k.IssueClass(ctx, puppies))
+	require.Equal(t, []types.Class{kitties, puppies}, k.GetClasses(ctx))
+	require.Equal(t, []types.Class{puppies}, k.GetClassesPaginated(ctx, 2, 1))
+}
+
+func TestMintTransferBurn(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx, k := app.BaseApp.NewContext(false, abci.Header{}), app.NFTKeeper
+	require.NoError(t, k.IssueClass(ctx, types.NewClass("kitties", "", "", "", "", addr1)))
+	require.NoError(t, k.IssueClass(ctx, types.NewClass("puppies", "", "", "", "", addr1)))
+
+	kitty1 := types.NewNFT("kitties", "kitty1", "uri", addr1)
+	kitty2 := types.NewNFT("kitties", "kitty2", "", addr1)
+	puppy1 := types.NewNFT("puppies", "puppy1", "", addr1)
+
+	require.True(t, types.ErrClassNotFound.Is(k.MintNFT(ctx, types.NewNFT("cats", "cat1", "", addr1))))
+	require.NoError(t, k.MintNFT(ctx, kitty1))
+	require.NoError(t, k.MintNFT(ctx, kitty2))
+	require.NoError(t, k.MintNFT(ctx, puppy1))
+	require.True(t, types.ErrNFTExists.Is(k.MintNFT(ctx, kitty1)))
+
+	require.Equal(t, uint64(2), k.GetTotalSupply(ctx, "kitties"))
+	require.Equal(t, []types.NFT{kitty1, kitty2}, k.GetNFTsOfClass(ctx, "kitties", 1, 10))
+	require.Equal(t, []types.NFT{kitty1, kitty2, puppy1}, k.GetNFTsOfOwner(ctx, addr1, "", 1, 10))
+	require.Equal(t, []types.NFT{puppy1}, k.GetNFTsOfOwner(ctx, addr1, "puppies", 1, 10))
+	require.Equal(t, []types.NFT{kitty2}, k.GetNFTsOfOwner(ctx, addr1, "", 2, 1))
+
+	// transfers move the nft between the owner indexes
+	require.NoError(t, k.TransferNFT(ctx, "kitties", "kitty1", addr2))
+	require.Equal(t, addr2, k.GetOwner(ctx, "kitties", "kitty1"))
+	require.Equal(t, []types.NFT{kitty2, puppy1}, k.GetNFTsOfOwner(ctx, addr1, "", 1, 10))
+
+	kitty1.Owner = addr2
+	require.Equal(t, []types.NFT{kitty1}, k.GetNFTsOfOwner(ctx, addr2, "kitties", 1, 10))
+	require.True(t, types.ErrNFTNotFound.Is(k.TransferNFT(ctx, "kitties", "kitty3", addr2)))
+
+	// burns remove the nft from the indexes and the supply
+	require.NoError(t, k.BurnNFT(ctx, "kitties", "kitty1"))
+	require.False(t, k.HasNFT(ctx, "kitties", "kitty1"))
+	require.Nil(t, k.GetOwner(ctx, "kitties", "kitty1"))
+	require.Empty(t, k.GetNFTsOfOwner(ctx, addr2, "", 1, 10))
+	require.Equal(t, uint64(1), k.GetTotalSupply(ctx, "kitties"))
+	require.True(t, types.ErrNFTNotFound.Is(k.BurnNFT(ctx, "kitties", "kitty1")))
+
+	require.Equal(t, []types.NFT{kitty2, puppy1}, k.GetNFTs(ctx))
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// MintNFT creates a new NFT of an existing class. It returns an error if the
+// NFT already exists.
+func (k Keeper) MintNFT(ctx sdk.Context, nft types.NFT) error {
+	if err := nft.Validate(); err != nil {
+		return err
+	}
+
+	if !k.HasClass(ctx, nft.ClassID) {
+		return sdkerrors.Wrap(types.ErrClassNotFound, nft.ClassID)
+	}
+
+	if k.HasNFT(ctx, nft.ClassID, nft.ID) {
+		return sdkerrors.Wrap(types.ErrNFTExists, nft.String())
+	}
+
+	k.setNFT(ctx, nft)
+	k.setTotalSupply(ctx, nft.ClassID, k.GetTotalSupply(ctx, nft.ClassID)+1)
+
+	return nil
+}
+
+// BurnNFT deletes an NFT.
+func (k Keeper) BurnNFT(ctx sdk.Context, classID, id string) error {
+	nft, found := k.GetNFT(ctx, classID, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "%s/%s", classID, id)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.NFTKey(classID, id))
+	store.Delete(types.OwnerKey(nft.Owner, classID, id))
+	k.setTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)-1)
+
+	return nil
+}
+
+// TransferNFT transfers the ownership of an NFT to a recipient.
+func (k Keeper) TransferNFT(ctx sdk.Context, classID, id string, recipient sdk.AccAddress) error {
+	nft, found := k.GetNFT(ctx, classID, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "%s/%s", classID, id)
+	}
+
+	if recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OwnerKey(nft.Owner, classID, id))
+
+	nft.Owner = recipient
+	k.setNFT(ctx, nft)
+
+	return nil
+}
+
+// GetNFT returns an NFT.
+func (k Keeper) GetNFT(ctx sdk.Context, classID, id string) (nft types.NFT, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NFTKey(classID, id))
+	if bz == nil {
+		return nft, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &nft)
+	return nft, true
+}
+
+// HasNFT returns true if the NFT exists.
+func (k Keeper) HasNFT(ctx sdk.Context, classID, id string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.NFTKey(classID, id))
+}
+
+// GetOwner returns the owner of an NFT, or nil if the NFT does not exist.
+func (k Keeper) GetOwner(ctx sdk.Context, classID, id string) sdk.AccAddress {
+	nft, found := k.GetNFT(ctx, classID, id)
+	if !found {
+		return nil
+	}
+
+	return nft.Owner
+}
+
+// setNFT stores an NFT and indexes it by owner.
+func (k Keeper) setNFT(ctx sdk.Context, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NFTKey(nft.ClassID, nft.ID), k.cdc.MustMarshalBinaryBare(nft))
+	store.Set(types.OwnerKey(nft.Owner, nft.ClassID, nft.ID), types.Placeholder)
+}
+
+// IterateNFTs iterates over all the NFTs, ordered by class and ID, until the
+// callback returns true.
+func (k Keeper) IterateNFTs(ctx sdk.Context, cb func(nft types.NFT) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NFTKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+
+		if cb(nft) {
+			break
+		}
+	}
+}
+
+// GetNFTs returns all the NFTs.
+func (k Keeper) GetNFTs(ctx sdk.Context) []types.NFT {
+	nfts := []types.NFT{}
+	k.IterateNFTs(ctx, func(nft types.NFT) bool {
+		nfts = append(nfts, nft)
+		return false
+	})
+
+	return nfts
+}
+
+// GetNFTsOfClass returns a page of the NFTs of a class, ordered by ID.
+func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string, page, limit uint) []types.NFT {
+	iterator := sdk.KVStorePrefixIteratorPaginated(ctx.KVStore(k.storeKey), types.NFTOfClassPrefix(classID), page, limit)
+	defer iterator.Close()
+
+	nfts := []types.NFT{}
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+
+	return nfts
+}
+
+// GetNFTsOfOwner returns a page of the NFTs of an owner, ordered by class and
+// ID. An empty class ID returns the NFTs of all the classes.
+func (k Keeper) GetNFTsOfOwner(ctx sdk.Context, owner sdk.AccAddress, classID string, page, limit uint) []types.NFT {
+	prefix := types.OwnerPrefix(owner)
+	if classID != "" {
+		prefix = types.OwnerOfClassPrefix(owner, classID)
+	}
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(ctx.KVStore(k.storeKey), prefix, page, limit)
+	defer iterator.Close()
+
+	nfts := []types.NFT{}
+	for ; iterator.Valid(); iterator.Next() {
+		nftClassID, id := types.SplitOwnerKey(iterator.Key())
+
+		nft, found := k.GetNFT(ctx, nftClassID, id)
+		if !found {
+			panic(fmt.Sprintf("indexed nft %s/%s not found", nftClassID, id))
+		}
+
+		nfts = append(nfts, nft)
+	}
+
+	return nfts
+}
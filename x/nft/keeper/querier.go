@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// defaultQueryLimit is the page size of the paginated queries which do not
+// set a limit.
+const defaultQueryLimit = 100
+
+// NewQuerier returns an nft Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryClass:
+			return queryClass(ctx, req, k)
+
+		case types.QueryClasses:
+			return queryClasses(ctx, req, k)
+
+		case types.QueryNFT:
+			return queryNFT(ctx, req, k)
+
+		case types.QueryNFTs:
+			return queryNFTs(ctx, req, k)
+
+		case types.QueryOwner:
+			return queryOwner(ctx, req, k)
+
+		case types.QuerySupply:
+			return querySupply(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+// pagination returns the page and limit of a paginated query, defaulting to
+// the first page of defaultQueryLimit items.
+func pagination(page, limit int) (uint, uint) {
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+
+	return uint(page), uint(limit)
+}
+
+func queryClass(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClassParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	class, found := k.GetClass(ctx, params.ClassID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrClassNotFound, params.ClassID)
+	}
+
+	return marshalJSON(k.cdc, class)
+}
+
+func queryClasses(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClassesParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	page, limit := pagination(params.Page, params.Limit)
+	return marshalJSON(k.cdc, k.GetClassesPaginated(ctx, page, limit))
+}
+
+func queryNFT(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNFTParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	nft, found := k.GetNFT(ctx, params.ClassID, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNFTNotFound, "%s/%s", params.ClassID, params.ID)
+	}
+
+	return marshalJSON(k.cdc, nft)
+}
+
+func queryNFTs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNFTsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if !k.HasClass(ctx, params.ClassID) {
+		return nil, sdkerrors.Wrap(types.ErrClassNotFound, params.ClassID)
+	}
+
+	page, limit := pagination(params.Page, params.Limit)
+	return marshalJSON(k.cdc, k.GetNFTsOfClass(ctx, params.ClassID, page, limit))
+}
+
+func queryOwner(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryOwnerParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Owner.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+
+	page, limit := pagination(params.Page, params.Limit)
+	return marshalJSON(k.cdc, k.GetNFTsOfOwner(ctx, params.Owner, params.ClassID, page, limit))
+}
+
+func querySupply(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClassParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if !k.HasClass(ctx, params.ClassID) {
+		return nil, sdkerrors.Wrap(types.ErrClassNotFound, params.ClassID)
+	}
+
+	return marshalJSON(k.cdc, k.GetTotalSupply(ctx, params.ClassID))
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/nft module sentinel errors
+var (
+	ErrInvalidClassID = sdkerrors.Register(ModuleName, 2, "invalid class id")
+	ErrInvalidNFTID   = sdkerrors.Register(ModuleName, 3, "invalid nft id")
+	ErrClassExists    = sdkerrors.Register(ModuleName, 4, "class already exists")
+	ErrClassNotFound  = sdkerrors.Register(ModuleName, 5, "class not found")
+	ErrNFTExists      = sdkerrors.Register(ModuleName, 6, "nft already exists")
+	ErrNFTNotFound    = sdkerrors.Register(ModuleName, 7, "nft not found")
+)
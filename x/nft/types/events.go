@@ -0,0 +1,17 @@
+package types
+
+// nft module event types
+const (
+	EventTypeIssueClass  = "issue_class"
+	EventTypeMintNFT     = "mint_nft"
+	EventTypeTransferNFT = "transfer_nft"
+	EventTypeBurnNFT     = "burn_nft"
+
+	AttributeKeyClassID   = "class_id"
+	AttributeKeyNFTID     = "nft_id"
+	AttributeKeyCreator   = "creator"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyRecipient = "recipient"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the nft module genesis state
+type GenesisState struct {
+	Classes []Class `json:"classes" yaml:"classes"`
+	NFTs    []NFT   `json:"nfts" yaml:"nfts"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(classes []Class, nfts []NFT) GenesisState {
+	return GenesisState{
+		Classes: classes,
+		NFTs:    nfts,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, without any class.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Class{}, []NFT{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	classes := make(map[string]bool)
+	for _, class := range gs.Classes {
+		if err := class.Validate(); err != nil {
+			return err
+		}
+
+		if classes[class.ID] {
+			return fmt.Errorf("duplicate class %s", class.ID)
+		}
+
+		classes[class.ID] = true
+	}
+
+	nfts := make(map[string]bool)
+	for _, nft := range gs.NFTs {
+		if err := nft.Validate(); err != nil {
+			return err
+		}
+
+		if !classes[nft.ClassID] {
+			return fmt.Errorf("nft %s class does not exist", nft)
+		}
+
+		key := string(NFTKey(nft.ClassID, nft.ID))
+		if nfts[key] {
+			return fmt.Errorf("duplicate nft %s", nft)
+		}
+
+		nfts[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nft"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for nft store
+// Items are stored with the following key: values
+//
+// - 0x01<classID_Bytes>: Class
+//
+// - 0x02<classID_Len><classID_Bytes><nftID_Bytes>: NFT
+//
+// - 0x03<owner_Bytes><classID_Len><classID_Bytes><nftID_Bytes>: []byte{0x01}
+//
+// - 0x04<classID_Bytes>: totalSupply
+var (
+	ClassKeyPrefix       = []byte{0x01}
+	NFTKeyPrefix         = []byte{0x02}
+	OwnerKeyPrefix       = []byte{0x03}
+	ClassSupplyKeyPrefix = []byte{0x04}
+
+	// Placeholder is the value of the owner index keys.
+	Placeholder = []byte{0x01}
+)
+
+// ClassKey returns the key storing a class.
+func ClassKey(classID string) []byte {
+	return append(ClassKeyPrefix, []byte(classID)...)
+}
+
+// ClassSupplyKey returns the key storing the number of NFTs of a class.
+func ClassSupplyKey(classID string) []byte {
+	return append(ClassSupplyKeyPrefix, []byte(classID)...)
+}
+
+// NFTOfClassPrefix returns the prefix of the keys storing the NFTs of a class.
+func NFTOfClassPrefix(classID string) []byte {
+	return append(NFTKeyPrefix, lengthPrefixed(classID)...)
+}
+
+// NFTKey returns the key storing an NFT.
+func NFTKey(classID, id string) []byte {
+	return append(NFTOfClassPrefix(classID), []byte(id)...)
+}
+
+// OwnerPrefix returns the prefix of the owner index keys of the NFTs of an
+// owner.
+func OwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(OwnerKeyPrefix, owner.Bytes()...)
+}
+
+// OwnerOfClassPrefix returns the prefix of the owner index keys of the NFTs of
+// a class owned by an owner.
+func OwnerOfClassPrefix(owner sdk.AccAddress, classID string) []byte {
+	return append(OwnerPrefix(owner), lengthPrefixed(classID)...)
+}
+
+// OwnerKey returns the owner index key of an NFT.
+func OwnerKey(owner sdk.AccAddress, classID, id string) []byte {
+	return append(OwnerOfClassPrefix(owner, classID), []byte(id)...)
+}
+
+// SplitOwnerKey returns the class and NFT IDs of an owner index key.
+func SplitOwnerKey(key []byte) (classID, id string) {
+	key = key[len(OwnerKeyPrefix)+sdk.AddrLen:]
+	classLen := int(key[0])
+
+	return string(key[1 : 1+classLen]), string(key[1+classLen:])
+}
+
+func lengthPrefixed(id string) []byte {
+	return append([]byte{byte(len(id))}, []byte(id)...)
+}
@@ -0,0 +1,233 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// nft message types
+const (
+	TypeMsgIssueClass  = "issue_class"
+	TypeMsgMintNFT     = "mint_nft"
+	TypeMsgTransferNFT = "transfer_nft"
+	TypeMsgBurnNFT     = "burn_nft"
+)
+
+var (
+	_ sdk.Msg = MsgIssueClass{}
+	_ sdk.Msg = MsgMintNFT{}
+	_ sdk.Msg = MsgTransferNFT{}
+	_ sdk.Msg = MsgBurnNFT{}
+
+	_ sdk.StoreAccessMsg = MsgTransferNFT{}
+)
+
+// MsgIssueClass defines a message to create a new NFT class, whose NFTs are
+// only minted by the class creator.
+type MsgIssueClass struct {
+	Creator     sdk.AccAddress `json:"creator" yaml:"creator"`
+	ID          string         `json:"id" yaml:"id"`
+	Name        string         `json:"name" yaml:"name"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	Description string         `json:"description" yaml:"description"`
+	URI         string         `json:"uri" yaml:"uri"`
+}
+
+// NewMsgIssueClass creates a new MsgIssueClass instance
+func NewMsgIssueClass(creator sdk.AccAddress, id, name, symbol, description, uri string) MsgIssueClass {
+	return MsgIssueClass{
+		Creator:     creator,
+		ID:          id,
+		Name:        name,
+		Symbol:      symbol,
+		Description: description,
+		URI:         uri,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueClass) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueClass) Type() string { return TypeMsgIssueClass }
+
+// ValidateBasic Implements Msg.
+func (msg MsgIssueClass) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing creator address")
+	}
+
+	return ValidateClassID(msg.ID)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueClass) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueClass) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgMintNFT defines a message to mint an NFT of a class to a recipient. It is
+// signed by the class creator.
+type MsgMintNFT struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ClassID   string         `json:"class_id" yaml:"class_id"`
+	ID        string         `json:"id" yaml:"id"`
+	URI       string         `json:"uri" yaml:"uri"`
+}
+
+// NewMsgMintNFT creates a new MsgMintNFT instance
+func NewMsgMintNFT(sender, recipient sdk.AccAddress, classID, id, uri string) MsgMintNFT {
+	return MsgMintNFT{
+		Sender:    sender,
+		Recipient: recipient,
+		ClassID:   classID,
+		ID:        id,
+		URI:       uri,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgMintNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgMintNFT) Type() string { return TypeMsgMintNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMintNFT) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+
+	if err := ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateNFTID(msg.ID)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMintNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMintNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgTransferNFT defines a message to transfer an NFT to a recipient. It is
+// signed by the NFT owner.
+type MsgTransferNFT struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ClassID   string         `json:"class_id" yaml:"class_id"`
+	ID        string         `json:"id" yaml:"id"`
+}
+
+// NewMsgTransferNFT creates a new MsgTransferNFT instance
+func NewMsgTransferNFT(sender, recipient sdk.AccAddress, classID, id string) MsgTransferNFT {
+	return MsgTransferNFT{
+		Sender:    sender,
+		Recipient: recipient,
+		ClassID:   classID,
+		ID:        id,
+	}
+}
+
+// GetStoreAccess implements sdk.StoreAccessMsg. A transfer only accesses the
+// NFT and its owner index keys, so that the transfers of distinct NFTs may be
+// executed in parallel.
+func (msg MsgTransferNFT) GetStoreAccess() []sdk.StoreAccess {
+	return []sdk.StoreAccess{
+		sdk.NewStoreAccess(StoreKey, NFTKey(msg.ClassID, msg.ID)),
+		sdk.NewStoreAccess(StoreKey, OwnerKey(msg.Sender, msg.ClassID, msg.ID)),
+		sdk.NewStoreAccess(StoreKey, OwnerKey(msg.Recipient, msg.ClassID, msg.ID)),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgTransferNFT) Type() string { return TypeMsgTransferNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferNFT) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+
+	if err := ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateNFTID(msg.ID)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgBurnNFT defines a message to burn an NFT. It is signed by the NFT owner.
+type MsgBurnNFT struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	ClassID string         `json:"class_id" yaml:"class_id"`
+	ID      string         `json:"id" yaml:"id"`
+}
+
+// NewMsgBurnNFT creates a new MsgBurnNFT instance
+func NewMsgBurnNFT(sender sdk.AccAddress, classID, id string) MsgBurnNFT {
+	return MsgBurnNFT{
+		Sender:  sender,
+		ClassID: classID,
+		ID:      id,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBurnNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurnNFT) Type() string { return TypeMsgBurnNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurnNFT) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if err := ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateNFTID(msg.ID)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurnNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
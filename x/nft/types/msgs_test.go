@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"issue", NewMsgIssueClass(addr, "kitties", "", "", "", ""), false},
+		{"issue no creator", NewMsgIssueClass(nil, "kitties", "", "", "", ""), true},
+		{"issue invalid id", NewMsgIssueClass(addr, "1kitties", "", "", "", ""), true},
+		{"mint", NewMsgMintNFT(addr, addr, "kitties", "kitty1", ""), false},
+		{"mint no recipient", NewMsgMintNFT(addr, nil, "kitties", "kitty1", ""), true},
+		{"mint invalid nft id", NewMsgMintNFT(addr, addr, "kitties", "k", ""), true},
+		{"transfer", NewMsgTransferNFT(addr, addr, "kitties", "kitty1"), false},
+		{"transfer no sender", NewMsgTransferNFT(nil, addr, "kitties", "kitty1"), true},
+		{"burn", NewMsgBurnNFT(addr, "kitties", "kitty1"), false},
+		{"burn invalid class id", NewMsgBurnNFT(addr, "", "kitty1"), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgTransferNFTStoreAccess(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	recipient1 := sdk.AccAddress([]byte("recipient1__________"))
+	recipient2 := sdk.AccAddress([]byte("recipient2__________"))
+
+	// the transfers of distinct NFTs may be executed in parallel
+	msgs := []sdk.Msg{
+		NewMsgTransferNFT(sender, recipient1, "kitties", "kitty1"),
+		NewMsgTransferNFT(sender, recipient2, "kitties", "kitty2"),
+		NewMsgTransferNFT(sender, recipient2, "puppies", "kitty1"),
+	}
+	require.True(t, sdk.StoreAccessDisjoint(msgs))
+
+	// but not the transfers of a same NFT
+	msgs = append(msgs, NewMsgTransferNFT(recipient1, recipient2, "kitties", "kitty1"))
+	require.False(t, sdk.StoreAccessDisjoint(msgs))
+}
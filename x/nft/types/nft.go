@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// reClassID and reNFTID define the valid class and NFT identifiers. IDs
+	// start with a letter, followed by 2 to 100 alphanumeric characters or
+	// '/', ':', '.', '_' and '-'.
+	reClassID = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:._-]{2,100}$`)
+	reNFTID   = reClassID
+)
+
+// ValidateClassID returns an error if the given class ID is invalid.
+func ValidateClassID(id string) error {
+	if !reClassID.MatchString(id) {
+		return sdkerrors.Wrap(ErrInvalidClassID, id)
+	}
+
+	return nil
+}
+
+// ValidateNFTID returns an error if the given NFT ID is invalid.
+func ValidateNFTID(id string) error {
+	if !reNFTID.MatchString(id) {
+		return sdkerrors.Wrap(ErrInvalidNFTID, id)
+	}
+
+	return nil
+}
+
+// Class defines a class of non-fungible tokens, e.g. a collection.
+type Class struct {
+	ID          string         `json:"id" yaml:"id"`
+	Name        string         `json:"name" yaml:"name"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	Description string         `json:"description" yaml:"description"`
+	URI         string         `json:"uri" yaml:"uri"`
+	Creator     sdk.AccAddress `json:"creator" yaml:"creator"`
+}
+
+// NewClass creates a new Class instance
+func NewClass(id, name, symbol, description, uri string, creator sdk.AccAddress) Class {
+	return Class{
+		ID:          id,
+		Name:        name,
+		Symbol:      symbol,
+		Description: description,
+		URI:         uri,
+		Creator:     creator,
+	}
+}
+
+// Validate performs a basic validation of the Class.
+func (c Class) Validate() error {
+	if err := ValidateClassID(c.ID); err != nil {
+		return err
+	}
+
+	if c.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "class %s creator cannot be empty", c.ID)
+	}
+
+	return nil
+}
+
+// NFT defines a non-fungible token, identified by its class and its ID within
+// the class.
+type NFT struct {
+	ClassID string         `json:"class_id" yaml:"class_id"`
+	ID      string         `json:"id" yaml:"id"`
+	URI     string         `json:"uri" yaml:"uri"`
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewNFT creates a new NFT instance
+func NewNFT(classID, id, uri string, owner sdk.AccAddress) NFT {
+	return NFT{
+		ClassID: classID,
+		ID:      id,
+		URI:     uri,
+		Owner:   owner,
+	}
+}
+
+// Validate performs a basic validation of the NFT.
+func (n NFT) Validate() error {
+	if err := ValidateClassID(n.ClassID); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTID(n.ID); err != nil {
+		return err
+	}
+
+	if n.Owner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "nft %s owner cannot be empty", n)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface, as <classID>/<id>.
+func (n NFT) String() string {
+	return fmt.Sprintf("%s/%s", n.ClassID, n.ID)
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier path constants
+const (
+	QueryClass   = "class"
+	QueryClasses = "classes"
+	QueryNFT     = "nft"
+	QueryNFTs    = "nfts"
+	QueryOwner   = "owner"
+	QuerySupply  = "supply"
+)
+
+// QueryClassParams defines the params for the following queries:
+//
+// - 'custom/nft/class'
+//
+// - 'custom/nft/supply'
+type QueryClassParams struct {
+	ClassID string `json:"class_id" yaml:"class_id"`
+}
+
+// NewQueryClassParams creates a new QueryClassParams instance.
+func NewQueryClassParams(classID string) QueryClassParams {
+	return QueryClassParams{ClassID: classID}
+}
+
+// QueryClassesParams defines the params for the following queries:
+//
+// - 'custom/nft/classes'
+type QueryClassesParams struct {
+	Page, Limit int
+}
+
+// NewQueryClassesParams creates a new QueryClassesParams instance.
+func NewQueryClassesParams(page, limit int) QueryClassesParams {
+	return QueryClassesParams{Page: page, Limit: limit}
+}
+
+// QueryNFTParams defines the params for the following queries:
+//
+// - 'custom/nft/nft'
+type QueryNFTParams struct {
+	ClassID string `json:"class_id" yaml:"class_id"`
+	ID      string `json:"id" yaml:"id"`
+}
+
+// NewQueryNFTParams creates a new QueryNFTParams instance.
+func NewQueryNFTParams(classID, id string) QueryNFTParams {
+	return QueryNFTParams{ClassID: classID, ID: id}
+}
+
+// QueryNFTsParams defines the params for the following queries:
+//
+// - 'custom/nft/nfts'
+type QueryNFTsParams struct {
+	ClassID     string `json:"class_id" yaml:"class_id"`
+	Page, Limit int
+}
+
+// NewQueryNFTsParams creates a new QueryNFTsParams instance.
+func NewQueryNFTsParams(classID string, page, limit int) QueryNFTsParams {
+	return QueryNFTsParams{ClassID: classID, Page: page, Limit: limit}
+}
+
+// QueryOwnerParams defines the params for the following queries:
+//
+// - 'custom/nft/owner'
+//
+// An empty ClassID queries the NFTs of all the classes.
+type QueryOwnerParams struct {
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	ClassID     string         `json:"class_id" yaml:"class_id"`
+	Page, Limit int
+}
+
+// NewQueryOwnerParams creates a new QueryOwnerParams instance.
+func NewQueryOwnerParams(owner sdk.AccAddress, classID string, page, limit int) QueryOwnerParams {
+	return QueryOwnerParams{Owner: owner, ClassID: classID, Page: page, Limit: limit}
+}
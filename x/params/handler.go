@@ -0,0 +1,47 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// NewHandler creates an sdk.Handler for all the params type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *proposal.MsgUpdateParams:
+			return handleMsgUpdateParams(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgUpdateParams(ctx sdk.Context, k keeper.Keeper, msg *proposal.MsgUpdateParams) (*sdk.Result, error) {
+	for _, c := range msg.Changes {
+		if authority := k.GetAuthority(c.Subspace); !authority.Equals(msg.Authority) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "%s is not the authority of subspace %s; expected %s", msg.Authority, c.Subspace, authority,
+			)
+		}
+	}
+
+	if err := applyParamChanges(ctx, k, msg.Changes); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
@@ -0,0 +1,55 @@
+package params_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func TestHandlerMsgUpdateParams(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	other := sdk.AccAddress([]byte("other_authority_____"))
+	changes := []proposal.ParamChange{proposal.NewParamChange(testSubspace, keyMaxValidators, "1")}
+	hdlr := params.NewHandler(input.keeper)
+
+	// the governance module account is the default authority
+	require.True(t, govAddr.Equals(input.keeper.GetAuthority(testSubspace)))
+
+	_, err := hdlr(input.ctx, proposal.NewMsgUpdateParams(other, changes))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+
+	res, err := hdlr(input.ctx, proposal.NewMsgUpdateParams(govAddr, changes))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Events)
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+
+	// a subspace authority may be overridden
+	input.keeper.SetAuthority(testSubspace, other)
+
+	changes = []proposal.ParamChange{proposal.NewParamChange(testSubspace, keyMaxValidators, "2")}
+	_, err = hdlr(input.ctx, proposal.NewMsgUpdateParams(govAddr, changes))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, err = hdlr(input.ctx, proposal.NewMsgUpdateParams(other, changes))
+	require.NoError(t, err)
+
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(2), param)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
@@ -17,15 +19,23 @@ type Keeper struct {
 	key    sdk.StoreKey
 	tkey   sdk.StoreKey
 	spaces map[string]*types.Subspace
+
+	// authority is the account allowed to update the parameters of the
+	// subspaces through MsgUpdateParams, unless overridden in authorities.
+	authority   sdk.AccAddress
+	authorities map[string]sdk.AccAddress
 }
 
-// NewKeeper constructs a params keeper
+// NewKeeper constructs a params keeper. The parameters are updated by the
+// governance module account, unless another authority is set for a subspace.
 func NewKeeper(cdc codec.Marshaler, key, tkey sdk.StoreKey) Keeper {
 	return Keeper{
-		cdc:    cdc,
-		key:    key,
-		tkey:   tkey,
-		spaces: make(map[string]*types.Subspace),
+		cdc:         cdc,
+		key:         key,
+		tkey:        tkey,
+		spaces:      make(map[string]*types.Subspace),
+		authority:   authtypes.NewModuleAddress(govtypes.ModuleName),
+		authorities: make(map[string]sdk.AccAddress),
 	}
 }
 
@@ -59,3 +69,22 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// SetAuthority sets the account allowed to update the parameters of a subspace.
+func (k Keeper) SetAuthority(s string, authority sdk.AccAddress) {
+	if authority.Empty() {
+		panic("cannot use empty authority for subspace")
+	}
+
+	k.authorities[s] = authority
+}
+
+// GetAuthority returns the account allowed to update the parameters of a
+// subspace, which is the governance module account unless set otherwise.
+func (k Keeper) GetAuthority(s string) sdk.AccAddress {
+	if authority, ok := k.authorities[s]; ok {
+		return authority
+	}
+
+	return k.authority
+}
@@ -34,6 +34,11 @@ func (AppModuleBasic) Name() string {
 	return proposal.ModuleName
 }
 
+// StoreKeys returns the keys of the params module stores.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey), sdk.NewTransientStoreKey(TStoreKey)}
+}
+
 // RegisterCodec registers the params module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	proposal.RegisterCodec(cdc)
@@ -78,14 +83,14 @@ func NewAppModule(k Keeper) AppModule {
 
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
-func (am AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper) }
 
 // InitGenesis performs a no-op.
 func (am AppModule) InitGenesis(_ sdk.Context, _ codec.JSONMarshaler, _ json.RawMessage) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
 
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return proposal.RouterKey }
 
 // GenerateGenesisState performs a no-op.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {}
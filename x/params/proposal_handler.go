@@ -24,7 +24,13 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
-	for _, c := range p.Changes {
+	return applyParamChanges(ctx, k, p.Changes)
+}
+
+// applyParamChanges updates the parameters of the subspaces with the given
+// changes.
+func applyParamChanges(ctx sdk.Context, k keeper.Keeper, changes []proposal.ParamChange) error {
+	for _, c := range changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
@@ -20,6 +20,8 @@ var _ govtypes.Content = &ParameterChangeProposal{}
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeChange)
 	govtypes.RegisterProposalTypeCodec(&ParameterChangeProposal{}, "cosmos-sdk/ParameterChangeProposal")
+	// MsgUpdateParams may be executed by a governance exec proposal
+	govtypes.RegisterProposalTypeCodec(&MsgUpdateParams{}, "cosmos-sdk/MsgUpdateParams")
 }
 
 func NewParameterChangeProposal(title, description string, changes []ParamChange) *ParameterChangeProposal {
@@ -0,0 +1,20 @@
+package scheduler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker executes the callbacks due at the current block, in the order of
+// Keeper.DueCallbacks.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	// the due callbacks are collected first, since executing them updates the
+	// queues
+	for _, cb := range k.DueCallbacks(ctx) {
+		// a callback may have been cancelled by a callback executed before it
+		if _, found := k.GetCallback(ctx, cb.ID); !found {
+			continue
+		}
+
+		k.ExecuteCallback(ctx, cb)
+	}
+}
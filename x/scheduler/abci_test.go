@@ -0,0 +1,149 @@
+package scheduler_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// executedKey is the key of the list of executed callbacks in the scheduler
+// store, outside of the prefixes of the module.
+var executedKey = []byte("executed")
+
+// appendHandler returns a handler appending its data to the list of executed
+// callbacks, which fails for the "fail" data after writing it.
+func appendHandler(key sdk.StoreKey) scheduler.Handler {
+	return func(ctx sdk.Context, data []byte) error {
+		store := ctx.KVStore(key)
+		store.Set(executedKey, append(store.Get(executedKey), data...))
+
+		if string(data) == "fail" {
+			return errors.New("callback failure")
+		}
+
+		return nil
+	}
+}
+
+// setupKeeper returns a keeper with the "append" route sharing the store of the
+// scheduler keeper of a new simapp, whose router is already set, along with the
+// key of the store.
+func setupKeeper() (sdk.Context, scheduler.Keeper, sdk.StoreKey) {
+	app := simapp.Setup(false)
+	header := abci.Header{Height: 1, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := app.BaseApp.NewContext(false, header)
+
+	key := app.GetKey(scheduler.StoreKey)
+	k := scheduler.NewKeeper(app.Codec(), key)
+	k.SetRouter(scheduler.NewRouter().AddRoute("append", appendHandler(key)))
+
+	return ctx, k, key
+}
+
+func executed(ctx sdk.Context, key sdk.StoreKey) string {
+	return string(ctx.KVStore(key).Get(executedKey))
+}
+
+func TestSchedule(t *testing.T) {
+	ctx, k, _ := setupKeeper()
+
+	_, err := k.ScheduleAtHeight(ctx, "append", nil, 1, 1000)
+	require.True(t, scheduler.ErrInvalidSchedule.Is(err))
+
+	_, err = k.ScheduleAtTime(ctx, "append", nil, ctx.BlockTime(), 1000)
+	require.True(t, scheduler.ErrInvalidSchedule.Is(err))
+
+	_, err = k.ScheduleAtHeight(ctx, "unknown", nil, 2, 1000)
+	require.True(t, scheduler.ErrNoCallbackRoute.Is(err))
+
+	_, err = k.ScheduleAtHeight(ctx, "append", nil, 2, 0)
+	require.True(t, scheduler.ErrInvalidSchedule.Is(err))
+
+	id, err := k.ScheduleAtHeight(ctx, "append", []byte("a"), 2, 1000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+
+	cb, found := k.GetCallback(ctx, id)
+	require.True(t, found)
+	require.Equal(t, uint64(1), cb.ID)
+	require.Equal(t, []byte("a"), cb.Data)
+	require.Equal(t, int64(2), cb.Height)
+	require.Equal(t, uint64(1000), cb.GasLimit)
+
+	require.NoError(t, k.CancelCallback(ctx, id))
+	_, found = k.GetCallback(ctx, id)
+	require.False(t, found)
+	require.True(t, scheduler.ErrUnknownCallback.Is(k.CancelCallback(ctx, id)))
+	require.Empty(t, k.DueCallbacks(ctx.WithBlockHeight(2)))
+}
+
+func TestEndBlocker(t *testing.T) {
+	ctx, k, key := setupKeeper()
+	blockTime := ctx.BlockTime()
+
+	schedule := func(data string, height int64, t2 time.Time, gasLimit uint64) {
+		var err error
+		if height > 0 {
+			_, err = k.ScheduleAtHeight(ctx, "append", []byte(data), height, gasLimit)
+		} else {
+			_, err = k.ScheduleAtTime(ctx, "append", []byte(data), t2, gasLimit)
+		}
+		require.NoError(t, err)
+	}
+
+	schedule("d", 0, blockTime.Add(time.Hour), 100000)
+	schedule("c", 3, time.Time{}, 100000)
+	schedule("b", 2, time.Time{}, 100000)
+	schedule("a", 2, time.Time{}, 100000)
+	schedule("fail", 2, time.Time{}, 100000)
+	schedule("e", 2, time.Time{}, 1)
+
+	// height callbacks are executed in order of height then scheduling, and
+	// the failing or out of gas callbacks are reverted
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime.Add(time.Minute))
+	scheduler.EndBlocker(ctx, k)
+	require.Equal(t, "ba", executed(ctx, key))
+
+	var failed int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "callback_failed" {
+			failed++
+		}
+	}
+	require.Equal(t, 2, failed)
+
+	// missed heights are caught up, followed by the time callbacks
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(blockTime.Add(time.Hour))
+	require.Len(t, k.DueCallbacks(ctx), 2)
+	scheduler.EndBlocker(ctx, k)
+	require.Equal(t, "bacd", executed(ctx, key))
+
+	require.Empty(t, k.DueCallbacks(ctx))
+	require.Empty(t, k.AllCallbacks(ctx))
+}
+
+func TestExportGenesis(t *testing.T) {
+	ctx, k, _ := setupKeeper()
+
+	_, err := k.ScheduleAtHeight(ctx, "append", []byte("a"), 5, 1000)
+	require.NoError(t, err)
+	_, err = k.ScheduleAtTime(ctx, "append", []byte("b"), ctx.BlockTime().Add(time.Hour), 1000)
+	require.NoError(t, err)
+
+	exported := scheduler.ExportGenesis(ctx, k)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, uint64(3), exported.NextCallbackID)
+	require.Len(t, exported.Callbacks, 2)
+
+	ctx2, k2, _ := setupKeeper()
+	scheduler.InitGenesis(ctx2, k2, exported)
+	require.Equal(t, exported, scheduler.ExportGenesis(ctx2, k2))
+	require.Len(t, k2.DueCallbacks(ctx2.WithBlockHeight(5).WithBlockTime(ctx.BlockTime().Add(time.Hour))), 2)
+}
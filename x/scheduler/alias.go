@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+const (
+	ModuleName    = types.ModuleName
+	StoreKey      = types.StoreKey
+	QuerierRoute  = types.QuerierRoute
+	QueryCallback = types.QueryCallback
+)
+
+var (
+	// functions aliases
+	NewKeeper              = keeper.NewKeeper
+	NewQuerier             = keeper.NewQuerier
+	NewRouter              = types.NewRouter
+	NewGenesisState        = types.NewGenesisState
+	DefaultGenesisState    = types.DefaultGenesisState
+	NewHeightCallback      = types.NewHeightCallback
+	NewTimeCallback        = types.NewTimeCallback
+	NewQueryCallbackParams = types.NewQueryCallbackParams
+	CallbackKey            = types.CallbackKey
+	HeightQueueKey         = types.HeightQueueKey
+	TimeQueueKey           = types.TimeQueueKey
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrUnknownCallback = types.ErrUnknownCallback
+	ErrNoCallbackRoute = types.ErrNoCallbackRoute
+	ErrInvalidSchedule = types.ErrInvalidSchedule
+)
+
+type (
+	Keeper              = keeper.Keeper
+	Callback            = types.Callback
+	Handler             = types.Handler
+	Router              = types.Router
+	GenesisState        = types.GenesisState
+	QueryCallbackParams = types.QueryCallbackParams
+)
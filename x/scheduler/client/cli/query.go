@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// GetQueryCmd returns the cli query commands for the scheduler module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	schedulerQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the scheduler module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	schedulerQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryCallback(cdc),
+		)...,
+	)
+
+	return schedulerQueryCmd
+}
+
+// GetCmdQueryCallback implements a command to return a scheduled callback.
+func GetCmdQueryCallback(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "callback [callback-id]",
+		Short:   "Query a scheduled callback",
+		Example: fmt.Sprintf("$ <appcli> query %s callback 1", types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("callback-id %s not a valid uint: %w", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryCallbackParams(id))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCallback)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var cb types.Callback
+			if err := cdc.UnmarshalJSON(res, &cb); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(cb)
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the scheduler module state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetNextCallbackID(ctx, data.NextCallbackID)
+	for _, cb := range data.Callbacks {
+		k.SetCallback(ctx, cb)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetNextCallbackID(ctx), k.AllCallbacks(ctx))
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// Keeper of the scheduler store
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   types.Router
+}
+
+// NewKeeper creates a new scheduler Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// SetRouter sets the callback Router. It should be called once all the
+// modules scheduling callbacks registered their routes, and panics if a
+// router is already set.
+func (k *Keeper) SetRouter(rtr types.Router) {
+	// the router is sealed so that no handler is registered once the keeper is
+	// in use, which would make the callback execution non-deterministic
+	if !rtr.Sealed() {
+		rtr.Seal()
+	}
+	if k.router != nil {
+		panic(fmt.Sprintf("attempting to reset router on x/%s", types.ModuleName))
+	}
+
+	k.router = rtr
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// ScheduleAtHeight schedules a callback of the given route at a future block
+// height, and returns its ID.
+func (k Keeper) ScheduleAtHeight(ctx sdk.Context, route string, data []byte, height int64, gasLimit uint64) (uint64, error) {
+	if height <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSchedule, "height %d is not after the current height %d", height, ctx.BlockHeight())
+	}
+
+	return k.schedule(ctx, types.NewHeightCallback(route, data, height, gasLimit))
+}
+
+// ScheduleAtTime schedules a callback of the given route at a future block
+// time, and returns its ID. The callback is executed in the first block whose
+// time is equal to or after the given time.
+func (k Keeper) ScheduleAtTime(ctx sdk.Context, route string, data []byte, t time.Time, gasLimit uint64) (uint64, error) {
+	if !t.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSchedule, "time %s is not after the current block time %s", t, ctx.BlockTime())
+	}
+
+	return k.schedule(ctx, types.NewTimeCallback(route, data, t, gasLimit))
+}
+
+func (k Keeper) schedule(ctx sdk.Context, cb types.Callback) (uint64, error) {
+	if k.router == nil || !k.router.HasRoute(cb.Route) {
+		return 0, sdkerrors.Wrap(types.ErrNoCallbackRoute, cb.Route)
+	}
+
+	cb.ID = k.GetNextCallbackID(ctx)
+	if err := cb.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidSchedule, err.Error())
+	}
+
+	k.SetNextCallbackID(ctx, cb.ID+1)
+	k.SetCallback(ctx, cb)
+
+	return cb.ID, nil
+}
+
+// CancelCallback removes a scheduled callback before its execution.
+func (k Keeper) CancelCallback(ctx sdk.Context, id uint64) error {
+	cb, found := k.GetCallback(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownCallback, "%d", id)
+	}
+
+	k.deleteCallback(ctx, cb)
+	return nil
+}
+
+// GetNextCallbackID returns the ID of the next scheduled callback.
+func (k Keeper) GetNextCallbackID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextCallbackIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextCallbackID sets the ID of the next scheduled callback.
+func (k Keeper) SetNextCallbackID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextCallbackIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetCallback returns a scheduled callback.
+func (k Keeper) GetCallback(ctx sdk.Context, id uint64) (cb types.Callback, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CallbackKey(id))
+	if bz == nil {
+		return cb, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &cb)
+	return cb, true
+}
+
+// SetCallback stores a callback and inserts it in the queue of its height or
+// time.
+func (k Keeper) SetCallback(ctx sdk.Context, cb types.Callback) {
+	store := ctx.KVStore(k.storeKey)
+	idBz := sdk.Uint64ToBigEndian(cb.ID)
+
+	store.Set(types.CallbackKey(cb.ID), k.cdc.MustMarshalBinaryBare(cb))
+	store.Set(queueKey(cb), idBz)
+}
+
+func (k Keeper) deleteCallback(ctx sdk.Context, cb types.Callback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CallbackKey(cb.ID))
+	store.Delete(queueKey(cb))
+}
+
+func queueKey(cb types.Callback) []byte {
+	if cb.IsHeightScheduled() {
+		return types.HeightQueueKey(cb.Height, cb.ID)
+	}
+
+	return types.TimeQueueKey(cb.Time, cb.ID)
+}
+
+// IterateCallbacks iterates over all the scheduled callbacks, ordered by ID,
+// until the callback returns true.
+func (k Keeper) IterateCallbacks(ctx sdk.Context, fn func(cb types.Callback) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CallbackKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var cb types.Callback
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &cb)
+
+		if fn(cb) {
+			break
+		}
+	}
+}
+
+// AllCallbacks returns all the scheduled callbacks.
+func (k Keeper) AllCallbacks(ctx sdk.Context) []types.Callback {
+	callbacks := []types.Callback{}
+	k.IterateCallbacks(ctx, func(cb types.Callback) bool {
+		callbacks = append(callbacks, cb)
+		return false
+	})
+
+	return callbacks
+}
+
+// DueCallbacks returns the callbacks due at the current block: first the
+// callbacks scheduled at a height up to the block height, ordered by height
+// then ID, then the callbacks scheduled at a time up to the block time,
+// ordered by time then ID.
+func (k Keeper) DueCallbacks(ctx sdk.Context) []types.Callback {
+	store := ctx.KVStore(k.storeKey)
+
+	var callbacks []types.Callback
+	collect := func(start, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			cb, found := k.GetCallback(ctx, sdk.BigEndianToUint64(iterator.Value()))
+			if !found {
+				panic(fmt.Sprintf("queued callback %d not found", sdk.BigEndianToUint64(iterator.Value())))
+			}
+
+			callbacks = append(callbacks, cb)
+		}
+	}
+
+	collect(types.HeightQueueKeyPrefix, sdk.PrefixEndBytes(types.HeightQueuePrefix(ctx.BlockHeight())))
+	collect(types.TimeQueueKeyPrefix, sdk.PrefixEndBytes(types.TimeQueuePrefix(ctx.BlockTime())))
+
+	return callbacks
+}
+
+// ExecuteCallback removes a callback from the schedule and executes it with
+// its gas limit. The state changes and events of the callback are discarded
+// if its handler fails or runs out of gas.
+func (k Keeper) ExecuteCallback(ctx sdk.Context, cb types.Callback) {
+	k.deleteCallback(ctx, cb)
+
+	gasUsed, err := k.runCallback(ctx, cb)
+	if err != nil {
+		k.Logger(ctx).Info("scheduled callback failed", "id", cb.ID, "route", cb.Route, "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCallbackFailed,
+				sdk.NewAttribute(types.AttributeKeyCallbackID, fmt.Sprintf("%d", cb.ID)),
+				sdk.NewAttribute(types.AttributeKeyCallbackRoute, cb.Route),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCallbackExecuted,
+			sdk.NewAttribute(types.AttributeKeyCallbackID, fmt.Sprintf("%d", cb.ID)),
+			sdk.NewAttribute(types.AttributeKeyCallbackRoute, cb.Route),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+}
+
+// runCallback runs the handler of a callback in a cached context metered by
+// the callback gas limit, and writes its state changes if it succeeds.
+func (k Keeper) runCallback(ctx sdk.Context, cb types.Callback) (gasUsed uint64, err error) {
+	if k.router == nil || !k.router.HasRoute(cb.Route) {
+		return 0, sdkerrors.Wrap(types.ErrNoCallbackRoute, cb.Route)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(cb.GasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			gasUsed = gasMeter.GasConsumed()
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasLimit: %d", oog.Descriptor, cb.GasLimit)
+		}
+	}()
+
+	if err := k.router.GetRoute(cb.Route)(cacheCtx, cb.Data); err != nil {
+		return gasMeter.GasConsumed(), err
+	}
+
+	writeCache()
+	return gasMeter.GasConsumed(), nil
+}
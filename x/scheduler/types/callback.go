@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Callback defines a callback scheduled by a module, which is executed in the
+// EndBlock of the first block reaching either its height or its time. The
+// callback is scheduled at a height if Height is set, and at a time otherwise.
+type Callback struct {
+	// ID is the unique identifier of the callback.
+	ID uint64 `json:"id" yaml:"id"`
+	// Route is the route of the handler executing the callback.
+	Route string `json:"route" yaml:"route"`
+	// Data is the opaque data the handler is called with.
+	Data []byte `json:"data" yaml:"data"`
+	// Height is the block height at which the callback is executed.
+	Height int64 `json:"height" yaml:"height"`
+	// Time is the block time at or after which the callback is executed, if
+	// Height is not set.
+	Time time.Time `json:"time" yaml:"time"`
+	// GasLimit is the gas budget of the callback execution.
+	GasLimit uint64 `json:"gas_limit" yaml:"gas_limit"`
+}
+
+// NewHeightCallback returns a new Callback executed at a block height.
+func NewHeightCallback(route string, data []byte, height int64, gasLimit uint64) Callback {
+	return Callback{
+		Route:    route,
+		Data:     data,
+		Height:   height,
+		GasLimit: gasLimit,
+	}
+}
+
+// NewTimeCallback returns a new Callback executed at a block time.
+func NewTimeCallback(route string, data []byte, t time.Time, gasLimit uint64) Callback {
+	return Callback{
+		Route:    route,
+		Data:     data,
+		Time:     t,
+		GasLimit: gasLimit,
+	}
+}
+
+// IsHeightScheduled returns true if the callback is scheduled at a block
+// height rather than at a block time.
+func (cb Callback) IsHeightScheduled() bool {
+	return cb.Height > 0
+}
+
+// Validate performs a basic validation of the Callback.
+func (cb Callback) Validate() error {
+	if cb.Route == "" {
+		return fmt.Errorf("callback %d route cannot be empty", cb.ID)
+	}
+
+	if cb.Height < 0 {
+		return fmt.Errorf("callback %d height cannot be negative: %d", cb.ID, cb.Height)
+	}
+
+	if cb.Height == 0 && cb.Time.IsZero() {
+		return fmt.Errorf("callback %d must be scheduled at a height or a time", cb.ID)
+	}
+
+	if cb.GasLimit == 0 {
+		return fmt.Errorf("callback %d gas limit must be positive", cb.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the amino codec used to encode the scheduler module state.
+var ModuleCdc = codec.New()
+
+func init() {
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/scheduler module sentinel errors
+var (
+	ErrUnknownCallback = sdkerrors.Register(ModuleName, 2, "unknown callback")
+	ErrNoCallbackRoute = sdkerrors.Register(ModuleName, 3, "no handler registered for callback route")
+	ErrInvalidSchedule = sdkerrors.Register(ModuleName, 4, "invalid callback schedule")
+)
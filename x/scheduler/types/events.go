@@ -0,0 +1,12 @@
+package types
+
+// scheduler module event types
+const (
+	EventTypeCallbackExecuted = "callback_executed"
+	EventTypeCallbackFailed   = "callback_failed"
+
+	AttributeKeyCallbackID    = "callback_id"
+	AttributeKeyCallbackRoute = "route"
+	AttributeKeyGasUsed       = "gas_used"
+	AttributeKeyError         = "error"
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the scheduler module genesis state
+type GenesisState struct {
+	NextCallbackID uint64     `json:"next_callback_id" yaml:"next_callback_id"`
+	Callbacks      []Callback `json:"callbacks" yaml:"callbacks"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(nextCallbackID uint64, callbacks []Callback) GenesisState {
+	return GenesisState{
+		NextCallbackID: nextCallbackID,
+		Callbacks:      callbacks,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, without any scheduled
+// callback.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, []Callback{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if gs.NextCallbackID == 0 {
+		return fmt.Errorf("next callback id must be positive")
+	}
+
+	ids := make(map[uint64]bool)
+	for _, cb := range gs.Callbacks {
+		if err := cb.Validate(); err != nil {
+			return err
+		}
+
+		if cb.ID == 0 || cb.ID >= gs.NextCallbackID {
+			return fmt.Errorf("callback id %d must be in [1, %d)", cb.ID, gs.NextCallbackID)
+		}
+
+		if ids[cb.ID] {
+			return fmt.Errorf("duplicate callback id %d", cb.ID)
+		}
+
+		ids[cb.ID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	heightCallback := func(id uint64) types.Callback {
+		cb := types.NewHeightCallback("route", nil, 10, 1000)
+		cb.ID = id
+		return cb
+	}
+
+	timeCallback := types.NewTimeCallback("route", nil, time.Now(), 1000)
+	timeCallback.ID = 2
+
+	testCases := []struct {
+		name      string
+		genesis   types.GenesisState
+		expectErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"callbacks", types.NewGenesisState(3, []types.Callback{heightCallback(1), timeCallback}), false},
+		{"zero next id", types.NewGenesisState(0, nil), true},
+		{"id not below next id", types.NewGenesisState(1, []types.Callback{heightCallback(1)}), true},
+		{"duplicate id", types.NewGenesisState(3, []types.Callback{heightCallback(1), heightCallback(1)}), true},
+		{"no schedule", types.NewGenesisState(3, []types.Callback{{ID: 1, Route: "route", GasLimit: 1000}}), true},
+		{"no route", types.NewGenesisState(3, []types.Callback{{ID: 1, Height: 10, GasLimit: 1000}}), true},
+		{"no gas limit", types.NewGenesisState(3, []types.Callback{{ID: 1, Route: "route", Height: 10}}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "scheduler"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the scheduler querier
+	QueryCallback = "callback"
+)
+
+// Keys for scheduler store
+// Items are stored with the following key: values
+//
+// - 0x01<callbackID_Bytes>: Callback
+//
+// - 0x02<height_Bytes><callbackID_Bytes>: callbackID_Bytes
+//
+// - 0x03<time_Bytes><callbackID_Bytes>: callbackID_Bytes
+//
+// - 0x04: nextCallbackID
+var (
+	CallbackKeyPrefix    = []byte{0x01}
+	HeightQueueKeyPrefix = []byte{0x02}
+	TimeQueueKeyPrefix   = []byte{0x03}
+	NextCallbackIDKey    = []byte{0x04}
+)
+
+// CallbackKey returns the key storing a callback.
+func CallbackKey(id uint64) []byte {
+	return append(CallbackKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeightQueueKey returns the key of a callback in the queue of the callbacks
+// scheduled at a block height.
+func HeightQueueKey(height int64, id uint64) []byte {
+	return append(HeightQueuePrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeightQueuePrefix returns the prefix of the keys of the callbacks scheduled
+// at a block height.
+func HeightQueuePrefix(height int64) []byte {
+	return append(HeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// TimeQueueKey returns the key of a callback in the queue of the callbacks
+// scheduled at a block time.
+func TimeQueueKey(t time.Time, id uint64) []byte {
+	return append(TimeQueuePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TimeQueuePrefix returns the prefix of the keys of the callbacks scheduled at
+// a block time.
+func TimeQueuePrefix(t time.Time) []byte {
+	return sdk.TimeQueueKey(TimeQueueKeyPrefix, t)
+}
@@ -0,0 +1,11 @@
+package types
+
+// QueryCallbackParams defines the params of a callback query.
+type QueryCallbackParams struct {
+	CallbackID uint64 `json:"callback_id" yaml:"callback_id"`
+}
+
+// NewQueryCallbackParams creates a new QueryCallbackParams instance.
+func NewQueryCallbackParams(id uint64) QueryCallbackParams {
+	return QueryCallbackParams{CallbackID: id}
+}
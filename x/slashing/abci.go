@@ -1,18 +1,18 @@
 package slashing
 
 import (
-	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+func BeginBlocker(ctx sdk.Context, votes []sdk.VoteInfo, k Keeper) {
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
-	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
-		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
+	for _, vote := range votes {
+		k.HandleValidatorSignature(ctx, crypto.Address(vote.ConsAddress), vote.Power, vote.SignedLastBlock)
 	}
 }
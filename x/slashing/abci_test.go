@@ -43,16 +43,13 @@ func TestBeginBlocker(t *testing.T) {
 	}
 
 	// mark the validator as having signed
-	req := abci.RequestBeginBlock{
-		LastCommitInfo: abci.LastCommitInfo{
-			Votes: []abci.VoteInfo{{
-				Validator:       val,
-				SignedLastBlock: true,
-			}},
-		},
-	}
+	votes := []sdk.VoteInfo{{
+		ConsAddress:     sdk.ConsAddress(val.Address),
+		Power:           val.Power,
+		SignedLastBlock: true,
+	}}
 
-	slashing.BeginBlocker(ctx, req, app.SlashingKeeper)
+	slashing.BeginBlocker(ctx, votes, app.SlashingKeeper)
 
 	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(pk.Address()))
 	require.True(t, found)
@@ -66,31 +63,25 @@ func TestBeginBlocker(t *testing.T) {
 	// for 1000 blocks, mark the validator as having signed
 	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx); height++ {
 		ctx = ctx.WithBlockHeight(height)
-		req = abci.RequestBeginBlock{
-			LastCommitInfo: abci.LastCommitInfo{
-				Votes: []abci.VoteInfo{{
-					Validator:       val,
-					SignedLastBlock: true,
-				}},
-			},
-		}
-
-		slashing.BeginBlocker(ctx, req, app.SlashingKeeper)
+		votes = []sdk.VoteInfo{{
+			ConsAddress:     sdk.ConsAddress(val.Address),
+			Power:           val.Power,
+			SignedLastBlock: true,
+		}}
+
+		slashing.BeginBlocker(ctx, votes, app.SlashingKeeper)
 	}
 
 	// for 500 blocks, mark the validator as having not signed
 	for ; height < ((app.SlashingKeeper.SignedBlocksWindow(ctx) * 2) - app.SlashingKeeper.MinSignedPerWindow(ctx) + 1); height++ {
 		ctx = ctx.WithBlockHeight(height)
-		req = abci.RequestBeginBlock{
-			LastCommitInfo: abci.LastCommitInfo{
-				Votes: []abci.VoteInfo{{
-					Validator:       val,
-					SignedLastBlock: false,
-				}},
-			},
-		}
-
-		slashing.BeginBlocker(ctx, req, app.SlashingKeeper)
+		votes = []sdk.VoteInfo{{
+			ConsAddress:     sdk.ConsAddress(val.Address),
+			Power:           val.Power,
+			SignedLastBlock: false,
+		}}
+
+		slashing.BeginBlocker(ctx, votes, app.SlashingKeeper)
 	}
 
 	// end block
@@ -142,6 +142,50 @@ func TestHandleNewValidator(t *testing.T) {
 	require.Equal(t, expTokens.Int64(), app.BankKeeper.GetBalance(ctx, bondPool.GetAddress(), app.StakingKeeper.BondDenom(ctx)).Amount.Int64())
 }
 
+func TestHandleRotatedConsPubKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	amt := sdk.TokensFromConsensusPower(100)
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	ctx = ctx.WithBlockHeight(app.SlashingKeeper.SignedBlocksWindow(ctx) + 1)
+
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, oldPk, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+
+	res, err = sh(ctx, staking.NewMsgRotateConsPubKey(addr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the signing info and missed blocks are carried over to the new key
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(newPk))
+	require.True(t, found)
+	require.Equal(t, sdk.GetConsAddress(newPk), info.Address)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.GetConsAddress(newPk), 0))
+
+	pk, err := app.SlashingKeeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+	require.Equal(t, newPk, pk)
+
+	// the old key remains accountable
+	_, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(oldPk))
+	require.True(t, found)
+	_, err = app.SlashingKeeper.GetPubkey(ctx, oldPk.Address())
+	require.NoError(t, err)
+}
+
 // Test a jailed validator being "down" twice
 // Ensure that they're only slashed once
 func TestHandleAlreadyJailed(t *testing.T) {
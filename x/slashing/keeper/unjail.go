@@ -20,7 +20,7 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		return types.ErrMissingSelfDelegation
 	}
 
-	tokens := validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
+	tokens := validator.TokensFromSharesTruncated(selfDel.GetShares()).TruncateInt()
 	minSelfBond := validator.GetMinSelfDelegation()
 	if tokens.LT(minSelfBond) {
 		return sdkerrors.Wrapf(
@@ -51,7 +51,7 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		}
 
 		// cannot be unjailed until out of jail
-		if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		if ctx.BlockTime().Before(info.JailedUntil) {
 			return types.ErrValidatorJailed
 		}
 	}
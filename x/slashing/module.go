@@ -26,6 +26,8 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ module.BeginBlockVotesHandler = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the slashing module.
@@ -40,6 +42,11 @@ func (AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
+// StoreKeys returns the keys of the slashing module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the slashing module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
@@ -143,11 +150,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the slashing module.
-func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, req, am.keeper)
+// HandleVotes handles the votes of the validators for the previous block to
+// track their liveness and jail the ones which missed too many blocks.
+func (am AppModule) HandleVotes(ctx sdk.Context, votes []sdk.VoteInfo) {
+	BeginBlocker(ctx, votes, am.keeper)
 }
 
+// BeginBlock returns the begin blocker for the slashing module. The votes of
+// the validators are handled by HandleVotes.
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
 // EndBlock returns the end blocker for the slashing module. It returns no validator
 // updates.
 func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
@@ -47,6 +47,7 @@ var (
 	NonNegativePowerInvariant          = keeper.NonNegativePowerInvariant
 	PositiveDelegationInvariant        = keeper.PositiveDelegationInvariant
 	DelegatorSharesInvariant           = keeper.DelegatorSharesInvariant
+	DelegatorTokensInvariant           = keeper.DelegatorTokensInvariant
 	NewKeeper                          = keeper.NewKeeper
 	ParamKeyTable                      = keeper.ParamKeyTable
 	NewQuerier                         = keeper.NewQuerier
@@ -121,6 +122,7 @@ var (
 	ErrInvalidHistoricalInfo           = types.ErrInvalidHistoricalInfo
 	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	ErrEmptyValidatorPubKey            = types.ErrEmptyValidatorPubKey
+	ErrConsPubKeyRotationLimit         = types.ErrConsPubKeyRotationLimit
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -155,6 +157,7 @@ var (
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgRotateConsPubKey             = types.NewMsgRotateConsPubKey
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -218,11 +221,13 @@ type (
 	GenesisState              = types.GenesisState
 	LastValidatorPower        = types.LastValidatorPower
 	MultiStakingHooks         = types.MultiStakingHooks
+	StakingHooksBase          = types.StakingHooksBase
 	MsgCreateValidator        = types.MsgCreateValidator
 	MsgEditValidator          = types.MsgEditValidator
 	MsgDelegate               = types.MsgDelegate
 	MsgBeginRedelegate        = types.MsgBeginRedelegate
 	MsgUndelegate             = types.MsgUndelegate
+	MsgRotateConsPubKey       = types.MsgRotateConsPubKey
 	Params                    = types.Params
 	Pool                      = types.Pool
 	QueryDelegatorParams      = types.QueryDelegatorParams
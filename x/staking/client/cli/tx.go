@@ -50,6 +50,7 @@ func NewTxCmd(ctx context.CLIContext) *cobra.Command {
 		NewDelegateCmd(ctx),
 		NewRedelegateCmd(ctx),
 		NewUnbondCmd(ctx),
+		NewRotateConsPubKeyCmd(ctx),
 	)...)
 
 	return stakingTxCmd
@@ -268,6 +269,43 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 	return cmd
 }
 
+func NewRotateConsPubKeyCmd(ctx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [new-pubkey]",
+		Short: "Rotate the consensus public key of an existing validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the Tendermint consensus public key of the validator operated by
+the signer. The replaced key keeps being accountable for infractions until the
+end of the unbonding period.
+
+Example:
+$ %s tx staking rotate-cons-pubkey $(%s tendermint show-validator) --from mykey
+`,
+				version.ClientName, version.ServerName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := ctx.InitWithInput(cmd.InOrStdin())
+
+			valAddr := cliCtx.GetFromAddress()
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), pk)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	return cmd
+}
+
 func NewBuildCreateValidatorMsg(cliCtx context.CLIContext, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
 	amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
 	if err != nil {
@@ -51,6 +51,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegationHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query the proof of existence or non-existence of a delegation
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegations/{validatorAddr}/proof",
+		delegationProofHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Query all unbonding delegations between a delegator and a validator
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}",
@@ -254,6 +260,38 @@ func delegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation))
 }
 
+// HTTP request handler to query a delegation along with the merkle proof of its
+// existence or non-existence. Only the key of the given delegation can be
+// proven, so that a request cannot make the node build proofs of ranges.
+func delegationProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(vars["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		proof, err := cliCtx.QueryStoreProof(types.GetDelegationKey(delegatorAddr, validatorAddr), types.StoreKey)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(proof.Height)
+		rest.PostProcessResponse(w, cliCtx, proof)
+	}
+}
+
 // HTTP request handler to query all delegator bonded validators
 func delegatorValidatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, "custom/staking/delegatorValidators")
@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -457,6 +459,196 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestUndelegateBelowMinSelfDelegationJailsValidator(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(10)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+
+	validatorAddr := valAddrs[0]
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	// create validator requiring half of its initial bond as self-delegation
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.TokensFromConsensusPower(5)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// must end-block
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+
+	// undelegating down to the minimum keeps the validator unjailed
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))
+	res, err = handler(ctx, types.NewMsgUndelegate(sdk.AccAddress(validatorAddr), validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.False(t, validator.Jailed)
+	for _, event := range res.Events {
+		require.NotEqual(t, types.EventTypeJailValidator, event.Type)
+	}
+
+	// undelegating below the minimum jails the validator
+	unbondAmt = sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	res, err = handler(ctx, types.NewMsgUndelegate(sdk.AccAddress(validatorAddr), validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+
+	var jailEvent abci.Event
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeJailValidator {
+			jailEvent = event
+		}
+	}
+	require.Contains(t, jailEvent.Attributes, tmkv.Pair{Key: []byte(types.AttributeKeyValidator), Value: []byte(validatorAddr.String())})
+	require.Contains(t, jailEvent.Attributes, tmkv.Pair{Key: []byte(types.AttributeKeyMinSelfDelegation), Value: []byte(msgCreateValidator.MinSelfDelegation.String())})
+
+	// the validator is removed from the validator set at the end of the block
+	updates = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, int64(0), updates[0].Power)
+}
+
+func TestEditValidatorDescriptionAndCommission(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+	validatorAddr := valAddrs[0]
+
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	// create validator
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(5, 2))
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// metadata can be updated without touching the commission
+	description := types.NewDescription("moniker", "identity", "https://example.com", types.DoNotModifyDesc, types.DoNotModifyDesc)
+	res, err = handler(ctx, types.NewMsgEditValidator(validatorAddr, description, nil, nil))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, "moniker", validator.Description.Moniker)
+	require.Equal(t, "identity", validator.Description.Identity)
+	require.Equal(t, "https://example.com", validator.Description.Website)
+
+	var editEvent abci.Event
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeEditValidator {
+			editEvent = event
+		}
+	}
+	require.Contains(t, editEvent.Attributes, tmkv.Pair{Key: []byte(types.AttributeKeyValidator), Value: []byte(validatorAddr.String())})
+	require.Contains(t, editEvent.Attributes, tmkv.Pair{Key: []byte(types.AttributeKeyMoniker), Value: []byte("moniker")})
+
+	editDescription := types.NewDescription(types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc)
+
+	// the commission cannot be changed within 24 hours of the last change
+	newRate := sdk.NewDecWithPrec(12, 2)
+	_, err = handler(ctx, types.NewMsgEditValidator(validatorAddr, editDescription, &newRate, nil))
+	require.True(t, errors.Is(err, types.ErrCommissionUpdateTime))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+
+	// the commission cannot increase by more than the max change rate
+	newRate = sdk.NewDecWithPrec(2, 1)
+	_, err = handler(ctx, types.NewMsgEditValidator(validatorAddr, editDescription, &newRate, nil))
+	require.True(t, errors.Is(err, types.ErrCommissionGTMaxChangeRate))
+
+	// the commission cannot exceed the max rate
+	newRate = sdk.NewDecWithPrec(4, 1)
+	_, err = handler(ctx, types.NewMsgEditValidator(validatorAddr, editDescription, &newRate, nil))
+	require.True(t, errors.Is(err, types.ErrCommissionGTMaxRate))
+
+	newRate = sdk.NewDecWithPrec(15, 2)
+	_, err = handler(ctx, types.NewMsgEditValidator(validatorAddr, editDescription, &newRate, nil))
+	require.NoError(t, err)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+	require.Equal(t, ctx.BlockTime(), validator.Commission.UpdateTime)
+	require.Equal(t, "moniker", validator.Description.Moniker)
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+	validatorAddr := valAddrs[0]
+
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updates := app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+
+	// rotate the consensus pubkey of the bonded validator
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[1]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, PKs[1], validator.GetConsPubKey())
+
+	// both the old and the new consensus addresses resolve to the validator
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(t, found)
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[1]))
+	require.True(t, found)
+
+	// a validator cannot rotate twice within a block, nor reuse a key in use
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[2]))
+	require.True(t, errors.Is(err, types.ErrConsPubKeyRotationLimit))
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[0]))
+	require.True(t, errors.Is(err, types.ErrValidatorPubKeyExists))
+
+	// Tendermint replaces the old key by the new one
+	updates = app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[0]), updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[1]), updates[1].PubKey)
+	require.Equal(t, initPower, updates[1].Power)
+
+	updates = app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Equal(t, 0, len(updates))
+
+	// the rotation limit applies within the unbonding period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[2]))
+	require.True(t, errors.Is(err, types.ErrConsPubKeyRotationLimit))
+
+	// the old consensus address is released once the rotation matures
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetValidatorConsPubKeyRotations(ctx, validatorAddr))
+
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[2]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromConsensusPower(initPower)
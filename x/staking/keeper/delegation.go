@@ -261,9 +261,7 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
-	return store.Iterator(types.UnbondingQueueKey,
-		sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(endTime)))
+	return sdk.TimeQueueIterator(ctx.KVStore(k.storeKey), types.UnbondingQueueKey, endTime)
 }
 
 // Returns a concatenated list of all the timeslices inclusively previous to
@@ -272,7 +270,7 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (m
 	store := ctx.KVStore(k.storeKey)
 
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.BlockHeader().Time)
+	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.BlockTime())
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
 		timeslice := types.DVPairs{}
 		value := unbondingTimesliceIterator.Value()
@@ -463,8 +461,7 @@ func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
 
 // Returns all the redelegation queue timeslices from time 0 until endTime
 func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
-	return store.Iterator(types.RedelegationQueueKey, sdk.InclusiveEndBytes(types.GetRedelegationTimeKey(endTime)))
+	return sdk.TimeQueueIterator(ctx.KVStore(k.storeKey), types.RedelegationQueueKey, endTime)
 }
 
 // Returns a concatenated list of all the timeslices inclusively previous to
@@ -473,7 +470,7 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	store := ctx.KVStore(k.storeKey)
 
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
+	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockTime())
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
 		timeslice := types.DVVTriplets{}
 		value := redelegationTimesliceIterator.Value()
@@ -598,9 +595,17 @@ func (k Keeper) Unbond(
 	// If the delegation is the operator of the validator and undelegating will decrease the validator's
 	// self-delegation below their minimum, we jail the validator.
 	if isValidatorOperator && !validator.Jailed &&
-		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
 		k.jailValidator(ctx, validator)
 		validator = k.mustGetValidator(ctx, validator.OperatorAddress)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeJailValidator,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			),
+		)
 	}
 
 	// remove the delegation
@@ -636,7 +641,7 @@ func (k Keeper) getBeginInfo(
 	switch {
 	case !found || validator.IsBonded():
 		// the longest wait - just unbonding period from now
-		completionTime = ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+		completionTime = ctx.BlockTime().Add(k.UnbondingTime(ctx))
 		height = ctx.BlockHeight()
 
 		return completionTime, height, false
@@ -679,7 +684,7 @@ func (k Keeper) Undelegate(
 		k.bondedTokensToNotBonded(ctx, returnAmount)
 	}
 
-	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	completionTime := ctx.BlockTime().Add(k.UnbondingTime(ctx))
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
@@ -697,7 +702,7 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 
 	bondDenom := k.GetParams(ctx).BondDenom
 	balances := sdk.NewCoins()
-	ctxTime := ctx.BlockHeader().Time
+	ctxTime := ctx.BlockTime()
 
 	// loop through all the entries and complete unbonding mature entries
 	for i := 0; i < len(ubd.Entries); i++ {
@@ -800,7 +805,7 @@ func (k Keeper) CompleteRedelegation(
 
 	bondDenom := k.GetParams(ctx).BondDenom
 	balances := sdk.NewCoins()
-	ctxTime := ctx.BlockHeader().Time
+	ctxTime := ctx.BlockTime()
 
 	// loop through all the entries and complete mature redelegation entries
 	for i := 0; i < len(red.Entries); i++ {
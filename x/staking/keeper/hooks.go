@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -22,6 +24,13 @@ func (k Keeper) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress)
 	}
 }
 
+// AfterConsensusPubKeyUpdate - call hook if registered
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey) {
+	if k.hooks != nil {
+		k.hooks.AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey)
+	}
+}
+
 // AfterValidatorRemoved - call hook if registered
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
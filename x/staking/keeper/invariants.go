@@ -19,6 +19,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegator-tokens",
+		DelegatorTokensInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -39,7 +41,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DelegatorTokensInvariant(k)(ctx)
 	}
 }
 
@@ -187,3 +194,41 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// DelegatorTokensInvariant checks that the token worth of all the delegations
+// to each validator, truncated, never exceeds the validator's tokens and maps
+// back to them within one unit.
+func DelegatorTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		for _, validator := range validators {
+			// the exchange rate is undefined without any delegator shares
+			if !validator.GetDelegatorShares().IsPositive() {
+				continue
+			}
+
+			totalDelTokens := sdk.ZeroDec()
+
+			delegations := k.GetValidatorDelegations(ctx, validator.GetOperator())
+			for _, delegation := range delegations {
+				totalDelTokens = totalDelTokens.Add(validator.TokensFromSharesTruncated(delegation.Shares))
+			}
+
+			diff := validator.GetTokens().ToDec().Sub(totalDelTokens)
+			if diff.IsNegative() || diff.GT(sdk.OneDec()) {
+				broken = true
+				msg += fmt.Sprintf("broken delegator tokens invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.Tokens: %v\n"+
+					"\tsum of delegation tokens: %v\n", validator.GetOperator(), validator.GetTokens(), totalDelTokens)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "delegator tokens", msg), broken
+	}
+}
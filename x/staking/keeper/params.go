@@ -50,6 +50,13 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MaxConsPubKeyRotations - Maximum number of consensus pubkey rotations a
+// validator may perform within an unbonding period
+func (k Keeper) MaxConsPubKeyRotations(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxConsPubKeyRotations, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -58,6 +65,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxConsPubKeyRotations(ctx),
 	)
 }
 
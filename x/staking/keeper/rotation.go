@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. A validator
+// may rotate its key at most MaxConsPubKeyRotations times within an unbonding
+// period, and at most once per block. The replaced consensus address keeps
+// resolving to the validator until the rotation matures, so that infractions
+// committed with the old key can still be slashed.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey crypto.PubKey) error {
+	valAddr := validator.OperatorAddress
+
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetPendingConsPubKeyRotationKey(valAddr)) {
+		return sdkerrors.Wrap(types.ErrConsPubKeyRotationLimit, "consensus pubkey already rotated in this block")
+	}
+
+	maxRotations := k.MaxConsPubKeyRotations(ctx)
+	if uint32(len(k.GetValidatorConsPubKeyRotations(ctx, valAddr))) >= maxRotations {
+		return sdkerrors.Wrapf(types.ErrConsPubKeyRotationLimit, "maximum %d", maxRotations)
+	}
+
+	oldPubKey := validator.GetConsPubKey()
+	oldConsAddr := validator.GetConsAddr()
+
+	validator.ConsensusPubkey = sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+
+	completionTime := ctx.BlockTime().Add(k.UnbondingTime(ctx))
+	store.Set(types.GetValidatorConsPubKeyRotationKey(valAddr, completionTime), oldConsAddr)
+	store.Set(types.GetConsPubKeyRotationQueueKey(completionTime, valAddr), oldConsAddr)
+	store.Set(
+		types.GetPendingConsPubKeyRotationKey(valAddr),
+		[]byte(sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, oldPubKey)),
+	)
+
+	k.AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey)
+
+	return nil
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus addresses replaced by
+// the rotations of a validator which have not matured yet
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (consAddrs []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorConsPubKeyRotationsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		consAddrs = append(consAddrs, sdk.ConsAddress(iterator.Value()))
+	}
+
+	return consAddrs
+}
+
+// DequeueAllMatureConsPubKeyRotations removes all the consensus pubkey rotations
+// maturing up to currTime, and releases the consensus addresses they replaced
+func (k Keeper) DequeueAllMatureConsPubKeyRotations(ctx sdk.Context, currTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.TimeQueueIterator(store, types.ConsPubKeyRotationQueueKey, currTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+
+		completionTime, suffix, err := sdk.ParseTimeQueueKey(types.ConsPubKeyRotationQueueKey, key)
+		if err != nil {
+			panic(err)
+		}
+		valAddr := sdk.ValAddress(suffix)
+
+		oldConsAddrKey := types.GetValidatorByConsAddrKey(iterator.Value())
+		if bytes.Equal(store.Get(oldConsAddrKey), valAddr) {
+			store.Delete(oldConsAddrKey)
+		}
+
+		store.Delete(types.GetValidatorConsPubKeyRotationKey(valAddr, completionTime))
+		store.Delete(key)
+	}
+}
+
+// dequeuePendingConsPubKeyRotations returns the consensus pubkeys replaced in
+// the current block by validator, so that Tendermint can be told to drop them,
+// and clears them from the store
+func (k Keeper) dequeuePendingConsPubKeyRotations(ctx sdk.Context) map[[sdk.AddrLen]byte]crypto.PubKey {
+	store := ctx.KVStore(k.storeKey)
+	rotated := make(map[[sdk.AddrLen]byte]crypto.PubKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var valAddr [sdk.AddrLen]byte
+		// extract the validator address from the key (prefix is 1-byte)
+		copy(valAddr[:], iterator.Key()[1:])
+		rotated[valAddr] = sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, string(iterator.Value()))
+
+		store.Delete(iterator.Key())
+	}
+
+	return rotated
+}
@@ -7,6 +7,7 @@ import (
 
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,8 +30,11 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
 
+	// Release the consensus addresses replaced by mature consensus pubkey rotations.
+	k.DequeueAllMatureConsPubKeyRotations(ctx, ctx.BlockTime())
+
 	// Remove all mature unbonding delegations from the ubd queue.
-	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
+	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockTime())
 	for _, dvPair := range matureUnbonds {
 		balances, err := k.CompleteUnbonding(ctx, dvPair.DelegatorAddress, dvPair.ValidatorAddress)
 		if err != nil {
@@ -48,7 +52,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	}
 
 	// Remove all mature redelegations from the red queue.
-	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockTime())
 	for _, dvvTriplet := range matureRedelegations {
 		balances, err := k.CompleteRedelegation(
 			ctx,
@@ -96,6 +100,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the consensus pubkeys replaced during this block, which
+	// Tendermint still knows the validators by.
+	rotated := k.dequeuePendingConsPubKeyRotations(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -138,8 +146,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryBare(&gogotypes.Int64Value{Value: newPower})
 
+		// replace the consensus pubkey of a bonded validator which rotated it
+		oldPubKey, isRotated := rotated[valAddrBytes]
+		if found && isRotated {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+		}
+
 		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) || isRotated {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -157,7 +171,16 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		validator = k.bondedToUnbonding(ctx, validator)
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		var rotatedKey [sdk.AddrLen]byte
+
+		copy(rotatedKey[:], valAddrBytes)
+
+		if oldPubKey, isRotated := rotated[rotatedKey]; isRotated {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -275,8 +298,8 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	validator = validator.UpdateStatus(sdk.Unbonding)
 
 	// set the unbonding completion time and completion height appropriately
-	validator.UnbondingTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
-	validator.UnbondingHeight = ctx.BlockHeader().Height
+	validator.UnbondingTime = ctx.BlockTime().Add(params.UnbondingTime)
+	validator.UnbondingHeight = ctx.BlockHeight()
 
 	// save the now unbonded validator record and power index
 	k.SetValidator(ctx, validator)
@@ -9,13 +9,20 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeRotateConsPubKey     = "rotate_cons_pubkey"
+	EventTypeJailValidator        = "jail_validator"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
+	AttributeKeyMoniker           = "moniker"
+	AttributeKeyIdentity          = "identity"
+	AttributeKeyWebsite           = "website"
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
 	AttributeValueCategory        = ModuleName
 )
@@ -1,9 +1,16 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+var (
+	_ StakingHooks = MultiStakingHooks{}
+	_ StakingHooks = StakingHooksBase{}
+)
+
 // combine multiple staking hooks, all hook functions are run in array sequence
 type MultiStakingHooks []StakingHooks
 
@@ -61,3 +68,26 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey) {
+	for i := range h {
+		h[i].AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey)
+	}
+}
+
+// StakingHooksBase implements all the StakingHooks as no-ops. It is meant to be
+// embedded by the hooks of modules which only observe some of the staking
+// events, e.g. delegation changes, so that they only implement those.
+type StakingHooksBase struct{}
+
+func (StakingHooksBase) AfterValidatorCreated(sdk.Context, sdk.ValAddress)                          {}
+func (StakingHooksBase) BeforeValidatorModified(sdk.Context, sdk.ValAddress)                        {}
+func (StakingHooksBase) AfterValidatorRemoved(sdk.Context, sdk.ConsAddress, sdk.ValAddress)         {}
+func (StakingHooksBase) AfterValidatorBonded(sdk.Context, sdk.ConsAddress, sdk.ValAddress)          {}
+func (StakingHooksBase) AfterValidatorBeginUnbonding(sdk.Context, sdk.ConsAddress, sdk.ValAddress)  {}
+func (StakingHooksBase) BeforeDelegationCreated(sdk.Context, sdk.AccAddress, sdk.ValAddress)        {}
+func (StakingHooksBase) BeforeDelegationSharesModified(sdk.Context, sdk.AccAddress, sdk.ValAddress) {}
+func (StakingHooksBase) BeforeDelegationRemoved(sdk.Context, sdk.AccAddress, sdk.ValAddress)        {}
+func (StakingHooksBase) AfterDelegationModified(sdk.Context, sdk.AccAddress, sdk.ValAddress)        {}
+func (StakingHooksBase) BeforeValidatorSlashed(sdk.Context, sdk.ValAddress, sdk.Dec)                {}
+func (StakingHooksBase) AfterConsensusPubKeyUpdate(sdk.Context, sdk.ValAddress, crypto.PubKey, crypto.PubKey) {
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// delegationHooks only observes delegation changes, relying on
+// StakingHooksBase for the other hooks.
+type delegationHooks struct {
+	StakingHooksBase
+
+	name  string
+	calls *[]string
+}
+
+func (h delegationHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
+	*h.calls = append(*h.calls, h.name+":created")
+}
+
+func (h delegationHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
+	*h.calls = append(*h.calls, h.name+":removed")
+}
+
+func TestMultiStakingHooks(t *testing.T) {
+	var calls []string
+	hooks := NewMultiStakingHooks(
+		delegationHooks{name: "a", calls: &calls},
+		StakingHooksBase{},
+		delegationHooks{name: "b", calls: &calls},
+	)
+
+	ctx := sdk.Context{}
+	delAddr := sdk.AccAddress(valAddr1)
+
+	hooks.AfterValidatorCreated(ctx, valAddr2)
+	hooks.BeforeValidatorSlashed(ctx, valAddr2, sdk.NewDecWithPrec(5, 2))
+	require.Empty(t, calls)
+
+	hooks.BeforeDelegationCreated(ctx, delAddr, valAddr2)
+	hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr2)
+	require.Equal(t, []string{"a:created", "b:created", "a:removed", "b:removed"}, calls)
+}
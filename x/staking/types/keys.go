@@ -44,6 +44,10 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ValidatorConsPubKeyRotationKey = []byte{0x60} // prefix for the consensus pubkey rotations of a validator
+	ConsPubKeyRotationQueueKey     = []byte{0x61} // prefix for the timestamps in consensus pubkey rotation queue
+	PendingConsPubKeyRotationKey   = []byte{0x62} // prefix for the consensus pubkey rotations not yet sent to Tendermint
 )
 
 // gets the key for the validator with address
@@ -122,8 +126,7 @@ func ParseValidatorPowerRankKey(key []byte) (operAddr []byte) {
 
 // gets the prefix for all unbonding delegations from a delegator
 func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
-	bz := sdk.FormatTimeBytes(timestamp)
-	return append(ValidatorQueueKey, bz...)
+	return sdk.TimeQueueKey(ValidatorQueueKey, timestamp)
 }
 
 //______________________________________________________________________________
@@ -182,8 +185,7 @@ func GetUBDsByValIndexKey(valAddr sdk.ValAddress) []byte {
 
 // gets the prefix for all unbonding delegations from a delegator
 func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
-	bz := sdk.FormatTimeBytes(timestamp)
-	return append(UnbondingQueueKey, bz...)
+	return sdk.TimeQueueKey(UnbondingQueueKey, timestamp)
 }
 
 //________________________________________________________________________________
@@ -260,8 +262,7 @@ func GetREDKeyFromValDstIndexKey(indexKey []byte) []byte {
 
 // gets the prefix for all unbonding delegations from a delegator
 func GetRedelegationTimeKey(timestamp time.Time) []byte {
-	bz := sdk.FormatTimeBytes(timestamp)
-	return append(RedelegationQueueKey, bz...)
+	return sdk.TimeQueueKey(RedelegationQueueKey, timestamp)
 }
 
 //______________
@@ -293,3 +294,38 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// GetValidatorConsPubKeyRotationsKey gets the prefix keyspace for the consensus
+// pubkey rotations of a validator
+func GetValidatorConsPubKeyRotationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// GetValidatorConsPubKeyRotationKey gets the key for a consensus pubkey rotation
+// of a validator, by the time the rotation matures
+// VALUE: replaced consensus address ([]byte)
+func GetValidatorConsPubKeyRotationKey(valAddr sdk.ValAddress, completionTime time.Time) []byte {
+	return append(GetValidatorConsPubKeyRotationsKey(valAddr), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetConsPubKeyRotationTimeKey gets the prefix for all consensus pubkey rotations
+// maturing at a given time
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	return sdk.TimeQueueKey(ConsPubKeyRotationQueueKey, timestamp)
+}
+
+// GetConsPubKeyRotationQueueKey gets the key for a consensus pubkey rotation in
+// the rotation queue
+// VALUE: replaced consensus address ([]byte)
+func GetConsPubKeyRotationQueueKey(completionTime time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationTimeKey(completionTime), valAddr.Bytes()...)
+}
+
+// GetPendingConsPubKeyRotationKey gets the key for a consensus pubkey rotation
+// that has not yet been sent to Tendermint
+// VALUE: replaced consensus pubkey (bech32 string)
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, valAddr.Bytes()...)
+}
@@ -159,3 +159,27 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        string
+		expectPass    bool
+	}{
+		{"regular", valAddr1, sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pk2), true},
+		{"empty validator", emptyAddr, sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pk2), false},
+		{"empty pubkey", valAddr1, "", false},
+		{"account pubkey", valAddr1, sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk2), false},
+	}
+
+	for _, tc := range tests {
+		msg := MsgRotateConsPubKey{ValidatorAddress: tc.validatorAddr, NewPubkey: tc.pubkey}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
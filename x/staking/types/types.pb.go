@@ -1147,6 +1147,9 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// max_cons_pubkey_rotations is the maximum number of consensus pubkey
+	// rotations a validator may perform within an unbonding period.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,6,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty" yaml:"max_cons_pubkey_rotations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1216,6 +1219,67 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxConsPubkeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
+// MsgRotateConsPubKey defines an SDK message for rotating the consensus public
+// key of an existing validator.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	NewPubkey        string                                        `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty" yaml:"new_pubkey"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c669c0a3ee1b124c, []int{20}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+func (m *MsgRotateConsPubKey) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgRotateConsPubKey) GetNewPubkey() string {
+	if m != nil {
+		return m.NewPubkey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos_sdk.x.staking.v1.MsgCreateValidator")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos_sdk.x.staking.v1.MsgEditValidator")
@@ -1237,118 +1301,122 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos_sdk.x.staking.v1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos_sdk.x.staking.v1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.staking.v1.Params")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos_sdk.x.staking.v1.MsgRotateConsPubKey")
 }
 
 func init() { proto.RegisterFile("x/staking/types/types.proto", fileDescriptor_c669c0a3ee1b124c) }
 
 var fileDescriptor_c669c0a3ee1b124c = []byte{
-	// 1682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xdb, 0x8e, 0x9d, 0x3c, 0x4f, 0xec, 0xa4, 0xa3, 0xc9, 0x78, 0xb2, 0xac, 0x3b, 0xf4,
-	0xa2, 0x55, 0x84, 0x58, 0x5b, 0xd9, 0x45, 0x42, 0xca, 0x5e, 0x76, 0x1c, 0x27, 0x4a, 0x50, 0x82,
-	0x66, 0x3b, 0xb3, 0x39, 0xf0, 0x21, 0xab, 0xdc, 0x5d, 0x69, 0x17, 0x71, 0x77, 0x9b, 0xae, 0x72,
-	0xd6, 0x41, 0x5c, 0x91, 0x10, 0x12, 0x62, 0x2f, 0x48, 0x7b, 0x1c, 0xf1, 0x0f, 0xf0, 0x1f, 0xa0,
-	0xe1, 0x36, 0xdc, 0x46, 0x1c, 0x10, 0x70, 0x30, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x40, 0xe2,
-	0x84, 0xba, 0xaa, 0xfa, 0x23, 0x6d, 0x7b, 0xc6, 0xc9, 0x30, 0xc3, 0x48, 0x93, 0xcb, 0x8c, 0xeb,
-	0xf5, 0x7b, 0xbf, 0x57, 0xf5, 0xbe, 0xab, 0x02, 0xef, 0x0c, 0xea, 0x94, 0xa1, 0x33, 0xe2, 0xda,
-	0x75, 0x76, 0xd1, 0xc3, 0x54, 0xfc, 0x5b, 0xeb, 0xf9, 0x1e, 0xf3, 0xd4, 0x3b, 0xa6, 0x47, 0x1d,
-	0x8f, 0xb6, 0xa8, 0x75, 0x56, 0x1b, 0xd4, 0x24, 0x5f, 0xed, 0x7c, 0x6b, 0xfd, 0x7d, 0xd6, 0x21,
-	0xbe, 0xd5, 0xea, 0x21, 0x9f, 0x5d, 0xd4, 0x39, 0x6f, 0xdd, 0xf6, 0x6c, 0x2f, 0xfe, 0x25, 0x00,
-	0xd6, 0x3f, 0x1a, 0xe7, 0x63, 0xd8, 0xb5, 0xb0, 0xef, 0x10, 0x97, 0xd5, 0x51, 0xdb, 0x24, 0xe3,
-	0x5a, 0xd7, 0x35, 0xdb, 0xf3, 0xec, 0x2e, 0x16, 0xfc, 0xed, 0xfe, 0x69, 0x9d, 0x11, 0x07, 0x53,
-	0x86, 0x9c, 0x9e, 0x64, 0xa8, 0xa6, 0x19, 0xac, 0xbe, 0x8f, 0x18, 0xf1, 0x5c, 0xf9, 0x7d, 0x65,
-	0x0c, 0x53, 0xff, 0x77, 0x0e, 0xd4, 0x23, 0x6a, 0xef, 0xf8, 0x18, 0x31, 0x7c, 0x82, 0xba, 0xc4,
-	0x42, 0xcc, 0xf3, 0xd5, 0x43, 0x28, 0x5a, 0x98, 0x9a, 0x3e, 0xe9, 0x05, 0xe2, 0x15, 0x65, 0x43,
-	0xd9, 0x2c, 0x7e, 0xf8, 0xb5, 0xda, 0x94, 0x63, 0xd7, 0x9a, 0x31, 0x6f, 0x23, 0xf7, 0x78, 0xa8,
-	0xcd, 0x19, 0x49, 0x71, 0xf5, 0x3b, 0x00, 0xa6, 0xe7, 0x38, 0x84, 0xd2, 0x00, 0x2c, 0xc3, 0xc1,
-	0x36, 0xa7, 0x82, 0xed, 0x44, 0xac, 0x06, 0x62, 0x98, 0x4a, 0xc0, 0x04, 0x82, 0xfa, 0x13, 0x58,
-	0x75, 0x88, 0xdb, 0xa2, 0xb8, 0x7b, 0xda, 0xb2, 0x70, 0x17, 0xdb, 0xfc, 0x90, 0x95, 0xec, 0x86,
-	0xb2, 0xb9, 0xd8, 0x38, 0x0c, 0xd8, 0xff, 0x32, 0xd4, 0xde, 0xb7, 0x09, 0xeb, 0xf4, 0xdb, 0x35,
-	0xd3, 0x73, 0xea, 0x42, 0x95, 0xfc, 0xef, 0x03, 0x6a, 0x9d, 0x49, 0x1b, 0x1c, 0xb8, 0x6c, 0x34,
-	0xd4, 0xd6, 0x2f, 0x90, 0xd3, 0xdd, 0xd6, 0x27, 0x40, 0xea, 0xc6, 0x8a, 0x43, 0xdc, 0x63, 0xdc,
-	0x3d, 0x6d, 0x46, 0x34, 0xf5, 0xc7, 0xb0, 0x22, 0x39, 0x3c, 0xbf, 0x85, 0x2c, 0xcb, 0xc7, 0x94,
-	0x56, 0x72, 0x1b, 0xca, 0xe6, 0xad, 0xc6, 0xd1, 0x68, 0xa8, 0x55, 0x04, 0xda, 0x18, 0x8b, 0xfe,
-	0x9f, 0xa1, 0xf6, 0xc1, 0x0c, 0x7b, 0xba, 0x67, 0x9a, 0xf7, 0x84, 0x84, 0xb1, 0x1c, 0x81, 0x48,
-	0x4a, 0xa0, 0xfb, 0x3c, 0x74, 0x52, 0xa4, 0x7b, 0x3e, 0xad, 0x7b, 0x8c, 0x65, 0x56, 0xdd, 0x27,
-	0xa8, 0x1b, 0xe9, 0x8e, 0x40, 0x42, 0xdd, 0x6b, 0x90, 0xef, 0xf5, 0xdb, 0x67, 0xf8, 0xa2, 0x92,
-	0x0f, 0x0c, 0x6d, 0xc8, 0x95, 0x5a, 0x87, 0xf9, 0x73, 0xd4, 0xed, 0xe3, 0x4a, 0x81, 0x3b, 0x76,
-	0x35, 0xe9, 0x58, 0xee, 0x4e, 0x12, 0x06, 0x85, 0xe0, 0xdb, 0xce, 0xfd, 0xfd, 0xa1, 0xa6, 0xe8,
-	0xbf, 0xcb, 0xc2, 0xf2, 0x11, 0xb5, 0x77, 0x2d, 0xc2, 0x5e, 0x55, 0xdc, 0xf5, 0x26, 0x59, 0x2b,
-	0xc3, 0xad, 0xb5, 0x33, 0x1a, 0x6a, 0x25, 0x61, 0xad, 0xff, 0xa5, 0x8d, 0x1c, 0x28, 0xc7, 0x71,
-	0xda, 0xf2, 0x11, 0xc3, 0x32, 0x2a, 0x9b, 0x33, 0x46, 0x64, 0x13, 0x9b, 0xa3, 0xa1, 0xb6, 0x26,
-	0x76, 0x96, 0x82, 0xd2, 0x8d, 0x92, 0x79, 0x29, 0x37, 0xd4, 0xc1, 0xe4, 0x44, 0xc8, 0x71, 0x95,
-	0xfb, 0xaf, 0x30, 0x09, 0xa4, 0x0f, 0x7f, 0x9b, 0x81, 0xe2, 0x11, 0xb5, 0x25, 0x1d, 0x4f, 0x4e,
-	0x0d, 0xe5, 0xff, 0x98, 0x1a, 0x99, 0xd7, 0x93, 0x1a, 0x5b, 0x90, 0x47, 0x8e, 0xd7, 0x77, 0x19,
-	0xf7, 0xf6, 0x73, 0x73, 0x40, 0x32, 0x4a, 0x03, 0xfe, 0x39, 0xcb, 0xcb, 0x6f, 0x03, 0xdb, 0xc4,
-	0x35, 0xb0, 0xf5, 0x26, 0xd8, 0xf1, 0xa7, 0x0a, 0xdc, 0x8e, 0xad, 0x44, 0x7d, 0x33, 0x65, 0xcc,
-	0x4f, 0x47, 0x43, 0xed, 0x2b, 0x69, 0x63, 0x26, 0xd8, 0xae, 0x61, 0xd0, 0xd5, 0x08, 0xe8, 0xd8,
-	0x37, 0x27, 0xef, 0xc3, 0xa2, 0x2c, 0xda, 0x47, 0x76, 0xfa, 0x3e, 0x12, 0x6c, 0x2f, 0xb5, 0x8f,
-	0x26, 0x65, 0xe3, 0xbe, 0xcd, 0x5d, 0xcd, 0xb7, 0x8f, 0x32, 0xb0, 0x74, 0x44, 0xed, 0xcf, 0x5c,
-	0xeb, 0x26, 0x3d, 0xae, 0x99, 0x1e, 0xbf, 0x52, 0xa0, 0xb4, 0x4f, 0x28, 0xf3, 0x7c, 0x62, 0xa2,
-	0xee, 0x81, 0x7b, 0xea, 0xa9, 0x1f, 0x43, 0xbe, 0x83, 0x91, 0x85, 0x7d, 0xd9, 0x1c, 0xde, 0xad,
-	0xc5, 0x83, 0x53, 0x2d, 0x18, 0x9c, 0x6a, 0x62, 0x43, 0xfb, 0x9c, 0x29, 0x44, 0x15, 0x22, 0xea,
-	0x27, 0x90, 0x3f, 0x47, 0x5d, 0x8a, 0x59, 0x25, 0xb3, 0x91, 0xdd, 0x2c, 0x7e, 0xa8, 0x4f, 0xed,
-	0x2c, 0x51, 0x4b, 0x0a, 0x11, 0x84, 0x9c, 0xdc, 0xd7, 0x6f, 0x32, 0x50, 0x4e, 0x8d, 0x29, 0x6a,
-	0x03, 0x72, 0xbc, 0xde, 0x2b, 0xbc, 0xf8, 0xd6, 0xae, 0x30, 0x85, 0x34, 0xb1, 0x69, 0x70, 0x59,
-	0xf5, 0xfb, 0xb0, 0xe0, 0xa0, 0x81, 0xe8, 0x1b, 0x19, 0x8e, 0x73, 0xef, 0x6a, 0x38, 0xa3, 0xa1,
-	0x56, 0x96, 0x85, 0x5c, 0xe2, 0xe8, 0x46, 0xc1, 0x41, 0x03, 0xde, 0x2d, 0x7a, 0x50, 0x0e, 0xa8,
-	0x66, 0x07, 0xb9, 0x36, 0x4e, 0x36, 0xa7, 0xfd, 0x2b, 0x2b, 0x59, 0x8b, 0x95, 0x24, 0xe0, 0x74,
-	0x63, 0xc9, 0x41, 0x83, 0x1d, 0x4e, 0x08, 0x34, 0x6e, 0x2f, 0x7c, 0xf9, 0x50, 0x9b, 0xe3, 0x16,
-	0xfb, 0x83, 0x02, 0x10, 0x5b, 0x4c, 0xfd, 0x01, 0x2c, 0xa7, 0x9a, 0x1b, 0x95, 0xfe, 0x9c, 0x7d,
-	0x2e, 0x5c, 0x08, 0x76, 0xfd, 0x64, 0xa8, 0x29, 0x46, 0xd9, 0x4c, 0xf9, 0xe2, 0x7b, 0x50, 0xec,
-	0xf7, 0x2c, 0xc4, 0x70, 0x2b, 0x18, 0x91, 0xe5, 0xc4, 0xb9, 0x5e, 0x13, 0xe3, 0x71, 0x2d, 0x1c,
-	0x8f, 0x6b, 0x0f, 0xc2, 0xf9, 0xb9, 0x51, 0x0d, 0xb0, 0x46, 0x43, 0x4d, 0x15, 0xe7, 0x4a, 0x08,
-	0xeb, 0x5f, 0xfc, 0x55, 0x53, 0x0c, 0x10, 0x94, 0x40, 0x20, 0x71, 0xa8, 0xdf, 0x2b, 0x50, 0x4c,
-	0x8c, 0x20, 0x6a, 0x05, 0x0a, 0x8e, 0xe7, 0x92, 0x33, 0x19, 0x9c, 0x8b, 0x46, 0xb8, 0x54, 0xd7,
-	0x61, 0x81, 0x58, 0xd8, 0x65, 0x84, 0x5d, 0x08, 0xc7, 0x1a, 0xd1, 0x3a, 0x90, 0xfa, 0x1c, 0xb7,
-	0x29, 0x09, 0xdd, 0x61, 0x84, 0x4b, 0x75, 0x0f, 0x96, 0x29, 0x36, 0xfb, 0x3e, 0x61, 0x17, 0x2d,
-	0xd3, 0x73, 0x19, 0x32, 0x99, 0xec, 0xed, 0xef, 0x8c, 0x86, 0xda, 0x1d, 0xb1, 0xd7, 0x34, 0x87,
-	0x6e, 0x94, 0x43, 0xd2, 0x8e, 0xa0, 0x04, 0x1a, 0x2c, 0xcc, 0x10, 0xe9, 0x8a, 0x59, 0x71, 0xd1,
-	0x08, 0x97, 0x89, 0xb3, 0x3c, 0x2a, 0xc0, 0x62, 0x3c, 0x87, 0x7d, 0x0e, 0xcb, 0x5e, 0x0f, 0xfb,
-	0x13, 0x0a, 0xd5, 0x61, 0xac, 0x39, 0xcd, 0x71, 0x8d, 0x5a, 0x51, 0x0e, 0x31, 0xc2, 0x52, 0xb1,
-	0x17, 0x04, 0x86, 0x4b, 0xb1, 0x4b, 0xfb, 0xb4, 0x25, 0xc7, 0xcd, 0x4c, 0xfa, 0xc8, 0x69, 0x0e,
-	0x3d, 0x88, 0x00, 0x49, 0xba, 0x2f, 0x86, 0xd2, 0x35, 0xc8, 0xff, 0x10, 0x91, 0x2e, 0xb6, 0xb8,
-	0x4d, 0x17, 0x0c, 0xb9, 0x52, 0x0f, 0x20, 0x4f, 0x19, 0x62, 0x7d, 0x31, 0xb1, 0xcf, 0x37, 0xb6,
-	0x66, 0xdc, 0x73, 0xc3, 0x73, 0xad, 0x63, 0x2e, 0x68, 0x48, 0x00, 0x75, 0x0f, 0xf2, 0xcc, 0x3b,
-	0xc3, 0xae, 0x34, 0xea, 0x95, 0x52, 0xfe, 0xc0, 0x65, 0x86, 0x94, 0x56, 0x19, 0xc4, 0xd5, 0xba,
-	0x45, 0x3b, 0xc8, 0xc7, 0x54, 0x4c, 0xd8, 0x8d, 0x83, 0x2b, 0xe7, 0xe5, 0x9d, 0x74, 0x0b, 0x11,
-	0x78, 0xba, 0x51, 0x8e, 0x48, 0xc7, 0x9c, 0x92, 0x9e, 0xb4, 0x0b, 0x2f, 0x37, 0x69, 0xef, 0xc1,
-	0x72, 0xdf, 0x6d, 0x7b, 0xae, 0x45, 0x5c, 0xbb, 0xd5, 0xc1, 0xc4, 0xee, 0xb0, 0xca, 0xc2, 0x86,
-	0xb2, 0x99, 0x4d, 0xba, 0x2d, 0xcd, 0xa1, 0x1b, 0xe5, 0x88, 0xb4, 0xcf, 0x29, 0xaa, 0x05, 0xa5,
-	0x98, 0x8b, 0xe7, 0xee, 0xe2, 0x0b, 0x73, 0xf7, 0xab, 0x32, 0x77, 0x6f, 0xa7, 0xb5, 0xc4, 0xe9,
-	0xbb, 0x14, 0x11, 0x03, 0x31, 0xf5, 0xe0, 0xd2, 0x7d, 0x14, 0xb8, 0x86, 0xf7, 0x66, 0xa8, 0x3b,
-	0xb3, 0x5f, 0x45, 0x8b, 0xaf, 0xe5, 0x2a, 0xba, 0x7d, 0xeb, 0x67, 0x0f, 0xb5, 0xb9, 0x28, 0x85,
-	0x7f, 0x9e, 0x81, 0x7c, 0xf3, 0xe4, 0x3e, 0x22, 0xfe, 0xdb, 0x3a, 0x69, 0x24, 0xea, 0xd9, 0x1e,
-	0x14, 0x84, 0x2d, 0xa8, 0xfa, 0x31, 0xcc, 0xf7, 0x82, 0x1f, 0x15, 0x85, 0x37, 0x7d, 0x6d, 0x7a,
-	0x90, 0x73, 0x81, 0xf0, 0xb2, 0xca, 0x65, 0xf4, 0x5f, 0x67, 0x01, 0x9a, 0x27, 0x27, 0x0f, 0x7c,
-	0xd2, 0xeb, 0x62, 0x76, 0x33, 0x99, 0xbf, 0x39, 0x93, 0x79, 0xc2, 0xd9, 0x0f, 0xa0, 0x18, 0xfb,
-	0x88, 0xaa, 0xbb, 0xb0, 0xc0, 0xe4, 0x6f, 0xe9, 0xf3, 0xf7, 0x9e, 0xe3, 0xf3, 0x50, 0x4e, 0xfa,
-	0x3d, 0x12, 0xd5, 0xff, 0x98, 0x01, 0x78, 0xd1, 0xbb, 0xcf, 0x5b, 0x30, 0xbd, 0xef, 0x41, 0x5e,
-	0x76, 0xa5, 0xec, 0xb5, 0x46, 0x5b, 0x29, 0x9d, 0x70, 0xd7, 0x3f, 0x32, 0xb0, 0xfa, 0x59, 0x58,
-	0x91, 0x6f, 0x2c, 0xac, 0x7e, 0x0a, 0x05, 0xec, 0x32, 0x9f, 0x70, 0x13, 0x07, 0xe1, 0xba, 0x35,
-	0x35, 0x5c, 0x27, 0x98, 0x6d, 0xd7, 0x65, 0xfe, 0x85, 0x0c, 0xde, 0x10, 0x27, 0x61, 0xec, 0x5f,
-	0x66, 0xa1, 0x32, 0x4d, 0x4a, 0xdd, 0x81, 0xb2, 0xe9, 0x63, 0x4e, 0x08, 0xdb, 0xb6, 0xc2, 0xdb,
-	0xf6, 0x7a, 0xe2, 0x15, 0xea, 0x32, 0x83, 0x6e, 0x94, 0x42, 0x8a, 0x6c, 0xda, 0x36, 0x7f, 0xf4,
-	0x0a, 0x72, 0x26, 0xe0, 0x9a, 0x71, 0xe2, 0xd6, 0x65, 0xd7, 0x8e, 0x9f, 0xba, 0x92, 0x00, 0xa2,
-	0x6d, 0x97, 0x62, 0x2a, 0xef, 0xdb, 0x3f, 0x82, 0x32, 0x71, 0x09, 0x23, 0xa8, 0xdb, 0x6a, 0xa3,
-	0x2e, 0x72, 0xcd, 0xeb, 0x5c, 0x60, 0x44, 0xa3, 0x95, 0x6a, 0x53, 0x70, 0xba, 0x51, 0x92, 0x94,
-	0x86, 0x20, 0xa8, 0xfb, 0x50, 0x08, 0x55, 0xe5, 0xae, 0x35, 0xe5, 0x85, 0xe2, 0x09, 0x8f, 0xfc,
-	0x22, 0x0b, 0x2b, 0xd1, 0x63, 0xcf, 0x8d, 0x2b, 0x66, 0x75, 0xc5, 0x11, 0x80, 0xa8, 0x24, 0x41,
-	0x2f, 0xb9, 0x86, 0x37, 0x82, 0x5a, 0xb4, 0x28, 0x10, 0x9a, 0x94, 0x25, 0xfc, 0xf1, 0xcf, 0x2c,
-	0xdc, 0x4a, 0xfa, 0xe3, 0xa6, 0xc9, 0xbf, 0x41, 0xcf, 0x6f, 0xdf, 0x8e, 0x6b, 0x63, 0x8e, 0xd7,
-	0xc6, 0xaf, 0x4f, 0xad, 0x8d, 0x63, 0x39, 0x35, 0xbd, 0x28, 0xfe, 0x2b, 0x03, 0xf9, 0xfb, 0xc8,
-	0x47, 0x0e, 0x55, 0xcd, 0xb1, 0x2b, 0x87, 0x78, 0x88, 0xb8, 0x3b, 0x96, 0x31, 0x4d, 0xf9, 0xd7,
-	0xb4, 0x17, 0xdc, 0x38, 0xbe, 0x9c, 0x70, 0xe3, 0xf8, 0x04, 0x4a, 0x0e, 0x1a, 0xb4, 0xa2, 0x03,
-	0x0a, 0x6f, 0x2e, 0x35, 0xee, 0xc6, 0x28, 0x97, 0xbf, 0x8b, 0xa7, 0x94, 0xe8, 0x42, 0x4e, 0xd5,
-	0x6f, 0x41, 0x31, 0xe0, 0x88, 0xfb, 0x44, 0x20, 0xbe, 0x16, 0x3f, 0x59, 0x24, 0x3e, 0xea, 0x06,
-	0x38, 0x68, 0xb0, 0x2b, 0x16, 0xea, 0x21, 0xa8, 0x9d, 0xe8, 0x09, 0xad, 0x15, 0xdb, 0x32, 0x90,
-	0x7f, 0x77, 0x34, 0xd4, 0xee, 0x0a, 0xf9, 0x71, 0x1e, 0xdd, 0x58, 0x89, 0x89, 0x21, 0xda, 0x37,
-	0x01, 0x82, 0x73, 0xb5, 0x2c, 0xec, 0x7a, 0x8e, 0xbc, 0xf8, 0xde, 0x1e, 0x0d, 0xb5, 0x15, 0x81,
-	0x12, 0x7f, 0xd3, 0x8d, 0xc5, 0x60, 0xd1, 0x0c, 0x7e, 0xc7, 0x86, 0x6f, 0xec, 0x3d, 0x7e, 0x5a,
-	0x55, 0x9e, 0x3c, 0xad, 0x2a, 0x7f, 0x7b, 0x5a, 0x55, 0xbe, 0x78, 0x56, 0x9d, 0x7b, 0xf2, 0xac,
-	0x3a, 0xf7, 0xa7, 0x67, 0xd5, 0xb9, 0xef, 0x7e, 0xe3, 0xb9, 0xc1, 0x92, 0xfa, 0x6b, 0x6c, 0x3b,
-	0xcf, 0xbd, 0xf2, 0xd1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x93, 0x44, 0xcc, 0xa7, 0x1d,
-	0x00, 0x00,
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x19, 0xcb, 0x6e, 0x1b, 0x55,
+	0xb4, 0x7e, 0xc4, 0x4e, 0x4e, 0x9a, 0x38, 0x99, 0xa8, 0xa9, 0x93, 0xd2, 0xb8, 0x4c, 0xab, 0xaa,
+	0x42, 0xd4, 0x56, 0x28, 0x12, 0x52, 0xd8, 0xb4, 0x8e, 0x13, 0x25, 0xd0, 0xa0, 0x76, 0xd2, 0x66,
+	0xc1, 0x43, 0xd6, 0x78, 0xe6, 0xd6, 0x1e, 0x62, 0xcf, 0x98, 0x99, 0xeb, 0x36, 0x41, 0x6c, 0x91,
+	0x10, 0x12, 0xa2, 0x1b, 0xa4, 0x2e, 0x2b, 0x7e, 0x80, 0x3f, 0x40, 0x65, 0x45, 0xd9, 0x55, 0x2c,
+	0x78, 0x2d, 0x02, 0x82, 0x0d, 0x62, 0x85, 0xd8, 0x20, 0xb1, 0xe2, 0xdc, 0xc7, 0x3c, 0x32, 0xb6,
+	0x53, 0x27, 0xa5, 0xa5, 0x52, 0xb3, 0x98, 0x64, 0xee, 0x99, 0xf3, 0xba, 0xe7, 0x7d, 0xaf, 0xe1,
+	0xc4, 0x56, 0xc9, 0xa3, 0xfa, 0xa6, 0x65, 0xd7, 0x4b, 0x74, 0xbb, 0x4d, 0x3c, 0xf1, 0xb7, 0xd8,
+	0x76, 0x1d, 0xea, 0x28, 0xc7, 0x0d, 0xc7, 0x6b, 0x39, 0x5e, 0xd5, 0x33, 0x37, 0x8b, 0x5b, 0x45,
+	0x89, 0x57, 0xbc, 0x39, 0x3f, 0x7b, 0x96, 0x36, 0x2c, 0xd7, 0xac, 0xb6, 0x75, 0x97, 0x6e, 0x97,
+	0x38, 0x6e, 0xa9, 0xee, 0xd4, 0x9d, 0xf0, 0x4d, 0x30, 0x98, 0xbd, 0xd0, 0x8d, 0x47, 0x89, 0x6d,
+	0x12, 0xb7, 0x65, 0xd9, 0xb4, 0xa4, 0xd7, 0x0c, 0xab, 0x5b, 0xea, 0x6c, 0xa1, 0xee, 0x38, 0xf5,
+	0x26, 0x11, 0xf8, 0xb5, 0xce, 0x8d, 0x12, 0xb5, 0x5a, 0x04, 0x85, 0xb7, 0xda, 0x12, 0x61, 0x2e,
+	0x8e, 0x60, 0x76, 0x5c, 0x9d, 0x5a, 0x8e, 0x2d, 0xbf, 0x4f, 0x76, 0xf1, 0x54, 0xff, 0x4e, 0x83,
+	0xb2, 0xe6, 0xd5, 0x17, 0x5d, 0xa2, 0x53, 0xb2, 0xa1, 0x37, 0x2d, 0x53, 0xa7, 0x8e, 0xab, 0x5c,
+	0x86, 0x51, 0x93, 0x78, 0x86, 0x6b, 0xb5, 0x19, 0x79, 0x3e, 0x71, 0x2a, 0x71, 0x6e, 0xf4, 0xa5,
+	0x33, 0xc5, 0x3e, 0xdb, 0x2e, 0x56, 0x42, 0xdc, 0x72, 0xfa, 0xfe, 0x4e, 0xe1, 0x88, 0x16, 0x25,
+	0x57, 0xde, 0x00, 0x30, 0x9c, 0x56, 0xcb, 0xf2, 0x3c, 0xc6, 0x2c, 0xc9, 0x99, 0x9d, 0xeb, 0xcb,
+	0x6c, 0x31, 0x40, 0xd5, 0x50, 0x27, 0x4f, 0x32, 0x8c, 0x70, 0x50, 0x3e, 0x80, 0x29, 0xb4, 0x53,
+	0xd5, 0x23, 0xcd, 0x1b, 0x55, 0x93, 0x34, 0x49, 0x9d, 0x6f, 0x32, 0x9f, 0x42, 0xc6, 0x23, 0xe5,
+	0xcb, 0x0c, 0xfd, 0xa7, 0x9d, 0xc2, 0xd9, 0xba, 0x45, 0x1b, 0x9d, 0x1a, 0x8a, 0x69, 0x95, 0x84,
+	0x28, 0xf9, 0xef, 0x3c, 0x4a, 0x94, 0x36, 0x58, 0xb5, 0xe9, 0x5f, 0x3b, 0x85, 0xd9, 0x6d, 0xbd,
+	0xd5, 0x5c, 0x50, 0x7b, 0xb0, 0x54, 0xb5, 0x49, 0x84, 0xae, 0x23, 0xb0, 0x12, 0xc0, 0x94, 0xf7,
+	0x61, 0x52, 0x62, 0x38, 0x6e, 0x55, 0x37, 0x4d, 0x97, 0x78, 0x5e, 0x3e, 0x8d, 0xb2, 0x8f, 0x96,
+	0xd7, 0x90, 0x5b, 0x5e, 0x70, 0xeb, 0x42, 0x51, 0xff, 0xd9, 0x29, 0x9c, 0x1f, 0x40, 0xa7, 0x4b,
+	0x86, 0x71, 0x49, 0x50, 0x68, 0x13, 0x01, 0x13, 0x09, 0x61, 0xb2, 0x6f, 0xfa, 0x4e, 0x0a, 0x64,
+	0x0f, 0xc5, 0x65, 0x77, 0xa1, 0x0c, 0x2a, 0x1b, 0x03, 0x20, 0x90, 0x1d, 0x30, 0xf1, 0x65, 0x4f,
+	0x43, 0xa6, 0xdd, 0xa9, 0x6d, 0x92, 0xed, 0x7c, 0x86, 0x19, 0x5a, 0x93, 0x2b, 0xa5, 0x04, 0x43,
+	0x88, 0xdb, 0x21, 0xf9, 0x2c, 0x77, 0xec, 0x54, 0xd4, 0xb1, 0xdc, 0x9d, 0x96, 0x1f, 0x14, 0x02,
+	0x6f, 0x21, 0xfd, 0xfb, 0xdd, 0x42, 0x42, 0xfd, 0x2a, 0x05, 0x13, 0x18, 0x79, 0x4b, 0xa6, 0x45,
+	0x1f, 0x57, 0xdc, 0xb5, 0x7b, 0x59, 0x2b, 0xc9, 0xad, 0xb5, 0x88, 0xd6, 0x1a, 0x17, 0xd6, 0xfa,
+	0x2f, 0x6d, 0xd4, 0x82, 0x5c, 0x18, 0xa7, 0x55, 0x4c, 0x3e, 0x22, 0xa3, 0xb2, 0x32, 0x60, 0x44,
+	0x56, 0x88, 0x81, 0x9a, 0x4d, 0x0b, 0xcd, 0x62, 0xac, 0x54, 0x6d, 0xdc, 0xd8, 0x95, 0x1b, 0xca,
+	0x56, 0xef, 0x44, 0x48, 0x73, 0x91, 0x2b, 0x8f, 0x31, 0x09, 0xa4, 0x0f, 0xbf, 0x4c, 0xc2, 0x28,
+	0xfa, 0x50, 0xc2, 0x49, 0xef, 0xd4, 0x48, 0xfc, 0x8f, 0xa9, 0x91, 0x7c, 0x32, 0xa9, 0x31, 0x0f,
+	0x19, 0xbd, 0xe5, 0x74, 0x6c, 0xca, 0xbd, 0xbd, 0x67, 0x0e, 0x48, 0x44, 0x69, 0xc0, 0x1f, 0x53,
+	0xbc, 0xfc, 0x96, 0x49, 0xdd, 0xb2, 0x35, 0x62, 0x3e, 0x0d, 0x76, 0xfc, 0x30, 0x01, 0xc7, 0x42,
+	0x2b, 0x79, 0xae, 0x11, 0x33, 0xe6, 0x55, 0x54, 0xe0, 0xb9, 0xb8, 0x31, 0x23, 0x68, 0x07, 0x30,
+	0xe8, 0x54, 0xc0, 0x68, 0xdd, 0x35, 0x7a, 0xeb, 0x61, 0x7a, 0x34, 0xd0, 0x23, 0xd5, 0x5f, 0x8f,
+	0x08, 0xda, 0x23, 0xe9, 0x51, 0xf1, 0x68, 0xb7, 0x6f, 0xd3, 0xfb, 0xf3, 0xed, 0xbd, 0x24, 0x8c,
+	0xa1, 0x6f, 0xaf, 0xdb, 0xe6, 0x61, 0x7a, 0x1c, 0x30, 0x3d, 0x3e, 0x4b, 0xc0, 0xf8, 0x8a, 0xe5,
+	0x21, 0x2b, 0xcb, 0xd0, 0x9b, 0xab, 0xf6, 0x0d, 0x47, 0x79, 0x15, 0x32, 0x0d, 0xa2, 0xe3, 0xa4,
+	0x24, 0x9b, 0xc3, 0xc9, 0x62, 0x38, 0x38, 0x15, 0xd9, 0xe0, 0x54, 0x14, 0x0a, 0xad, 0x70, 0x24,
+	0x9f, 0xab, 0x20, 0x51, 0x2e, 0x42, 0x06, 0x95, 0xf3, 0x08, 0xc5, 0x9d, 0xa7, 0x90, 0x58, 0xed,
+	0xdb, 0x59, 0x82, 0x96, 0xe4, 0x73, 0x10, 0x74, 0x52, 0xaf, 0x2f, 0x92, 0x90, 0x8b, 0x8d, 0x29,
+	0x4a, 0x19, 0xd2, 0xbc, 0xde, 0x27, 0x78, 0xf1, 0x2d, 0xee, 0x63, 0x0a, 0xc1, 0x9a, 0xaf, 0x71,
+	0x5a, 0xe5, 0x6d, 0x18, 0x6e, 0xe9, 0x5b, 0xa2, 0x6f, 0x24, 0x39, 0x9f, 0x4b, 0xfb, 0xe3, 0x83,
+	0x9e, 0xcc, 0xc9, 0x42, 0x2e, 0xf9, 0xa8, 0x5a, 0x16, 0x5f, 0x79, 0xb7, 0x68, 0x43, 0x8e, 0x41,
+	0x8d, 0x86, 0x6e, 0xd7, 0x49, 0xb4, 0x39, 0xad, 0xec, 0x5b, 0xc8, 0x74, 0x28, 0x24, 0xc2, 0x4e,
+	0xd5, 0xc6, 0x10, 0xb2, 0xc8, 0x01, 0x4c, 0xe2, 0xc2, 0xf0, 0x9d, 0xbb, 0x85, 0x23, 0xdc, 0x62,
+	0xdf, 0x26, 0x00, 0x42, 0x8b, 0x29, 0xef, 0xc0, 0x44, 0xac, 0xb9, 0x79, 0xd2, 0x9f, 0x83, 0xcf,
+	0x85, 0xc3, 0x4c, 0xeb, 0x07, 0x3b, 0x85, 0x84, 0x96, 0x33, 0x62, 0xbe, 0x78, 0x0b, 0x46, 0x3b,
+	0x6d, 0x74, 0x1f, 0xa9, 0xb2, 0x11, 0x59, 0x4e, 0x9c, 0xb3, 0x45, 0x31, 0x1e, 0x17, 0xfd, 0xf1,
+	0xb8, 0x78, 0xcd, 0x9f, 0x9f, 0xcb, 0x73, 0x8c, 0x17, 0xee, 0x4b, 0x11, 0xfb, 0x8a, 0x10, 0xab,
+	0xb7, 0x7f, 0x46, 0x09, 0x20, 0x20, 0x8c, 0x20, 0xb2, 0xa9, 0x6f, 0x12, 0x30, 0x1a, 0x19, 0x41,
+	0x94, 0x3c, 0x64, 0x5b, 0x8e, 0x6d, 0x6d, 0xca, 0xe0, 0x1c, 0xd1, 0xfc, 0xa5, 0x32, 0x0b, 0xc3,
+	0x96, 0x49, 0x6c, 0x6a, 0xd1, 0x6d, 0xe1, 0x58, 0x2d, 0x58, 0x33, 0xaa, 0x5b, 0xa4, 0xe6, 0x59,
+	0xbe, 0x3b, 0x34, 0x7f, 0xa9, 0x2c, 0xc3, 0x84, 0x47, 0x8c, 0x8e, 0x8b, 0x58, 0x55, 0xc3, 0xb1,
+	0xa9, 0x6e, 0x50, 0xd9, 0xdb, 0x4f, 0xa0, 0xae, 0xc7, 0x85, 0xae, 0x71, 0x0c, 0x55, 0xcb, 0xf9,
+	0xa0, 0x45, 0x01, 0x61, 0x12, 0x4c, 0x42, 0x75, 0xab, 0x29, 0x66, 0x45, 0x94, 0x20, 0x97, 0x91,
+	0xbd, 0xdc, 0xcb, 0xc2, 0x48, 0x38, 0x87, 0xdd, 0x82, 0x09, 0xa7, 0x4d, 0xdc, 0x1e, 0x85, 0xea,
+	0x72, 0x28, 0x39, 0x8e, 0x71, 0x80, 0x5a, 0x91, 0xf3, 0x79, 0xf8, 0xa5, 0x62, 0x99, 0x05, 0x86,
+	0xed, 0x11, 0xdb, 0xeb, 0x78, 0x55, 0x39, 0x6e, 0x26, 0xe3, 0x5b, 0x8e, 0x63, 0xa8, 0x2c, 0x02,
+	0x24, 0xe8, 0x8a, 0x18, 0x4a, 0x71, 0x58, 0x7d, 0x17, 0x77, 0x48, 0x4c, 0x6e, 0xd3, 0x61, 0x4d,
+	0xae, 0x94, 0x55, 0xc8, 0xa0, 0xc7, 0x69, 0x47, 0x4c, 0xec, 0x43, 0xe5, 0xf9, 0x01, 0x75, 0x2e,
+	0x3b, 0xb6, 0xb9, 0xce, 0x09, 0x35, 0xc9, 0x00, 0x55, 0xcd, 0x50, 0x67, 0x13, 0x85, 0x0a, 0xa3,
+	0xee, 0x2b, 0xe5, 0x71, 0xe6, 0xd2, 0x24, 0xb5, 0x42, 0x21, 0xac, 0xd6, 0x55, 0xaf, 0xa1, 0xa3,
+	0x1d, 0xc4, 0x84, 0x5d, 0x5e, 0xdd, 0x77, 0x5e, 0x1e, 0x8f, 0xb7, 0x10, 0xc1, 0x0f, 0x0d, 0x14,
+	0x80, 0xd6, 0x39, 0x24, 0x3e, 0x69, 0x67, 0x1f, 0x6d, 0xd2, 0x46, 0xb7, 0x75, 0xec, 0x1a, 0xda,
+	0x08, 0xd1, 0xab, 0x0d, 0x62, 0xd5, 0x1b, 0x34, 0x3f, 0x8c, 0x2c, 0x53, 0x51, 0xb7, 0xc5, 0x31,
+	0x50, 0xab, 0x00, 0xb4, 0xc2, 0x21, 0x8a, 0x09, 0xe3, 0x21, 0x16, 0xcf, 0xdd, 0x91, 0x87, 0xe6,
+	0xee, 0xf3, 0x32, 0x77, 0x8f, 0xc5, 0xa5, 0x84, 0xe9, 0x3b, 0x16, 0x00, 0x19, 0x19, 0x06, 0x41,
+	0xf4, 0x3c, 0x0a, 0x5c, 0xc2, 0xe9, 0x01, 0xea, 0xce, 0xe0, 0x47, 0xd1, 0xd1, 0x27, 0x72, 0x14,
+	0x5d, 0x38, 0xfa, 0x11, 0xa6, 0x6f, 0x90, 0xc2, 0x1f, 0x27, 0x21, 0x53, 0xd9, 0xb8, 0xa2, 0x5b,
+	0xee, 0xb3, 0x3a, 0x69, 0x44, 0xea, 0xd9, 0x32, 0x64, 0x85, 0x2d, 0x3c, 0x1c, 0x19, 0x86, 0xda,
+	0xec, 0x05, 0x0d, 0xc0, 0x9a, 0x7e, 0xa1, 0x7f, 0x90, 0x73, 0x02, 0xff, 0xb0, 0xca, 0x69, 0xd4,
+	0xcf, 0x53, 0x00, 0x95, 0x8d, 0x8d, 0x6b, 0x18, 0xe9, 0x4d, 0x42, 0x0f, 0x27, 0xf3, 0xa7, 0x67,
+	0x32, 0x8f, 0x38, 0xfb, 0x1a, 0xf6, 0xe1, 0xc0, 0x47, 0x9e, 0xb2, 0x04, 0xc3, 0x54, 0xbe, 0x4b,
+	0x9f, 0x9f, 0xde, 0xc3, 0xe7, 0x3e, 0x9d, 0xf4, 0x7b, 0x40, 0xaa, 0x7e, 0x97, 0x44, 0xd7, 0x3f,
+	0xe4, 0xde, 0xe7, 0x19, 0x98, 0xde, 0xb1, 0xcf, 0xc9, 0xae, 0x94, 0x3a, 0xd0, 0x68, 0x2b, 0xa9,
+	0x23, 0xee, 0xfa, 0x23, 0x09, 0x53, 0xd7, 0xfd, 0x8a, 0x7c, 0x68, 0x61, 0xe5, 0x2a, 0x64, 0x71,
+	0x16, 0x74, 0x2d, 0x6e, 0x62, 0x16, 0xae, 0xf3, 0x7d, 0xc3, 0xb5, 0x87, 0xd9, 0x96, 0x90, 0x74,
+	0x5b, 0x06, 0xaf, 0xcf, 0x27, 0x62, 0xec, 0x4f, 0x53, 0x90, 0xef, 0x47, 0xa5, 0x2c, 0x42, 0xce,
+	0x60, 0x57, 0xbf, 0x6c, 0x0a, 0x97, 0x6d, 0x3b, 0xc1, 0xdb, 0xf6, 0x6c, 0xe4, 0x16, 0x6a, 0x37,
+	0x02, 0xbb, 0x85, 0x92, 0x10, 0xd9, 0xb4, 0xeb, 0xfc, 0xd2, 0x8b, 0xe5, 0x0c, 0xc3, 0x1a, 0x70,
+	0xe2, 0x56, 0x65, 0xd7, 0x0e, 0xaf, 0xba, 0xa2, 0x0c, 0x44, 0xdb, 0x1e, 0x0f, 0xa1, 0xbc, 0x6f,
+	0xbf, 0x07, 0x39, 0xcb, 0xb6, 0xa8, 0xa5, 0x37, 0xab, 0x35, 0xbd, 0xa9, 0xdb, 0xc6, 0x41, 0x0e,
+	0x30, 0xa2, 0xd1, 0x4a, 0xb1, 0x31, 0x76, 0xb8, 0x37, 0x09, 0x29, 0x0b, 0x80, 0xb2, 0x02, 0x59,
+	0x5f, 0x54, 0xfa, 0x40, 0x53, 0x9e, 0x4f, 0x1e, 0xf1, 0xc8, 0x27, 0x29, 0x98, 0x0c, 0x2e, 0x7b,
+	0x0e, 0x5d, 0x31, 0xa8, 0x2b, 0xd6, 0x00, 0x44, 0x25, 0x61, 0xbd, 0xe4, 0x00, 0xde, 0x60, 0xb5,
+	0x68, 0x44, 0x70, 0xc0, 0x26, 0x12, 0xf1, 0xc7, 0x9f, 0x29, 0x38, 0x1a, 0xf5, 0xc7, 0x61, 0x93,
+	0x7f, 0x8a, 0xae, 0xdf, 0x5e, 0x0b, 0x6b, 0x63, 0x9a, 0xd7, 0xc6, 0x17, 0xfa, 0xd6, 0xc6, 0xae,
+	0x9c, 0xea, 0x5f, 0x14, 0xbf, 0x4f, 0x41, 0xe6, 0x8a, 0xee, 0xea, 0x2d, 0x4f, 0x31, 0xba, 0x8e,
+	0x1c, 0xe2, 0x22, 0x62, 0xa6, 0x2b, 0x63, 0x2a, 0xf2, 0xd7, 0xb4, 0x87, 0x9c, 0x38, 0xee, 0xf4,
+	0x38, 0x71, 0x5c, 0x84, 0x71, 0x76, 0x57, 0x12, 0x6c, 0x50, 0x78, 0x73, 0xac, 0x3c, 0x13, 0x72,
+	0xd9, 0xfd, 0x5d, 0x5c, 0xa5, 0x04, 0x07, 0x72, 0x4f, 0x79, 0x05, 0x46, 0x19, 0x46, 0xd8, 0x27,
+	0x18, 0xf9, 0x74, 0x78, 0x65, 0x11, 0xf9, 0xa8, 0x6a, 0x80, 0xab, 0x25, 0xb1, 0xc0, 0x83, 0x9e,
+	0xd2, 0x08, 0xae, 0xd0, 0xaa, 0xa1, 0x2d, 0x19, 0xfd, 0x49, 0xa4, 0x9f, 0x11, 0xf4, 0xdd, 0x38,
+	0x78, 0xe2, 0x08, 0x81, 0x3e, 0xb7, 0x97, 0x01, 0xd8, 0xbe, 0xf0, 0x60, 0x62, 0x3b, 0x2d, 0x79,
+	0xf0, 0x3d, 0x86, 0x5c, 0x26, 0x05, 0x97, 0xf0, 0x9b, 0xaa, 0x8d, 0xb0, 0x45, 0x85, 0xbd, 0x2b,
+	0x55, 0x98, 0xe1, 0x57, 0x45, 0x78, 0x48, 0x97, 0x47, 0xf6, 0x2a, 0x1a, 0x95, 0x1b, 0x53, 0x9c,
+	0x75, 0xc7, 0xca, 0x67, 0x90, 0xc9, 0xa9, 0xc8, 0xad, 0x52, 0x2f, 0x54, 0x55, 0x9b, 0x66, 0xf7,
+	0x4b, 0xf8, 0x26, 0x4e, 0xf9, 0x9a, 0xff, 0x21, 0xe2, 0xd9, 0xaf, 0x13, 0x30, 0xb5, 0xe6, 0xd5,
+	0xf9, 0x27, 0x22, 0x51, 0x5f, 0x27, 0xdb, 0xbd, 0xfb, 0xbb, 0xcc, 0xe9, 0x7d, 0x87, 0xea, 0x5e,
+	0x03, 0x41, 0x8f, 0xfe, 0x8e, 0x46, 0xb3, 0xc9, 0xad, 0xdd, 0xd7, 0x19, 0x11, 0xa3, 0x85, 0xdf,
+	0xd0, 0x68, 0xb8, 0x10, 0x9b, 0x2b, 0x2f, 0xdf, 0xff, 0x75, 0x2e, 0xf1, 0x00, 0x9f, 0x5f, 0xf0,
+	0xb9, 0xfd, 0xdb, 0xdc, 0x91, 0x07, 0xf8, 0xfc, 0x80, 0xcf, 0x9b, 0x2f, 0xee, 0xa9, 0x6c, 0xec,
+	0x87, 0xeb, 0x5a, 0x86, 0x07, 0xf0, 0x85, 0x7f, 0x01, 0x87, 0x0b, 0xfb, 0x36, 0xd2, 0x1e, 0x00,
+	0x00,
 }
 
 func (this *MsgCreateValidator) Equal(that interface{}) bool {
@@ -1966,6 +2034,36 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if this.MaxConsPubkeyRotations != that1.MaxConsPubkeyRotations {
+		return false
+	}
+	return true
+}
+func (this *MsgRotateConsPubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRotateConsPubKey)
+	if !ok {
+		that2, ok := that.(MsgRotateConsPubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.NewPubkey != that1.NewPubkey {
+		return false
+	}
 	return true
 }
 func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
@@ -3021,6 +3119,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsPubkeyRotations != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxConsPubkeyRotations))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3054,6 +3157,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPubkey) > 0 {
+		i -= len(m.NewPubkey)
+		copy(dAtA[i:], m.NewPubkey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -3484,6 +3624,26 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.MaxConsPubkeyRotations != 0 {
+		n += 1 + sovTypes(uint64(m.MaxConsPubkeyRotations))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewPubkey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -6898,6 +7058,144 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
+			}
+			m.MaxConsPubkeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
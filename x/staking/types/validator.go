@@ -262,12 +262,16 @@ func (v Validator) InvalidExRate() bool {
 	return v.Tokens.IsZero() && v.DelegatorShares.IsPositive()
 }
 
-// calculate the token worth of provided shares
+// TokensFromShares returns the token worth of provided shares using banker's
+// rounding. It must only be used for display purposes; any computation that
+// moves tokens out of a validator must use TokensFromSharesTruncated so that
+// the issued amount never exceeds the validator's tokens.
 func (v Validator) TokensFromShares(shares sdk.Dec) sdk.Dec {
 	return (shares.MulInt(v.Tokens)).Quo(v.DelegatorShares)
 }
 
-// calculate the token worth of provided shares, truncated
+// TokensFromSharesTruncated returns the token worth of provided shares,
+// truncated.
 func (v Validator) TokensFromSharesTruncated(shares sdk.Dec) sdk.Dec {
 	return (shares.MulInt(v.Tokens)).QuoTruncate(v.DelegatorShares)
 }
@@ -380,7 +384,7 @@ func (v Validator) RemoveDelShares(delShares sdk.Dec) (Validator, sdk.Int) {
 	} else {
 		// leave excess tokens in the validator
 		// however fully use all the delegator shares
-		issuedTokens = v.TokensFromShares(delShares).TruncateInt()
+		issuedTokens = v.TokensFromSharesTruncated(delShares).TruncateInt()
 		v.Tokens = v.Tokens.Sub(issuedTokens)
 
 		if v.Tokens.IsNegative() {
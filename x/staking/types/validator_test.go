@@ -179,6 +179,25 @@ func TestRemoveDelShares(t *testing.T) {
 	require.True(sdk.IntEq(t, sdk.NewInt(1286), tokens))
 }
 
+func TestRemoveDelSharesTruncates(t *testing.T) {
+	validator := Validator{
+		OperatorAddress: sdk.ValAddress(pk1.Address().Bytes()),
+		ConsensusPubkey: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pk1),
+		Status:          sdk.Bonded,
+		Tokens:          sdk.NewInt(1),
+		DelegatorShares: sdk.NewDec(3),
+	}
+
+	// the rounded token worth of these shares is exactly one token, however
+	// the truncated worth is just below it
+	shares := sdk.MustNewDecFromStr("2.999999999999999999")
+	require.True(sdk.DecEq(t, sdk.OneDec(), validator.TokensFromShares(shares)))
+
+	validator, tokens := validator.RemoveDelShares(shares)
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), tokens))
+	require.True(sdk.IntEq(t, sdk.OneInt(), validator.Tokens))
+}
+
 func TestAddTokensFromDel(t *testing.T) {
 	validator := NewValidator(sdk.ValAddress(pk1.Address().Bytes()), pk1, Description{})
 
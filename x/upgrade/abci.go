@@ -3,12 +3,10 @@ package upgrade
 import (
 	"fmt"
 
-	abci "github.com/tendermint/tendermint/abci/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
+// PreBlocker will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
@@ -16,10 +14,14 @@ import (
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
 // skipUpgradeHeightArray is a set of block heights for which the upgrade must be skipped
-func BeginBlocker(k Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
+//
+// It runs before the BeginBlock of any module, so that no module logic touches the state before
+// it is migrated. As the migration may change the consensus params, their change is signaled
+// once an upgrade is applied.
+func PreBlocker(k Keeper, ctx sdk.Context) sdk.ResponsePreBlock {
 	plan, found := k.GetUpgradePlan(ctx)
 	if !found {
-		return
+		return sdk.ResponsePreBlock{}
 	}
 
 	// To make sure clear upgrade is executed at the same block
@@ -27,17 +29,17 @@ func BeginBlocker(k Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
 		if k.IsSkipHeight(ctx.BlockHeight()) {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
-			ctx.Logger().Info(skipUpgradeMsg)
+			k.Logger(ctx).Info(skipUpgradeMsg)
 
 			// Clear the upgrade plan at current height
 			k.ClearUpgradePlan(ctx)
-			return
+			return sdk.ResponsePreBlock{}
 		}
 
 		if !k.HasHandler(plan.Name) {
 			upgradeMsg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
 			// We don't have an upgrade handler for this upgrade name, meaning this software is out of date so shutdown
-			ctx.Logger().Error(upgradeMsg)
+			k.Logger(ctx).Error(upgradeMsg)
 
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
 			// store migrations.
@@ -49,17 +51,19 @@ func BeginBlocker(k Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			panic(upgradeMsg)
 		}
 		// We have an upgrade handler for this upgrade name, so apply the upgrade
-		ctx.Logger().Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
+		k.Logger(ctx).Info("applying upgrade", "name", plan.Name, "due", plan.DueAt())
 		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
 		k.ApplyUpgrade(ctx, plan)
-		return
+		return sdk.ResponsePreBlock{ConsensusParamsChanged: true}
 	}
 
 	// if we have a pending upgrade, but it is not yet time, make sure we did not
 	// set the handler already
 	if k.HasHandler(plan.Name) {
 		downgradeMsg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain", plan.Name)
-		ctx.Logger().Error(downgradeMsg)
+		k.Logger(ctx).Error(downgradeMsg)
 		panic(downgradeMsg)
 	}
+
+	return sdk.ResponsePreBlock{}
 }
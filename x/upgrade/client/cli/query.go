@@ -86,13 +86,14 @@ func GetAppliedHeightCmd(storeName string, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("no headers returned for height %d", applied)
 			}
 
-			// always output json as Header is unreable in toml ([]byte is a long list of numbers)
-			bz, err = cdc.MarshalJSONIndent(headers.BlockMetas[0], "", "  ")
+			// the header is printed from its JSON encoding, as its []byte fields
+			// are a long list of numbers in YAML
+			bz, err = cdc.MarshalJSON(headers.BlockMetas[0])
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(bz))
-			return nil
+
+			return cliCtx.PrintRaw(bz)
 		},
 	}
 }
@@ -0,0 +1,144 @@
+package rest
+
+// SwaggerSpec defines the Swagger specification of the upgrade module REST
+// routes. It refers to the common definitions (e.g. BaseReq, Coin and StdTx)
+// of the REST server specification it is merged into.
+const SwaggerSpec = `
+tags:
+  - name: Upgrade
+    description: Upgrade module APIs
+paths:
+  /upgrade/current:
+    get:
+      summary: Get the current upgrade plan
+      tags:
+        - Upgrade
+      produces:
+        - application/json
+      responses:
+        200:
+          description: The currently scheduled upgrade plan
+          schema:
+            $ref: "#/definitions/UpgradePlan"
+        404:
+          description: No upgrade plan is currently scheduled
+        500:
+          description: Internal Server Error
+  /upgrade/applied/{name}:
+    get:
+      summary: Get the height at which a named upgrade was applied
+      tags:
+        - Upgrade
+      produces:
+        - application/json
+      parameters:
+        - in: path
+          name: name
+          description: Upgrade plan name
+          required: true
+          type: string
+      responses:
+        200:
+          description: The block height at which the upgrade was applied
+          schema:
+            type: string
+            example: "100000"
+        404:
+          description: The upgrade was never applied
+        400:
+          description: Invalid request
+  /upgrade/plan:
+    post:
+      summary: Generate a software upgrade proposal transaction
+      tags:
+        - Upgrade
+      consumes:
+        - application/json
+      produces:
+        - application/json
+      parameters:
+        - in: body
+          name: post_plan_body
+          required: true
+          schema:
+            type: object
+            properties:
+              base_req:
+                $ref: "#/definitions/BaseReq"
+              title:
+                type: string
+              description:
+                type: string
+              deposit:
+                type: array
+                items:
+                  $ref: "#/definitions/Coin"
+              upgrade_name:
+                type: string
+              upgrade_height:
+                type: string
+                example: "100000"
+              upgrade_time:
+                type: string
+                example: "2020-06-01T00:00:00Z"
+              upgrade_info:
+                type: string
+      responses:
+        200:
+          description: The transaction was successfully generated
+          schema:
+            $ref: "#/definitions/StdTx"
+        400:
+          description: Invalid proposal body
+        500:
+          description: Internal Server Error
+  /upgrade/cancel:
+    post:
+      summary: Generate a cancel software upgrade proposal transaction
+      tags:
+        - Upgrade
+      consumes:
+        - application/json
+      produces:
+        - application/json
+      parameters:
+        - in: body
+          name: post_cancel_body
+          required: true
+          schema:
+            type: object
+            properties:
+              base_req:
+                $ref: "#/definitions/BaseReq"
+              title:
+                type: string
+              description:
+                type: string
+              deposit:
+                type: array
+                items:
+                  $ref: "#/definitions/Coin"
+      responses:
+        200:
+          description: The transaction was successfully generated
+          schema:
+            $ref: "#/definitions/StdTx"
+        400:
+          description: Invalid proposal body
+        500:
+          description: Internal Server Error
+definitions:
+  UpgradePlan:
+    type: object
+    properties:
+      name:
+        type: string
+      time:
+        type: string
+        example: "2020-06-01T00:00:00Z"
+      height:
+        type: string
+        example: "100000"
+      info:
+        type: string
+`
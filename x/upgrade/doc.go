@@ -1,6 +1,6 @@
 /*
 Package upgrade provides a Cosmos SDK module that can be used for smoothly upgrading a live Cosmos chain to a
-new software version. It accomplishes this by providing a BeginBlocker hook that prevents the blockchain state
+new software version. It accomplishes this by providing a PreBlocker hook that prevents the blockchain state
 machine from proceeding once a pre-defined upgrade block time or height has been reached. The module does not prescribe
 anything regarding how governance decides to do an upgrade, but just the mechanism for coordinating the upgrade safely.
 Without software support for upgrades, upgrading a live chain is risky because all of the validators need to pause
@@ -23,11 +23,11 @@ a handler registered for the named upgrade).
 Once the release candidate along with an appropriate upgrade handler is frozen,
 we can have a governance vote to approve this upgrade at some future block time
 or block height (e.g. 200000). This is known as an upgrade.Plan. The v0.38.0 code will not know of this
-handler, but will continue to run until block 200000, when the plan kicks in at BeginBlock. It will check
+handler, but will continue to run until block 200000, when the plan kicks in at PreBlock. It will check
 for existence of the handler, and finding it missing, know that it is running the obsolete software,
 and gracefully exit.
 
-Generally the application binary will restart on exit, but then will execute this BeginBlocker
+Generally the application binary will restart on exit, but then will execute this PreBlocker
 again and exit, causing a restart loop. Either the operator can manually install the new software,
 or you can make use of an external watcher daemon to possibly download and then switch binaries,
 also potentially doing a backup. An example of such a daemon is https://github.com/regen-network/cosmosd/
@@ -42,13 +42,20 @@ be a matter of minutes and not even require them to be awake at that time.
 
 Integrating With An App
 
-Setup an upgrade Keeper for the app and then define a BeginBlocker that calls the upgrade
-keeper's BeginBlocker method:
-    func (app *myApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-    	app.upgradeKeeper.BeginBlocker(ctx, req)
-    	return abci.ResponseBeginBlock{}
+Setup an upgrade Keeper for the app and then register the upgrade module as the first pre-blocker
+of the module manager, and a PreBlocker that calls the module manager's PreBlock method, so that
+upgrades are applied before any other module logic runs:
+    app.mm.SetOrderPreBlockers(upgrade.ModuleName)
+    app.SetPreBlocker(app.PreBlocker)
+
+    func (app *myApp) PreBlocker(ctx sdk.Context, req abci.RequestBeginBlock) (sdk.ResponsePreBlock, error) {
+    	return app.mm.PreBlock(ctx, req)
     }
 
+The module manager panics if the upgrade module is left out of the pre-blockers order, and in
+BeginBlock if its PreBlock was not run, so that a misconfigured app halts instead of skipping
+the upgrades.
+
 The app must then integrate the upgrade keeper with its governance module as appropriate. The governance module
 should call ScheduleUpgrade to schedule an upgrade and ClearUpgradePlan to cancel a pending upgrade.
 
@@ -91,7 +98,7 @@ Here is a sample code to set store migrations with an upgrade:
 
 Halt Behavior
 
-Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
+Before halting the ABCI state machine in the PreBlocker method, the upgrade module will log an error
 that looks like:
 	UPGRADE "<Name>" NEEDED at height <NNNN>: <Info>
 where Name are Info are the values of the respective fields on the upgrade Plan.
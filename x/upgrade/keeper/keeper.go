@@ -59,7 +59,7 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	}
 
 	if !plan.Time.IsZero() {
-		if !plan.Time.After(ctx.BlockHeader().Time) {
+		if !plan.Time.After(ctx.BlockTime()) {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be scheduled in the past")
 		}
 	} else if plan.Height <= ctx.BlockHeight() {
@@ -30,6 +30,8 @@ var (
 	_ module.AppModule       = AppModule{}
 	_ module.AppModuleBasic  = AppModuleBasic{}
 	_ module.InterfaceModule = AppModuleBasic{}
+	_ module.SwaggerModule   = AppModuleBasic{}
+	_ module.PreBlockModule  = AppModule{}
 )
 
 // AppModuleBasic implements the sdk.AppModuleBasic interface
@@ -40,6 +42,11 @@ func (AppModuleBasic) Name() string {
 	return ModuleName
 }
 
+// StoreKeys returns the keys of the upgrade module store.
+func (AppModuleBasic) StoreKeys() []sdk.StoreKey {
+	return []sdk.StoreKey{sdk.NewKVStoreKey(StoreKey)}
+}
+
 // RegisterCodec registers the upgrade types on the amino codec
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
@@ -50,6 +57,11 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, r *mux.Router)
 	rest.RegisterRoutes(ctx, r)
 }
 
+// SwaggerSpec returns the Swagger specification of the upgrade module REST routes
+func (AppModuleBasic) SwaggerSpec() []byte {
+	return []byte(rest.SwaggerSpec)
+}
+
 // GetQueryCmd returns the cli query commands for this module
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -129,13 +141,19 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONMarshaler) json.R
 	return am.DefaultGenesis(cdc)
 }
 
-// BeginBlock calls the upgrade module hooks
+// PreBlock calls the upgrade module hooks
 //
-// CONTRACT: this is registered in BeginBlocker *before* all other modules' BeginBlock functions
-func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(am.keeper, ctx, req)
+// CONTRACT: this is registered first in the pre-blockers, which run before all modules' BeginBlock
+// functions
+func (am AppModule) PreBlock(ctx sdk.Context) (sdk.ResponsePreBlock, error) {
+	return PreBlocker(am.keeper, ctx), nil
 }
 
+// BeginBlock does nothing, as the upgrade module hooks are called by PreBlock.
+// The module manager panics in BeginBlock if PreBlock was not run, so that an
+// app which does not call it cannot silently skip the upgrades.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
 // EndBlock does nothing
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
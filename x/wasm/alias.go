@@ -0,0 +1,74 @@
+package wasm
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+const (
+	ModuleName                 = types.ModuleName
+	StoreKey                   = types.StoreKey
+	RouterKey                  = types.RouterKey
+	QuerierRoute               = types.QuerierRoute
+	QueryCode                  = types.QueryCode
+	QueryContract              = types.QueryContract
+	QueryContractSmart         = types.QueryContractSmart
+	QueryContractRaw           = types.QueryContractRaw
+	TypeMsgStoreCode           = types.TypeMsgStoreCode
+	TypeMsgInstantiateContract = types.TypeMsgInstantiateContract
+	TypeMsgExecuteContract     = types.TypeMsgExecuteContract
+	DefaultGasMultiplier       = types.DefaultGasMultiplier
+	DefaultQueryGasLimit       = types.DefaultQueryGasLimit
+)
+
+var (
+	// functions aliases
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterCodec             = types.RegisterCodec
+	RegisterInterfaces        = types.RegisterInterfaces
+	NewContractAccount        = types.NewContractAccount
+	ContractAddress           = types.ContractAddress
+	NewCodeInfo               = types.NewCodeInfo
+	NewContractInfo           = types.NewContractInfo
+	NewMsgStoreCode           = types.NewMsgStoreCode
+	NewMsgInstantiateContract = types.NewMsgInstantiateContract
+	NewMsgExecuteContract     = types.NewMsgExecuteContract
+	DefaultGenesisState       = types.DefaultGenesisState
+	NewQueryCodeParams        = types.NewQueryCodeParams
+	NewQueryContractParams    = types.NewQueryContractParams
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	NextCodeIDKey        = types.NextCodeIDKey
+	NextInstanceSeqKey   = types.NextInstanceSeqKey
+	ErrCreateFailed      = types.ErrCreateFailed
+	ErrInstantiateFailed = types.ErrInstantiateFailed
+	ErrExecuteFailed     = types.ErrExecuteFailed
+	ErrQueryFailed       = types.ErrQueryFailed
+	ErrCodeNotFound      = types.ErrCodeNotFound
+	ErrContractNotFound  = types.ErrContractNotFound
+	ErrInvalid           = types.ErrInvalid
+	ErrNoVM              = types.ErrNoVM
+)
+
+type (
+	Keeper                 = keeper.Keeper
+	VM                     = types.VM
+	NoVM                   = types.NoVM
+	Querier                = types.Querier
+	Env                    = types.Env
+	Response               = types.Response
+	ContractAccount        = types.ContractAccount
+	CodeInfo               = types.CodeInfo
+	ContractInfo           = types.ContractInfo
+	Model                  = types.Model
+	Code                   = types.Code
+	Contract               = types.Contract
+	MsgStoreCode           = types.MsgStoreCode
+	MsgInstantiateContract = types.MsgInstantiateContract
+	MsgExecuteContract     = types.MsgExecuteContract
+	GenesisState           = types.GenesisState
+	QueryCodeParams        = types.QueryCodeParams
+	QueryContractParams    = types.QueryContractParams
+)
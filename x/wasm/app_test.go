@@ -0,0 +1,118 @@
+package wasm_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/wasm"
+)
+
+var (
+	priv1 = secp256k1.GenPrivKey()
+	addr1 = sdk.AccAddress(priv1.PubKey().Address())
+)
+
+// appVM stores the codes it is given, and the messages of the contracts under
+// the "msg" key of their state.
+type appVM struct {
+	codes map[string][]byte
+}
+
+func (vm appVM) Create(code []byte) ([]byte, error) {
+	checksum := sha256.Sum256(code)
+	vm.codes[string(checksum[:])] = code
+	return checksum[:], nil
+}
+
+func (vm appVM) GetCode(checksum []byte) ([]byte, error) {
+	code, ok := vm.codes[string(checksum)]
+	if !ok {
+		return nil, errors.New("code not found")
+	}
+
+	return code, nil
+}
+
+func (vm appVM) Instantiate(
+	_ []byte, _ wasm.Env, initMsg []byte, store sdk.KVStore, _ wasm.Querier, _ uint64,
+) (wasm.Response, uint64, error) {
+	store.Set([]byte("msg"), initMsg)
+	return wasm.Response{}, 1000, nil
+}
+
+func (vm appVM) Execute(
+	_ []byte, _ wasm.Env, msg []byte, store sdk.KVStore, _ wasm.Querier, _ uint64,
+) (wasm.Response, uint64, error) {
+	store.Set([]byte("msg"), msg)
+	return wasm.Response{Data: msg}, 1000, nil
+}
+
+func (vm appVM) Query(
+	_ []byte, _ wasm.Env, msg []byte, store sdk.KVStore, _ wasm.Querier, _ uint64,
+) ([]byte, uint64, error) {
+	return store.Get(msg), 1000, nil
+}
+
+func setupApp(t *testing.T, vm wasm.VM) *simapp.SimApp {
+	defaultVM := simapp.WasmVM
+	simapp.WasmVM = vm
+	t.Cleanup(func() { simapp.WasmVM = defaultVM })
+
+	genAccs := []authtypes.GenesisAccount{&auth.BaseAccount{Address: addr1}}
+	return simapp.SetupWithGenesisAccounts(genAccs)
+}
+
+func TestWasmContractLifecycle(t *testing.T) {
+	app := setupApp(t, appVM{codes: make(map[string][]byte)})
+
+	storeMsg := wasm.NewMsgStoreCode(addr1, []byte("code"))
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, res, err := simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp, header, []sdk.Msg{storeMsg}, []uint64{0}, []uint64{0}, true, true, priv1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Uint64ToBigEndian(1), res.Data)
+
+	initMsg := wasm.NewMsgInstantiateContract(addr1, nil, 1, "test", []byte(`{"init":{}}`), nil)
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	_, res, err = simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp, header, []sdk.Msg{initMsg}, []uint64{0}, []uint64{1}, true, true, priv1,
+	)
+	require.NoError(t, err)
+	contract := sdk.AccAddress(res.Data)
+
+	executeMsg := wasm.NewMsgExecuteContract(addr1, contract, []byte(`{"execute":{}}`), nil)
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	_, res, err = simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp, header, []sdk.Msg{executeMsg}, []uint64{0}, []uint64{2}, true, true, priv1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"execute":{}}`), res.Data)
+
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	require.True(t, app.AccountKeeper.HasAccount(ctx, contract))
+
+	out, err := app.WasmKeeper.QuerySmart(ctx, contract, []byte("msg"))
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"execute":{}}`), out)
+}
+
+func TestWasmNoVM(t *testing.T) {
+	app := setupApp(t, wasm.NoVM{})
+
+	storeMsg := wasm.NewMsgStoreCode(addr1, []byte("code"))
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err := simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp, header, []sdk.Msg{storeMsg}, []uint64{0}, []uint64{0}, false, false, priv1,
+	)
+	require.Error(t, err)
+}
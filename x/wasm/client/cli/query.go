@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+// GetQueryCmd returns the cli query commands for the wasm module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	wasmQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the wasm module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	wasmQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryCode(cdc),
+			GetCmdQueryContract(cdc),
+			GetCmdQueryContractSmart(cdc),
+			GetCmdQueryContractRaw(cdc),
+		)...,
+	)
+
+	return wasmQueryCmd
+}
+
+// GetCmdQueryCode implements a command to return the info of a contract code.
+func GetCmdQueryCode(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "code [code-id]",
+		Short: "Query the info of a contract code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code-id %s not a valid uint, please input a valid code-id", args[0])
+			}
+
+			var info types.CodeInfo
+			if err := query(cliCtx, types.QueryCode, types.NewQueryCodeParams(codeID), &info); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+// GetCmdQueryContract implements a command to return the info of a contract.
+func GetCmdQueryContract(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract [contract-address]",
+		Short: "Query the info of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var info types.ContractInfo
+			if err := query(cliCtx, types.QueryContract, types.NewQueryContractParams(contract, nil), &info); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+// GetCmdQueryContractSmart implements a command to run a query of a contract,
+// printing its raw response.
+func GetCmdQueryContractSmart(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-smart [contract-address] [query-msg]",
+		Short: "Run a query of a contract with the given JSON query message",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := queryRaw(cliCtx, types.QueryContractSmart, types.NewQueryContractParams(contract, []byte(args[1])))
+			if err != nil {
+				return err
+			}
+
+			return printContractOutput(cliCtx, out)
+		},
+	}
+}
+
+// GetCmdQueryContractRaw implements a command to return the value of a key of
+// the state of a contract.
+func GetCmdQueryContractRaw(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-raw [contract-address] [key]",
+		Short: "Query the value of a key of the state of a contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := queryRaw(cliCtx, types.QueryContractRaw, types.NewQueryContractParams(contract, []byte(args[1])))
+			if err != nil {
+				return err
+			}
+
+			return printContractOutput(cliCtx, out)
+		},
+	}
+}
+
+// printContractOutput prints the raw output of a contract query, as is if it
+// is JSON encoded, as the responses of the contracts usually are, or else as a
+// string, while respecting the output flags.
+func printContractOutput(cliCtx context.CLIContext, out []byte) error {
+	if json.Valid(out) {
+		return cliCtx.PrintRaw(out)
+	}
+
+	return cliCtx.PrintOutput(string(out))
+}
+
+func query(cliCtx context.CLIContext, path string, params, res interface{}) error {
+	out, err := queryRaw(cliCtx, path, params)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.Codec.UnmarshalJSON(out, res)
+}
+
+func queryRaw(cliCtx context.CLIContext, path string, params interface{}) ([]byte, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	out, _, err := cliCtx.QueryWithData(route, bz)
+	return out, err
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+const (
+	flagAdmin = "admin"
+	flagLabel = "label"
+	flagFunds = "funds"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/wasm transaction commands.
+func NewTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Wasm transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		NewStoreCodeTxCmd(cliCtx),
+		NewInstantiateContractTxCmd(cliCtx),
+		NewExecuteContractTxCmd(cliCtx),
+	)...)
+
+	return txCmd
+}
+
+// NewStoreCodeTxCmd returns a CLI command handler for creating a MsgStoreCode
+// transaction.
+func NewStoreCodeTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "store [from_key_or_address] [wasm-file]",
+		Short: "Upload a contract code",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			code, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStoreCode(cliCtx.GetFromAddress(), code)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+}
+
+// NewInstantiateContractTxCmd returns a CLI command handler for creating a
+// MsgInstantiateContract transaction.
+func NewInstantiateContractTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate [from_key_or_address] [code-id] [init-msg]",
+		Short: "Instantiate a contract of a code with the given JSON init message",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code-id %s not a valid uint, please input a valid code-id", args[1])
+			}
+
+			var admin sdk.AccAddress
+			if adminStr, _ := cmd.Flags().GetString(flagAdmin); adminStr != "" {
+				if admin, err = sdk.AccAddressFromBech32(adminStr); err != nil {
+					return err
+				}
+			}
+
+			funds, err := fundsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			label, _ := cmd.Flags().GetString(flagLabel)
+
+			msg := types.NewMsgInstantiateContract(cliCtx.GetFromAddress(), admin, codeID, label, []byte(args[2]), funds)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagAdmin, "", "The admin address of the contract")
+	cmd.Flags().String(flagLabel, "", "A human readable label of the contract")
+	cmd.Flags().String(flagFunds, "", "The coins to send to the contract")
+	_ = cmd.MarkFlagRequired(flagLabel)
+
+	return cmd
+}
+
+// NewExecuteContractTxCmd returns a CLI command handler for creating a
+// MsgExecuteContract transaction.
+func NewExecuteContractTxCmd(cliCtx context.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [from_key_or_address] [contract-address] [msg]",
+		Short: "Execute a contract with the given JSON message",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx = cliCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			contract, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			funds, err := fundsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteContract(cliCtx.GetFromAddress(), contract, []byte(args[2]), funds)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagFunds, "", "The coins to send to the contract")
+
+	return cmd
+}
+
+// fundsFlag returns the coins of the funds flag, if any.
+func fundsFlag(cmd *cobra.Command) (sdk.Coins, error) {
+	fundsStr, _ := cmd.Flags().GetString(flagFunds)
+	if fundsStr == "" {
+		return nil, nil
+	}
+
+	return sdk.ParseCoins(fundsStr)
+}
@@ -0,0 +1,64 @@
+package wasm
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the wasm module state from a genesis state. The
+// codes are stored again in the VM, which must yield their genesis checksum.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetSequence(ctx, NextCodeIDKey, data.NextCodeID)
+	k.SetSequence(ctx, NextInstanceSeqKey, data.NextInstanceSeq)
+
+	for _, code := range data.Codes {
+		checksum, err := k.VM().Create(code.Code)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create genesis code %d: %s", code.Info.CodeID, err))
+		}
+
+		if !bytes.Equal(checksum, code.Info.Checksum) {
+			panic(fmt.Sprintf("genesis code %d checksum mismatch", code.Info.CodeID))
+		}
+
+		k.SetCodeInfo(ctx, code.Info)
+	}
+
+	for _, contract := range data.Contracts {
+		k.SetContractInfo(ctx, contract.Info)
+
+		store := k.ContractStore(ctx, contract.Info.Address)
+		for _, model := range contract.State {
+			store.Set(model.Key, model.Value)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	codes := []Code{}
+	k.IterateCodeInfos(ctx, func(info CodeInfo) bool {
+		code, err := k.VM().GetCode(info.Checksum)
+		if err != nil {
+			panic(fmt.Sprintf("failed to export code %d: %s", info.CodeID, err))
+		}
+
+		codes = append(codes, Code{Info: info, Code: code})
+		return false
+	})
+
+	contracts := []Contract{}
+	k.IterateContractInfos(ctx, func(info ContractInfo) bool {
+		contracts = append(contracts, Contract{Info: info, State: k.GetContractState(ctx, info.Address)})
+		return false
+	})
+
+	return GenesisState{
+		NextCodeID:      k.GetSequence(ctx, NextCodeIDKey),
+		NextInstanceSeq: k.GetSequence(ctx, NextInstanceSeqKey),
+		Codes:           codes,
+		Contracts:       contracts,
+	}
+}
@@ -0,0 +1,72 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+// NewHandler returns a handler for "wasm" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgStoreCode:
+			return handleMsgStoreCode(ctx, k, msg)
+
+		case types.MsgInstantiateContract:
+			return handleMsgInstantiateContract(ctx, k, msg)
+
+		case types.MsgExecuteContract:
+			return handleMsgExecuteContract(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm message type: %T", msg)
+		}
+	}
+}
+
+// handleMsgStoreCode returns the big endian encoded code ID as data.
+func handleMsgStoreCode(ctx sdk.Context, k keeper.Keeper, msg types.MsgStoreCode) (*sdk.Result, error) {
+	codeID, err := k.StoreCode(ctx, msg.Sender, msg.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+	return &sdk.Result{Data: sdk.Uint64ToBigEndian(codeID), Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgInstantiateContract returns the contract address as data.
+func handleMsgInstantiateContract(ctx sdk.Context, k keeper.Keeper, msg types.MsgInstantiateContract) (*sdk.Result, error) {
+	contract, _, err := k.Instantiate(ctx, msg.CodeID, msg.Sender, msg.Admin, msg.Label, msg.InitMsg, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+	return &sdk.Result{Data: contract, Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgExecuteContract returns the data of the contract response.
+func handleMsgExecuteContract(ctx sdk.Context, k keeper.Keeper, msg types.MsgExecuteContract) (*sdk.Result, error) {
+	data, err := k.Execute(ctx, msg.Contract, msg.Sender, msg.Msg, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+	return &sdk.Result{Data: data, Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+// StoreCode stores a contract code in the VM, and returns its code ID.
+func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, code []byte) (uint64, error) {
+	checksum, err := k.vm.Create(code)
+	if err != nil {
+		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+
+	codeID := k.nextSequence(ctx, types.NextCodeIDKey)
+	k.SetCodeInfo(ctx, types.NewCodeInfo(codeID, creator, checksum))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	return codeID, nil
+}
+
+// Instantiate creates a contract instance of a code, sends it the funds of the
+// sender and runs its instantiation entry point. It returns the address of the
+// contract and the data of its response.
+func (k Keeper) Instantiate(
+	ctx sdk.Context, codeID uint64, sender, admin sdk.AccAddress, label string, initMsg []byte, funds sdk.Coins,
+) (sdk.AccAddress, []byte, error) {
+	codeInfo, found := k.GetCodeInfo(ctx, codeID)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(types.ErrCodeNotFound, "%d", codeID)
+	}
+
+	contract := k.newContractAddress(ctx)
+	acc := types.NewContractAccount(authtypes.NewBaseAccountWithAddress(contract), codeID, admin)
+	k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccount(ctx, acc))
+
+	if !funds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, sender, contract, funds); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	info := types.NewContractInfo(contract, codeID, sender, admin, label)
+	k.SetContractInfo(ctx, info)
+
+	env := k.env(ctx, contract, sender, funds)
+	res, gasUsed, err := k.vm.Instantiate(
+		codeInfo.Checksum, env, initMsg, k.ContractStore(ctx, contract), k.newQuerier(ctx), k.vmGasLimit(ctx),
+	)
+	k.consumeVMGas(ctx, gasUsed)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantiate,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()),
+		),
+	)
+
+	if err := k.handleResponse(ctx, contract, res); err != nil {
+		return nil, nil, err
+	}
+
+	return contract, res.Data, nil
+}
+
+// newContractAddress returns the address of a new contract instance, derived
+// from the next value of the instance sequence. The addresses holding an
+// account are skipped, so that sending tokens to the next address cannot
+// prevent the instantiation of contracts.
+func (k Keeper) newContractAddress(ctx sdk.Context) sdk.AccAddress {
+	for {
+		contract := types.ContractAddress(k.nextSequence(ctx, types.NextInstanceSeqKey))
+		if !k.accountKeeper.HasAccount(ctx, contract) {
+			return contract
+		}
+	}
+}
+
+// Execute sends the funds of the sender to a contract and runs its execution
+// entry point. It returns the data of the contract response.
+func (k Keeper) Execute(
+	ctx sdk.Context, contract, sender sdk.AccAddress, msg []byte, funds sdk.Coins,
+) ([]byte, error) {
+	codeInfo, err := k.getContractCode(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if !funds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, sender, contract, funds); err != nil {
+			return nil, err
+		}
+	}
+
+	env := k.env(ctx, contract, sender, funds)
+	res, gasUsed, err := k.vm.Execute(
+		codeInfo.Checksum, env, msg, k.ContractStore(ctx, contract), k.newQuerier(ctx), k.vmGasLimit(ctx),
+	)
+	k.consumeVMGas(ctx, gasUsed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecute,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()),
+		),
+	)
+
+	if err := k.handleResponse(ctx, contract, res); err != nil {
+		return nil, err
+	}
+
+	return res.Data, nil
+}
+
+// QuerySmart runs the query entry point of a contract. The writes of the
+// contract to its state, if any, are discarded.
+func (k Keeper) QuerySmart(ctx sdk.Context, contract sdk.AccAddress, req []byte) ([]byte, error) {
+	codeInfo, err := k.getContractCode(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, _ = ctx.CacheContext()
+
+	res, gasUsed, err := k.vm.Query(
+		codeInfo.Checksum, k.env(ctx, contract, nil, nil), req, k.ContractStore(ctx, contract),
+		k.newQuerier(ctx), k.vmGasLimit(ctx),
+	)
+	k.consumeVMGas(ctx, gasUsed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrQueryFailed, err.Error())
+	}
+
+	return res, nil
+}
+
+// QueryRaw returns the value of a key of the state of a contract.
+func (k Keeper) QueryRaw(ctx sdk.Context, contract sdk.AccAddress, key []byte) []byte {
+	return k.ContractStore(ctx, contract).Get(key)
+}
+
+func (k Keeper) getContractCode(ctx sdk.Context, contract sdk.AccAddress) (types.CodeInfo, error) {
+	info, found := k.GetContractInfo(ctx, contract)
+	if !found {
+		return types.CodeInfo{}, sdkerrors.Wrapf(types.ErrContractNotFound, "%s", contract)
+	}
+
+	codeInfo, found := k.GetCodeInfo(ctx, info.CodeID)
+	if !found {
+		return codeInfo, sdkerrors.Wrapf(types.ErrCodeNotFound, "%d", info.CodeID)
+	}
+
+	return codeInfo, nil
+}
+
+func (k Keeper) env(ctx sdk.Context, contract, sender sdk.AccAddress, funds sdk.Coins) types.Env {
+	return types.Env{
+		ChainID:     ctx.ChainID(),
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+		Contract:    contract,
+		Sender:      sender,
+		Funds:       funds,
+	}
+}
+
+// handleResponse emits the attributes of a contract response and dispatches
+// its messages on behalf of the contract.
+func (k Keeper) handleResponse(ctx sdk.Context, contract sdk.AccAddress, res types.Response) error {
+	if len(res.Attributes) > 0 {
+		attrs := append(
+			[]sdk.Attribute{sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String())},
+			res.Attributes...,
+		)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeWasm, attrs...))
+	}
+
+	for i, msg := range res.Messages {
+		if _, err := k.msgRouter.DispatchMsg(ctx, contract, msg); err != nil {
+			return sdkerrors.Wrapf(err, "contract message index: %d", i)
+		}
+	}
+
+	return nil
+}
+
+// querier implements the types.Querier of the contracts, running their
+// queries through the application query router.
+type querier struct {
+	ctx         sdk.Context
+	queryRouter sdk.QueryRouter
+}
+
+func (k Keeper) newQuerier(ctx sdk.Context) types.Querier {
+	return querier{ctx: ctx, queryRouter: k.queryRouter}
+}
+
+// Query implements types.Querier. The queries are run on a cached context, so
+// that their writes, if any, are discarded.
+func (q querier) Query(path string, data []byte) ([]byte, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 3 || parts[0] != "custom" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unsupported contract query path: %s", path)
+	}
+
+	route := q.queryRouter.Route(parts[1])
+	if route == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", parts[1])
+	}
+
+	ctx, _ := q.ctx.CacheContext()
+	return route(ctx, parts[2:], abci.RequestQuery{Data: data, Path: path, Height: ctx.BlockHeight()})
+}
+
+// GasConsumed implements types.Querier.
+func (q querier) GasConsumed() uint64 {
+	return q.ctx.GasMeter().GasConsumed()
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+// Keeper of the wasm store
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	vm            types.VM
+
+	// the messages returned by the contracts are dispatched with msgRouter,
+	// and their queries are run with the application query router.
+	msgRouter   sdk.MsgRouterService
+	queryRouter sdk.QueryRouter
+
+	gasMultiplier uint64
+	queryGasLimit uint64
+}
+
+// NewKeeper creates a new wasm Keeper instance, running the contracts with the
+// given VM. The codec must have the messages of all the modules registered,
+// since they may be returned by the contracts.
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, vm types.VM,
+	msgRouter sdk.MsgRouterService, queryRouter sdk.QueryRouter,
+) Keeper {
+	if vm == nil {
+		panic("wasm keeper requires a vm")
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		vm:            vm,
+		msgRouter:     msgRouter,
+		queryRouter:   queryRouter,
+		gasMultiplier: types.DefaultGasMultiplier,
+		queryGasLimit: types.DefaultQueryGasLimit,
+	}
+}
+
+// WithGasMultiplier returns a copy of the keeper converting SDK gas to VM gas
+// with the given multiplier.
+func (k Keeper) WithGasMultiplier(gasMultiplier uint64) Keeper {
+	if gasMultiplier == 0 {
+		panic("wasm gas multiplier cannot be zero")
+	}
+
+	k.gasMultiplier = gasMultiplier
+	return k
+}
+
+// WithQueryGasLimit returns a copy of the keeper limiting the VM calls run
+// with an infinite gas meter, e.g. the queries, to the given SDK gas.
+func (k Keeper) WithQueryGasLimit(queryGasLimit uint64) Keeper {
+	if queryGasLimit == 0 {
+		panic("wasm query gas limit cannot be zero")
+	}
+
+	k.queryGasLimit = queryGasLimit
+	return k
+}
+
+// VM returns the VM running the contracts.
+func (k Keeper) VM() types.VM {
+	return k.vm
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetSequence returns the next value of a sequence, which starts at 1.
+func (k Keeper) GetSequence(ctx sdk.Context, key []byte) uint64 {
+	return sdk.GetSequence(ctx.KVStore(k.storeKey), key)
+}
+
+// SetSequence sets the next value of a sequence.
+func (k Keeper) SetSequence(ctx sdk.Context, key []byte, seq uint64) {
+	sdk.SetSequence(ctx.KVStore(k.storeKey), key, seq)
+}
+
+// nextSequence returns the next value of a sequence and increments it.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	return sdk.NextSequence(ctx.KVStore(k.storeKey), key)
+}
+
+// GetCodeInfo returns the info of a code.
+func (k Keeper) GetCodeInfo(ctx sdk.Context, codeID uint64) (info types.CodeInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CodeKey(codeID))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// SetCodeInfo stores the info of a code.
+func (k Keeper) SetCodeInfo(ctx sdk.Context, info types.CodeInfo) {
+	ctx.KVStore(k.storeKey).Set(types.CodeKey(info.CodeID), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// IterateCodeInfos iterates over the infos of all the codes, by code ID, and
+// calls cb until it returns true.
+func (k Keeper) IterateCodeInfos(ctx sdk.Context, cb func(info types.CodeInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CodeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.CodeInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// GetContractInfo returns the info of a contract.
+func (k Keeper) GetContractInfo(ctx sdk.Context, contract sdk.AccAddress) (info types.ContractInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractKey(contract))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// SetContractInfo stores the info of a contract.
+func (k Keeper) SetContractInfo(ctx sdk.Context, info types.ContractInfo) {
+	ctx.KVStore(k.storeKey).Set(types.ContractKey(info.Address), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// IterateContractInfos iterates over the infos of all the contracts, by
+// address, and calls cb until it returns true.
+func (k Keeper) IterateContractInfos(ctx sdk.Context, cb func(info types.ContractInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ContractKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ContractInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// ContractStore returns the store of the state of a contract.
+func (k Keeper) ContractStore(ctx sdk.Context, contract sdk.AccAddress) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractStorePrefix(contract))
+}
+
+// GetContractState returns the state of a contract, ordered by key.
+func (k Keeper) GetContractState(ctx sdk.Context, contract sdk.AccAddress) []types.Model {
+	iterator := k.ContractStore(ctx, contract).Iterator(nil, nil)
+	defer iterator.Close()
+
+	state := []types.Model{}
+	for ; iterator.Valid(); iterator.Next() {
+		state = append(state, types.Model{Key: iterator.Key(), Value: iterator.Value()})
+	}
+
+	return state
+}
+
+// vmGasLimit returns the gas limit of a VM call, in VM gas units, as the gas
+// remaining on the gas meter of the context, or the query gas limit if the gas
+// meter is infinite, so that no VM call runs without bound.
+func (k Keeper) vmGasLimit(ctx sdk.Context) uint64 {
+	meter := ctx.GasMeter()
+
+	remaining := k.queryGasLimit
+	if meter.Limit() != 0 {
+		remaining = meter.Limit() - meter.GasConsumedToLimit()
+	}
+
+	if remaining > math.MaxUint64/k.gasMultiplier {
+		return math.MaxUint64
+	}
+
+	return remaining * k.gasMultiplier
+}
+
+// consumeVMGas consumes the gas used by a VM call on the gas meter of the
+// context, rounded up to the next SDK gas unit.
+func (k Keeper) consumeVMGas(ctx sdk.Context, vmGasUsed uint64) {
+	gas := vmGasUsed / k.gasMultiplier
+	if vmGasUsed%k.gasMultiplier != 0 {
+		gas++
+	}
+
+	ctx.GasMeter().ConsumeGas(gas, "wasm vm")
+}
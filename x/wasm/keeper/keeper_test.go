@@ -0,0 +1,327 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+// mockVM stores the state of the contracts it is given, and returns the
+// messages, gas and error it is set up with.
+type mockVM struct {
+	codes    map[string][]byte
+	gasUsed  uint64
+	gasLimit uint64
+	messages []sdk.Msg
+	err      error
+}
+
+func newMockVM() *mockVM {
+	return &mockVM{codes: make(map[string][]byte)}
+}
+
+func (vm *mockVM) Create(code []byte) ([]byte, error) {
+	checksum := sha256.Sum256(code)
+	vm.codes[string(checksum[:])] = code
+	return checksum[:], nil
+}
+
+func (vm *mockVM) GetCode(checksum []byte) ([]byte, error) {
+	code, ok := vm.codes[string(checksum)]
+	if !ok {
+		return nil, errors.New("code not found")
+	}
+
+	return code, nil
+}
+
+func (vm *mockVM) Instantiate(
+	_ []byte, env types.Env, initMsg []byte, store sdk.KVStore, _ types.Querier, gasLimit uint64,
+) (types.Response, uint64, error) {
+	return vm.execute(env, initMsg, store, gasLimit)
+}
+
+func (vm *mockVM) Execute(
+	_ []byte, env types.Env, msg []byte, store sdk.KVStore, _ types.Querier, gasLimit uint64,
+) (types.Response, uint64, error) {
+	return vm.execute(env, msg, store, gasLimit)
+}
+
+func (vm *mockVM) Query(
+	_ []byte, _ types.Env, msg []byte, store sdk.KVStore, _ types.Querier, gasLimit uint64,
+) ([]byte, uint64, error) {
+	vm.gasLimit = gasLimit
+	return store.Get(msg), vm.gasUsed, vm.err
+}
+
+func (vm *mockVM) execute(env types.Env, msg []byte, store sdk.KVStore, gasLimit uint64) (types.Response, uint64, error) {
+	vm.gasLimit = gasLimit
+	if vm.err != nil {
+		return types.Response{}, vm.gasUsed, vm.err
+	}
+
+	store.Set([]byte("last"), msg)
+	return types.Response{
+		Messages:   vm.messages,
+		Attributes: []sdk.Attribute{sdk.NewAttribute("sender", env.Sender.String())},
+		Data:       msg,
+	}, vm.gasUsed, nil
+}
+
+type mockAccountKeeper struct {
+	accounts map[string]authtypes.AccountI
+}
+
+func (ak mockAccountKeeper) NewAccount(_ sdk.Context, acc authtypes.AccountI) authtypes.AccountI {
+	_ = acc.SetAccountNumber(uint64(len(ak.accounts)))
+	return acc
+}
+
+func (ak mockAccountKeeper) HasAccount(_ sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := ak.accounts[addr.String()]
+	return ok
+}
+
+func (ak mockAccountKeeper) SetAccount(_ sdk.Context, acc authtypes.AccountI) {
+	ak.accounts[acc.GetAddress().String()] = acc
+}
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk mockBankKeeper) SendCoins(_ sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[from.String()].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s", amt)
+	}
+
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+
+type testSetup struct {
+	ctx sdk.Context
+	k   keeper.Keeper
+	vm  *mockVM
+	ak  mockAccountKeeper
+	bk  mockBankKeeper
+
+	// routed are the messages dispatched to the test route
+	routed *[]sdk.Msg
+}
+
+func setupKeeper(t *testing.T) testSetup {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	routed := &[]sdk.Msg{}
+	router := baseapp.NewRouter()
+	router.AddRoute("TestMsg", func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		*routed = append(*routed, msg)
+		return &sdk.Result{Events: sdk.Events{sdk.NewEvent("routed")}.ToABCIEvents()}, nil
+	})
+
+	vm := newMockVM()
+	ak := mockAccountKeeper{accounts: make(map[string]authtypes.AccountI)}
+	bk := mockBankKeeper{balances: map[string]sdk.Coins{addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}}
+	k := keeper.NewKeeper(
+		codec.New(), key, ak, bk, vm, baseapp.NewMsgRouterService(router), baseapp.NewQueryRouter(),
+	)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test"}, false, log.NewNopLogger())
+	return testSetup{ctx: ctx, k: k, vm: vm, ak: ak, bk: bk, routed: routed}
+}
+
+func TestStoreCodeAndInstantiate(t *testing.T) {
+	s := setupKeeper(t)
+
+	codeID, err := s.k.StoreCode(s.ctx, addr1, []byte("code"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), codeID)
+
+	info, found := s.k.GetCodeInfo(s.ctx, codeID)
+	require.True(t, found)
+	require.Equal(t, addr1, info.Creator)
+
+	_, _, err = s.k.Instantiate(s.ctx, 2, addr1, nil, "label", []byte("init"), nil)
+	require.True(t, types.ErrCodeNotFound.Is(err))
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	contract, data, err := s.k.Instantiate(s.ctx, codeID, addr1, addr2, "label", []byte("init"), funds)
+	require.NoError(t, err)
+	require.Equal(t, types.ContractAddress(1), contract)
+	require.Equal(t, []byte("init"), data)
+
+	// the contract owns an account without public key, and the funds
+	acc, ok := s.ak.accounts[contract.String()].(*types.ContractAccount)
+	require.True(t, ok)
+	require.Equal(t, codeID, acc.CodeID)
+	require.Equal(t, addr2, acc.Admin)
+	require.Nil(t, acc.GetPubKey())
+	require.Equal(t, funds, s.bk.balances[contract.String()])
+
+	contractInfo, found := s.k.GetContractInfo(s.ctx, contract)
+	require.True(t, found)
+	require.Equal(t, types.NewContractInfo(contract, codeID, addr1, addr2, "label"), contractInfo)
+
+	// the response attributes are emitted with the contract address
+	var wasmEvent *sdk.Event
+	for i, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeWasm {
+			wasmEvent = &s.ctx.EventManager().Events()[i]
+		}
+	}
+	require.NotNil(t, wasmEvent)
+	require.Equal(t, []byte(contract.String()), wasmEvent.Attributes[0].Value)
+}
+
+func TestInstantiateFrontRun(t *testing.T) {
+	s := setupKeeper(t)
+
+	codeID, err := s.k.StoreCode(s.ctx, addr1, []byte("code"))
+	require.NoError(t, err)
+
+	// sending tokens to the next contract address does not prevent the
+	// instantiation of contracts, the address being skipped
+	next := types.ContractAddress(s.k.GetSequence(s.ctx, types.NextInstanceSeqKey))
+	s.ak.SetAccount(s.ctx, authtypes.NewBaseAccountWithAddress(next))
+
+	contract, _, err := s.k.Instantiate(s.ctx, codeID, addr1, nil, "", []byte("init"), nil)
+	require.NoError(t, err)
+	require.NotEqual(t, next, contract)
+
+	_, found := s.k.GetContractInfo(s.ctx, next)
+	require.False(t, found)
+	_, found = s.k.GetContractInfo(s.ctx, contract)
+	require.True(t, found)
+}
+
+func TestContractStoreIsolation(t *testing.T) {
+	s := setupKeeper(t)
+
+	codeID, err := s.k.StoreCode(s.ctx, addr1, []byte("code"))
+	require.NoError(t, err)
+
+	contract1, _, err := s.k.Instantiate(s.ctx, codeID, addr1, nil, "", []byte("one"), nil)
+	require.NoError(t, err)
+	contract2, _, err := s.k.Instantiate(s.ctx, codeID, addr1, nil, "", []byte("two"), nil)
+	require.NoError(t, err)
+
+	_, err = s.k.Execute(s.ctx, contract1, addr2, []byte("three"), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("three"), s.k.QueryRaw(s.ctx, contract1, []byte("last")))
+	require.Equal(t, []byte("two"), s.k.QueryRaw(s.ctx, contract2, []byte("last")))
+	require.Equal(t, []types.Model{{Key: []byte("last"), Value: []byte("two")}}, s.k.GetContractState(s.ctx, contract2))
+
+	res, err := s.k.QuerySmart(s.ctx, contract1, []byte("last"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("three"), res)
+
+	_, err = s.k.Execute(s.ctx, addr2, addr1, []byte("msg"), nil)
+	require.True(t, types.ErrContractNotFound.Is(err))
+}
+
+func TestVMGasBridge(t *testing.T) {
+	s := setupKeeper(t)
+
+	codeID, err := s.k.StoreCode(s.ctx, addr1, []byte("code"))
+	require.NoError(t, err)
+	contract, _, err := s.k.Instantiate(s.ctx, codeID, addr1, nil, "", []byte("init"), nil)
+	require.NoError(t, err)
+
+	// the VM gas limit is the remaining SDK gas times the multiplier, and the
+	// VM gas used is charged rounded up to the next SDK gas unit
+	ctx := s.ctx.WithGasMeter(sdk.NewGasMeter(100000))
+	s.vm.gasUsed = 1001
+
+	before := ctx.GasMeter().GasConsumed()
+	_, err = s.k.Execute(ctx, contract, addr1, []byte("msg"), nil)
+	require.NoError(t, err)
+	require.Zero(t, s.vm.gasLimit%types.DefaultGasMultiplier)
+	require.LessOrEqual(t, s.vm.gasLimit, (100000-before)*types.DefaultGasMultiplier)
+	require.LessOrEqual(t, uint64(11), ctx.GasMeter().GasConsumed()-before)
+
+	// a VM using more gas than the limit runs the SDK gas meter out of gas
+	ctx = s.ctx.WithGasMeter(sdk.NewGasMeter(5000))
+	s.vm.gasUsed = 5000*types.DefaultGasMultiplier + 1
+	require.Panics(t, func() { _, _ = s.k.Execute(ctx, contract, addr1, []byte("msg"), nil) })
+
+	// the gas of failing VM calls is charged as well
+	ctx = s.ctx.WithGasMeter(sdk.NewGasMeter(100000))
+	s.vm.gasUsed, s.vm.err = 200000, errors.New("vm failure")
+	_, err = s.k.Execute(ctx, contract, addr1, []byte("msg"), nil)
+	require.True(t, types.ErrExecuteFailed.Is(err))
+	require.LessOrEqual(t, uint64(2000), ctx.GasMeter().GasConsumed())
+
+	// the VM calls run with an infinite gas meter, e.g. the queries, are
+	// limited to the query gas limit
+	s.vm.gasUsed, s.vm.err = 0, nil
+	_, err = s.k.QuerySmart(s.ctx, contract, []byte("last"))
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultQueryGasLimit*types.DefaultGasMultiplier, s.vm.gasLimit)
+
+	_, err = s.k.WithQueryGasLimit(1000).QuerySmart(s.ctx, contract, []byte("last"))
+	require.NoError(t, err)
+	require.Equal(t, 1000*types.DefaultGasMultiplier, s.vm.gasLimit)
+	require.Panics(t, func() { s.k.WithQueryGasLimit(0) })
+}
+
+func TestResponseMessages(t *testing.T) {
+	s := setupKeeper(t)
+
+	codeID, err := s.k.StoreCode(s.ctx, addr1, []byte("code"))
+	require.NoError(t, err)
+	contract, _, err := s.k.Instantiate(s.ctx, codeID, addr1, nil, "", []byte("init"), nil)
+	require.NoError(t, err)
+
+	// messages signed by the contract are dispatched with the msg router
+	msg := sdk.NewTestMsg(contract)
+	s.vm.messages = []sdk.Msg{msg}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.k.Execute(ctx, contract, addr1, []byte("msg"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msg}, *s.routed)
+
+	var routed bool
+	for _, event := range ctx.EventManager().Events() {
+		routed = routed || event.Type == "routed"
+	}
+	require.True(t, routed)
+
+	// contracts cannot dispatch messages on behalf of other accounts
+	s.vm.messages = []sdk.Msg{sdk.NewTestMsg(addr1)}
+	_, err = s.k.Execute(s.ctx, contract, addr1, []byte("msg"), nil)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// nor messages without route
+	s.vm.messages = []sdk.Msg{types.NewMsgStoreCode(contract, []byte("code"))}
+	_, err = s.k.Execute(s.ctx, contract, addr1, []byte("msg"), nil)
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+}
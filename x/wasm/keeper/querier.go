@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/wasm/types"
+)
+
+// NewQuerier returns a wasm Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryCode:
+			return queryCode(ctx, req, k)
+
+		case types.QueryContract:
+			return queryContract(ctx, req, k)
+
+		case types.QueryContractSmart:
+			return queryContractSmart(ctx, req, k)
+
+		case types.QueryContractRaw:
+			return queryContractRaw(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryCode(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCodeParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	info, found := k.GetCodeInfo(ctx, params.CodeID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCodeNotFound, "%d", params.CodeID)
+	}
+
+	return marshalJSON(k.cdc, info)
+}
+
+func queryContract(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryContractParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	info, found := k.GetContractInfo(ctx, params.Contract)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrContractNotFound, "%s", params.Contract)
+	}
+
+	return marshalJSON(k.cdc, info)
+}
+
+// queryContractSmart returns the raw response of the contract, which is
+// usually JSON encoded by the contract itself.
+func queryContractSmart(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryContractParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return k.QuerySmart(ctx, params.Contract, params.Data)
+}
+
+func queryContractRaw(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryContractParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := k.GetContractInfo(ctx, params.Contract); !found {
+		return nil, sdkerrors.Wrapf(types.ErrContractNotFound, "%s", params.Contract)
+	}
+
+	return k.QueryRaw(ctx, params.Contract, params.Data), nil
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto"
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ authtypes.AccountI       = (*ContractAccount)(nil)
+	_ authtypes.GenesisAccount = (*ContractAccount)(nil)
+)
+
+// ContractAccount defines the account of a contract instance. It cannot have
+// a public key, so that only the contract, through the messages it returns,
+// can spend its funds.
+//
+// ContractAccount implements proto.Message through the protobuf tags of its
+// fields, so that the account keeper can pack it in an Any once registered as
+// an implementation of AccountI. The base account is not embedded, since the
+// generated proto methods of BaseAccount would otherwise be promoted and
+// marshal the base account only.
+type ContractAccount struct {
+	BaseAccount *authtypes.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3" json:"base_account,omitempty" yaml:"base_account"`
+	CodeID      uint64                 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	Admin       sdk.AccAddress         `protobuf:"bytes,3,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty" yaml:"admin"`
+}
+
+// NewContractAccount creates a new ContractAccount instance
+func NewContractAccount(ba *authtypes.BaseAccount, codeID uint64, admin sdk.AccAddress) *ContractAccount {
+	return &ContractAccount{BaseAccount: ba, CodeID: codeID, Admin: admin}
+}
+
+// Reset implements proto.Message.
+func (ca *ContractAccount) Reset() { *ca = ContractAccount{} }
+
+// ProtoMessage implements proto.Message.
+func (*ContractAccount) ProtoMessage() {}
+
+// GetAddress implements AccountI.
+func (ca ContractAccount) GetAddress() sdk.AccAddress { return ca.BaseAccount.GetAddress() }
+
+// SetAddress implements AccountI.
+func (ca *ContractAccount) SetAddress(addr sdk.AccAddress) error {
+	return ca.BaseAccount.SetAddress(addr)
+}
+
+// GetPubKey implements AccountI. Contract accounts have no public key.
+func (ca ContractAccount) GetPubKey() crypto.PubKey { return nil }
+
+// SetPubKey implements AccountI. Contract accounts cannot have a public key.
+func (ca *ContractAccount) SetPubKey(_ crypto.PubKey) error {
+	return fmt.Errorf("not supported for contract accounts")
+}
+
+// GetAccountNumber implements AccountI.
+func (ca ContractAccount) GetAccountNumber() uint64 { return ca.BaseAccount.GetAccountNumber() }
+
+// SetAccountNumber implements AccountI.
+func (ca *ContractAccount) SetAccountNumber(accNumber uint64) error {
+	return ca.BaseAccount.SetAccountNumber(accNumber)
+}
+
+// GetSequence implements AccountI.
+func (ca ContractAccount) GetSequence() uint64 { return ca.BaseAccount.GetSequence() }
+
+// SetSequence implements AccountI.
+func (ca *ContractAccount) SetSequence(seq uint64) error { return ca.BaseAccount.SetSequence(seq) }
+
+// Validate checks for errors on the account fields
+func (ca ContractAccount) Validate() error {
+	if ca.BaseAccount == nil {
+		return fmt.Errorf("contract account base account cannot be nil")
+	}
+
+	if ca.CodeID == 0 {
+		return fmt.Errorf("contract account %s code id cannot be zero", ca.BaseAccount.Address)
+	}
+
+	return ca.BaseAccount.Validate()
+}
+
+type contractAccountPretty struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+	CodeID        uint64         `json:"code_id" yaml:"code_id"`
+	Admin         sdk.AccAddress `json:"admin" yaml:"admin"`
+}
+
+func (ca ContractAccount) String() string {
+	out, _ := ca.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ContractAccount.
+func (ca ContractAccount) MarshalYAML() (interface{}, error) {
+	bs, err := yaml.Marshal(contractAccountPretty{
+		Address:       ca.BaseAccount.Address,
+		AccountNumber: ca.BaseAccount.AccountNumber,
+		Sequence:      ca.BaseAccount.Sequence,
+		CodeID:        ca.CodeID,
+		Admin:         ca.Admin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bs), nil
+}
+
+func init() {
+	proto.RegisterType((*ContractAccount)(nil), "cosmos_sdk.x.wasm.v1.ContractAccount")
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterCodec registers the necessary x/wasm interfaces and concrete types on
+// the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&ContractAccount{}, "cosmos-sdk/ContractAccount", nil)
+	cdc.RegisterConcrete(MsgStoreCode{}, "cosmos-sdk/MsgStoreCode", nil)
+	cdc.RegisterConcrete(MsgInstantiateContract{}, "cosmos-sdk/MsgInstantiateContract", nil)
+	cdc.RegisterConcrete(MsgExecuteContract{}, "cosmos-sdk/MsgExecuteContract", nil)
+}
+
+// RegisterInterfaces registers the contract account as an implementation of
+// AccountI, so that the account keeper can store it.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*authtypes.AccountI)(nil),
+		&ContractAccount{},
+	)
+}
+
+// ModuleCdc defines the amino codec used to encode the wasm module messages and
+// state.
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
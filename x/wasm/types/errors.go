@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/wasm module sentinel errors
+var (
+	ErrCreateFailed      = sdkerrors.Register(ModuleName, 2, "create wasm contract failed")
+	ErrInstantiateFailed = sdkerrors.Register(ModuleName, 3, "instantiate wasm contract failed")
+	ErrExecuteFailed     = sdkerrors.Register(ModuleName, 4, "execute wasm contract failed")
+	ErrQueryFailed       = sdkerrors.Register(ModuleName, 5, "query wasm contract failed")
+	ErrCodeNotFound      = sdkerrors.Register(ModuleName, 6, "code not found")
+	ErrContractNotFound  = sdkerrors.Register(ModuleName, 7, "contract not found")
+	ErrInvalid           = sdkerrors.Register(ModuleName, 8, "invalid value")
+	ErrNoVM              = sdkerrors.Register(ModuleName, 9, "no wasm vm")
+)
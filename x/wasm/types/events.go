@@ -0,0 +1,16 @@
+package types
+
+// wasm module event types
+const (
+	EventTypeStoreCode   = "store_code"
+	EventTypeInstantiate = "instantiate"
+	EventTypeExecute     = "execute"
+	// EventTypeWasm is the type of the events carrying the attributes returned
+	// by the contracts.
+	EventTypeWasm = "wasm"
+
+	AttributeKeyCodeID          = "code_id"
+	AttributeKeyContractAddress = "contract_address"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Code defines a contract code in the genesis state.
+type Code struct {
+	Info CodeInfo `json:"info" yaml:"info"`
+	Code []byte   `json:"code" yaml:"code"`
+}
+
+// Contract defines a contract instance and its state in the genesis state.
+type Contract struct {
+	Info  ContractInfo `json:"info" yaml:"info"`
+	State []Model      `json:"state" yaml:"state"`
+}
+
+// GenesisState defines the wasm module genesis state
+type GenesisState struct {
+	NextCodeID      uint64     `json:"next_code_id" yaml:"next_code_id"`
+	NextInstanceSeq uint64     `json:"next_instance_seq" yaml:"next_instance_seq"`
+	Codes           []Code     `json:"codes" yaml:"codes"`
+	Contracts       []Contract `json:"contracts" yaml:"contracts"`
+}
+
+// DefaultGenesisState returns the default genesis state, without any code.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextCodeID:      1,
+		NextInstanceSeq: 1,
+		Codes:           []Code{},
+		Contracts:       []Contract{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if gs.NextCodeID == 0 || gs.NextInstanceSeq == 0 {
+		return fmt.Errorf("next code id and instance sequence must be positive")
+	}
+
+	codes := make(map[uint64]bool)
+	for _, c := range gs.Codes {
+		if err := c.Info.Validate(); err != nil {
+			return err
+		}
+
+		if codes[c.Info.CodeID] || c.Info.CodeID >= gs.NextCodeID {
+			return fmt.Errorf("invalid or duplicate code id %d", c.Info.CodeID)
+		}
+
+		if len(c.Code) == 0 {
+			return fmt.Errorf("code %d cannot be empty", c.Info.CodeID)
+		}
+
+		codes[c.Info.CodeID] = true
+	}
+
+	contracts := make(map[string]bool)
+	for _, c := range gs.Contracts {
+		if err := c.Info.Validate(); err != nil {
+			return err
+		}
+
+		if !codes[c.Info.CodeID] {
+			return fmt.Errorf("contract %s code %d does not exist", c.Info.Address, c.Info.CodeID)
+		}
+
+		if contracts[c.Info.Address.String()] {
+			return fmt.Errorf("duplicate contract %s", c.Info.Address)
+		}
+
+		contracts[c.Info.Address.String()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "wasm"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for wasm store
+// Items are stored with the following key: values
+//
+// - 0x01<codeID_Bytes>: CodeInfo
+//
+// - 0x02<contract_Bytes>: ContractInfo
+//
+// - 0x03<contract_Len><contract_Bytes><key_Bytes>: contract state value
+//
+// - 0x04: nextCodeID
+//
+// - 0x05: nextInstanceSeq
+var (
+	CodeKeyPrefix          = []byte{0x01}
+	ContractKeyPrefix      = []byte{0x02}
+	ContractStoreKeyPrefix = []byte{0x03}
+	NextCodeIDKey          = []byte{0x04}
+	NextInstanceSeqKey     = []byte{0x05}
+)
+
+// CodeKey returns the key storing the info of a code.
+func CodeKey(codeID uint64) []byte {
+	return append(CodeKeyPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// ContractKey returns the key storing the info of a contract.
+func ContractKey(contract sdk.AccAddress) []byte {
+	return append(ContractKeyPrefix, contract.Bytes()...)
+}
+
+// ContractStorePrefix returns the prefix of the keys of the state of a
+// contract. The contracts only access their state through a prefix store, so
+// that a contract cannot read or write the state of another contract.
+func ContractStorePrefix(contract sdk.AccAddress) []byte {
+	return append(ContractStoreKeyPrefix, append([]byte{byte(len(contract))}, contract.Bytes()...)...)
+}
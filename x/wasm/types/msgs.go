@@ -0,0 +1,178 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// wasm message types
+const (
+	TypeMsgStoreCode           = "store_code"
+	TypeMsgInstantiateContract = "instantiate"
+	TypeMsgExecuteContract     = "execute"
+
+	// MaxCodeSize is the maximum size of a contract code, in bytes.
+	MaxCodeSize = 800 * 1024
+
+	// MaxLabelSize is the maximum size of a contract label, in bytes.
+	MaxLabelSize = 128
+)
+
+var (
+	_ sdk.Msg = MsgStoreCode{}
+	_ sdk.Msg = MsgInstantiateContract{}
+	_ sdk.Msg = MsgExecuteContract{}
+)
+
+// MsgStoreCode defines a message to store a contract code.
+type MsgStoreCode struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Code   []byte         `json:"code" yaml:"code"`
+}
+
+// NewMsgStoreCode creates a new MsgStoreCode instance
+func NewMsgStoreCode(sender sdk.AccAddress, code []byte) MsgStoreCode {
+	return MsgStoreCode{Sender: sender, Code: code}
+}
+
+// Route Implements Msg.
+func (msg MsgStoreCode) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgStoreCode) Type() string { return TypeMsgStoreCode }
+
+// ValidateBasic Implements Msg.
+func (msg MsgStoreCode) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if len(msg.Code) == 0 || len(msg.Code) > MaxCodeSize {
+		return sdkerrors.Wrapf(ErrInvalid, "code size must be between 1 and %d bytes", MaxCodeSize)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgStoreCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgStoreCode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgInstantiateContract defines a message to instantiate a contract of a
+// code, sending it funds from the sender.
+type MsgInstantiateContract struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Admin   sdk.AccAddress `json:"admin" yaml:"admin"`
+	CodeID  uint64         `json:"code_id" yaml:"code_id"`
+	Label   string         `json:"label" yaml:"label"`
+	InitMsg []byte         `json:"init_msg" yaml:"init_msg"`
+	Funds   sdk.Coins      `json:"funds" yaml:"funds"`
+}
+
+// NewMsgInstantiateContract creates a new MsgInstantiateContract instance
+func NewMsgInstantiateContract(
+	sender, admin sdk.AccAddress, codeID uint64, label string, initMsg []byte, funds sdk.Coins,
+) MsgInstantiateContract {
+	return MsgInstantiateContract{
+		Sender:  sender,
+		Admin:   admin,
+		CodeID:  codeID,
+		Label:   label,
+		InitMsg: initMsg,
+		Funds:   funds,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgInstantiateContract) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgInstantiateContract) Type() string { return TypeMsgInstantiateContract }
+
+// ValidateBasic Implements Msg.
+func (msg MsgInstantiateContract) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id cannot be zero")
+	}
+
+	if msg.Label == "" || len(msg.Label) > MaxLabelSize {
+		return sdkerrors.Wrapf(ErrInvalid, "label size must be between 1 and %d bytes", MaxLabelSize)
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Funds.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgInstantiateContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgExecuteContract defines a message to execute a contract, sending it
+// funds from the sender.
+type MsgExecuteContract struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Msg      []byte         `json:"msg" yaml:"msg"`
+	Funds    sdk.Coins      `json:"funds" yaml:"funds"`
+}
+
+// NewMsgExecuteContract creates a new MsgExecuteContract instance
+func NewMsgExecuteContract(sender, contract sdk.AccAddress, msg []byte, funds sdk.Coins) MsgExecuteContract {
+	return MsgExecuteContract{Sender: sender, Contract: contract, Msg: msg, Funds: funds}
+}
+
+// Route Implements Msg.
+func (msg MsgExecuteContract) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgExecuteContract) Type() string { return TypeMsgExecuteContract }
+
+// ValidateBasic Implements Msg.
+func (msg MsgExecuteContract) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing contract address")
+	}
+
+	if len(msg.Msg) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "msg cannot be empty")
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Funds.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgExecuteContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgExecuteContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
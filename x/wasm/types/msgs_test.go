@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"store", NewMsgStoreCode(addr, []byte("code")), false},
+		{"store no sender", NewMsgStoreCode(nil, []byte("code")), true},
+		{"store empty code", NewMsgStoreCode(addr, nil), true},
+		{"store code too large", NewMsgStoreCode(addr, make([]byte, MaxCodeSize+1)), true},
+		{"instantiate", NewMsgInstantiateContract(addr, nil, 1, "label", []byte("{}"), funds), false},
+		{"instantiate zero code id", NewMsgInstantiateContract(addr, nil, 0, "label", []byte("{}"), nil), true},
+		{"instantiate no label", NewMsgInstantiateContract(addr, nil, 1, "", []byte("{}"), nil), true},
+		{"instantiate invalid funds", NewMsgInstantiateContract(addr, nil, 1, "label", nil, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}), true},
+		{"execute", NewMsgExecuteContract(addr, addr, []byte("{}"), nil), false},
+		{"execute no contract", NewMsgExecuteContract(addr, nil, []byte("{}"), nil), true},
+		{"execute empty msg", NewMsgExecuteContract(addr, addr, nil, nil), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
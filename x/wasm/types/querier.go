@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier path constants
+const (
+	QueryCode          = "code"
+	QueryContract      = "contract"
+	QueryContractSmart = "contract_smart"
+	QueryContractRaw   = "contract_raw"
+)
+
+// QueryCodeParams defines the params for the following queries:
+//
+// - 'custom/wasm/code'
+type QueryCodeParams struct {
+	CodeID uint64 `json:"code_id" yaml:"code_id"`
+}
+
+// NewQueryCodeParams creates a new QueryCodeParams instance
+func NewQueryCodeParams(codeID uint64) QueryCodeParams {
+	return QueryCodeParams{CodeID: codeID}
+}
+
+// QueryContractParams defines the params for the following queries:
+//
+// - 'custom/wasm/contract'
+//
+// - 'custom/wasm/contract_smart'
+//
+// - 'custom/wasm/contract_raw'
+//
+// Data is the query message of smart queries, and the key of raw queries.
+type QueryContractParams struct {
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Data     []byte         `json:"data" yaml:"data"`
+}
+
+// NewQueryContractParams creates a new QueryContractParams instance
+func NewQueryContractParams(contract sdk.AccAddress, data []byte) QueryContractParams {
+	return QueryContractParams{Contract: contract, Data: data}
+}
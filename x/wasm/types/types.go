@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractAddress returns the address of the contract instance with the given
+// sequence number.
+func ContractAddress(seq uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/contract/%d", ModuleName, seq))))
+}
+
+// CodeInfo defines a contract code stored in the VM.
+type CodeInfo struct {
+	CodeID   uint64         `json:"code_id" yaml:"code_id"`
+	Creator  sdk.AccAddress `json:"creator" yaml:"creator"`
+	Checksum []byte         `json:"checksum" yaml:"checksum"`
+}
+
+// NewCodeInfo creates a new CodeInfo instance
+func NewCodeInfo(codeID uint64, creator sdk.AccAddress, checksum []byte) CodeInfo {
+	return CodeInfo{CodeID: codeID, Creator: creator, Checksum: checksum}
+}
+
+// Validate performs a basic validation of the CodeInfo.
+func (c CodeInfo) Validate() error {
+	if c.CodeID == 0 {
+		return fmt.Errorf("code id cannot be zero")
+	}
+
+	if c.Creator.Empty() {
+		return fmt.Errorf("code %d creator cannot be empty", c.CodeID)
+	}
+
+	if len(c.Checksum) == 0 {
+		return fmt.Errorf("code %d checksum cannot be empty", c.CodeID)
+	}
+
+	return nil
+}
+
+// ContractInfo defines a contract instance of a code.
+type ContractInfo struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	CodeID  uint64         `json:"code_id" yaml:"code_id"`
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	// Admin is informative for VMs supporting contract migrations; it may be
+	// empty.
+	Admin sdk.AccAddress `json:"admin" yaml:"admin"`
+	Label string         `json:"label" yaml:"label"`
+}
+
+// NewContractInfo creates a new ContractInfo instance
+func NewContractInfo(address sdk.AccAddress, codeID uint64, creator, admin sdk.AccAddress, label string) ContractInfo {
+	return ContractInfo{Address: address, CodeID: codeID, Creator: creator, Admin: admin, Label: label}
+}
+
+// Validate performs a basic validation of the ContractInfo.
+func (c ContractInfo) Validate() error {
+	if c.Address.Empty() {
+		return fmt.Errorf("contract address cannot be empty")
+	}
+
+	if c.CodeID == 0 {
+		return fmt.Errorf("contract %s code id cannot be zero", c.Address)
+	}
+
+	if c.Creator.Empty() {
+		return fmt.Errorf("contract %s creator cannot be empty", c.Address)
+	}
+
+	return nil
+}
+
+// Model defines a key/value pair of the state of a contract.
+type Model struct {
+	Key   []byte `json:"key" yaml:"key"`
+	Value []byte `json:"value" yaml:"value"`
+}
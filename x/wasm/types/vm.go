@@ -0,0 +1,113 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGasMultiplier is the default number of VM gas units per SDK gas unit.
+// VMs usually meter the execution of contract instructions in much smaller
+// units than the gas of the SDK store operations.
+const DefaultGasMultiplier uint64 = 100
+
+// DefaultQueryGasLimit is the default gas limit, in SDK gas units, of the VM
+// calls run without gas limit, i.e. with an infinite gas meter, e.g. the
+// queries and the genesis initialization.
+const DefaultQueryGasLimit uint64 = 3000000
+
+// VM defines the interface of a smart contract virtual machine, e.g. a
+// CosmWasm VM, through which the wasm keeper stores, instantiates, executes
+// and queries contracts.
+//
+// The VM is given a gas limit in VM gas units and returns the gas used by the
+// execution, which the keeper converts and consumes on the SDK gas meter. The
+// store passed to the VM is the prefix store of the contract state, backed by
+// the gas-metered store of the context, so that the store operations of the
+// contracts are charged as any other store operation.
+type VM interface {
+	// Create compiles and stores a contract code, and returns its checksum.
+	Create(code []byte) (checksum []byte, err error)
+
+	// GetCode returns a code stored by Create.
+	GetCode(checksum []byte) ([]byte, error)
+
+	// Instantiate runs the instantiation entry point of a contract.
+	Instantiate(
+		checksum []byte, env Env, initMsg []byte, store sdk.KVStore, querier Querier, gasLimit uint64,
+	) (res Response, gasUsed uint64, err error)
+
+	// Execute runs the execution entry point of a contract.
+	Execute(
+		checksum []byte, env Env, msg []byte, store sdk.KVStore, querier Querier, gasLimit uint64,
+	) (res Response, gasUsed uint64, err error)
+
+	// Query runs the query entry point of a contract. The store must not be
+	// written by queries.
+	Query(
+		checksum []byte, env Env, msg []byte, store sdk.KVStore, querier Querier, gasLimit uint64,
+	) (res []byte, gasUsed uint64, err error)
+}
+
+// Querier defines the interface through which contracts query the other
+// modules of the application, and other contracts.
+type Querier interface {
+	// Query runs a custom query of the application query router, e.g.
+	// "custom/bank/balance", with the given JSON params.
+	Query(path string, data []byte) ([]byte, error)
+
+	// GasConsumed returns the SDK gas consumed so far by the contract call,
+	// so that the VM can account for the gas of the queries.
+	GasConsumed() uint64
+}
+
+// Env defines the environment of a contract call.
+type Env struct {
+	ChainID     string         `json:"chain_id" yaml:"chain_id"`
+	BlockHeight int64          `json:"block_height" yaml:"block_height"`
+	BlockTime   time.Time      `json:"block_time" yaml:"block_time"`
+	Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+	// Sender and Funds are empty for queries.
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Funds  sdk.Coins      `json:"funds" yaml:"funds"`
+}
+
+// Response defines the result of the instantiation or execution of a
+// contract.
+type Response struct {
+	// Messages are dispatched by the keeper through the application message
+	// router, on behalf of the contract account, which must be their only
+	// signer.
+	Messages []sdk.Msg `json:"messages" yaml:"messages"`
+	// Attributes are emitted in an event of type EventTypeWasm.
+	Attributes []sdk.Attribute `json:"attributes" yaml:"attributes"`
+	Data       []byte          `json:"data" yaml:"data"`
+}
+
+var _ VM = NoVM{}
+
+// NoVM is a VM which runs no contract, all its methods failing with ErrNoVM.
+// It lets applications, e.g. simapp, register the wasm module without a VM
+// implementation, in which case no code can be stored.
+type NoVM struct{}
+
+// Create implements VM.
+func (NoVM) Create(_ []byte) ([]byte, error) { return nil, ErrNoVM }
+
+// GetCode implements VM.
+func (NoVM) GetCode(_ []byte) ([]byte, error) { return nil, ErrNoVM }
+
+// Instantiate implements VM.
+func (NoVM) Instantiate(_ []byte, _ Env, _ []byte, _ sdk.KVStore, _ Querier, _ uint64) (Response, uint64, error) {
+	return Response{}, 0, ErrNoVM
+}
+
+// Execute implements VM.
+func (NoVM) Execute(_ []byte, _ Env, _ []byte, _ sdk.KVStore, _ Querier, _ uint64) (Response, uint64, error) {
+	return Response{}, 0, ErrNoVM
+}
+
+// Query implements VM.
+func (NoVM) Query(_ []byte, _ Env, _ []byte, _ sdk.KVStore, _ Querier, _ uint64) ([]byte, uint64, error) {
+	return nil, 0, ErrNoVM
+}